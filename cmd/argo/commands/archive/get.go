@@ -13,6 +13,7 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	"github.com/argoproj/argo-workflows/v3/util/printer"
 )
 
 func NewGetCommand() *cobra.Command {
@@ -45,6 +46,8 @@ func NewGetCommand() *cobra.Command {
 					log.Fatal(err)
 				}
 				fmt.Println(string(output))
+			case "dot", "mermaid":
+				errors.CheckError(printer.PrintWorkflowGraph(wf, os.Stdout, output))
 			default:
 				const fmtStr = "%-20s %v\n"
 				fmt.Printf(fmtStr, "Name:", wf.ObjectMeta.Name)
@@ -71,6 +74,6 @@ func NewGetCommand() *cobra.Command {
 			}
 		},
 	}
-	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|dot|mermaid")
 	return command
 }
@@ -0,0 +1,53 @@
+package archive
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+)
+
+func NewImportCommand() *cobra.Command {
+	var key string
+	command := &cobra.Command{
+		Use:   "import [DATE]",
+		Short: "import workflows exported to cold storage back into the archive",
+		Long: `Import workflows exported to cold storage back into the archive, so they can be investigated.
+
+Imported workflows have already expired, so they are deleted by the next archived workflow garbage collection, but they are not exported again.`,
+		Example: `# Import all workflows that finished on a date:
+
+  argo archive import 2021-03-04
+
+# Import a single exported object:
+
+  argo archive import --key archived-workflows/2021-03-04/default-1614902400000000000.jsonl.gz
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &workflowarchivepkg.ImportArchivedWorkflowsRequest{Key: key}
+			switch {
+			case len(args) == 1 && key == "":
+				req.Date = args[0]
+			case len(args) == 0 && key != "":
+			default:
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			resp, err := serviceClient.ImportArchivedWorkflows(ctx, req)
+			errors.CheckError(err)
+			for _, key := range resp.Keys {
+				fmt.Printf("Imported '%s'\n", key)
+			}
+			fmt.Printf("%d archived workflows imported\n", len(resp.Uids))
+		},
+	}
+	command.Flags().StringVar(&key, "key", "", "import a single exported object, rather than all objects for a date")
+	return command
+}
@@ -0,0 +1,73 @@
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func NewResubmitCommand() *cobra.Command {
+	var (
+		memoized bool
+		output   string
+	)
+	command := &cobra.Command{
+		Use:   "resubmit UID...",
+		Short: "resubmit one or more workflows from the archive",
+		Example: `# Resubmit an archived workflow:
+
+  argo archive resubmit 7e5cb5c2-6d5b-4b0b-9d5e-0e1a2b3c4d5e
+
+# Resubmit an archived workflow using memoized results:
+
+  argo archive resubmit --memoized 7e5cb5c2-6d5b-4b0b-9d5e-0e1a2b3c4d5e
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			namespace := client.Namespace()
+			for _, uid := range args {
+				wf, err := serviceClient.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{
+					Uid:       uid,
+					Namespace: namespace,
+					Memoized:  memoized,
+				})
+				errors.CheckError(err)
+				printWorkflow(wf, output, fmt.Sprintf("Archived workflow '%s' resubmitted as '%s'", uid, wf.Name))
+			}
+		},
+	}
+	command.Flags().BoolVar(&memoized, "memoized", false, "re-use successful steps & outputs from the previous run")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: name|json|yaml")
+	return command
+}
+
+func printWorkflow(wf *wfv1.Workflow, output string, message string) {
+	switch output {
+	case "name":
+		fmt.Println(wf.Name)
+	case "json":
+		output, err := json.Marshal(wf)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(output))
+	case "yaml":
+		output, err := yaml.Marshal(wf)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(output))
+	default:
+		fmt.Println(message)
+	}
+}
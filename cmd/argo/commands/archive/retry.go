@@ -0,0 +1,60 @@
+package archive
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+)
+
+func NewRetryCommand() *cobra.Command {
+	var (
+		nodeFieldSelector string
+		restartSuccessful bool
+		output            string
+	)
+	command := &cobra.Command{
+		Use:   "retry UID...",
+		Short: "retry one or more workflows from the archive",
+		Long:  "Retry one or more workflows from the archive. The workflow is re-created with its failed nodes reset, so it must no longer exist in the cluster.",
+		Example: `# Retry an archived workflow:
+
+  argo archive retry 7e5cb5c2-6d5b-4b0b-9d5e-0e1a2b3c4d5e
+
+# Retry an archived workflow, also restarting successful nodes matching a selector:
+
+  argo archive retry --restart-successful --node-field-selector templateName=my-template 7e5cb5c2-6d5b-4b0b-9d5e-0e1a2b3c4d5e
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			namespace := client.Namespace()
+
+			selector, err := fields.ParseSelector(nodeFieldSelector)
+			if err != nil {
+				log.Fatalf("Unable to parse node field selector '%s': %s", nodeFieldSelector, err)
+			}
+
+			for _, uid := range args {
+				wf, err := serviceClient.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{
+					Uid:               uid,
+					Namespace:         namespace,
+					RestartSuccessful: restartSuccessful,
+					NodeFieldSelector: selector.String(),
+				})
+				errors.CheckError(err)
+				printWorkflow(wf, output, fmt.Sprintf("Archived workflow '%s' retried as '%s'", uid, wf.Name))
+			}
+		},
+	}
+	command.Flags().BoolVar(&restartSuccessful, "restart-successful", false, "indicates to restart successful nodes matching the --node-field-selector")
+	command.Flags().StringVar(&nodeFieldSelector, "node-field-selector", "", "selector of nodes to reset, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: name|json|yaml")
+	return command
+}
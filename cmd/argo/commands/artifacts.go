@@ -0,0 +1,175 @@
+package commands
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+)
+
+// artifactDownloader downloads the output artifacts of workflows
+type artifactDownloader interface {
+	// download saves the named output artifact of the node to the path, as it is stored in the artifact repository,
+	// i.e. a tgz archive unless archiving was disabled
+	download(ctx context.Context, wf *wfv1.Workflow, nodeID, artifactName, path string) error
+}
+
+// newArtifactDownloader returns a downloader that uses the Argo Server if the CLI is configured to, or otherwise
+// downloads directly from the artifact repository. Archived workflows can only be downloaded from the Argo Server.
+func newArtifactDownloader(archived bool, controllerNamespace string) (artifactDownloader, error) {
+	opts := client.ArgoServerOpts()
+	if opts.URL != "" {
+		return &argoServerArtifactDownloader{
+			baseURL:       opts.GetURL(),
+			authorization: client.GetAuthString(),
+			archived:      archived,
+			httpClient: &http.Client{Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: opts.InsecureSkipVerify},
+			}},
+		}, nil
+	}
+	if archived {
+		return nil, fmt.Errorf("downloading artifacts of archived workflows requires the Argo Server")
+	}
+	restConfig, err := client.GetConfig().ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &kubeArtifactDownloader{kubeClient: kubeClient, controllerNamespace: controllerNamespace}, nil
+}
+
+type argoServerArtifactDownloader struct {
+	baseURL       string
+	authorization string
+	archived      bool
+	httpClient    *http.Client
+}
+
+func (d *argoServerArtifactDownloader) download(ctx context.Context, wf *wfv1.Workflow, nodeID, artifactName, path string) error {
+	u := fmt.Sprintf("%s/artifacts/%s/%s/%s/%s", d.baseURL, url.PathEscape(wf.Namespace), url.PathEscape(wf.Name), url.PathEscape(nodeID), url.PathEscape(artifactName))
+	if d.archived {
+		u = fmt.Sprintf("%s/artifacts-by-uid/%s/%s/%s", d.baseURL, url.PathEscape(string(wf.UID)), url.PathEscape(nodeID), url.PathEscape(artifactName))
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", d.authorization)
+	log.Debugf("curl -H 'Authorization: ******' '%v'", u)
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		message, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("failed to download artifact %q of node %q: %s: %s", artifactName, nodeID, resp.Status, message)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, resp.Body)
+	closeErr := f.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+type kubeArtifactDownloader struct {
+	kubeClient          kubernetes.Interface
+	controllerNamespace string
+	repositories        artifactrepositories.Interface
+}
+
+func (d *kubeArtifactDownloader) download(ctx context.Context, wf *wfv1.Workflow, nodeID, artifactName, path string) error {
+	found := wf.Status.Nodes[nodeID].Outputs.GetArtifactByName(artifactName)
+	if found == nil {
+		return fmt.Errorf("artifact %q of node %q not found", artifactName, nodeID)
+	}
+	// copy the artifact, so relocating it does not change the workflow
+	art := found.DeepCopy()
+	if !art.HasLocation() {
+		repository, err := d.artifactRepository(ctx, wf)
+		if err != nil {
+			return err
+		}
+		if err := art.Relocate(repository.ToArtifactLocation()); err != nil {
+			return err
+		}
+	}
+	driver, err := artifact.NewDriver(ctx, art, kubeResources{d.kubeClient, wf.Namespace})
+	if err != nil {
+		return err
+	}
+	return driver.Load(art, path)
+}
+
+// artifactRepository returns the repository the workflow's artifacts were saved to
+func (d *kubeArtifactDownloader) artifactRepository(ctx context.Context, wf *wfv1.Workflow) (*config.ArtifactRepository, error) {
+	if d.repositories == nil {
+		// the default artifact repository is configured in the workflow-controller's config map
+		c, err := config.NewController(d.controllerNamespace, "workflow-controller-configmap", d.kubeClient, config.EmptyConfigFunc).Get(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the workflow-controller's config map: %w", err)
+		}
+		d.repositories = artifactrepositories.New(d.kubeClient, d.controllerNamespace, &c.(*config.Config).ArtifactRepository)
+	}
+	ref := wf.Status.ArtifactRepositoryRef
+	if ref == nil {
+		var err error
+		ref, err = d.repositories.Resolve(ctx, wf.Spec.ArtifactRepositoryRef, wf.Namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+	repository, err := d.repositories.Get(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if repository == nil {
+		return nil, fmt.Errorf("no artifact repository configured")
+	}
+	return repository, nil
+}
+
+type kubeResources struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+func (r kubeResources) GetSecret(ctx context.Context, name, key string) (string, error) {
+	secret, err := r.kubeClient.CoreV1().Secrets(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+func (r kubeResources) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	configMap, err := r.kubeClient.CoreV1().ConfigMaps(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.Data[key], nil
+}
@@ -10,9 +10,9 @@ import (
 )
 
 func NewTokenCommand() *cobra.Command {
-	return &cobra.Command{
+	command := &cobra.Command{
 		Use:   "token",
-		Short: "Print the auth token",
+		Short: "Print the auth token, or manage personal access tokens",
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) != 0 {
 				cmd.HelpFunc()(cmd, args)
@@ -21,4 +21,8 @@ func NewTokenCommand() *cobra.Command {
 			fmt.Println(client.GetAuthString())
 		},
 	}
+	command.AddCommand(NewTokenCreateCommand())
+	command.AddCommand(NewTokenListCommand())
+	command.AddCommand(NewTokenRevokeCommand())
+	return command
 }
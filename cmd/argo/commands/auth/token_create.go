@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+)
+
+func NewTokenCreateCommand() *cobra.Command {
+	var expiry time.Duration
+	command := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create a personal access token",
+		Long: `Create a personal access token that carries your SSO identity, e.g. for use by CI systems.
+
+The Argo Server must be running with "--auth-mode token", and you must be logged in using SSO.
+The token is only printed once, use it as "Authorization: Bearer <token>", or by setting ARGO_TOKEN="Bearer <token>".`,
+		Example: `# Create a token that is valid for 90 days:
+
+  argo auth token create my-ci --expiry 2160h
+`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewInfoServiceClient()
+			errors.CheckError(err)
+			resp, err := serviceClient.CreateToken(ctx, &infopkg.CreateTokenRequest{
+				Name:   args[0],
+				Expiry: &metav1.Duration{Duration: expiry},
+			})
+			errors.CheckError(err)
+			fmt.Printf("Token %s expires at %s:\n", resp.Token.Name, resp.Token.ExpiresAt.Format(time.RFC3339))
+			fmt.Println(resp.Value)
+		},
+	}
+	command.Flags().DurationVar(&expiry, "expiry", 0, "how long the token is valid for, defaults to 30 days or the server's maximum if that is less")
+	return command
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+)
+
+func NewTokenListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List your personal access tokens",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewInfoServiceClient()
+			errors.CheckError(err)
+			list, err := serviceClient.ListTokens(ctx, &infopkg.ListTokensRequest{})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			_, _ = fmt.Fprintln(w, "NAME\tAGE\tEXPIRES")
+			now := time.Now()
+			for _, t := range list.Items {
+				expires := humanize.RelativeDurationShort(now, t.ExpiresAt.Time)
+				if t.ExpiresAt.Time.Before(now) {
+					expires = "expired"
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", t.Name, humanize.RelativeDurationShort(t.CreatedAt.Time, now), expires)
+			}
+			_ = w.Flush()
+		},
+	}
+}
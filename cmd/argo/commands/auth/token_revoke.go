@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+)
+
+func NewTokenRevokeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke NAME...",
+		Short: "Revoke personal access tokens",
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewInfoServiceClient()
+			errors.CheckError(err)
+			for _, name := range args {
+				_, err := serviceClient.RevokeToken(ctx, &infopkg.RevokeTokenRequest{Name: name})
+				errors.CheckError(err)
+				fmt.Printf("Token %s revoked\n", name)
+			}
+		},
+	}
+}
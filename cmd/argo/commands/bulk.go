@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+)
+
+// bulkFlags are the flags for acting on the workflows matching a selector, rather than on named workflows
+type bulkFlags struct {
+	labels      string // --selector
+	fields      string // --field-selector
+	dryRun      bool   // --dry-run
+	concurrency int32  // --concurrency
+}
+
+func (f *bulkFlags) addFlags(command *cobra.Command) {
+	command.Flags().StringVarP(&f.labels, "selector", "l", "", "Selector (label query) of the workflows to act on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	command.Flags().StringVar(&f.fields, "field-selector", "", "Selector (field query) of the workflows to act on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
+	command.Flags().BoolVar(&f.dryRun, "dry-run", false, "When used with a selector, only print the workflows that would be acted on")
+	command.Flags().Int32Var(&f.concurrency, "concurrency", 10, "When used with a selector, the maximum number of workflows to act on at the same time")
+}
+
+func (f bulkFlags) enabled() bool {
+	return f.labels != "" || f.fields != ""
+}
+
+// runBulkAction acts on the workflows matching the selectors, exiting non-zero if the action failed for any workflow
+func runBulkAction(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, args []string, f bulkFlags, req *workflowpkg.WorkflowBulkActionRequest, done string) {
+	if len(args) > 0 {
+		log.Fatalf("Cannot specify both workflow names and a selector")
+	}
+	req.ListOptions = &metav1.ListOptions{LabelSelector: f.labels, FieldSelector: f.fields}
+	req.DryRun = f.dryRun
+	req.Concurrency = f.concurrency
+	res, err := serviceClient.BulkWorkflowAction(ctx, req)
+	errors.CheckError(err)
+	if printBulkActionResults(os.Stdout, res, req.DryRun, done) > 0 {
+		os.Exit(1)
+	}
+}
+
+// printBulkActionResults prints the results, returning the number of failures
+func printBulkActionResults(w io.Writer, res *workflowpkg.WorkflowBulkActionResponse, dryRun bool, done string) int {
+	failures := 0
+	for _, r := range res.Results {
+		switch {
+		case dryRun:
+			_, _ = fmt.Fprintf(w, "workflow %s would be %s (dry-run)\n", r.Name, done)
+		case r.Error != "":
+			failures++
+			_, _ = fmt.Fprintf(w, "workflow %s failed: %s\n", r.Name, r.Error)
+		case r.CreatedName != "":
+			_, _ = fmt.Fprintf(w, "workflow %s %s as %s\n", r.Name, done, r.CreatedName)
+		default:
+			_, _ = fmt.Fprintf(w, "workflow %s %s\n", r.Name, done)
+		}
+	}
+	if len(res.Results) == 0 {
+		_, _ = fmt.Fprintln(w, "No workflows found")
+	}
+	return failures
+}
@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+)
+
+func Test_printBulkActionResults(t *testing.T) {
+	res := &workflowpkg.WorkflowBulkActionResponse{Results: []*workflowpkg.WorkflowBulkActionResult{
+		{Namespace: "my-ns", Name: "my-wf"},
+		{Namespace: "my-ns", Name: "my-other-wf", CreatedName: "my-other-wf-2"},
+		{Namespace: "my-ns", Name: "my-failed-wf", Error: "boom"},
+	}}
+	t.Run("DryRun", func(t *testing.T) {
+		w := &bytes.Buffer{}
+		assert.Zero(t, printBulkActionResults(w, res, true, "resubmitted"))
+		assert.Equal(t, `workflow my-wf would be resubmitted (dry-run)
+workflow my-other-wf would be resubmitted (dry-run)
+workflow my-failed-wf would be resubmitted (dry-run)
+`, w.String())
+	})
+	t.Run("Results", func(t *testing.T) {
+		w := &bytes.Buffer{}
+		assert.Equal(t, 1, printBulkActionResults(w, res, false, "resubmitted"))
+		assert.Equal(t, `workflow my-wf resubmitted
+workflow my-other-wf resubmitted as my-other-wf-2
+workflow my-failed-wf failed: boom
+`, w.String())
+	})
+	t.Run("None", func(t *testing.T) {
+		w := &bytes.Buffer{}
+		assert.Zero(t, printBulkActionResults(w, &workflowpkg.WorkflowBulkActionResponse{}, false, "stopped"))
+		assert.Equal(t, "No workflows found\n", w.String())
+	})
+}
@@ -65,6 +65,11 @@ func NewAPIClient() (context.Context, apiclient.Client) {
 	return ctx, client
 }
 
+// ArgoServerOpts returns the options used to connect to the Argo Server. The URL is empty if the Argo Server is not used.
+func ArgoServerOpts() apiclient.ArgoServerOpts {
+	return argoServerOpts
+}
+
 func Namespace() string {
 	if overrides.Context.Namespace != "" {
 		return overrides.Context.Namespace
@@ -1,7 +1,9 @@
 package clustertemplate
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -13,6 +15,7 @@ import (
 func NewLintCommand() *cobra.Command {
 	var (
 		strict bool
+		rules  []string
 		output string
 	)
 
@@ -25,11 +28,12 @@ func NewLintCommand() *cobra.Command {
 				os.Exit(1)
 			}
 			ctx, apiClient := client.NewAPIClient()
-			lint.RunLint(ctx, apiClient, args, []string{wf.ClusterWorkflowTemplatePlural}, client.Namespace(), output, strict)
+			lint.RunLint(ctx, apiClient, args, []string{wf.ClusterWorkflowTemplatePlural}, rules, client.Namespace(), output, strict)
 		},
 	}
 
-	command.Flags().StringVarP(&output, "output", "o", "pretty", "Linting results output format. One of: pretty|simple")
+	command.Flags().StringSliceVar(&rules, "rules", []string{"all"}, fmt.Sprintf("Which rules will be checked, in addition to validation. Can be: all|%s", strings.Join(lint.GetRuleIDs(), "|")))
+	command.Flags().StringVarP(&output, "output", "o", "pretty", "Linting results output format. One of: pretty|simple|json|sarif")
 	command.Flags().BoolVar(&strict, "strict", true, "perform strict workflow validation")
 	return command
 }
@@ -0,0 +1,170 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/archive"
+)
+
+var uidRegexp = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+type artifactFilter struct {
+	nodeID       string
+	templateName string
+	artifactName string
+}
+
+func (f artifactFilter) matches(node wfv1.NodeStatus, art wfv1.Artifact) bool {
+	templateName := node.TemplateName
+	if node.TemplateRef != nil {
+		templateName = node.TemplateRef.Template
+	}
+	return (f.nodeID == "" || f.nodeID == node.ID) &&
+		(f.templateName == "" || f.templateName == templateName) &&
+		(f.artifactName == "" || f.artifactName == art.Name)
+}
+
+func NewCpCommand() *cobra.Command {
+	var (
+		filter              artifactFilter
+		controllerNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "cp WORKFLOW|UID DIR",
+		Short: "copy the output artifacts of a workflow to a local directory",
+		Long: `Copy the output artifacts of a workflow to a local directory.
+
+Each artifact is copied to DIR/NODE_ID/ARTIFACT_NAME, and tgz archives are unpacked.
+Artifacts of archived workflows are copied if a UID is specified instead of a name, which requires the Argo Server.
+Without the Argo Server, the artifacts are downloaded directly from the artifact repository.`,
+		Example: `# Copy all the artifacts of a workflow:
+
+  argo cp my-wf ./artifacts
+
+# Copy the "notebook" artifacts of the "analyse" template:
+
+  argo cp my-wf ./artifacts --template-name analyse --artifact-name notebook
+
+# Copy the artifacts of an archived workflow:
+
+  argo cp 0f8b64fe-8d4c-4c5a-9d5f-8c0f1c4d7a01 ./artifacts
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			wf, archived, err := getWorkflowOrArchivedWorkflow(ctx, apiClient, args[0])
+			errors.CheckError(err)
+			downloader, err := newArtifactDownloader(archived, controllerNamespace)
+			errors.CheckError(err)
+			err = copyArtifacts(ctx, downloader, wf, filter, args[1], os.Stdout)
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().StringVar(&filter.nodeID, "node-id", "", "only copy the artifacts of the node with this ID")
+	command.Flags().StringVar(&filter.templateName, "template-name", "", "only copy the artifacts of nodes of this template")
+	command.Flags().StringVar(&filter.artifactName, "artifact-name", "", "only copy the artifacts with this name")
+	command.Flags().StringVar(&controllerNamespace, "controller-namespace", "argo", "the namespace of the workflow-controller, used to find the default artifact repository if the Argo Server is not used")
+	return command
+}
+
+// getWorkflowOrArchivedWorkflow returns the named workflow, or the archived workflow if a UID is specified
+func getWorkflowOrArchivedWorkflow(ctx context.Context, apiClient apiclient.Client, nameOrUID string) (*wfv1.Workflow, bool, error) {
+	if uidRegexp.MatchString(nameOrUID) {
+		serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+		if err != nil {
+			return nil, false, err
+		}
+		wf, err := serviceClient.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: nameOrUID})
+		return wf, true, err
+	}
+	wf, err := apiClient.NewWorkflowServiceClient().GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: nameOrUID, Namespace: client.Namespace()})
+	return wf, false, err
+}
+
+func copyArtifacts(ctx context.Context, downloader artifactDownloader, wf *wfv1.Workflow, filter artifactFilter, dest string, out io.Writer) error {
+	var nodeIDs []string
+	for id := range wf.Status.Nodes {
+		nodeIDs = append(nodeIDs, id)
+	}
+	sort.Strings(nodeIDs)
+	copied := 0
+	for _, id := range nodeIDs {
+		node := wf.Status.Nodes[id]
+		if node.Outputs == nil {
+			continue
+		}
+		for _, art := range node.Outputs.Artifacts {
+			if !filter.matches(node, art) {
+				continue
+			}
+			dir := filepath.Join(dest, id, art.Name)
+			if err := copyArtifact(ctx, downloader, wf, id, art, dir); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(out, "%s/%s -> %s\n", node.DisplayName, art.Name, dir)
+			copied++
+		}
+	}
+	if copied == 0 {
+		return fmt.Errorf("no artifacts found")
+	}
+	return nil
+}
+
+// copyArtifact downloads the artifact into the directory, unpacking it if it is a tgz archive
+func copyArtifact(ctx context.Context, downloader artifactDownloader, wf *wfv1.Workflow, nodeID string, art wfv1.Artifact, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempDir(filepath.Dir(dir), ".download-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+	downloaded := filepath.Join(tmp, art.Name)
+	if err := downloader.download(ctx, wf, nodeID, art.Name, downloaded); err != nil {
+		return err
+	}
+	key, _ := art.GetKey()
+	info, err := os.Stat(downloaded)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() && (strings.HasSuffix(key, ".tgz") || strings.HasSuffix(key, ".tar.gz")) {
+		f, err := os.Open(downloaded)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		return archive.UntarGz(f, dir)
+	}
+	name := path.Base(key)
+	if key == "" || name == "/" || name == "." {
+		name = art.Name
+	}
+	target := filepath.Join(dir, name)
+	if err := os.RemoveAll(target); err != nil {
+		return err
+	}
+	return os.Rename(downloaded, target)
+}
@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/archive"
+)
+
+// testArtifactDownloader downloads the contents of a local directory, archived unless the key is not a tgz
+type testArtifactDownloader struct{ src string }
+
+func (d testArtifactDownloader) download(_ context.Context, wf *wfv1.Workflow, nodeID, artifactName, path string) error {
+	art := wf.Status.Nodes[nodeID].Outputs.GetArtifactByName(artifactName)
+	if art.S3.Key == "result.txt" {
+		return ioutil.WriteFile(path, []byte("raw"), 0o600)
+	}
+	buf := &bytes.Buffer{}
+	if err := archive.TarGzToWriter(d.src, gzip.DefaultCompression, buf); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, buf.Bytes(), 0o600)
+}
+
+func TestCopyArtifacts(t *testing.T) {
+	src, err := ioutil.TempDir("", "argo-test")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(src) }()
+	assert.NoError(t, os.MkdirAll(filepath.Join(src, "notebook"), 0o755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(src, "notebook", "out.ipynb"), []byte("{}"), 0o600))
+
+	s3 := func(key string) wfv1.ArtifactLocation {
+		return wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: key}}
+	}
+	wf := &wfv1.Workflow{Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+		"my-wf-1": {ID: "my-wf-1", TemplateName: "analyse", Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+			{Name: "notebook", ArtifactLocation: s3("my-wf/my-wf-1/notebook.tgz")},
+			{Name: "result", ArtifactLocation: s3("result.txt")},
+		}}},
+		"my-wf-2": {ID: "my-wf-2", TemplateName: "report", Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+			{Name: "notebook", ArtifactLocation: s3("my-wf/my-wf-2/notebook.tgz")},
+		}}},
+	}}}
+	downloader := testArtifactDownloader{filepath.Join(src, "notebook")}
+
+	t.Run("All", func(t *testing.T) {
+		dest := filepath.Join(src, "all")
+		out := &bytes.Buffer{}
+		if assert.NoError(t, copyArtifacts(context.Background(), downloader, wf, artifactFilter{}, dest, out)) {
+			assert.FileExists(t, filepath.Join(dest, "my-wf-1", "notebook", "notebook", "out.ipynb"))
+			assert.FileExists(t, filepath.Join(dest, "my-wf-1", "result", "result.txt"))
+			assert.FileExists(t, filepath.Join(dest, "my-wf-2", "notebook", "notebook", "out.ipynb"))
+			assert.Equal(t, 3, bytes.Count(out.Bytes(), []byte("\n")))
+		}
+	})
+	t.Run("Filtered", func(t *testing.T) {
+		dest := filepath.Join(src, "filtered")
+		if assert.NoError(t, copyArtifacts(context.Background(), downloader, wf, artifactFilter{templateName: "analyse", artifactName: "notebook"}, dest, ioutil.Discard)) {
+			assert.FileExists(t, filepath.Join(dest, "my-wf-1", "notebook", "notebook", "out.ipynb"))
+			assert.NoDirExists(t, filepath.Join(dest, "my-wf-1", "result"))
+			assert.NoDirExists(t, filepath.Join(dest, "my-wf-2"))
+		}
+	})
+	t.Run("NoneFound", func(t *testing.T) {
+		err := copyArtifacts(context.Background(), downloader, wf, artifactFilter{nodeID: "my-wf-3"}, filepath.Join(src, "none"), ioutil.Discard)
+		assert.EqualError(t, err, "no artifacts found")
+	})
+}
+
+func TestArgoServerArtifactDownloader(t *testing.T) {
+	var requested, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		authorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("my-data"))
+	}))
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "argo-test")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "my-uid"}}
+	d := &argoServerArtifactDownloader{baseURL: server.URL, authorization: "Bearer my-token", httpClient: server.Client()}
+	if assert.NoError(t, d.download(context.Background(), wf, "my-node", "my-art", filepath.Join(dir, "a"))) {
+		assert.Equal(t, "/artifacts/my-ns/my-wf/my-node/my-art", requested)
+		assert.Equal(t, "Bearer my-token", authorization)
+		data, err := ioutil.ReadFile(filepath.Join(dir, "a"))
+		assert.NoError(t, err)
+		assert.Equal(t, "my-data", string(data))
+	}
+	d.archived = true
+	if assert.NoError(t, d.download(context.Background(), wf, "my-node", "my-art", filepath.Join(dir, "b"))) {
+		assert.Equal(t, "/artifacts-by-uid/my-uid/my-node/my-art", requested)
+	}
+}
@@ -0,0 +1,82 @@
+package cron
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// NewBackfillCommand returns a new instance of an `argo cron backfill` command
+func NewBackfillCommand() *cobra.Command {
+	var (
+		from        string
+		to          string
+		maxParallel int32
+		parameter   string
+	)
+	command := &cobra.Command{
+		Use:   "backfill CRON_WORKFLOW",
+		Short: "run a cron workflow for each time it was scheduled at in a range",
+		Long: `Run a cron workflow for each time it was scheduled at in a range, e.g. to process historical partitions.
+
+The workflow-controller runs the workflows, honouring the concurrency policy, and the scheduled time is available to each workflow as {{workflow.scheduledTime}}.`,
+		Example: `# Run the cron workflow for each time it was scheduled at in January:
+  argo cron backfill my-cron --from 2021-01-01 --to 2021-01-31T23:59:59Z
+
+# Run up to 3 workflows at once, passing the scheduled time as the "date" parameter:
+  argo cron backfill my-cron --from 2021-01-01 --to 2021-01-31T23:59:59Z --max-parallel 3 --parameter date
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			fromTime, err := parseTime(from)
+			errors.CheckError(err)
+			toTime, err := parseTime(to)
+			errors.CheckError(err)
+
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient := apiClient.NewCronWorkflowServiceClient()
+			_, err = serviceClient.BackfillCronWorkflow(ctx, &cronworkflowpkg.CronWorkflowBackfillRequest{
+				Name:      args[0],
+				Namespace: client.Namespace(),
+				Backfill: &wfv1.CronWorkflowBackfill{
+					From:        metav1.NewTime(fromTime),
+					To:          metav1.NewTime(toTime),
+					MaxParallel: maxParallel,
+					Parameter:   parameter,
+				},
+			})
+			errors.CheckError(err)
+			fmt.Printf("CronWorkflow '%s' backfill from %s to %s started\n", args[0], fromTime.Format(time.RFC3339), toTime.Format(time.RFC3339))
+		},
+	}
+	command.Flags().StringVar(&from, "from", "", "The start of the range, inclusive, as RFC3339 or a date, e.g. 2021-01-01")
+	command.Flags().StringVar(&to, "to", "", "The end of the range, inclusive, as RFC3339 or a date, e.g. 2021-01-31")
+	command.Flags().Int32Var(&maxParallel, "max-parallel", 1, "The maximum number of backfill workflows to run at once")
+	command.Flags().StringVar(&parameter, "parameter", "", "The name of a workflow parameter to pass the scheduled time as, in RFC3339 format")
+	errors.CheckError(command.MarkFlagRequired("from"))
+	errors.CheckError(command.MarkFlagRequired("to"))
+	return command
+}
+
+// parseTime parses either a RFC3339 time, or a date, which is midnight UTC
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a RFC3339 time or a date", value)
+	}
+	return t, nil
+}
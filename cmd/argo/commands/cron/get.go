@@ -72,7 +72,7 @@ func getCronWorkflowGet(cwf *wfv1.CronWorkflow) string {
 	out += fmt.Sprintf(fmtStr, "Name:", cwf.ObjectMeta.Name)
 	out += fmt.Sprintf(fmtStr, "Namespace:", cwf.ObjectMeta.Namespace)
 	out += fmt.Sprintf(fmtStr, "Created:", humanize.Timestamp(cwf.ObjectMeta.CreationTimestamp.Time))
-	out += fmt.Sprintf(fmtStr, "Schedule:", cwf.Spec.Schedule)
+	out += fmt.Sprintf(fmtStr, "Schedule:", strings.Join(cwf.Spec.GetSchedules(), ", "))
 	out += fmt.Sprintf(fmtStr, "Suspended:", cwf.Spec.Suspend)
 	if cwf.Spec.Timezone != "" {
 		out += fmt.Sprintf(fmtStr, "Timezone:", cwf.Spec.Timezone)
@@ -83,14 +83,28 @@ func getCronWorkflowGet(cwf *wfv1.CronWorkflow) string {
 	if cwf.Spec.ConcurrencyPolicy != "" {
 		out += fmt.Sprintf(fmtStr, "ConcurrencyPolicy:", cwf.Spec.ConcurrencyPolicy)
 	}
+	if cwf.Spec.When != "" {
+		out += fmt.Sprintf(fmtStr, "When:", cwf.Spec.When)
+	}
 	if cwf.Status.LastScheduledTime != nil {
 		out += fmt.Sprintf(fmtStr, "LastScheduledTime:", humanize.Timestamp(cwf.Status.LastScheduledTime.Time))
 	}
 
-	next, err := GetNextRuntime(cwf)
-	if err == nil {
+	if next := cwf.Status.NextScheduledTime; next != nil {
+		out += fmt.Sprintf(fmtStr, "NextScheduledTime:", humanize.Timestamp(next.Time))
+	} else if next, err := GetNextRuntime(cwf); err == nil {
 		out += fmt.Sprintf(fmtStr, "NextScheduledTime:", humanize.Timestamp(next)+" (assumes workflow-controller is in UTC)")
 	}
+	if cwf.Status.Succeeded > 0 || cwf.Status.Failed > 0 {
+		out += fmt.Sprintf(fmtStr, "Runs:", fmt.Sprintf("%d succeeded, %d failed", cwf.Status.Succeeded, cwf.Status.Failed))
+	}
+	if cwf.Status.ConsecutiveFailures > 0 {
+		consecutiveFailures := fmt.Sprint(cwf.Status.ConsecutiveFailures)
+		if s := cwf.Spec.StopStrategy; s != nil {
+			consecutiveFailures += fmt.Sprintf(" (suspends after %d)", s.ConsecutiveFailures)
+		}
+		out += fmt.Sprintf(fmtStr, "ConsecutiveFailures:", consecutiveFailures)
+	}
 
 	if len(cwf.Status.Active) > 0 {
 		var activeWfNames []string
@@ -99,8 +113,21 @@ func getCronWorkflowGet(cwf *wfv1.CronWorkflow) string {
 		}
 		out += fmt.Sprintf(fmtStr, "Active Workflows:", strings.Join(activeWfNames, ", "))
 	}
+	if b := cwf.Status.Backfill; b != nil {
+		progress := "not started"
+		if b.Next != nil {
+			progress = "next " + humanize.Timestamp(b.Next.Time)
+		}
+		out += fmt.Sprintf(fmtStr, "Backfill:", fmt.Sprintf("%s to %s, %s", humanize.Timestamp(b.From.Time), humanize.Timestamp(b.To.Time), progress))
+	}
+	if len(cwf.Status.RecentRuns) > 0 {
+		out += fmt.Sprintf(fmtStr, "Recent Runs:", "")
+		for _, run := range cwf.Status.RecentRuns {
+			out += fmt.Sprintf(fmtStr, "  "+run.Name+":", fmt.Sprintf("%s %s", run.Phase, humanize.Timestamp(run.FinishedAt.Time)))
+		}
+	}
 	if len(cwf.Status.Conditions) > 0 {
-		out += cwf.Status.Conditions.DisplayString(fmtStr, map[wfv1.ConditionType]string{wfv1.ConditionTypeSubmissionError: "✖"})
+		out += cwf.Status.Conditions.DisplayString(fmtStr, map[wfv1.ConditionType]string{wfv1.ConditionTypeSubmissionError: "✖", wfv1.ConditionTypeAutoSuspended: "⚠"})
 	}
 	if len(cwf.Spec.WorkflowSpec.Arguments.Parameters) > 0 {
 		out += fmt.Sprintf(fmtStr, "Workflow Parameters:", "")
@@ -75,3 +75,19 @@ func TestNextRuntime(t *testing.T) {
 		}
 	}
 }
+
+func TestPrintCronWorkflowRuns(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	err := yaml.Unmarshal([]byte(invalidCwf), &cronWf)
+	if assert.NoError(t, err) {
+		cronWf.Spec.StopStrategy = &v1alpha1.StopStrategy{ConsecutiveFailures: 3}
+		cronWf.Status.Succeeded = 5
+		cronWf.Status.Failed = 2
+		cronWf.Status.ConsecutiveFailures = 2
+		cronWf.Status.RecentRuns = []v1alpha1.CronWorkflowRun{{Name: "wonderful-tiger-1589910960", Phase: v1alpha1.WorkflowFailed}}
+		out := getCronWorkflowGet(&cronWf)
+		assert.Contains(t, out, "Runs:                          5 succeeded, 2 failed\n")
+		assert.Contains(t, out, "ConsecutiveFailures:           2 (suspends after 3)\n")
+		assert.Contains(t, out, "  wonderful-tiger-1589910960:  Failed")
+	}
+}
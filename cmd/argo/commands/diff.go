@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+)
+
+func NewDiffCommand() *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "diff WORKFLOW OTHER_WORKFLOW",
+		Short: "compare two workflows",
+		Long:  "Compare the resolved arguments, templates, and nodes of two workflows. Archived workflows are specified by their UID.",
+		Example: `# Compare a failed workflow to a successful one:
+
+  argo diff my-wf-failed my-wf-succeeded
+
+# Compare a workflow to an archived workflow:
+
+  argo diff my-wf 9fbbe5bd-5ef6-4c1f-8a65-4e8f0fdb7f38
+
+# Compare as JSON:
+
+  argo diff my-wf my-other-wf -o json
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient := apiClient.NewWorkflowServiceClient()
+			diff, err := serviceClient.DiffWorkflows(ctx, &workflowpkg.WorkflowDiffRequest{
+				Namespace: client.Namespace(),
+				Name:      args[0],
+				OtherName: args[1],
+			})
+			errors.CheckError(err)
+			switch output {
+			case "json":
+				outBytes, _ := json.MarshalIndent(diff, "", "    ")
+				fmt.Println(string(outBytes))
+			case "":
+				printWorkflowDiff(os.Stdout, diff)
+			default:
+				log.Fatalf("Unknown output format: %s", output)
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json")
+	return command
+}
+
+func printWorkflowDiff(w io.Writer, diff *workflowpkg.WorkflowDiffResponse) {
+	if len(diff.Arguments) == 0 && len(diff.Templates) == 0 && len(diff.Nodes) == 0 {
+		fmt.Fprintln(w, "No differences")
+		return
+	}
+	if len(diff.Arguments) > 0 {
+		fmt.Fprintln(w, "Arguments:")
+		printFieldDiffs(w, "  ", diff.Arguments)
+	}
+	if len(diff.Templates) > 0 {
+		fmt.Fprintln(w, "Templates:")
+		for _, t := range diff.Templates {
+			fmt.Fprintf(w, "  %s:\n", t.Name)
+			printFieldDiffs(w, "    ", t.Fields)
+		}
+	}
+	if len(diff.Nodes) > 0 {
+		fmt.Fprintln(w, "Nodes:")
+		for _, n := range diff.Nodes {
+			name := n.Name
+			if name == "" {
+				name = "(root)"
+			}
+			fmt.Fprintf(w, "  %s:\n", name)
+			printFieldDiffs(w, "    ", n.Fields)
+		}
+	}
+}
+
+func printFieldDiffs(w io.Writer, indent string, fields []*workflowpkg.FieldDiff) {
+	for _, f := range fields {
+		prefix := indent
+		if f.Path != "" {
+			prefix += f.Path + ": "
+		}
+		fmt.Fprintf(w, "%s%s -> %s\n", prefix, orNone(f.Value), orNone(f.OtherValue))
+	}
+}
+
+func orNone(v string) string {
+	if v == "" {
+		return "<none>"
+	}
+	return v
+}
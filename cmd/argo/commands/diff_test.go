@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+)
+
+func Test_printWorkflowDiff(t *testing.T) {
+	t.Run("NoDifferences", func(t *testing.T) {
+		var out bytes.Buffer
+		printWorkflowDiff(&out, &workflowpkg.WorkflowDiffResponse{})
+		assert.Equal(t, "No differences\n", out.String())
+	})
+	t.Run("Differences", func(t *testing.T) {
+		var out bytes.Buffer
+		printWorkflowDiff(&out, &workflowpkg.WorkflowDiffResponse{
+			Arguments: []*workflowpkg.FieldDiff{{Path: "parameters.message", Value: "hello", OtherValue: "goodbye"}},
+			Templates: []*workflowpkg.TemplateDiff{{Name: "main", Fields: []*workflowpkg.FieldDiff{{OtherValue: `{"name":"main"}`}}}},
+			Nodes: []*workflowpkg.NodeDiff{
+				{Fields: []*workflowpkg.FieldDiff{{Path: "phase", Value: "Succeeded", OtherValue: "Failed"}}},
+				{Name: "step", Fields: []*workflowpkg.FieldDiff{{Path: "exitCode", Value: "0", OtherValue: "1"}}},
+			},
+		})
+		assert.Equal(t, `Arguments:
+  parameters.message: hello -> goodbye
+Templates:
+  main:
+    <none> -> {"name":"main"}
+Nodes:
+  (root):
+    phase: Succeeded -> Failed
+  step:
+    exitCode: 0 -> 1
+`, out.String())
+	})
+}
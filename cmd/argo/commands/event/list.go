@@ -0,0 +1,118 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
+)
+
+func NewListCommand() *cobra.Command {
+	var (
+		allNamespaces bool
+		output        string
+		limit         int64
+	)
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "list events received, most recent first",
+		Example: `# List the events received in the current namespace:
+  argo event list
+
+# Show why each binding did, or did not, match:
+  argo event list -o wide
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewEventServiceClient()
+			errors.CheckError(err)
+			namespace := client.Namespace()
+			if allNamespaces {
+				namespace = ""
+			}
+			list, err := serviceClient.ListEventDeliveries(ctx, &eventpkg.ListEventDeliveriesRequest{
+				Namespace:   namespace,
+				ListOptions: &metav1.ListOptions{Limit: limit},
+			})
+			errors.CheckError(err)
+			switch output {
+			case "json":
+				data, err := json.MarshalIndent(list.Items, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(data))
+			case "yaml":
+				data, err := yaml.Marshal(list.Items)
+				errors.CheckError(err)
+				fmt.Print(string(data))
+			case "", "wide":
+				printDeliveries(list.Items, output == "wide")
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "Show events from all namespaces")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide")
+	command.Flags().Int64Var(&limit, "limit", 0, "The maximum number of events to list, 0 for all")
+	return command
+}
+
+func printDeliveries(deliveries []*eventpkg.EventDelivery, wide bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "ID\tNAMESPACE\tDISCRIMINATOR\tRECEIVED\tPHASE\tBINDINGS")
+	if wide {
+		_, _ = fmt.Fprint(w, "\tMESSAGE")
+	}
+	_, _ = fmt.Fprint(w, "\n")
+	for _, d := range deliveries {
+		received := ""
+		if d.ReceivedAt != nil {
+			received = humanize.RelativeDurationShort(d.ReceivedAt.Time, time.Now())
+		}
+		matched := 0
+		for _, b := range d.Bindings {
+			if b.Matched {
+				matched++
+			}
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d/%d", d.Id, d.Namespace, d.Discriminator, received, d.Phase, matched, len(d.Bindings))
+		if wide {
+			_, _ = fmt.Fprintf(w, "\t%s", message(d))
+		}
+		_, _ = fmt.Fprint(w, "\n")
+	}
+	_ = w.Flush()
+}
+
+// message summarises the outcome of the delivery, including the outcome of each binding
+func message(d *eventpkg.EventDelivery) string {
+	var parts []string
+	if d.Message != "" {
+		parts = append(parts, d.Message)
+	}
+	if d.ReplayOf != "" {
+		parts = append(parts, "replay of "+d.ReplayOf)
+	}
+	for _, b := range d.Bindings {
+		switch {
+		case b.Error != "":
+			parts = append(parts, b.Name+": "+b.Error)
+		case b.Workflow != "":
+			parts = append(parts, b.Name+": "+b.Workflow)
+		case b.Matched:
+			parts = append(parts, b.Name+": matched")
+		}
+	}
+	return strings.Join(parts, "; ")
+}
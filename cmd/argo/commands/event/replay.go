@@ -0,0 +1,34 @@
+package event
+
+import (
+	"fmt"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
+)
+
+func NewReplayCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "replay ID...",
+		Short: "send events received previously again, e.g. after fixing a binding",
+		Example: `# Replay an event:
+  argo event replay 2e5bc1f4-0d3d-4c0a-a0f8-8a7f2c6c8f01
+`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewEventServiceClient()
+			errors.CheckError(err)
+			namespace := client.Namespace()
+			for _, id := range args {
+				d, err := serviceClient.ReplayEvent(ctx, &eventpkg.ReplayEventRequest{Namespace: namespace, Id: id})
+				errors.CheckError(err)
+				fmt.Printf("%s replayed as %s\n", id, d.Id)
+			}
+		},
+	}
+	return command
+}
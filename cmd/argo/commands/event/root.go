@@ -0,0 +1,18 @@
+package event
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewEventCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "event",
+		Short: "manage events received by the Argo Server",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+	command.AddCommand(NewListCommand())
+	command.AddCommand(NewReplayCommand())
+	return command
+}
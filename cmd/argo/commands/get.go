@@ -53,7 +53,10 @@ func (g getFlags) shouldPrint(node wfv1.NodeStatus) bool {
 }
 
 func NewGetCommand() *cobra.Command {
-	var getArgs getFlags
+	var (
+		getArgs   getFlags
+		filenames []string
+	)
 
 	command := &cobra.Command{
 		Use:   "get WORKFLOW...",
@@ -64,8 +67,27 @@ func NewGetCommand() *cobra.Command {
 
 # Get the latest workflow:
   argo get @latest
+
+# Print the graph of a workflow as a Mermaid flowchart:
+
+  argo get my-wf -o mermaid
+
+# Print the graph of a workflow that has not been submitted, rendered with Graphviz:
+
+  argo get -f my-wf.yaml -o dot | dot -Tsvg > my-wf.svg
 `,
 		Run: func(cmd *cobra.Command, args []string) {
+			if len(filenames) > 0 {
+				fileContents, err := util.ReadManifest(filenames...)
+				errors.CheckError(err)
+				for _, body := range fileContents {
+					wfs := unmarshalWorkflows(body, false)
+					for i := range wfs {
+						printWorkflow(&wfs[i], getArgs)
+					}
+				}
+				return
+			}
 			if len(args) == 0 {
 				cmd.HelpFunc()(cmd, args)
 				os.Exit(1)
@@ -84,7 +106,8 @@ func NewGetCommand() *cobra.Command {
 		},
 	}
 
-	command.Flags().StringVarP(&getArgs.output, "output", "o", "", "Output format. One of: json|yaml|short|wide")
+	command.Flags().StringVarP(&getArgs.output, "output", "o", "", "Output format. One of: json|yaml|short|wide|dot|mermaid")
+	command.Flags().StringSliceVarP(&filenames, "filename", "f", nil, "Get the workflows in these files instead, e.g. to print the graph of workflows that have not been submitted")
 	command.Flags().BoolVar(&noColor, "no-color", false, "Disable colorized output")
 	command.Flags().BoolVar(&noUtf8, "no-utf8", false, "Use plain 7-bits ascii characters")
 	command.Flags().StringVar(&getArgs.status, "status", "", "Filter by status (Pending, Running, Succeeded, Skipped, Failed, Error)")
@@ -108,6 +131,8 @@ func printWorkflow(wf *wfv1.Workflow, getArgs getFlags) {
 		fmt.Print(string(outBytes))
 	case "short", "wide", "":
 		fmt.Print(printWorkflowHelper(wf, getArgs))
+	case "dot", "mermaid":
+		errors.CheckError(printer.PrintWorkflowGraph(wf, os.Stdout, getArgs.output))
 	default:
 		log.Fatalf("Unknown output format: %s", getArgs.output)
 	}
@@ -173,6 +198,9 @@ func printWorkflowHelper(wf *wfv1.Workflow, getArgs getFlags) string {
 				} else if art.Artifactory != nil {
 					out += fmt.Sprintf(fmtStr, "  "+art.Name+":", art.Artifactory.String())
 				}
+				if art.Digest != "" {
+					out += fmt.Sprintf(fmtStr, "    Digest:", art.Digest)
+				}
 			}
 		}
 	}
@@ -596,7 +624,20 @@ func getArtifactsString(node wfv1.NodeStatus) string {
 	}
 	var artNames []string
 	for _, art := range node.Outputs.Artifacts {
-		artNames = append(artNames, art.Name)
+		artNames = append(artNames, art.Name+shortDigest(art.Digest))
 	}
 	return strings.Join(artNames, ",")
 }
+
+// shortDigest returns the digest abbreviated to its first 12 hex digits, e.g. "@sha256:c0b8114a809d", or "" if there
+// is no digest
+func shortDigest(digest string) string {
+	parts := strings.SplitN(digest, ":", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	if len(parts[1]) > 12 {
+		parts[1] = parts[1][:12]
+	}
+	return "@" + parts[0] + ":" + parts[1]
+}
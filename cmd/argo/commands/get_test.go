@@ -111,7 +111,32 @@ func TestStatusToNodeFieldSelector(t *testing.T) {
 	assert.Equal(t, "phase=Running", one)
 }
 
+func TestGetArtifactsString(t *testing.T) {
+	assert.Empty(t, getArtifactsString(wfv1.NodeStatus{}))
+	node := wfv1.NodeStatus{Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+		{Name: "main-logs"},
+		{Name: "result", Digest: "sha256:c0b8114a809d94b548e3f098b4b76b1589e8ea6297dc795b1377df2c99055385"},
+	}}}
+	assert.Equal(t, "main-logs,result@sha256:c0b8114a809d", getArtifactsString(node))
+}
+
 func Test_printWorkflowHelper(t *testing.T) {
+	t.Run("OutputArtifactDigest", func(t *testing.T) {
+		var wf wfv1.Workflow
+		testutil.MustUnmarshallYAML(`
+status:
+  phase: Succeeded
+  outputs:
+    artifacts:
+    - name: result
+      digest: sha256:c0b8114a809d94b548e3f098b4b76b1589e8ea6297dc795b1377df2c99055385
+      s3:
+        bucket: my-bucket
+        key: result.tgz
+`, &wf)
+		output := printWorkflowHelper(&wf, getFlags{})
+		assert.Regexp(t, `Digest: *sha256:c0b8114a809d94b548e3f098b4b76b1589e8ea6297dc795b1377df2c99055385`, output)
+	})
 	t.Run("Progress", func(t *testing.T) {
 		var wf wfv1.Workflow
 		testutil.MustUnmarshallYAML(`
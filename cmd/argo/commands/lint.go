@@ -16,6 +16,7 @@ func NewLintCommand() *cobra.Command {
 	var (
 		strict    bool
 		lintKinds []string
+		rules     []string
 		output    string
 	)
 
@@ -31,7 +32,15 @@ func NewLintCommand() *cobra.Command {
 
 # Lint only manifests of Workflows and CronWorkflows from stdin:
 
-  cat manifests.yaml | argo lint --kinds=workflows,cronworkflows -`,
+  cat manifests.yaml | argo lint --kinds=workflows,cronworkflows -
+
+# Lint only with the server's validation, without any rules:
+
+  argo lint --rules= ./manifests
+
+# Upload the results to GitHub code scanning:
+
+  argo lint --output=sarif ./manifests > argo-lint.sarif`,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, apiClient := client.NewAPIClient()
 			if len(args) == 0 {
@@ -41,12 +50,13 @@ func NewLintCommand() *cobra.Command {
 			if len(lintKinds) == 0 || strings.Contains(strings.Join(lintKinds, ","), "all") {
 				lintKinds = allKinds
 			}
-			lint.RunLint(ctx, apiClient, args, lintKinds, client.Namespace(), output, strict)
+			lint.RunLint(ctx, apiClient, args, lintKinds, rules, client.Namespace(), output, strict)
 		},
 	}
 
 	command.Flags().StringSliceVar(&lintKinds, "kinds", []string{"all"}, fmt.Sprintf("Which kinds will be linted. Can be: %s", strings.Join(allKinds, "|")))
-	command.Flags().StringVarP(&output, "output", "o", "pretty", "Linting results output format. One of: pretty|simple")
+	command.Flags().StringSliceVar(&rules, "rules", []string{"all"}, fmt.Sprintf("Which rules will be checked, in addition to validation. Can be: all|%s", strings.Join(lint.GetRuleIDs(), "|")))
+	command.Flags().StringVarP(&output, "output", "o", "pretty", "Linting results output format. One of: pretty|simple|json|sarif")
 	command.Flags().BoolVar(&strict, "strict", true, "Perform strict workflow validation")
 
 	return command
@@ -23,11 +23,16 @@ func NewLogsCommand() *cobra.Command {
 		since     time.Duration
 		sinceTime string
 		tailLines int64
+		grep      string
 	)
 	logOptions := &corev1.PodLogOptions{}
 	command := &cobra.Command{
 		Use:   "logs WORKFLOW [POD]",
 		Short: "view logs of a pod or workflow",
+		Long: `View logs of a pod or workflow.
+
+The logs of pods that have been deleted, and of archived workflows (specified by UID), are read from the logs saved as artifacts (i.e. "archiveLogs: true"), which requires the Argo Server.
+Only the logs of the main container are saved, and saved log lines have no timestamps of their own, so the time the pod finished is used instead.`,
 		Example: `# Print the logs of a workflow:
 
   argo logs my-wf
@@ -50,6 +55,14 @@ func NewLogsCommand() *cobra.Command {
 
 # Print the logs of the latest workflow:
   argo logs @latest
+
+# Print the lines of a workflow's logs that match a regular expression:
+
+  argo logs my-wf --grep 'error|warning'
+
+# Print the logs of an archived workflow:
+
+  argo logs 0f8b64fe-8d4c-4c5a-9d5f-8c0f1c4d7a01
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			// parse all the args
@@ -91,7 +104,7 @@ func NewLogsCommand() *cobra.Command {
 			serviceClient := apiClient.NewWorkflowServiceClient()
 			namespace := client.Namespace()
 
-			logWorkflow(ctx, serviceClient, namespace, workflow, podName, logOptions)
+			logWorkflow(ctx, serviceClient, namespace, workflow, podName, grep, logOptions)
 		},
 	}
 	command.Flags().StringVarP(&logOptions.Container, "container", "c", "main", "Print the logs of this container")
@@ -101,17 +114,19 @@ func NewLogsCommand() *cobra.Command {
 	command.Flags().StringVar(&sinceTime, "since-time", "", "Only return logs after a specific date (RFC3339). Defaults to all logs. Only one of since-time / since may be used.")
 	command.Flags().Int64Var(&tailLines, "tail", -1, "If set, the number of lines from the end of the logs to show. If not specified, logs are shown from the creation of the container or sinceSeconds or sinceTime")
 	command.Flags().BoolVar(&logOptions.Timestamps, "timestamps", false, "Include timestamps on each line in the log output")
+	command.Flags().StringVar(&grep, "grep", "", "Only print the log lines that match this regular expression")
 	command.Flags().BoolVar(&noColor, "no-color", false, "Disable colorized output")
 	return command
 }
 
-func logWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, workflow, podName string, logOptions *corev1.PodLogOptions) {
+func logWorkflow(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, namespace, workflow, podName, grep string, logOptions *corev1.PodLogOptions) {
 	// logs
 	stream, err := serviceClient.WorkflowLogs(ctx, &workflowpkg.WorkflowLogRequest{
 		Name:       workflow,
 		Namespace:  namespace,
 		PodName:    podName,
 		LogOptions: logOptions,
+		Grep:       grep,
 	})
 	errors.CheckError(err)
 
@@ -13,6 +13,7 @@ func NewResubmitCommand() *cobra.Command {
 		memoized      bool
 		priority      int32
 		cliSubmitOpts cliSubmitOpts
+		bulkFlags     bulkFlags
 	)
 	command := &cobra.Command{
 		Use:   "resubmit [WORKFLOW...]",
@@ -36,6 +37,10 @@ func NewResubmitCommand() *cobra.Command {
 # Resubmit the latest workflow:
 
   argo resubmit @latest
+
+# Resubmit all workflows matching a label selector:
+
+  argo resubmit -l workflows.argoproj.io/workflow-template=my-wftmpl
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			if cmd.Flag("priority").Changed {
@@ -46,6 +51,15 @@ func NewResubmitCommand() *cobra.Command {
 			serviceClient := apiClient.NewWorkflowServiceClient()
 			namespace := client.Namespace()
 
+			if bulkFlags.enabled() {
+				runBulkAction(ctx, serviceClient, args, bulkFlags, &workflowpkg.WorkflowBulkActionRequest{
+					Namespace: namespace,
+					Action:    "resubmit",
+					Memoized:  memoized,
+				}, "resubmitted")
+				return
+			}
+
 			for _, name := range args {
 				created, err := serviceClient.ResubmitWorkflow(ctx, &workflowpkg.WorkflowResubmitRequest{
 					Namespace: namespace,
@@ -65,5 +79,6 @@ func NewResubmitCommand() *cobra.Command {
 	command.Flags().BoolVar(&cliSubmitOpts.watch, "watch", false, "watch the workflow until it completes")
 	command.Flags().BoolVar(&cliSubmitOpts.log, "log", false, "log the workflow until it completes")
 	command.Flags().BoolVar(&memoized, "memoized", false, "re-use successful steps & outputs from the previous run (experimental)")
+	bulkFlags.addFlags(command)
 	return command
 }
@@ -20,6 +20,7 @@ func NewRetryCommand() *cobra.Command {
 	var (
 		cliSubmitOpts cliSubmitOpts
 		retryOps      retryOps
+		bulkFlags     bulkFlags
 	)
 	command := &cobra.Command{
 		Use:   "retry [WORKFLOW...]",
@@ -47,6 +48,10 @@ func NewRetryCommand() *cobra.Command {
 # Retry the latest workflow:
 
   argo retry @latest
+
+# Retry all workflows matching a label selector:
+
+  argo retry -l workflows.argoproj.io/phase=Failed
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, apiClient := client.NewAPIClient()
@@ -58,6 +63,16 @@ func NewRetryCommand() *cobra.Command {
 				log.Fatalf("Unable to parse node field selector '%s': %s", retryOps.nodeFieldSelector, err)
 			}
 
+			if bulkFlags.enabled() {
+				runBulkAction(ctx, serviceClient, args, bulkFlags, &workflowpkg.WorkflowBulkActionRequest{
+					Namespace:         namespace,
+					Action:            "retry",
+					RestartSuccessful: retryOps.restartSuccessful,
+					NodeFieldSelector: selector.String(),
+				}, "retried")
+				return
+			}
+
 			for _, name := range args {
 				wf, err := serviceClient.RetryWorkflow(ctx, &workflowpkg.WorkflowRetryRequest{
 					Name:              name,
@@ -80,5 +95,6 @@ func NewRetryCommand() *cobra.Command {
 	command.Flags().BoolVar(&cliSubmitOpts.log, "log", false, "log the workflow until it completes")
 	command.Flags().BoolVar(&retryOps.restartSuccessful, "restart-successful", false, "indicates to restart successful nodes matching the --node-field-selector")
 	command.Flags().StringVar(&retryOps.nodeFieldSelector, "node-field-selector", "", "selector of nodes to reset, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
+	bulkFlags.addFlags(command)
 	return command
 }
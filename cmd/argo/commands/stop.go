@@ -18,7 +18,10 @@ type stopOps struct {
 }
 
 func NewStopCommand() *cobra.Command {
-	var stopArgs stopOps
+	var (
+		stopArgs  stopOps
+		bulkFlags bulkFlags
+	)
 
 	command := &cobra.Command{
 		Use:   "stop WORKFLOW WORKFLOW2...",
@@ -29,6 +32,12 @@ func NewStopCommand() *cobra.Command {
 
 # Stop the latest workflow:
   argo stop @latest
+
+# Stop all workflows matching a label selector:
+  argo stop -l workflows.argoproj.io/workflow-template=my-wftmpl
+
+# Print the workflows that would be stopped:
+  argo stop -l workflows.argoproj.io/workflow-template=my-wftmpl --dry-run
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx, apiClient := client.NewAPIClient()
@@ -40,6 +49,16 @@ func NewStopCommand() *cobra.Command {
 				log.Fatalf("Unable to parse node field selector '%s': %s", stopArgs.nodeFieldSelector, err)
 			}
 
+			if bulkFlags.enabled() {
+				runBulkAction(ctx, serviceClient, args, bulkFlags, &workflowpkg.WorkflowBulkActionRequest{
+					Namespace:         namespace,
+					Action:            "stop",
+					NodeFieldSelector: selector.String(),
+					Message:           stopArgs.message,
+				}, "stopped")
+				return
+			}
+
 			for _, name := range args {
 				wf, err := serviceClient.StopWorkflow(ctx, &workflowpkg.WorkflowStopRequest{
 					Name:              name,
@@ -54,5 +73,6 @@ func NewStopCommand() *cobra.Command {
 	}
 	command.Flags().StringVar(&stopArgs.message, "message", "", "Message to add to previously running nodes")
 	command.Flags().StringVar(&stopArgs.nodeFieldSelector, "node-field-selector", "", "selector of node to stop, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
+	bulkFlags.addFlags(command)
 	return command
 }
@@ -0,0 +1,52 @@
+package lint
+
+import (
+	"encoding/json"
+)
+
+type formatterJSON struct{}
+
+type jsonFinding struct {
+	RuleID   string   `json:"ruleId"`
+	Severity Severity `json:"severity"`
+	Object   string   `json:"object,omitempty"`
+	Message  string   `json:"message"`
+	Line     int      `json:"line,omitempty"`
+}
+
+type jsonResult struct {
+	File     string        `json:"file"`
+	Findings []jsonFinding `json:"findings"`
+}
+
+type jsonResults struct {
+	Success bool         `json:"success"`
+	Results []jsonResult `json:"results"`
+}
+
+// Format returns nothing, because all the results are formatted as a single JSON document by Summarize
+func (f formatterJSON) Format(*LintResult) string {
+	return ""
+}
+
+func (f formatterJSON) Summarize(l *LintResults) string {
+	out := jsonResults{Success: l.Success, Results: []jsonResult{}}
+	for _, r := range l.Results {
+		if !r.Linted {
+			continue
+		}
+		res := jsonResult{File: r.File, Findings: []jsonFinding{}}
+		for _, finding := range getFindings(r) {
+			res.Findings = append(res.Findings, jsonFinding{
+				RuleID:   finding.RuleID,
+				Severity: finding.Severity,
+				Object:   finding.Object,
+				Message:  finding.Message,
+				Line:     finding.Line,
+			})
+		}
+		out.Results = append(out.Results, res)
+	}
+	data, _ := json.MarshalIndent(out, "", "  ")
+	return string(data) + "\n"
+}
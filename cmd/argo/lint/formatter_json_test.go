@@ -0,0 +1,62 @@
+package lint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONFormat(t *testing.T) {
+	msg := formatterJSON{}.Format(&LintResult{File: "test1", Errs: []error{fmt.Errorf("some error")}, Linted: true})
+	assert.Empty(t, msg)
+}
+
+func TestJSONSummarize(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		msg := formatterJSON{}.Summarize(&LintResults{Success: true})
+		assert.Equal(t, `{
+  "success": true,
+  "results": []
+}
+`, msg)
+	})
+	t.Run("Findings", func(t *testing.T) {
+		msg := formatterJSON{}.Summarize(&LintResults{
+			Results: []*LintResult{
+				{
+					File: "test1",
+					Errs: []error{
+						fmt.Errorf("some error"),
+						&Finding{RuleID: "latest-image", Severity: SeverityWarning, Object: `"foo" (Workflow)`, Message: "some warning", Line: 10},
+					},
+					Linted: true,
+				},
+				{File: "test2", Linted: false},
+			},
+		})
+		assert.Equal(t, `{
+  "success": false,
+  "results": [
+    {
+      "file": "test1",
+      "findings": [
+        {
+          "ruleId": "validation",
+          "severity": "error",
+          "message": "some error"
+        },
+        {
+          "ruleId": "latest-image",
+          "severity": "warning",
+          "object": "\"foo\" (Workflow)",
+          "message": "some warning",
+          "line": 10
+        }
+      ]
+    }
+  ]
+}
+`, msg)
+	})
+}
@@ -30,7 +30,15 @@ func (f formatterPretty) Format(l *LintResult) string {
 	fmt.Fprintf(sb, "%s:\n", color.Ize(underline, l.File)) // print source name
 
 	for _, e := range l.Errs {
-		fmt.Fprintf(sb, "%s%s %s\n", lintIndentation, color.Ize(color.Red, "✖"), e)
+		icon := color.Ize(color.Red, "✖")
+		if severity(e) == SeverityWarning {
+			icon = color.Ize(color.Yellow, "⚠")
+		}
+		if line := getLine(e); line > 0 {
+			fmt.Fprintf(sb, "%s%s line %d: %s\n", lintIndentation, icon, line, e)
+		} else {
+			fmt.Fprintf(sb, "%s%s %s\n", lintIndentation, icon, e)
+		}
 	}
 	sb.WriteString("\n")
 
@@ -38,7 +46,21 @@ func (f formatterPretty) Format(l *LintResult) string {
 }
 
 func (f formatterPretty) Summarize(l *LintResults) string {
+	totErr, totWarn := 0, 0
+	for _, r := range l.Results {
+		for _, e := range r.Errs {
+			if severity(e) == SeverityWarning {
+				totWarn++
+			} else {
+				totErr++
+			}
+		}
+	}
+
 	if l.Success {
+		if totWarn > 0 {
+			return fmt.Sprintf("%s no linting errors found, %d warnings\n", color.Ize(color.Green, "✔"), totWarn)
+		}
 		return fmt.Sprintf("%s no linting errors found!\n", color.Ize(color.Green, "✔"))
 	}
 
@@ -46,10 +68,8 @@ func (f formatterPretty) Summarize(l *LintResults) string {
 		return fmt.Sprintf("%s\n", color.Ize(color.Red, "✖ found nothing to lint in the specified paths, failing..."))
 	}
 
-	totErr := 0
-	for _, r := range l.Results {
-		totErr += len(r.Errs)
+	if totWarn > 0 {
+		return fmt.Sprintln(color.Ize(color.Red, fmt.Sprintf("✖ %d linting errors found, %d warnings", totErr, totWarn)))
 	}
-
 	return fmt.Sprintln(color.Ize(color.Red, fmt.Sprintf("✖ %d linting errors found!", totErr)))
 }
@@ -0,0 +1,101 @@
+package lint
+
+import (
+	"encoding/json"
+)
+
+// formatterSARIF formats the results as a SARIF log (https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html),
+// e.g. to upload them to GitHub code scanning
+type formatterSARIF struct{}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifRule struct {
+	ID                   string       `json:"id"`
+	ShortDescription     sarifMessage `json:"shortDescription"`
+	DefaultConfiguration struct {
+		Level string `json:"level"`
+	} `json:"defaultConfiguration"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI string `json:"uri"`
+		} `json:"artifactLocation"`
+		Region *sarifRegion `json:"region,omitempty"`
+	} `json:"physicalLocation"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifRun struct {
+	Tool struct {
+		Driver struct {
+			Name           string      `json:"name"`
+			InformationURI string      `json:"informationUri"`
+			Rules          []sarifRule `json:"rules"`
+		} `json:"driver"`
+	} `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+// Format returns nothing, because all the results are formatted as a single SARIF log by Summarize
+func (f formatterSARIF) Format(*LintResult) string {
+	return ""
+}
+
+func (f formatterSARIF) Summarize(l *LintResults) string {
+	run := sarifRun{Results: []sarifResult{}}
+	run.Tool.Driver.Name = "argo lint"
+	run.Tool.Driver.InformationURI = "https://argoproj.github.io/argo-workflows/cli/argo_lint/"
+	validation := sarifRule{ID: ValidationRuleID, ShortDescription: sarifMessage{"Errors found by validating the manifests"}}
+	validation.DefaultConfiguration.Level = string(SeverityError)
+	run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, validation)
+	for _, r := range rules {
+		rule := sarifRule{ID: r.id, ShortDescription: sarifMessage{r.description}}
+		rule.DefaultConfiguration.Level = string(r.severity)
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+	}
+	for _, r := range l.Results {
+		if !r.Linted {
+			continue
+		}
+		for _, finding := range getFindings(r) {
+			location := sarifLocation{}
+			location.PhysicalLocation.ArtifactLocation.URI = r.File
+			if finding.Line > 0 {
+				location.PhysicalLocation.Region = &sarifRegion{StartLine: finding.Line}
+			}
+			run.Results = append(run.Results, sarifResult{
+				RuleID:    finding.RuleID,
+				Level:     string(finding.Severity),
+				Message:   sarifMessage{finding.Error()},
+				Locations: []sarifLocation{location},
+			})
+		}
+	}
+	data, _ := json.MarshalIndent(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+	return string(data) + "\n"
+}
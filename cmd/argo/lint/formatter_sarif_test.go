@@ -0,0 +1,49 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSARIFFormat(t *testing.T) {
+	msg := formatterSARIF{}.Format(&LintResult{File: "test1", Errs: []error{fmt.Errorf("some error")}, Linted: true})
+	assert.Empty(t, msg)
+}
+
+func TestSARIFSummarize(t *testing.T) {
+	msg := formatterSARIF{}.Summarize(&LintResults{
+		Results: []*LintResult{
+			{
+				File: "test1",
+				Errs: []error{
+					fmt.Errorf("some error"),
+					&Finding{RuleID: "latest-image", Severity: SeverityWarning, Object: `"foo" (Workflow)`, Message: "some warning", Line: 10},
+				},
+				Linted: true,
+			},
+		},
+	})
+	log := &sarifLog{}
+	if assert.NoError(t, json.Unmarshal([]byte(msg), log)) {
+		assert.Equal(t, "2.1.0", log.Version)
+		if assert.Len(t, log.Runs, 1) {
+			run := log.Runs[0]
+			assert.Equal(t, "argo lint", run.Tool.Driver.Name)
+			assert.Len(t, run.Tool.Driver.Rules, len(rules)+1)
+			if assert.Len(t, run.Results, 2) {
+				assert.Equal(t, "validation", run.Results[0].RuleID)
+				assert.Equal(t, "error", run.Results[0].Level)
+				assert.Equal(t, "some error", run.Results[0].Message.Text)
+				assert.Equal(t, "test1", run.Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+				assert.Nil(t, run.Results[0].Locations[0].PhysicalLocation.Region)
+				assert.Equal(t, "latest-image", run.Results[1].RuleID)
+				assert.Equal(t, "warning", run.Results[1].Level)
+				assert.Equal(t, `in "foo" (Workflow): some warning (latest-image)`, run.Results[1].Message.Text)
+				assert.Equal(t, &sarifRegion{StartLine: 10}, run.Results[1].Locations[0].PhysicalLocation.Region)
+			}
+		}
+	}
+}
@@ -18,7 +18,11 @@ func (f formatterSimple) Format(l *LintResult) string {
 
 	sb := &strings.Builder{}
 	for _, e := range l.Errs {
-		fmt.Fprintf(sb, "%s: %s\n", l.File, e)
+		if line := getLine(e); line > 0 {
+			fmt.Fprintf(sb, "%s:%d: %s\n", l.File, line, e)
+		} else {
+			fmt.Fprintf(sb, "%s: %s\n", l.File, e)
+		}
 	}
 
 	return sb.String()
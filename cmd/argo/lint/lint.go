@@ -34,8 +34,10 @@ type LintOptions struct {
 	Files            []string
 	Strict           bool
 	DefaultNamespace string
-	Formatter        Formatter
-	ServiceClients   ServiceClients
+	// Rules are the IDs of the rules to check, in addition to the server's validation
+	Rules          []string
+	Formatter      Formatter
+	ServiceClients ServiceClients
 
 	// Printer if not nil the lint result is written to this writer after each
 	// file is linted.
@@ -75,6 +77,8 @@ var (
 	formatters = map[string]Formatter{
 		"pretty": formatterPretty{},
 		"simple": formatterSimple{},
+		"json":   formatterJSON{},
+		"sarif":  formatterSARIF{},
 	}
 )
 
@@ -86,20 +90,23 @@ func GetFormatter(fmtr string) (Formatter, error) {
 	return f, nil
 }
 
-// RunLint lints the specified kinds in the specified files and prints the results to os.Stdout.
-// If linting fails it will exit with status code 1.
-func RunLint(ctx context.Context, client apiclient.Client, files, kinds []string, defaultNs, output string, strict bool) {
+// RunLint lints the specified kinds in the specified files, with the specified rules, and prints the results to
+// os.Stdout. If linting fails it will exit with status code 1.
+func RunLint(ctx context.Context, client apiclient.Client, files, kinds, rules []string, defaultNs, output string, strict bool) {
 	fmtr, err := GetFormatter(output)
 	errors.CheckError(err)
 
 	clients, err := getLintClients(client, kinds)
 	errors.CheckError(err)
 
+	rules = expandRuleIDs(rules)
+
 	res, err := Lint(ctx, &LintOptions{
 		ServiceClients:   clients,
 		Files:            files,
 		Strict:           strict,
 		DefaultNamespace: defaultNs,
+		Rules:            rules,
 		Formatter:        fmtr,
 		Printer:          os.Stdout,
 	})
@@ -122,6 +129,11 @@ func Lint(ctx context.Context, opts *LintOptions) (*LintResults, error) {
 		w = opts.Printer
 	}
 
+	rules, err := getRules(opts.Rules)
+	if err != nil {
+		return nil, err
+	}
+
 	results := &LintResults{
 		Results: []*LintResult{},
 		fmtr:    fmtr,
@@ -155,7 +167,7 @@ func Lint(ctx context.Context, opts *LintOptions) (*LintResults, error) {
 				return err
 			}
 
-			res := lintData(ctx, path, data, opts)
+			res := lintData(ctx, path, data, opts, rules)
 			results.Results = append(results.Results, res)
 
 			_, err = w.Write([]byte(results.fmtr.Format(res)))
@@ -167,16 +179,21 @@ func Lint(ctx context.Context, opts *LintOptions) (*LintResults, error) {
 	}
 
 	results.evaluate()
-	_, err := w.Write([]byte(results.fmtr.Summarize(results)))
+	_, err = w.Write([]byte(results.fmtr.Summarize(results)))
 	return results, err
 }
 
-func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *LintResult {
+func lintData(ctx context.Context, src string, data []byte, opts *LintOptions, rules []rule) *LintResult {
 	res := &LintResult{
 		File: src,
 		Errs: []error{},
 	}
 
+	var docs []document
+	if len(rules) > 0 {
+		docs = parseDocuments(data)
+	}
+
 	for i, pr := range common.ParseObjects(data, opts.Strict) {
 		obj, err := pr.Object, pr.Err
 		if obj == nil {
@@ -188,15 +205,21 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 			namespace = opts.DefaultNamespace
 		}
 		objName := ""
+		kind := ""
+		// the workflow spec of the object, which the rules check
+		var spec *wfv1.WorkflowSpec
+		var specPath []interface{}
 
 		switch v := obj.(type) {
 		case *wfv1.ClusterWorkflowTemplate:
-			objName = getObjectName(wf.ClusterWorkflowTemplateKind, v, i)
+			kind = wf.ClusterWorkflowTemplateKind
+			objName = getObjectName(kind, v, i)
 			if opts.ServiceClients.ClusterWorkflowTemplateClient == nil {
 				log.Debugf("ignoring %s, not in lint options", objName)
 				continue
 			}
 			res.Linted = true
+			spec, specPath = &v.Spec.WorkflowSpec, []interface{}{"spec"}
 			if err == nil {
 				_, err = opts.ServiceClients.ClusterWorkflowTemplateClient.LintClusterWorkflowTemplate(
 					ctx,
@@ -204,12 +227,14 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 				)
 			}
 		case *wfv1.CronWorkflow:
-			objName = getObjectName(wf.CronWorkflowKind, v, i)
+			kind = wf.CronWorkflowKind
+			objName = getObjectName(kind, v, i)
 			if opts.ServiceClients.CronWorkflowsClient == nil {
 				log.Debugf("ignoring %s, not in lint options kinds", objName)
 				continue
 			}
 			res.Linted = true
+			spec, specPath = &v.Spec.WorkflowSpec, []interface{}{"spec", "workflowSpec"}
 			if err == nil {
 				_, err = opts.ServiceClients.CronWorkflowsClient.LintCronWorkflow(
 					ctx,
@@ -217,12 +242,14 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 				)
 			}
 		case *wfv1.Workflow:
-			objName = getObjectName(wf.WorkflowKind, v, i)
+			kind = wf.WorkflowKind
+			objName = getObjectName(kind, v, i)
 			if opts.ServiceClients.WorkflowsClient == nil {
 				log.Debugf("ignoring %s, not in lint options kinds", objName)
 				continue
 			}
 			res.Linted = true
+			spec, specPath = &v.Spec, []interface{}{"spec"}
 			if err == nil {
 				_, err = opts.ServiceClients.WorkflowsClient.LintWorkflow(
 					ctx,
@@ -232,12 +259,14 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 		case *wfv1.WorkflowEventBinding:
 			// noop
 		case *wfv1.WorkflowTemplate:
-			objName = getObjectName(wf.WorkflowTemplateKind, v, i)
+			kind = wf.WorkflowTemplateKind
+			objName = getObjectName(kind, v, i)
 			if opts.ServiceClients.WorkflowTemplatesClient == nil {
 				log.Debugf("ignoring %s, not in lint options kinds", objName)
 				continue
 			}
 			res.Linted = true
+			spec, specPath = &v.Spec.WorkflowSpec, []interface{}{"spec"}
 			if err == nil {
 				_, err = opts.ServiceClients.WorkflowTemplatesClient.LintWorkflowTemplate(
 					ctx,
@@ -249,7 +278,21 @@ func lintData(ctx context.Context, src string, data []byte, opts *LintOptions) *
 		}
 
 		if err != nil {
-			res.Errs = append(res.Errs, fmt.Errorf("in %s: %w", objName, err))
+			res.Errs = append(res.Errs, &Finding{RuleID: ValidationRuleID, Severity: SeverityError, Object: objName, Message: err.Error()})
+		}
+		if spec == nil || pr.Err != nil || i >= len(docs) {
+			continue
+		}
+		for _, r := range rules {
+			for _, p := range r.check(spec, kind) {
+				res.Errs = append(res.Errs, &Finding{
+					RuleID:   r.id,
+					Severity: r.severity,
+					Object:   objName,
+					Message:  p.message,
+					Line:     docs[i].line(append(specPath, p.path...)),
+				})
+			}
 		}
 	}
 
@@ -271,10 +314,11 @@ func (l *LintResults) evaluate() *LintResults {
 		}
 		l.anythingLinted = true
 
-		if len(r.Errs) == 0 {
-			continue
+		for _, err := range r.Errs {
+			if severity(err) == SeverityError {
+				success = false
+			}
 		}
-		success = false
 	}
 
 	if !l.anythingLinted {
@@ -331,3 +375,32 @@ func getLintClients(client apiclient.Client, kinds []string) (ServiceClients, er
 
 	return res, nil
 }
+
+// severity returns the severity of the error, errors that are not findings are errors
+func severity(err error) Severity {
+	if f, ok := err.(*Finding); ok {
+		return f.Severity
+	}
+	return SeverityError
+}
+
+// getLine returns the line of the file the error was found at, or 0 if it is not known
+func getLine(err error) int {
+	if f, ok := err.(*Finding); ok {
+		return f.Line
+	}
+	return 0
+}
+
+// getFindings returns the errors of the result as findings
+func getFindings(r *LintResult) []*Finding {
+	var findings []*Finding
+	for _, err := range r.Errs {
+		finding, ok := err.(*Finding)
+		if !ok {
+			finding = &Finding{RuleID: ValidationRuleID, Severity: SeverityError, Message: err.Error()}
+		}
+		findings = append(findings, finding)
+	}
+	return findings
+}
@@ -0,0 +1,84 @@
+package lint
+
+import (
+	"regexp"
+	"strings"
+
+	jsonpkg "github.com/argoproj/pkg/json"
+	"gopkg.in/yaml.v3"
+)
+
+// the same separator common.ParseObjects splits documents with
+var yamlSeparator = regexp.MustCompile(`\n---`)
+
+// document is a YAML or JSON document of a file, so that the lines of the fields of its object can be found
+type document struct {
+	// the number of lines before the document in the file
+	offset int
+	node   *yaml.Node
+}
+
+// parseDocuments parses the documents of the file, in the same order as common.ParseObjects returns their objects
+func parseDocuments(data []byte) []document {
+	if jsonpkg.IsJSON(data) {
+		return []document{parseDocument(0, string(data))}
+	}
+	var docs []document
+	offset := 0
+	start := 0
+	for _, loc := range append(yamlSeparator.FindAllStringIndex(string(data), -1), []int{len(data), len(data)}) {
+		text := string(data[start:loc[0]])
+		if strings.TrimSpace(text) != "" {
+			docs = append(docs, parseDocument(offset, text))
+		}
+		offset += strings.Count(string(data[start:loc[1]]), "\n")
+		start = loc[1]
+	}
+	return docs
+}
+
+func parseDocument(offset int, text string) document {
+	node := &yaml.Node{}
+	if err := yaml.Unmarshal([]byte(text), node); err != nil {
+		return document{offset: offset}
+	}
+	return document{offset: offset, node: node}
+}
+
+// line returns the line of the field at the path, or the line of its closest ancestor that exists, or 0 if the
+// document could not be parsed
+func (d document) line(path []interface{}) int {
+	node := d.node
+	if node == nil {
+		return 0
+	}
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	line := node.Line
+	for _, p := range path {
+		var next *yaml.Node
+		switch key := p.(type) {
+		case string:
+			if node.Kind == yaml.MappingNode {
+				for i := 0; i+1 < len(node.Content); i += 2 {
+					if node.Content[i].Value == key {
+						// report fields at the line of their key, rather than the line their value starts at
+						next, line = node.Content[i+1], node.Content[i].Line
+						break
+					}
+				}
+			}
+		case int:
+			if node.Kind == yaml.SequenceNode && key < len(node.Content) {
+				next = node.Content[key]
+				line = next.Line
+			}
+		}
+		if next == nil {
+			break
+		}
+		node = next
+	}
+	return d.offset + line
+}
@@ -0,0 +1,366 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	wf "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// ValidationRuleID is the rule ID of the errors found by the server's validation
+const ValidationRuleID = "validation"
+
+// Finding is a problem found in an object by the server's validation or one of the rules
+type Finding struct {
+	RuleID   string
+	Severity Severity
+	// Object describes the object the problem was found in, e.g. `"my-wf" (Workflow)`
+	Object  string
+	Message string
+	// Line is the line of the file the problem was found at, starting at 1, or 0 if it is not known
+	Line int
+}
+
+func (f *Finding) Error() string {
+	msg := f.Message
+	if f.RuleID != ValidationRuleID {
+		msg = fmt.Sprintf("%s (%s)", msg, f.RuleID)
+	}
+	if f.Object == "" {
+		return msg
+	}
+	return fmt.Sprintf("in %s: %s", f.Object, msg)
+}
+
+// problem is a problem found by a rule, at a path relative to the workflow spec
+type problem struct {
+	path    []interface{}
+	message string
+}
+
+type rule struct {
+	id          string
+	severity    Severity
+	description string
+	// check returns the problems of the workflow spec of an object of the kind
+	check func(spec *wfv1.WorkflowSpec, kind string) []problem
+}
+
+var rules = []rule{
+	{"unused-template", SeverityWarning, "Templates that the workflow never runs", checkUnusedTemplates},
+	{"unused-parameter", SeverityWarning, "Workflow arguments and template inputs that are never used", checkUnusedParameters},
+	{"undefined-artifact-reference", SeverityError, "Artifacts that reference input or output artifacts that are not defined", checkArtifactReferences},
+	{"latest-image", SeverityWarning, "Images with the latest tag, or no tag, which can change between runs", checkLatestImages},
+	{"missing-resource-requests", SeverityWarning, "Containers without resource requests, which cannot be scheduled reliably", checkResourceRequests},
+	{"undefined-when-reference", SeverityError, "When expressions that reference steps or tasks that do not exist", checkWhenReferences},
+}
+
+// GetRuleIDs returns the IDs of all the rules
+func GetRuleIDs() []string {
+	var ids []string
+	for _, r := range rules {
+		ids = append(ids, r.id)
+	}
+	return ids
+}
+
+// expandRuleIDs returns the IDs of all the rules if the IDs include "all"
+func expandRuleIDs(ids []string) []string {
+	for _, id := range ids {
+		if id == "all" {
+			return GetRuleIDs()
+		}
+	}
+	return ids
+}
+
+func getRules(ids []string) ([]rule, error) {
+	var res []rule
+	for _, id := range ids {
+		found := false
+		for _, r := range rules {
+			if r.id == id {
+				res = append(res, r)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown rule: %s", id)
+		}
+	}
+	return res, nil
+}
+
+func getTemplate(spec *wfv1.WorkflowSpec, name string) *wfv1.Template {
+	for i, t := range spec.Templates {
+		if t.Name == name {
+			return &spec.Templates[i]
+		}
+	}
+	return nil
+}
+
+// templatesMayBeReferenced is whether the templates of objects of the kind may be run by other objects
+func templatesMayBeReferenced(kind string) bool {
+	return kind == wf.WorkflowTemplateKind || kind == wf.ClusterWorkflowTemplateKind
+}
+
+func checkUnusedTemplates(spec *wfv1.WorkflowSpec, kind string) []problem {
+	if templatesMayBeReferenced(kind) || spec.Entrypoint == "" {
+		return nil
+	}
+	used := map[string]bool{}
+	todo := []string{spec.Entrypoint, spec.OnExit}
+	for len(todo) > 0 {
+		name := todo[0]
+		todo = todo[1:]
+		if name == "" || used[name] {
+			continue
+		}
+		used[name] = true
+		tmpl := getTemplate(spec, name)
+		if tmpl == nil {
+			continue
+		}
+		for _, parallelSteps := range tmpl.Steps {
+			for _, step := range parallelSteps.Steps {
+				todo = append(todo, step.Template, step.OnExit)
+			}
+		}
+		if tmpl.DAG != nil {
+			for _, task := range tmpl.DAG.Tasks {
+				todo = append(todo, task.Template, task.OnExit)
+			}
+		}
+	}
+	var problems []problem
+	for i, tmpl := range spec.Templates {
+		if !used[tmpl.Name] {
+			problems = append(problems, problem{[]interface{}{"templates", i, "name"}, fmt.Sprintf("template %q is never used", tmpl.Name)})
+		}
+	}
+	return problems
+}
+
+// referencesParameter returns whether the JSON references the parameter, by name or as part of all the parameters
+func referencesParameter(data []byte, prefix, name string) bool {
+	quoted := regexp.QuoteMeta(name)
+	return regexp.MustCompile(regexp.QuoteMeta(prefix) + `(\.` + quoted + `([^\w-]|$)|\[\\?['"]` + quoted + `\\?['"]\]|\s*}})`).Match(data)
+}
+
+func checkUnusedParameters(spec *wfv1.WorkflowSpec, _ string) []problem {
+	var problems []problem
+	if spec.WorkflowTemplateRef == nil {
+		// the arguments of a workflow that references a template are used by the referenced template
+		data, _ := json.Marshal(spec)
+		for i, param := range spec.Arguments.Parameters {
+			if !referencesParameter(data, "workflow.parameters", param.Name) {
+				problems = append(problems, problem{[]interface{}{"arguments", "parameters", i, "name"}, fmt.Sprintf("workflow parameter %q is never used", param.Name)})
+			}
+		}
+	}
+	for i, tmpl := range spec.Templates {
+		data, _ := json.Marshal(tmpl)
+		for j, param := range tmpl.Inputs.Parameters {
+			if !referencesParameter(data, "inputs.parameters", param.Name) {
+				problems = append(problems, problem{[]interface{}{"templates", i, "inputs", "parameters", j, "name"}, fmt.Sprintf("input parameter %q of template %q is never used", param.Name, tmpl.Name)})
+			}
+		}
+	}
+	return problems
+}
+
+var (
+	outputArtifactReference = regexp.MustCompile(`{{\s*(steps|tasks)\.([^.}\s]+)\.outputs\.artifacts\.([^}\s]+)\s*}}`)
+	inputArtifactReference  = regexp.MustCompile(`{{\s*inputs\.artifacts\.([^}\s]+)\s*}}`)
+)
+
+func checkArtifactReferences(spec *wfv1.WorkflowSpec, _ string) []problem {
+	var problems []problem
+	for i, tmpl := range spec.Templates {
+		// the templates of the steps or tasks of the template, nil if they are not known, e.g. they are in other objects
+		templates := map[string]*wfv1.Template{}
+		check := func(path []interface{}, from string) {
+			for _, match := range outputArtifactReference.FindAllStringSubmatch(from, -1) {
+				name, artifactName := match[2], match[3]
+				t, ok := templates[name]
+				if !ok || t == nil {
+					continue
+				}
+				if t.Outputs.GetArtifactByName(artifactName) == nil {
+					problems = append(problems, problem{path, fmt.Sprintf("%q references artifact %q, which is not an output of template %q", from, artifactName, t.Name)})
+				}
+			}
+			for _, match := range inputArtifactReference.FindAllStringSubmatch(from, -1) {
+				if tmpl.Inputs.GetArtifactByName(match[1]) == nil {
+					problems = append(problems, problem{path, fmt.Sprintf("%q references artifact %q, which is not an input of template %q", from, match[1], tmpl.Name)})
+				}
+			}
+		}
+		for _, parallelSteps := range tmpl.Steps {
+			for _, step := range parallelSteps.Steps {
+				if step.TemplateRef == nil {
+					templates[step.Name] = getTemplate(spec, step.Template)
+				}
+			}
+		}
+		for j, parallelSteps := range tmpl.Steps {
+			for k, step := range parallelSteps.Steps {
+				for l, art := range step.Arguments.Artifacts {
+					check([]interface{}{"templates", i, "steps", j, k, "arguments", "artifacts", l, "from"}, art.From)
+				}
+			}
+		}
+		if tmpl.DAG != nil {
+			for _, task := range tmpl.DAG.Tasks {
+				if task.TemplateRef == nil {
+					templates[task.Name] = getTemplate(spec, task.Template)
+				}
+			}
+			for j, task := range tmpl.DAG.Tasks {
+				for l, art := range task.Arguments.Artifacts {
+					check([]interface{}{"templates", i, "dag", "tasks", j, "arguments", "artifacts", l, "from"}, art.From)
+				}
+			}
+		}
+		for j, art := range tmpl.Outputs.Artifacts {
+			check([]interface{}{"templates", i, "outputs", "artifacts", j, "from"}, art.From)
+		}
+	}
+	return problems
+}
+
+// usesLatestImage returns whether the image has the latest tag, or no tag, which is the same
+func usesLatestImage(image string) bool {
+	if image == "" || strings.Contains(image, "{{") || strings.Contains(image, "@") {
+		return false
+	}
+	name := image[strings.LastIndex(image, "/")+1:]
+	i := strings.LastIndex(name, ":")
+	return i < 0 || name[i+1:] == "latest"
+}
+
+// forEachContainer calls the func for each container of the template, with the path of the container
+func forEachContainer(tmpl *wfv1.Template, f func(path []interface{}, name, image string)) {
+	if tmpl.Container != nil {
+		f([]interface{}{"container"}, "main", tmpl.Container.Image)
+	}
+	if tmpl.Script != nil {
+		f([]interface{}{"script"}, "main", tmpl.Script.Image)
+	}
+	for i, c := range tmpl.ContainerSet.GetGraph() {
+		f([]interface{}{"containerSet", "containers", i}, c.Name, c.Image)
+	}
+	for i, c := range tmpl.InitContainers {
+		f([]interface{}{"initContainers", i}, c.Name, c.Image)
+	}
+	for i, c := range tmpl.Sidecars {
+		f([]interface{}{"sidecars", i}, c.Name, c.Image)
+	}
+}
+
+func checkLatestImages(spec *wfv1.WorkflowSpec, _ string) []problem {
+	var problems []problem
+	for i := range spec.Templates {
+		tmpl := &spec.Templates[i]
+		forEachContainer(tmpl, func(path []interface{}, name, image string) {
+			if usesLatestImage(image) {
+				problems = append(problems, problem{append([]interface{}{"templates", i}, append(path, "image")...), fmt.Sprintf("image %q of template %q uses the latest tag", image, tmpl.Name)})
+			}
+		})
+	}
+	return problems
+}
+
+func checkResourceRequests(spec *wfv1.WorkflowSpec, _ string) []problem {
+	if spec.PodSpecPatch != "" || (spec.TemplateDefaults != nil && spec.TemplateDefaults.Container != nil && len(spec.TemplateDefaults.Container.Resources.Requests) > 0) {
+		// the requests may be set by these instead
+		return nil
+	}
+	var problems []problem
+	for i := range spec.Templates {
+		tmpl := &spec.Templates[i]
+		if tmpl.PodSpecPatch != "" {
+			continue
+		}
+		missing := func(path []interface{}, name string) {
+			problems = append(problems, problem{append([]interface{}{"templates", i}, path...), fmt.Sprintf("container %q of template %q has no resource requests", name, tmpl.Name)})
+		}
+		if tmpl.Container != nil && len(tmpl.Container.Resources.Requests) == 0 {
+			missing([]interface{}{"container"}, "main")
+		}
+		if tmpl.Script != nil && len(tmpl.Script.Resources.Requests) == 0 {
+			missing([]interface{}{"script"}, "main")
+		}
+		for j, c := range tmpl.ContainerSet.GetGraph() {
+			if len(c.Resources.Requests) == 0 {
+				missing([]interface{}{"containerSet", "containers", j}, c.Name)
+			}
+		}
+	}
+	return problems
+}
+
+var (
+	whenStepReference = regexp.MustCompile(`steps\.([a-zA-Z0-9][-a-zA-Z0-9]*)\.`)
+	whenTaskReference = regexp.MustCompile(`tasks\.([a-zA-Z0-9][-a-zA-Z0-9]*)\.`)
+)
+
+// undefinedReferences returns the names referenced by the expression that are not defined, sorted
+func undefinedReferences(reference *regexp.Regexp, expression string, defined map[string]bool) []string {
+	undefined := map[string]bool{}
+	for _, match := range reference.FindAllStringSubmatch(expression, -1) {
+		if !defined[match[1]] {
+			undefined[match[1]] = true
+		}
+	}
+	var names []string
+	for name := range undefined {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func checkWhenReferences(spec *wfv1.WorkflowSpec, _ string) []problem {
+	var problems []problem
+	for i, tmpl := range spec.Templates {
+		steps := map[string]bool{}
+		for _, parallelSteps := range tmpl.Steps {
+			for _, step := range parallelSteps.Steps {
+				steps[step.Name] = true
+			}
+		}
+		for j, parallelSteps := range tmpl.Steps {
+			for k, step := range parallelSteps.Steps {
+				for _, name := range undefinedReferences(whenStepReference, step.When, steps) {
+					problems = append(problems, problem{[]interface{}{"templates", i, "steps", j, k, "when"}, fmt.Sprintf("when expression of step %q references step %q, which does not exist", step.Name, name)})
+				}
+			}
+		}
+		if tmpl.DAG == nil {
+			continue
+		}
+		tasks := map[string]bool{}
+		for _, task := range tmpl.DAG.Tasks {
+			tasks[task.Name] = true
+		}
+		for j, task := range tmpl.DAG.Tasks {
+			for _, name := range undefinedReferences(whenTaskReference, task.When, tasks) {
+				problems = append(problems, problem{[]interface{}{"templates", i, "dag", "tasks", j, "when"}, fmt.Sprintf("when expression of task %q references task %q, which does not exist", task.Name, name)})
+			}
+		}
+	}
+	return problems
+}
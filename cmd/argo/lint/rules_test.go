@@ -0,0 +1,181 @@
+package lint
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	workflowmocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow/mocks"
+	wftemplatemocks "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate/mocks"
+)
+
+var rulesFileData = []byte(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: rules-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: used
+    - name: unused
+  templates:
+  - name: main
+    steps:
+    - - name: produce
+        template: produce
+        arguments:
+          parameters: [{name: message, value: "{{workflow.parameters.used}}"}]
+    - - name: consume
+        template: consume
+        when: "{{steps.produce.status}} == Succeeded && {{steps.missing.status}} == Failed"
+        arguments:
+          artifacts:
+          - name: in
+            from: "{{steps.produce.outputs.artifacts.missing}}"
+  - name: produce
+    inputs:
+      parameters:
+      - name: message
+      - name: unused
+    container:
+      image: argoproj/argosay:v2
+      args: ["{{inputs.parameters.message}}"]
+      resources:
+        requests:
+          cpu: 100m
+    outputs:
+      artifacts:
+      - name: out
+        path: /tmp/out
+  - name: consume
+    inputs:
+      artifacts:
+      - name: in
+        path: /tmp/in
+    container:
+      image: argoproj/argosay
+  - name: orphan
+    container:
+      image: argoproj/argosay:latest
+      resources:
+        requests:
+          cpu: 100m
+---
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: my-wftmpl
+spec:
+  templates:
+  - name: orphan
+    script:
+      image: python:3.9
+      source: print("hello")
+    podSpecPatch: '{"containers":[{"name":"main","resources":{"requests":{"cpu":"100m"}}}]}'
+`)
+
+func lintRules(t *testing.T, ruleIDs []string) []*Finding {
+	wfServiceClientMock := &workflowmocks.WorkflowServiceClient{}
+	wfServiceClientMock.On("LintWorkflow", mock.Anything, mock.Anything).Return(nil, nil)
+	wftServiceClientMock := &wftemplatemocks.WorkflowTemplateServiceClient{}
+	wftServiceClientMock.On("LintWorkflowTemplate", mock.Anything, mock.Anything).Return(nil, nil)
+	r, w, err := os.Pipe()
+	assert.NoError(t, err)
+	_, err = w.Write(rulesFileData)
+	assert.NoError(t, err)
+	w.Close()
+	stdin := os.Stdin
+	defer func() { os.Stdin = stdin }()
+	os.Stdin = r
+	res, err := Lint(context.Background(), &LintOptions{
+		Files: []string{"-"},
+		Rules: ruleIDs,
+		ServiceClients: ServiceClients{
+			WorkflowsClient:         wfServiceClientMock,
+			WorkflowTemplatesClient: wftServiceClientMock,
+		},
+	})
+	if !assert.NoError(t, err) || !assert.Len(t, res.Results, 1) {
+		return nil
+	}
+	return getFindings(res.Results[0])
+}
+
+func TestRules(t *testing.T) {
+	tests := map[string][]Finding{
+		"unused-template": {
+			{Severity: SeverityWarning, Line: 47, Message: `template "orphan" is never used`},
+		},
+		"unused-parameter": {
+			{Severity: SeverityWarning, Line: 10, Message: `workflow parameter "unused" is never used`},
+			{Severity: SeverityWarning, Line: 29, Message: `input parameter "unused" of template "produce" is never used`},
+		},
+		"undefined-artifact-reference": {
+			{Severity: SeverityError, Line: 24, Message: `"{{steps.produce.outputs.artifacts.missing}}" references artifact "missing", which is not an output of template "produce"`},
+		},
+		"latest-image": {
+			{Severity: SeverityWarning, Line: 46, Message: `image "argoproj/argosay" of template "consume" uses the latest tag`},
+			{Severity: SeverityWarning, Line: 49, Message: `image "argoproj/argosay:latest" of template "orphan" uses the latest tag`},
+		},
+		"missing-resource-requests": {
+			{Severity: SeverityWarning, Line: 45, Message: `container "main" of template "consume" has no resource requests`},
+		},
+		"undefined-when-reference": {
+			{Severity: SeverityError, Line: 20, Message: `when expression of step "consume" references step "missing", which does not exist`},
+		},
+	}
+	for id, expected := range tests {
+		t.Run(id, func(t *testing.T) {
+			findings := lintRules(t, []string{id})
+			if assert.Len(t, findings, len(expected)) {
+				for i, finding := range findings {
+					assert.Equal(t, id, finding.RuleID)
+					assert.Equal(t, `"rules-" (Workflow)`, finding.Object)
+					assert.Equal(t, expected[i].Severity, finding.Severity)
+					assert.Equal(t, expected[i].Message, finding.Message)
+					assert.Equal(t, expected[i].Line, finding.Line)
+				}
+			}
+		})
+	}
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := Lint(context.Background(), &LintOptions{Rules: []string{"foo"}})
+		assert.EqualError(t, err, "unknown rule: foo")
+	})
+}
+
+func TestExpandRuleIDs(t *testing.T) {
+	assert.Equal(t, GetRuleIDs(), expandRuleIDs([]string{"all"}))
+	assert.Equal(t, GetRuleIDs(), expandRuleIDs([]string{"latest-image", "all"}))
+	assert.Equal(t, []string{"latest-image"}, expandRuleIDs([]string{"latest-image"}))
+	// only the exact ID "all" means all the rules
+	assert.Equal(t, []string{"install"}, expandRuleIDs([]string{"install"}))
+}
+
+func TestUsesLatestImage(t *testing.T) {
+	for image, expected := range map[string]bool{
+		"argoproj/argosay":             true,
+		"argoproj/argosay:latest":      true,
+		"localhost:5000/argosay":       true,
+		"localhost:5000/argosay:v2":    false,
+		"argoproj/argosay:v2":          false,
+		"argoproj/argosay@sha256:0123": false,
+		"{{inputs.parameters.image}}":  false,
+	} {
+		assert.Equal(t, expected, usesLatestImage(image), image)
+	}
+}
+
+func TestDocumentLine(t *testing.T) {
+	docs := parseDocuments(rulesFileData)
+	if assert.Len(t, docs, 2) {
+		assert.Equal(t, 6, docs[0].line([]interface{}{"spec", "entrypoint"}))
+		assert.Equal(t, 14, docs[0].line([]interface{}{"spec", "templates", 0, "steps", 0, 0}))
+		assert.Equal(t, 12, docs[0].line([]interface{}{"spec", "templates", 0, "missing"}))
+		assert.Equal(t, 60, docs[1].line([]interface{}{"spec", "templates", 0, "name"}))
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/argoproj/argo-workflows/v3"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
 	"github.com/argoproj/argo-workflows/v3/util/cmd"
 	"github.com/argoproj/argo-workflows/v3/util/logs"
@@ -45,6 +46,9 @@ func init() {
 
 func initConfig() {
 	cmd.SetLogFormatter(logFormat)
+	if values := common.GetSensitiveParameterValues(); len(values) > 0 {
+		log.SetFormatter(logs.NewRedactingFormatter(log.StandardLogger().Formatter, values, wfv1.SensitiveParameterMask))
+	}
 	cli.SetLogLevel(logLevel)
 	cmd.SetGLogLevel(glogLevel)
 }
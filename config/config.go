@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"path"
+	"time"
 
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -82,6 +83,9 @@ type Config struct {
 	// Persistence contains the workflow persistence DB configuration
 	Persistence *PersistConfig `json:"persistence,omitempty"`
 
+	// ArtifactNodeStatusOffload offloads the status of large workflows to an S3 artifact repository, rather than to the persistence database
+	ArtifactNodeStatusOffload *ArtifactNodeStatusOffloadConfig `json:"artifactNodeStatusOffload,omitempty"`
+
 	// Links to related apps.
 	Links []*wfv1.Link `json:"links,omitempty"`
 
@@ -159,6 +163,24 @@ type ArtifactRepository struct {
 	GCS *GCSArtifactRepository `json:"gcs,omitempty"`
 }
 
+// Validate returns an error if the artifact repository is misconfigured
+func (a *ArtifactRepository) Validate() error {
+	if a == nil {
+		return nil
+	}
+	if a.S3 != nil && a.S3.Streaming != nil {
+		if err := a.S3.Streaming.ValidateMultipart(); err != nil {
+			return fmt.Errorf("s3.%w", err)
+		}
+	}
+	if a.OSS != nil && a.OSS.Streaming != nil {
+		if err := a.OSS.Streaming.ValidateMultipart(); err != nil {
+			return fmt.Errorf("oss.%w", err)
+		}
+	}
+	return nil
+}
+
 func (a *ArtifactRepository) IsArchiveLogs() bool {
 	return a != nil && a.ArchiveLogs != nil && *a.ArchiveLogs
 }
@@ -205,12 +227,19 @@ type PersistConfig struct {
 	// ArchivelabelSelector holds LabelSelector to determine workflow persistence.
 	ArchiveLabelSelector *metav1.LabelSelector `json:"archiveLabelSelector,omitempty"`
 	// in days
-	ArchiveTTL     TTL               `json:"archiveTTL,omitempty"`
-	ClusterName    string            `json:"clusterName,omitempty"`
-	ConnectionPool *ConnectionPool   `json:"connectionPool,omitempty"`
-	PostgreSQL     *PostgreSQLConfig `json:"postgresql,omitempty"`
-	MySQL          *MySQLConfig      `json:"mysql,omitempty"`
-	SkipMigration  bool              `json:"skipMigration,omitempty"`
+	ArchiveTTL TTL `json:"archiveTTL,omitempty"`
+	// ArchiveExport exports archived workflows to an artifact repository before they are deleted by the archive TTL
+	ArchiveExport  *ArchiveExportConfig `json:"archiveExport,omitempty"`
+	ClusterName    string               `json:"clusterName,omitempty"`
+	ConnectionPool *ConnectionPool      `json:"connectionPool,omitempty"`
+	PostgreSQL     *PostgreSQLConfig    `json:"postgresql,omitempty"`
+	MySQL          *MySQLConfig         `json:"mysql,omitempty"`
+	SQLite         *SQLiteConfig        `json:"sqlite,omitempty"`
+	SkipMigration  bool                 `json:"skipMigration,omitempty"`
+	// EventDeliveries records the events received by the Argo Server in the database, rather than in memory
+	EventDeliveries bool `json:"eventDeliveries,omitempty"`
+	// EventDeliveriesTTL is how long event deliveries are kept for, defaults to 7d
+	EventDeliveriesTTL TTL `json:"eventDeliveriesTTL,omitempty"`
 }
 
 func (c PersistConfig) GetArchiveLabelSelector() (labels.Selector, error) {
@@ -220,6 +249,13 @@ func (c PersistConfig) GetArchiveLabelSelector() (labels.Selector, error) {
 	return metav1.LabelSelectorAsSelector(c.ArchiveLabelSelector)
 }
 
+func (c PersistConfig) GetEventDeliveriesTTL() time.Duration {
+	if c.EventDeliveriesTTL > 0 {
+		return time.Duration(c.EventDeliveriesTTL)
+	}
+	return 7 * 24 * time.Hour
+}
+
 func (c PersistConfig) GetClusterName() string {
 	if c.ClusterName != "" {
 		return c.ClusterName
@@ -227,6 +263,44 @@ func (c PersistConfig) GetClusterName() string {
 	return "default"
 }
 
+// ArchiveExportConfig configures the export of expiring archived workflows to an artifact repository for cold retention
+type ArchiveExportConfig struct {
+	// ArtifactRepository to export to, any secrets must be in the same namespace as the controller
+	ArtifactRepository ArtifactRepository `json:"artifactRepository"`
+	// KeyPrefix is prefix of the keys exported workflows are stored under, defaults to "archived-workflows"
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+}
+
+func (c ArchiveExportConfig) GetKeyPrefix() string {
+	if c.KeyPrefix != "" {
+		return c.KeyPrefix
+	}
+	return "archived-workflows"
+}
+
+// ArtifactNodeStatusOffloadConfig configures offloading node status to an artifact repository
+type ArtifactNodeStatusOffloadConfig struct {
+	// ArtifactRepository to offload to, defaults to the default artifact repository, only S3 is supported
+	// Any secrets must be in the same namespace as the controller.
+	ArtifactRepository *ArtifactRepository `json:"artifactRepository,omitempty"`
+	// KeyPrefix is prefix of the keys offloaded node statuses are stored under, defaults to "offloaded-node-status"
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+}
+
+func (c ArtifactNodeStatusOffloadConfig) GetArtifactRepository(defaultRepository ArtifactRepository) ArtifactRepository {
+	if c.ArtifactRepository != nil {
+		return *c.ArtifactRepository
+	}
+	return defaultRepository
+}
+
+func (c ArtifactNodeStatusOffloadConfig) GetKeyPrefix() string {
+	if c.KeyPrefix != "" {
+		return c.KeyPrefix
+	}
+	return "offloaded-node-status"
+}
+
 type ConnectionPool struct {
 	MaxIdleConns    int `json:"maxIdleConns,omitempty"`
 	MaxOpenConns    int `json:"maxOpenConns,omitempty"`
@@ -260,6 +334,14 @@ type MySQLConfig struct {
 	Options map[string]string `json:"options,omitempty"`
 }
 
+// SQLiteConfig configures an embedded SQLite database, suitable for small installs and testing.
+// The database file cannot be shared by pods, so the workflow controller and Argo Server must run on the same host.
+type SQLiteConfig struct {
+	// Path of the database file, e.g. "/data/argo.db"
+	Path      string `json:"path"`
+	TableName string `json:"tableName,omitempty"`
+}
+
 // S3ArtifactRepository defines the controller configuration for an S3 artifact repository
 type S3ArtifactRepository struct {
 	wfv1.S3Bucket `json:",inline"`
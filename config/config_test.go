@@ -4,9 +4,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/pointer"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func TestArtifactRepository(t *testing.T) {
@@ -63,6 +66,14 @@ func TestArtifactRepository(t *testing.T) {
 	})
 }
 
+func TestArtifactRepository_Validate(t *testing.T) {
+	partSize := resource.MustParse("1Mi")
+	assert.NoError(t, (*ArtifactRepository)(nil).Validate())
+	assert.NoError(t, (&ArtifactRepository{S3: &S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Streaming: &wfv1.ArtifactStreaming{}}}}).Validate())
+	assert.EqualError(t, (&ArtifactRepository{S3: &S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Streaming: &wfv1.ArtifactStreaming{PartSize: &partSize}}}}).Validate(), "s3.streaming.partSize 1Mi is less than the minimum of 5Mi")
+	assert.EqualError(t, (&ArtifactRepository{OSS: &OSSArtifactRepository{OSSBucket: wfv1.OSSBucket{Streaming: &wfv1.ArtifactStreaming{PartSize: &partSize}}}}).Validate(), "oss.streaming.partSize 1Mi is less than the minimum of 5Mi")
+}
+
 func TestArtifactRepository_IsArchiveLogs(t *testing.T) {
 	assert.False(t, (&ArtifactRepository{}).IsArchiveLogs())
 	assert.False(t, (&ArtifactRepository{ArchiveLogs: pointer.BoolPtr(false)}).IsArchiveLogs())
@@ -0,0 +1,330 @@
+package artifactoffload
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+const fileExtension = ".json.gz"
+
+// offload is a single offloaded node status object
+type offload struct {
+	sqldb.UUIDVersion
+	namespace    string
+	key          string
+	lastModified time.Time
+}
+
+// repo stores each version of the node status as a gzip compressed JSON object:
+//
+//	<keyPrefix>/<uid>/<namespace>/<version>.json.gz
+//
+// A version is old once the object was last modified more than the TTL ago, so that this survives restarts. As with
+// the database, a version is only deleted if it is not live.
+//
+// Only S3 supports listing objects with when they were last modified, and deleting objects, so only S3 is supported.
+type repo struct {
+	location  *wfv1.ArtifactLocation
+	keyPrefix string
+	ri        resource.Interface
+	newDriver artifact.NewDriverFunc
+	// time to live - at what ttl an offload becomes old
+	ttl time.Duration
+	now func() time.Time
+	// the namespace of each uid saved or last listed, so that offloads can be deleted without listing them
+	namespaces     map[string]string
+	namespacesLock sync.Mutex
+}
+
+// New returns an offload node status repo backed by an artifact repository, with any secrets read from the namespace
+func New(offloadConfig config.ArtifactNodeStatusOffloadConfig, defaultRepository config.ArtifactRepository, kubeClient kubernetes.Interface, namespace string) (sqldb.OffloadNodeStatusRepo, error) {
+	// this environment variable allows you to make Argo Workflows delete offloaded data more or less aggressively,
+	// useful for testing
+	ttl := env.LookupEnvDurationOr("OFFLOAD_NODE_STATUS_TTL", 5*time.Minute)
+	log.WithField("ttl", ttl).Info("Artifact node status offloading config")
+	return newRepo(offloadConfig, defaultRepository, resource.New(kubeClient, namespace), artifact.NewDriver, ttl, time.Now)
+}
+
+func newRepo(offloadConfig config.ArtifactNodeStatusOffloadConfig, defaultRepository config.ArtifactRepository, ri resource.Interface, newDriver artifact.NewDriverFunc, ttl time.Duration, now func() time.Time) (*repo, error) {
+	artifactRepository := offloadConfig.GetArtifactRepository(defaultRepository)
+	l := artifactRepository.ToArtifactLocation()
+	if l.S3 == nil {
+		return nil, fmt.Errorf("node status offload artifact repository must be S3")
+	}
+	return &repo{
+		location:   l,
+		keyPrefix:  offloadConfig.GetKeyPrefix(),
+		ri:         ri,
+		newDriver:  newDriver,
+		ttl:        ttl,
+		now:        now,
+		namespaces: make(map[string]string),
+	}, nil
+}
+
+func (r *repo) IsEnabled() bool {
+	return true
+}
+
+func (r *repo) Save(uid, namespace string, nodes wfv1.Nodes) (string, error) {
+	marshalled, version, err := sqldb.NodeStatusVersion(nodes)
+	if err != nil {
+		return "", err
+	}
+	logCtx := log.WithFields(log.Fields{"uid": uid, "version": version})
+	logCtx.Debug("Offloading nodes")
+	err = r.save(r.key(uid, namespace, version), marshalled)
+	if err != nil {
+		return "", err
+	}
+	r.namespacesLock.Lock()
+	r.namespaces[uid] = namespace
+	r.namespacesLock.Unlock()
+	logCtx.Debug("Nodes offloaded, cleaning up old offloads")
+
+	// as with the database, this might fail, or not delete every old version, which is fine as we always key on version
+	offloads, err := r.list(uid)
+	if err != nil {
+		return "", err
+	}
+	for _, o := range offloads {
+		if o.Version != version && r.isOld(o) {
+			err := r.delete(o.key)
+			if err != nil {
+				return "", err
+			}
+			logCtx.WithField("key", o.key).Debug("Deleted offloaded nodes")
+		}
+	}
+	return version, nil
+}
+
+func (r *repo) Get(uid, namespace, version string) (wfv1.Nodes, error) {
+	log.WithFields(log.Fields{"uid": uid, "namespace": namespace, "version": version}).Debug("Getting offloaded nodes")
+	return r.load(r.key(uid, namespace, version))
+}
+
+func (r *repo) List(namespace string) (map[sqldb.UUIDVersion]wfv1.Nodes, error) {
+	log.WithFields(log.Fields{"namespace": namespace}).Debug("Listing offloaded nodes")
+	offloads, err := r.list("")
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[sqldb.UUIDVersion]wfv1.Nodes)
+	for _, o := range offloads {
+		if namespace != "" && o.namespace != namespace {
+			continue
+		}
+		nodes, err := r.load(o.key)
+		if err != nil {
+			return nil, err
+		}
+		res[o.UUIDVersion] = nodes
+	}
+	return res, nil
+}
+
+func (r *repo) ListOldOffloads(namespace string) ([]sqldb.UUIDVersion, error) {
+	log.WithFields(log.Fields{"namespace": namespace}).Debug("Listing old offloaded nodes")
+	offloads, err := r.list("")
+	if err != nil {
+		return nil, err
+	}
+	var records []sqldb.UUIDVersion
+	for _, o := range offloads {
+		if (namespace == "" || o.namespace == namespace) && r.isOld(o) {
+			records = append(records, o.UUIDVersion)
+		}
+	}
+	return records, nil
+}
+
+func (r *repo) Delete(uid, version string) error {
+	if uid == "" {
+		return fmt.Errorf("invalid uid")
+	}
+	if version == "" {
+		return fmt.Errorf("invalid version")
+	}
+	logCtx := log.WithFields(log.Fields{"uid": uid, "version": version})
+	logCtx.Debug("Deleting offloaded nodes")
+	r.namespacesLock.Lock()
+	namespace, ok := r.namespaces[uid]
+	r.namespacesLock.Unlock()
+	// offloads are normally listed before they are deleted, so we only need to list them if we are deleting an offload
+	// we have never seen
+	if !ok {
+		offloads, err := r.list(uid)
+		if err != nil {
+			return err
+		}
+		if len(offloads) == 0 {
+			return nil
+		}
+		namespace = offloads[0].namespace
+	}
+	key := r.key(uid, namespace, version)
+	err := r.delete(key)
+	if err != nil {
+		return err
+	}
+	logCtx.WithField("key", key).Debug("Deleted offloaded nodes")
+	return nil
+}
+
+func (r *repo) isOld(o offload) bool {
+	return r.now().Sub(o.lastModified) > r.ttl
+}
+
+// versions contain a colon, which is best avoided in keys
+func (r *repo) key(uid, namespace, version string) string {
+	return path.Join(r.keyPrefix, uid, namespace, strings.Replace(version, ":", "-", 1)+fileExtension)
+}
+
+func (r *repo) parseKey(key string, lastModified time.Time) (offload, bool) {
+	parts := strings.Split(strings.TrimPrefix(key, r.keyPrefix+"/"), "/")
+	if len(parts) != 3 || !strings.HasSuffix(parts[2], fileExtension) {
+		return offload{}, false
+	}
+	version := strings.Replace(strings.TrimSuffix(parts[2], fileExtension), "-", ":", 1)
+	return offload{UUIDVersion: sqldb.UUIDVersion{UID: parts[0], Version: version}, namespace: parts[1], key: key, lastModified: lastModified}, true
+}
+
+// list lists the offloads for the uid, or every offload if the uid is empty
+func (r *repo) list(uid string) ([]offload, error) {
+	art, driver, err := r.artifactAndDriver(path.Join(r.keyPrefix, uid))
+	if err != nil {
+		return nil, err
+	}
+	lister, ok := driver.(common.ArtifactObjectLister)
+	if !ok {
+		return nil, fmt.Errorf("artifact driver %T does not support listing offloaded nodes", driver)
+	}
+	objects, err := lister.ListObjectsInfo(art)
+	if err != nil {
+		return nil, err
+	}
+	var offloads []offload
+	namespaces := make(map[string]string)
+	for _, object := range objects {
+		o, ok := r.parseKey(object.Key, object.LastModified)
+		if ok && (uid == "" || o.UID == uid) {
+			offloads = append(offloads, o)
+			namespaces[o.UID] = o.namespace
+		}
+	}
+	r.namespacesLock.Lock()
+	defer r.namespacesLock.Unlock()
+	// listing every offload replaces the namespaces, so that we forget uids that no longer have any offloads
+	if uid == "" {
+		r.namespaces = namespaces
+	} else {
+		for u, namespace := range namespaces {
+			r.namespaces[u] = namespace
+		}
+	}
+	return offloads, nil
+}
+
+func (r *repo) save(key, marshalled string) error {
+	tmp, err := ioutil.TempFile("", "offloaded-node-status")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	gz := gzip.NewWriter(tmp)
+	_, err = gz.Write([]byte(marshalled))
+	if err == nil {
+		err = gz.Close()
+	}
+	if err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	art, driver, err := r.artifactAndDriver(key)
+	if err != nil {
+		return err
+	}
+	return driver.Save(tmp.Name(), art)
+}
+
+func (r *repo) load(key string) (wfv1.Nodes, error) {
+	art, driver, err := r.artifactAndDriver(key)
+	if err != nil {
+		return nil, err
+	}
+	tmp, err := ioutil.TempFile("", "offloaded-node-status")
+	if err != nil {
+		return nil, err
+	}
+	_ = tmp.Close()
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	err = driver.Load(art, tmp.Name())
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(tmp.Name())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = gz.Close() }()
+	nodes := wfv1.Nodes{}
+	err = json.NewDecoder(gz).Decode(&nodes)
+	if err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+func (r *repo) delete(key string) error {
+	art, driver, err := r.artifactAndDriver(key)
+	if err != nil {
+		return err
+	}
+	deleter, ok := driver.(common.ArtifactDeleter)
+	if !ok {
+		return fmt.Errorf("artifact driver %T does not support deleting offloaded nodes", driver)
+	}
+	return deleter.Delete(art)
+}
+
+func (r *repo) artifactAndDriver(key string) (*wfv1.Artifact, common.ArtifactDriver, error) {
+	art := &wfv1.Artifact{Name: "offloaded-node-status", ArtifactLocation: *r.location.DeepCopy()}
+	err := art.SetKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	driver, err := r.newDriver(context.Background(), art, r.ri)
+	if err != nil {
+		return nil, nil, err
+	}
+	return art, driver, nil
+}
@@ -0,0 +1,131 @@
+package artifactoffload
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+var s3Repository = config.ArtifactRepository{S3: &config.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}}}
+
+// listCountingDriver counts how many times objects are listed, as listing is expensive
+type listCountingDriver struct {
+	*fake.ArtifactDriver
+	lists int
+}
+
+func (d *listCountingDriver) ListObjectsInfo(art *wfv1.Artifact) ([]common.ObjectInfo, error) {
+	d.lists++
+	return d.ArtifactDriver.ListObjectsInfo(art)
+}
+
+func TestNew(t *testing.T) {
+	_, err := newRepo(config.ArtifactNodeStatusOffloadConfig{}, config.ArtifactRepository{}, nil, nil, time.Minute, time.Now)
+	assert.EqualError(t, err, "node status offload artifact repository must be S3")
+	r, err := newRepo(config.ArtifactNodeStatusOffloadConfig{}, s3Repository, nil, nil, time.Minute, time.Now)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "my-bucket", r.location.S3.Bucket)
+		assert.Equal(t, "offloaded-node-status", r.keyPrefix)
+	}
+}
+
+func TestOffloadNodeStatusRepo(t *testing.T) {
+	now := time.Unix(0, 0)
+	clock := func() time.Time { return now }
+	driver := &listCountingDriver{ArtifactDriver: fake.NewArtifactDriver(clock)}
+	newDriver := func(context.Context, *wfv1.Artifact, resource.Interface) (common.ArtifactDriver, error) {
+		return driver, nil
+	}
+	r, err := newRepo(config.ArtifactNodeStatusOffloadConfig{KeyPrefix: "my-prefix"}, s3Repository, nil, newDriver, time.Minute, clock)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, r.IsEnabled())
+	nodes := wfv1.Nodes{"my-node": wfv1.NodeStatus{Name: "my-node"}}
+	version, err := r.Save("my-uid", "my-ns", nodes)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, expectedVersion, _ := sqldb.NodeStatusVersion(nodes)
+	assert.Equal(t, expectedVersion, version)
+	assert.Contains(t, driver.Objects, "my-prefix/my-uid/my-ns/"+strings.Replace(version, ":", "-", 1)+".json.gz")
+	t.Run("Get", func(t *testing.T) {
+		lists := driver.lists
+		got, err := r.Get("my-uid", "my-ns", version)
+		if assert.NoError(t, err) {
+			assert.Equal(t, nodes, got)
+		}
+		_, err = r.Get("my-uid", "my-ns", "fnv:0")
+		assert.Error(t, err)
+		assert.Equal(t, lists, driver.lists, "getting does not list")
+	})
+	t.Run("List", func(t *testing.T) {
+		list, err := r.List("my-ns")
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[sqldb.UUIDVersion]wfv1.Nodes{{UID: "my-uid", Version: version}: nodes}, list)
+		}
+		list, err = r.List("other-ns")
+		if assert.NoError(t, err) {
+			assert.Empty(t, list)
+		}
+	})
+	t.Run("ListOldOffloads", func(t *testing.T) {
+		old, err := r.ListOldOffloads("my-ns")
+		if assert.NoError(t, err) {
+			assert.Empty(t, old, "recently saved versions are not old")
+		}
+		restarted, err := newRepo(config.ArtifactNodeStatusOffloadConfig{KeyPrefix: "my-prefix"}, s3Repository, nil, newDriver, time.Minute, clock)
+		if assert.NoError(t, err) {
+			old, err := restarted.ListOldOffloads("my-ns")
+			if assert.NoError(t, err) {
+				assert.Empty(t, old, "recently saved versions are not old after a restart")
+			}
+		}
+		now = now.Add(2 * time.Minute)
+		old, err = r.ListOldOffloads("")
+		if assert.NoError(t, err) {
+			assert.Equal(t, []sqldb.UUIDVersion{{UID: "my-uid", Version: version}}, old)
+		}
+	})
+	t.Run("SaveDeletesOldVersions", func(t *testing.T) {
+		newNodes := wfv1.Nodes{"my-node": wfv1.NodeStatus{Name: "my-node", Phase: wfv1.NodeSucceeded}}
+		newVersion, err := r.Save("my-uid", "my-ns", newNodes)
+		if assert.NoError(t, err) {
+			assert.NotEqual(t, version, newVersion)
+			assert.Len(t, driver.Objects, 1)
+			_, err := r.Get("my-uid", "my-ns", newVersion)
+			assert.NoError(t, err)
+		}
+		version = newVersion
+	})
+	t.Run("Delete", func(t *testing.T) {
+		assert.Error(t, r.Delete("", version))
+		assert.Error(t, r.Delete("my-uid", ""))
+		lists := driver.lists
+		assert.NoError(t, r.Delete("my-uid", version))
+		assert.Empty(t, driver.Objects)
+		assert.Equal(t, lists, driver.lists, "deleting a saved offload does not list")
+	})
+	t.Run("DeleteAfterRestart", func(t *testing.T) {
+		version, err := r.Save("my-uid", "my-ns", nodes)
+		if !assert.NoError(t, err) {
+			return
+		}
+		restarted, err := newRepo(config.ArtifactNodeStatusOffloadConfig{KeyPrefix: "my-prefix"}, s3Repository, nil, newDriver, time.Minute, clock)
+		if assert.NoError(t, err) {
+			assert.NoError(t, restarted.Delete("my-uid", version))
+			assert.Empty(t, driver.Objects)
+			assert.NoError(t, restarted.Delete("my-uid", version), "deleting an offload that does not exist is not an error")
+		}
+	})
+}
@@ -0,0 +1,212 @@
+package coldstorage
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+const (
+	fileExtension = ".jsonl.gz"
+	dateFormat    = "2006-01-02"
+)
+
+// Interface exports archived workflows to an artifact repository for cold retention, and imports them back.
+//
+// Workflows are stored as gzip compressed JSON lines, partitioned by the (UTC) date they finished on:
+//
+//	<keyPrefix>/<yyyy-mm-dd>/<clusterName>-<exportedAt>.jsonl.gz
+type Interface interface {
+	// Export saves the workflows to the artifact repository, returning the keys written.
+	Export(ctx context.Context, wfs wfv1.Workflows) ([]string, error)
+	// Keys lists the keys of the objects in the partition for the date (yyyy-mm-dd).
+	Keys(ctx context.Context, date string) ([]string, error)
+	// Import loads the workflows stored under the key.
+	Import(ctx context.Context, key string) (wfv1.Workflows, error)
+}
+
+type coldStorage struct {
+	location    *wfv1.ArtifactLocation
+	keyPrefix   string
+	clusterName string
+	ri          resource.Interface
+	newDriver   artifact.NewDriverFunc
+	now         func() time.Time
+}
+
+// New returns cold storage backed by the export artifact repository, with any secrets read from the namespace
+func New(exportConfig config.ArchiveExportConfig, clusterName string, kubeClient kubernetes.Interface, namespace string) (Interface, error) {
+	return newColdStorage(exportConfig, clusterName, resource.New(kubeClient, namespace), artifact.NewDriver, time.Now)
+}
+
+func newColdStorage(exportConfig config.ArchiveExportConfig, clusterName string, ri resource.Interface, newDriver artifact.NewDriverFunc, now func() time.Time) (*coldStorage, error) {
+	l := exportConfig.ArtifactRepository.ToArtifactLocation()
+	if l.Get() == nil {
+		return nil, fmt.Errorf("archive export artifact repository not configured")
+	}
+	return &coldStorage{
+		location:    l,
+		keyPrefix:   exportConfig.GetKeyPrefix(),
+		clusterName: clusterName,
+		ri:          ri,
+		newDriver:   newDriver,
+		now:         now,
+	}, nil
+}
+
+func (s *coldStorage) Export(ctx context.Context, wfs wfv1.Workflows) ([]string, error) {
+	partitions := make(map[string]wfv1.Workflows)
+	for _, wf := range wfs {
+		date := wf.Status.FinishedAt.UTC().Format(dateFormat)
+		partitions[date] = append(partitions[date], wf)
+	}
+	dates := make([]string, 0, len(partitions))
+	for date := range partitions {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+	exportedAt := s.now().UnixNano()
+	var keys []string
+	for _, date := range dates {
+		key := path.Join(s.keyPrefix, date, fmt.Sprintf("%s-%d%s", s.clusterName, exportedAt, fileExtension))
+		err := s.save(ctx, key, partitions[date])
+		if err != nil {
+			return keys, fmt.Errorf("failed to export archived workflows to %s: %w", key, err)
+		}
+		log.WithFields(log.Fields{"key": key, "workflows": len(partitions[date])}).Info("Exported archived workflows")
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (s *coldStorage) save(ctx context.Context, key string, wfs wfv1.Workflows) error {
+	tmp, err := ioutil.TempFile("", "archived-workflows")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	err = write(tmp, wfs)
+	if err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	art, driver, err := s.artifactAndDriver(ctx, key)
+	if err != nil {
+		return err
+	}
+	return driver.Save(tmp.Name(), art)
+}
+
+func (s *coldStorage) Keys(ctx context.Context, date string) ([]string, error) {
+	if _, err := time.Parse(dateFormat, date); err != nil {
+		return nil, fmt.Errorf("invalid date %q, must be yyyy-mm-dd: %w", date, err)
+	}
+	art, driver, err := s.artifactAndDriver(ctx, path.Join(s.keyPrefix, date))
+	if err != nil {
+		return nil, err
+	}
+	objects, err := driver.ListObjects(art)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, key := range objects {
+		if strings.HasSuffix(key, fileExtension) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *coldStorage) Import(ctx context.Context, key string) (wfv1.Workflows, error) {
+	art, driver, err := s.artifactAndDriver(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	tmp, err := ioutil.TempFile("", "archived-workflows")
+	if err != nil {
+		return nil, err
+	}
+	_ = tmp.Close()
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	err = driver.Load(art, tmp.Name())
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(tmp.Name())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	return read(f)
+}
+
+func (s *coldStorage) artifactAndDriver(ctx context.Context, key string) (*wfv1.Artifact, common.ArtifactDriver, error) {
+	art := &wfv1.Artifact{Name: "archived-workflows", ArtifactLocation: *s.location.DeepCopy()}
+	err := art.SetKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	driver, err := s.newDriver(ctx, art, s.ri)
+	if err != nil {
+		return nil, nil, err
+	}
+	return art, driver, nil
+}
+
+// write writes the workflows as gzip compressed JSON lines
+func write(w io.Writer, wfs wfv1.Workflows) error {
+	gz := gzip.NewWriter(w)
+	encoder := json.NewEncoder(gz)
+	for _, wf := range wfs {
+		err := encoder.Encode(wf)
+		if err != nil {
+			return err
+		}
+	}
+	return gz.Close()
+}
+
+// read reads workflows from gzip compressed JSON lines
+func read(r io.Reader) (wfv1.Workflows, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = gz.Close() }()
+	decoder := json.NewDecoder(gz)
+	var wfs wfv1.Workflows
+	for {
+		var wf wfv1.Workflow
+		err := decoder.Decode(&wf)
+		if err == io.EOF {
+			return wfs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		wfs = append(wfs, wf)
+	}
+}
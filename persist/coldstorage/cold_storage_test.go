@@ -0,0 +1,74 @@
+package coldstorage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+func newFakeColdStorage(t *testing.T, exportConfig config.ArchiveExportConfig) (*coldStorage, *fake.ArtifactDriver) {
+	driver := fake.NewArtifactDriver(time.Now)
+	s, err := newColdStorage(exportConfig, "my-cluster", nil, func(context.Context, *wfv1.Artifact, resource.Interface) (common.ArtifactDriver, error) {
+		return driver, nil
+	}, func() time.Time { return time.Unix(0, 1) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, driver
+}
+
+func finishedAt(value string) wfv1.Workflow {
+	t, _ := time.Parse(time.RFC3339, value)
+	return wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: value},
+		Status:     wfv1.WorkflowStatus{FinishedAt: metav1.Time{Time: t}},
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := newColdStorage(config.ArchiveExportConfig{}, "my-cluster", nil, nil, time.Now)
+	assert.EqualError(t, err, "archive export artifact repository not configured")
+}
+
+func TestColdStorage(t *testing.T) {
+	ctx := context.Background()
+	s, driver := newFakeColdStorage(t, config.ArchiveExportConfig{
+		ArtifactRepository: config.ArtifactRepository{S3: &config.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}}},
+	})
+	keys, err := s.Export(ctx, wfv1.Workflows{
+		finishedAt("2021-01-02T23:00:00Z"),
+		finishedAt("2021-01-01T00:00:00Z"),
+		finishedAt("2021-01-02T01:00:00Z"),
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{
+			"archived-workflows/2021-01-01/my-cluster-1.jsonl.gz",
+			"archived-workflows/2021-01-02/my-cluster-1.jsonl.gz",
+		}, keys)
+		assert.Len(t, driver.Objects, 2)
+	}
+	t.Run("Keys", func(t *testing.T) {
+		keys, err := s.Keys(ctx, "2021-01-02")
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"archived-workflows/2021-01-02/my-cluster-1.jsonl.gz"}, keys)
+		}
+		_, err = s.Keys(ctx, "yesterday")
+		assert.Error(t, err)
+	})
+	t.Run("Import", func(t *testing.T) {
+		wfs, err := s.Import(ctx, "archived-workflows/2021-01-02/my-cluster-1.jsonl.gz")
+		if assert.NoError(t, err) && assert.Len(t, wfs, 2) {
+			assert.Equal(t, "2021-01-02T23:00:00Z", wfs[0].Name)
+			assert.Equal(t, "2021-01-02T01:00:00Z", wfs[1].Name)
+		}
+	})
+}
@@ -2,8 +2,11 @@ package sqldb
 
 import (
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
+	"github.com/mattn/go-sqlite3"
 	"upper.io/db.v3"
 )
 
@@ -12,12 +15,15 @@ type dbType string
 const (
 	MySQL    dbType = "mysql"
 	Postgres dbType = "postgres"
+	SQLite   dbType = "sqlite"
 )
 
 func dbTypeFor(session db.Database) dbType {
 	switch session.Driver().(*sql.DB).Driver().(type) {
 	case *mysql.MySQLDriver:
 		return MySQL
+	case *sqlite3.SQLiteDriver:
+		return SQLite
 	}
 	return Postgres
 }
@@ -28,3 +34,12 @@ func (t dbType) intType() string {
 	}
 	return "int"
 }
+
+// olderThan returns a clause matching rows where the timestamp column is more than ttl in the past
+func (t dbType) olderThan(column string, ttl time.Duration) string {
+	if t == SQLite {
+		// SQLite stores timestamps as text, which datetime normalizes to UTC
+		return fmt.Sprintf("datetime(%s) < datetime('now', '-%d seconds')", column, int(ttl.Seconds()))
+	}
+	return fmt.Sprintf("%s < current_timestamp - interval '%d' second", column, int(ttl.Seconds()))
+}
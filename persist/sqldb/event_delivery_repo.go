@@ -0,0 +1,132 @@
+package sqldb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
+)
+
+const eventDeliveriesTableName = "argo_event_deliveries"
+
+type eventDeliveryRecord struct {
+	ClusterName string    `db:"clustername"`
+	ID          string    `db:"id"`
+	Namespace   string    `db:"namespace"`
+	ReceivedAt  time.Time `db:"receivedat"`
+	Delivery    string    `db:"delivery"`
+}
+
+// EventDeliveryRepo records the events received by the Argo Server, and what it did with them
+type EventDeliveryRepo interface {
+	// SaveEventDelivery inserts, or replaces, the delivery
+	SaveEventDelivery(d *eventpkg.EventDelivery) error
+	// ListEventDeliveries lists deliveries, with the most recently received at the beginning
+	ListEventDeliveries(namespace string, limit int) ([]*eventpkg.EventDelivery, error)
+	GetEventDelivery(id string) (*eventpkg.EventDelivery, error)
+}
+
+// ErrEventDeliveryNotFound is returned when the delivery does not exist, or has expired
+var ErrEventDeliveryNotFound = fmt.Errorf("event delivery not found")
+
+type eventDeliveryRepo struct {
+	session     sqlbuilder.Database
+	clusterName string
+	ttl         time.Duration
+	dbType      dbType
+}
+
+// NewEventDeliveryRepo returns a repo that stores deliveries in the database, deleting them after the TTL
+func NewEventDeliveryRepo(session sqlbuilder.Database, clusterName string, ttl time.Duration) EventDeliveryRepo {
+	return &eventDeliveryRepo{session: session, clusterName: clusterName, ttl: ttl, dbType: dbTypeFor(session)}
+}
+
+func (r *eventDeliveryRepo) SaveEventDelivery(d *eventpkg.EventDelivery) error {
+	delivery, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	receivedAt := time.Now().UTC()
+	if d.ReceivedAt != nil {
+		receivedAt = d.ReceivedAt.Time
+	}
+	return r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		// deliveries are only ever inserted as pending, so this is a cheap time to remove expired ones
+		if d.Phase == "Pending" {
+			_, err := sess.
+				DeleteFrom(eventDeliveriesTableName).
+				Where(db.Cond{"clustername": r.clusterName}).
+				And(r.dbType.olderThan("receivedat", r.ttl)).
+				Exec()
+			if err != nil {
+				return err
+			}
+		}
+		_, err := sess.
+			DeleteFrom(eventDeliveriesTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"id": d.Id}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		_, err = sess.Collection(eventDeliveriesTableName).
+			Insert(&eventDeliveryRecord{
+				ClusterName: r.clusterName,
+				ID:          d.Id,
+				Namespace:   d.Namespace,
+				ReceivedAt:  receivedAt,
+				Delivery:    string(delivery),
+			})
+		return err
+	})
+}
+
+func (r *eventDeliveryRepo) ListEventDeliveries(namespace string, limit int) ([]*eventpkg.EventDelivery, error) {
+	var records []eventDeliveryRecord
+	if limit == 0 {
+		limit = -1
+	}
+	err := r.session.
+		Select("delivery").
+		From(eventDeliveriesTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(namespaceEqual(namespace)).
+		OrderBy("-receivedat").
+		Limit(limit).
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]*eventpkg.EventDelivery, len(records))
+	for i, record := range records {
+		deliveries[i] = &eventpkg.EventDelivery{}
+		if err := json.Unmarshal([]byte(record.Delivery), deliveries[i]); err != nil {
+			return nil, err
+		}
+	}
+	return deliveries, nil
+}
+
+func (r *eventDeliveryRepo) GetEventDelivery(id string) (*eventpkg.EventDelivery, error) {
+	record := &eventDeliveryRecord{}
+	err := r.session.
+		Select("delivery").
+		From(eventDeliveriesTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"id": id}).
+		One(record)
+	if err == db.ErrNoMoreRows {
+		return nil, ErrEventDeliveryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	d := &eventpkg.EventDelivery{}
+	return d, json.Unmarshal([]byte(record.Delivery), d)
+}
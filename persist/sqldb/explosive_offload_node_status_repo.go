@@ -21,7 +21,7 @@ func (n *explosiveOffloadNodeStatusRepo) Save(string, string, wfv1.Nodes) (strin
 	return "", OffloadNotSupportedError
 }
 
-func (n *explosiveOffloadNodeStatusRepo) Get(string, string) (wfv1.Nodes, error) {
+func (n *explosiveOffloadNodeStatusRepo) Get(string, string, string) (wfv1.Nodes, error) {
 	return nil, OffloadNotSupportedError
 }
 
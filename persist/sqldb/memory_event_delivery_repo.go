@@ -0,0 +1,66 @@
+package sqldb
+
+import (
+	"sync"
+
+	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
+)
+
+type memoryEventDeliveryRepo struct {
+	mu   sync.Mutex
+	size int
+	// oldest first
+	ids        []string
+	deliveries map[string]eventpkg.EventDelivery
+}
+
+// NewMemoryEventDeliveryRepo returns a repo that keeps the most recent deliveries in memory
+func NewMemoryEventDeliveryRepo(size int) EventDeliveryRepo {
+	return &memoryEventDeliveryRepo{size: size, deliveries: map[string]eventpkg.EventDelivery{}}
+}
+
+func (r *memoryEventDeliveryRepo) SaveEventDelivery(d *eventpkg.EventDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.deliveries[d.Id]; !ok {
+		r.ids = append(r.ids, d.Id)
+	}
+	r.deliveries[d.Id] = copyEventDelivery(d)
+	for len(r.ids) > r.size {
+		delete(r.deliveries, r.ids[0])
+		r.ids = r.ids[1:]
+	}
+	return nil
+}
+
+func (r *memoryEventDeliveryRepo) ListEventDeliveries(namespace string, limit int) ([]*eventpkg.EventDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var deliveries []*eventpkg.EventDelivery
+	for i := len(r.ids) - 1; i >= 0 && (limit <= 0 || len(deliveries) < limit); i-- {
+		d := r.deliveries[r.ids[i]]
+		if namespace == "" || d.Namespace == namespace {
+			c := copyEventDelivery(&d)
+			deliveries = append(deliveries, &c)
+		}
+	}
+	return deliveries, nil
+}
+
+func (r *memoryEventDeliveryRepo) GetEventDelivery(id string) (*eventpkg.EventDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d, ok := r.deliveries[id]
+	if !ok {
+		return nil, ErrEventDeliveryNotFound
+	}
+	c := copyEventDelivery(&d)
+	return &c, nil
+}
+
+// copyEventDelivery copies the delivery, so that it cannot be modified while we hold it
+func copyEventDelivery(d *eventpkg.EventDelivery) eventpkg.EventDelivery {
+	c := *d
+	c.Bindings = append([]*eventpkg.EventBindingResult(nil), d.Bindings...)
+	return c
+}
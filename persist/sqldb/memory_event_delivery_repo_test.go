@@ -0,0 +1,37 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
+)
+
+func TestMemoryEventDeliveryRepo(t *testing.T) {
+	repo := NewMemoryEventDeliveryRepo(2)
+	assert.NoError(t, repo.SaveEventDelivery(&eventpkg.EventDelivery{Id: "1", Namespace: "my-ns"}))
+	assert.NoError(t, repo.SaveEventDelivery(&eventpkg.EventDelivery{Id: "2", Namespace: "other-ns"}))
+	assert.NoError(t, repo.SaveEventDelivery(&eventpkg.EventDelivery{Id: "3", Namespace: "my-ns", Phase: "Pending"}))
+	// saving again replaces the delivery
+	assert.NoError(t, repo.SaveEventDelivery(&eventpkg.EventDelivery{Id: "3", Namespace: "my-ns", Phase: "Succeeded"}))
+
+	_, err := repo.GetEventDelivery("1")
+	assert.Equal(t, ErrEventDeliveryNotFound, err, "the oldest delivery is removed")
+	d, err := repo.GetEventDelivery("3")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Succeeded", d.Phase)
+	}
+	list, err := repo.ListEventDeliveries("", 0)
+	if assert.NoError(t, err) && assert.Len(t, list, 2) {
+		assert.Equal(t, "3", list[0].Id, "most recent first")
+	}
+	list, err = repo.ListEventDeliveries("my-ns", 0)
+	if assert.NoError(t, err) {
+		assert.Len(t, list, 1)
+	}
+	list, err = repo.ListEventDeliveries("", 1)
+	if assert.NoError(t, err) {
+		assert.Len(t, list, 1)
+	}
+}
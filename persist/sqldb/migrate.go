@@ -22,7 +22,7 @@ type migrate struct {
 }
 
 type change interface {
-	apply(session sqlbuilder.Database) error
+	apply(session sqlbuilder.SQLBuilder) error
 }
 
 func ternary(condition bool, left, right change) change {
@@ -62,7 +62,7 @@ func (m migrate) Exec(ctx context.Context) error {
 	// try and make changes idempotent, as it is possible for the change to apply, but the archive update to fail
 	// and therefore try and apply again next try
 
-	for changeSchemaVersion, change := range []change{
+	changes := []change{
 		ansiSQLChange(`create table if not exists ` + m.tableName + ` (
     id varchar(128) ,
     name varchar(256),
@@ -249,8 +249,34 @@ func (m migrate) Exec(ctx context.Context) error {
 		ansiSQLChange(`create index ` + m.tableName + `_i1 on ` + m.tableName + ` (clustername,namespace,updatedat)`),
 		// index to find records that need deleting, this omits namespaces as this might be null
 		ansiSQLChange(`create index argo_archived_workflows_i2 on argo_archived_workflows (clustername,instanceid,finishedat)`),
-	} {
-		err := m.applyChange(ctx, changeSchemaVersion, change)
+		// the events received by the Argo Server, and what it did with them
+		ternary(dbType == SQLite,
+			ansiSQLChange(`create table if not exists argo_event_deliveries (
+    clustername varchar(64) not null,
+    id varchar(128) not null,
+    namespace varchar(256) not null,
+    receivedat timestamp not null default current_timestamp,
+    delivery text not null,
+    primary key (clustername, id)
+)`),
+			ansiSQLChange(`create table if not exists argo_event_deliveries (
+    clustername varchar(64) not null,
+    id varchar(128) not null,
+    namespace varchar(256) not null,
+    receivedat timestamp not null default current_timestamp,
+    delivery json not null,
+    primary key (clustername, id)
+)`),
+		),
+		ansiSQLChange(`create index argo_event_deliveries_i1 on argo_event_deliveries (clustername,namespace,receivedat)`),
+		// imported workflows are only ever written by the server, so this cannot be set by labelling a workflow
+		ansiSQLChange(`alter table argo_archived_workflows add column imported boolean not null default false`),
+	}
+	if dbType == SQLite {
+		changes = sqliteChanges(m.tableName, changes)
+	}
+	for changeSchemaVersion, change := range changes {
+		err := m.applyChange(ctx, dbType, changeSchemaVersion, change)
 		if err != nil {
 			return err
 		}
@@ -259,7 +285,7 @@ func (m migrate) Exec(ctx context.Context) error {
 	return nil
 }
 
-func (m migrate) applyChange(ctx context.Context, changeSchemaVersion int, c change) error {
+func (m migrate) applyChange(ctx context.Context, dbType dbType, changeSchemaVersion int, c change) error {
 	tx, err := m.session.NewTx(ctx)
 	if err != nil {
 		return err
@@ -275,7 +301,12 @@ func (m migrate) applyChange(ctx context.Context, changeSchemaVersion int, c cha
 	}
 	if rowsAffected == 1 {
 		log.WithFields(log.Fields{"changeSchemaVersion": changeSchemaVersion, "change": c}).Info("applying database change")
-		err := c.apply(m.session)
+		var session sqlbuilder.SQLBuilder = m.session
+		if dbType == SQLite {
+			// SQLite only allows one writer at a time, so the change must be made by the transaction holding the lock
+			session = tx
+		}
+		err := c.apply(session)
 		if err != nil {
 			return err
 		}
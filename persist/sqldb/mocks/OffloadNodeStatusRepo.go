@@ -28,13 +28,13 @@ func (_m *OffloadNodeStatusRepo) Delete(uid string, version string) error {
 	return r0
 }
 
-// Get provides a mock function with given fields: uid, version
-func (_m *OffloadNodeStatusRepo) Get(uid string, version string) (v1alpha1.Nodes, error) {
-	ret := _m.Called(uid, version)
+// Get provides a mock function with given fields: uid, namespace, version
+func (_m *OffloadNodeStatusRepo) Get(uid string, namespace string, version string) (v1alpha1.Nodes, error) {
+	ret := _m.Called(uid, namespace, version)
 
 	var r0 v1alpha1.Nodes
-	if rf, ok := ret.Get(0).(func(string, string) v1alpha1.Nodes); ok {
-		r0 = rf(uid, version)
+	if rf, ok := ret.Get(0).(func(string, string, string) v1alpha1.Nodes); ok {
+		r0 = rf(uid, namespace, version)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(v1alpha1.Nodes)
@@ -42,8 +42,8 @@ func (_m *OffloadNodeStatusRepo) Get(uid string, version string) (v1alpha1.Nodes
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(uid, version)
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(uid, namespace, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -81,6 +81,20 @@ func (_m *WorkflowArchive) GetWorkflow(uid string) (*v1alpha1.Workflow, error) {
 	return r0, r1
 }
 
+// ImportWorkflow provides a mock function with given fields: wf
+func (_m *WorkflowArchive) ImportWorkflow(wf *v1alpha1.Workflow) error {
+	ret := _m.Called(wf)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*v1alpha1.Workflow) error); ok {
+		r0 = rf(wf)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // IsEnabled provides a mock function with given fields:
 func (_m *WorkflowArchive) IsEnabled() bool {
 	ret := _m.Called()
@@ -95,6 +109,29 @@ func (_m *WorkflowArchive) IsEnabled() bool {
 	return r0
 }
 
+// ListExpiredWorkflows provides a mock function with given fields: ttl, limit, offset
+func (_m *WorkflowArchive) ListExpiredWorkflows(ttl time.Duration, limit int, offset int) (v1alpha1.Workflows, error) {
+	ret := _m.Called(ttl, limit, offset)
+
+	var r0 v1alpha1.Workflows
+	if rf, ok := ret.Get(0).(func(time.Duration, int, int) v1alpha1.Workflows); ok {
+		r0 = rf(ttl, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v1alpha1.Workflows)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(time.Duration, int, int) error); ok {
+		r1 = rf(ttl, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListWorkflows provides a mock function with given fields: namespace, minStartAt, maxStartAt, labelRequirements, limit, offset
 func (_m *WorkflowArchive) ListWorkflows(namespace string, minStartAt time.Time, maxStartAt time.Time, labelRequirements labels.Requirements, limit int, offset int) (v1alpha1.Workflows, error) {
 	ret := _m.Called(namespace, minStartAt, maxStartAt, labelRequirements, limit, offset)
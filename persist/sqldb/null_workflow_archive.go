@@ -21,6 +21,10 @@ func (r *nullWorkflowArchive) ArchiveWorkflow(*wfv1.Workflow) error {
 	return nil
 }
 
+func (r *nullWorkflowArchive) ImportWorkflow(*wfv1.Workflow) error {
+	return fmt.Errorf("importing archived workflows not supported")
+}
+
 func (r *nullWorkflowArchive) ListWorkflows(string, time.Time, time.Time, labels.Requirements, int, int) (wfv1.Workflows, error) {
 	return wfv1.Workflows{}, nil
 }
@@ -36,3 +40,7 @@ func (r *nullWorkflowArchive) DeleteWorkflow(string) error {
 func (r *nullWorkflowArchive) DeleteExpiredWorkflows(time.Duration) error {
 	return nil
 }
+
+func (r *nullWorkflowArchive) ListExpiredWorkflows(time.Duration, int, int) (wfv1.Workflows, error) {
+	return wfv1.Workflows{}, nil
+}
@@ -1,6 +1,7 @@
 package sqldb
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
@@ -24,7 +25,7 @@ type UUIDVersion struct {
 
 type OffloadNodeStatusRepo interface {
 	Save(uid, namespace string, nodes wfv1.Nodes) (string, error)
-	Get(uid, version string) (wfv1.Nodes, error)
+	Get(uid, namespace, version string) (wfv1.Nodes, error)
 	List(namespace string) (map[UUIDVersion]wfv1.Nodes, error)
 	ListOldOffloads(namespace string) ([]UUIDVersion, error)
 	Delete(uid, version string) error
@@ -36,7 +37,7 @@ func NewOffloadNodeStatusRepo(session sqlbuilder.Database, clusterName, tableNam
 	// useful for testing
 	ttl := env.LookupEnvDurationOr("OFFLOAD_NODE_STATUS_TTL", 5*time.Minute)
 	log.WithField("ttl", ttl).Info("Node status offloading config")
-	return &nodeOffloadRepo{session: session, clusterName: clusterName, tableName: tableName, ttl: ttl}, nil
+	return &nodeOffloadRepo{session: session, clusterName: clusterName, tableName: tableName, ttl: ttl, dbType: dbTypeFor(session)}, nil
 }
 
 type nodesRecord struct {
@@ -51,14 +52,16 @@ type nodeOffloadRepo struct {
 	clusterName string
 	tableName   string
 	// time to live - at what ttl an offload becomes old
-	ttl time.Duration
+	ttl    time.Duration
+	dbType dbType
 }
 
 func (wdc *nodeOffloadRepo) IsEnabled() bool {
 	return true
 }
 
-func nodeStatusVersion(s wfv1.Nodes) (string, string, error) {
+// NodeStatusVersion returns the marshalled nodes, and a version that is a hash of them
+func NodeStatusVersion(s wfv1.Nodes) (string, string, error) {
 	marshalled, err := json.Marshal(s)
 	if err != nil {
 		return "", "", err
@@ -70,7 +73,7 @@ func nodeStatusVersion(s wfv1.Nodes) (string, string, error) {
 }
 
 func (wdc *nodeOffloadRepo) Save(uid, namespace string, nodes wfv1.Nodes) (string, error) {
-	marshalled, version, err := nodeStatusVersion(nodes)
+	marshalled, version, err := NodeStatusVersion(nodes)
 	if err != nil {
 		return "", err
 	}
@@ -87,7 +90,11 @@ func (wdc *nodeOffloadRepo) Save(uid, namespace string, nodes wfv1.Nodes) (strin
 
 	logCtx := log.WithFields(log.Fields{"uid": uid, "version": version})
 	logCtx.Debug("Offloading nodes")
-	_, err = wdc.session.Collection(wdc.tableName).Insert(record)
+	// insert within a transaction, so that it is rolled back on failure, otherwise SQLite stays locked
+	err = wdc.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		_, err := sess.Collection(wdc.tableName).Insert(record)
+		return err
+	})
 	if err != nil {
 		// if we have a duplicate, then it must have the same clustername+uid+version, which MUST mean that we
 		// have already written this record
@@ -129,10 +136,15 @@ func isDuplicateKeyError(err error) bool {
 	if strings.Contains(err.Error(), "Duplicate entry") {
 		return true
 	}
+	// sqlite
+	if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		return true
+	}
 	return false
 }
 
-func (wdc *nodeOffloadRepo) Get(uid, version string) (wfv1.Nodes, error) {
+// Get gets the offloaded nodes, the uid and version are unique, so the namespace is not needed
+func (wdc *nodeOffloadRepo) Get(uid, _, version string) (wfv1.Nodes, error) {
 	log.WithFields(log.Fields{"uid": uid, "version": version}).Debug("Getting offloaded nodes")
 	r := &nodesRecord{}
 	err := wdc.session.
@@ -221,5 +233,5 @@ func (wdc *nodeOffloadRepo) Delete(uid, version string) error {
 }
 
 func (wdc *nodeOffloadRepo) oldOffload() string {
-	return fmt.Sprintf("updatedat < current_timestamp - interval '%d' second", int(wdc.ttl.Seconds()))
+	return wdc.dbType.olderThan("updatedat", wdc.ttl)
 }
@@ -8,16 +8,16 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
-func Test_nodeStatusVersion(t *testing.T) {
+func TestNodeStatusVersion(t *testing.T) {
 	t.Run("Empty", func(t *testing.T) {
-		marshalled, version, err := nodeStatusVersion(nil)
+		marshalled, version, err := NodeStatusVersion(nil)
 		if assert.NoError(t, err) {
 			assert.NotEmpty(t, marshalled)
 			assert.Equal(t, "fnv:784127654", version)
 		}
 	})
 	t.Run("NonEmpty", func(t *testing.T) {
-		marshalled, version, err := nodeStatusVersion(wfv1.Nodes{"my-node": wfv1.NodeStatus{}})
+		marshalled, version, err := NodeStatusVersion(wfv1.Nodes{"my-node": wfv1.NodeStatus{}})
 		if assert.NoError(t, err) {
 			assert.NotEmpty(t, marshalled)
 			assert.Equal(t, "fnv:2308444803", version)
@@ -10,6 +10,7 @@ import (
 	"upper.io/db.v3/lib/sqlbuilder"
 	"upper.io/db.v3/mysql"
 	"upper.io/db.v3/postgresql"
+	"upper.io/db.v3/sqlite"
 
 	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/errors"
@@ -28,6 +29,8 @@ func CreateDBSession(kubectlConfig kubernetes.Interface, namespace string, persi
 		return CreatePostGresDBSession(kubectlConfig, namespace, persistConfig.PostgreSQL, persistConfig.ConnectionPool)
 	} else if persistConfig.MySQL != nil {
 		return CreateMySQLDBSession(kubectlConfig, namespace, persistConfig.MySQL, persistConfig.ConnectionPool)
+	} else if persistConfig.SQLite != nil {
+		return CreateSQLiteDBSession(persistConfig.SQLite, persistConfig.ConnectionPool)
 	}
 	return nil, "", fmt.Errorf("no databases are configured")
 }
@@ -119,3 +122,33 @@ func CreateMySQLDBSession(kubectlConfig kubernetes.Interface, namespace string,
 	}
 	return session, cfg.TableName, nil
 }
+
+// CreateSQLiteDBSession creates SQLite DB session
+func CreateSQLiteDBSession(cfg *config.SQLiteConfig, persistPool *config.ConnectionPool) (sqlbuilder.Database, string, error) {
+	if cfg.TableName == "" {
+		return nil, "", errors.InternalError("tableName is empty")
+	}
+	if cfg.Path == "" {
+		return nil, "", errors.InternalError("path is empty")
+	}
+
+	session, err := sqlite.Open(sqlite.ConnectionURL{
+		Database: cfg.Path,
+		Options: map[string]string{
+			// foreign keys are needed to cascade deletes to archived workflow labels
+			"_foreign_keys": "true",
+			// allow reads while writing
+			"_journal_mode": "WAL",
+		},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if persistPool != nil {
+		session.SetMaxOpenConns(persistPool.MaxOpenConns)
+		session.SetMaxIdleConns(persistPool.MaxIdleConns)
+		session.SetConnMaxLifetime(time.Duration(persistPool.ConnMaxLifetime))
+	}
+	return session, cfg.TableName, nil
+}
@@ -0,0 +1,59 @@
+package sqldb
+
+import "upper.io/db.v3/lib/sqlbuilder"
+
+// sqliteSchemaVersion is the last schema version before SQLite was supported
+const sqliteSchemaVersion = 56
+
+// a change that does nothing, used to keep schema versions the same across database providers
+type noop struct{}
+
+func (noop) apply(sqlbuilder.SQLBuilder) error {
+	return nil
+}
+
+// sqliteChanges returns the changes to apply to a SQLite database.
+//
+// SQLite has limited support for altering tables, and there are no SQLite databases created before SQLite was
+// supported, so rather than replaying the history of changes up to sqliteSchemaVersion, the schema as it was at that
+// version is created in one go. Changes after sqliteSchemaVersion are applied as normal, so must be SQLite compatible.
+func sqliteChanges(tableName string, changes []change) []change {
+	sqlite := []change{
+		ansiSQLChange(`create table if not exists ` + tableName + ` (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    namespace varchar(256) not null,
+    version varchar(64) not null,
+    nodes text not null,
+    updatedat timestamp not null default current_timestamp,
+    primary key (clustername, uid, version)
+)`),
+		ansiSQLChange(`create index ` + tableName + `_i1 on ` + tableName + ` (clustername,namespace,updatedat)`),
+		ansiSQLChange(`create table if not exists argo_archived_workflows (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    name varchar(256) not null,
+    phase varchar(25) not null,
+    namespace varchar(256) not null,
+    workflow text not null,
+    startedat timestamp not null default current_timestamp,
+    finishedat timestamp not null default current_timestamp,
+    instanceid varchar(64) not null,
+    primary key (clustername, uid)
+)`),
+		ansiSQLChange(`create index argo_archived_workflows_i1 on argo_archived_workflows (clustername,instanceid,namespace)`),
+		ansiSQLChange(`create index argo_archived_workflows_i2 on argo_archived_workflows (clustername,instanceid,finishedat)`),
+		ansiSQLChange(`create table if not exists argo_archived_workflows_labels (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    name varchar(317) not null,
+    value varchar(63) not null,
+    primary key (clustername, uid, name),
+    foreign key (clustername, uid) references argo_archived_workflows(clustername, uid) on delete cascade
+)`),
+	}
+	for len(sqlite) <= sqliteSchemaVersion {
+		sqlite = append(sqlite, noop{})
+	}
+	return append(sqlite, changes[sqliteSchemaVersion+1:]...)
+}
@@ -0,0 +1,165 @@
+package sqldb
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func newSQLiteSession(t *testing.T) (sqlbuilder.Database, string) {
+	session, tableName, err := CreateSQLiteDBSession(&config.SQLiteConfig{Path: filepath.Join(t.TempDir(), "argo.db"), TableName: "argo_workflows"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = session.Close() })
+	err = NewMigrate(session, "my-cluster", tableName).Exec(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return session, tableName
+}
+
+func TestSQLite(t *testing.T) {
+	session, tableName := newSQLiteSession(t)
+	assert.Equal(t, SQLite, dbTypeFor(session))
+	t.Run("Migrate", func(t *testing.T) {
+		// migrating again is a no-op
+		err := NewMigrate(session, "my-cluster", tableName).Exec(context.Background())
+		assert.NoError(t, err)
+		var version int
+		row, err := session.QueryRow("select schema_version from schema_history")
+		if assert.NoError(t, err) && assert.NoError(t, row.Scan(&version)) {
+			assert.Equal(t, sqliteSchemaVersion+3, version)
+		}
+	})
+	t.Run("WorkflowArchive", func(t *testing.T) {
+		wfArchive := NewWorkflowArchive(session, "my-cluster", "", instanceid.NewService(""))
+		finishedAt := metav1.NewTime(time.Now().Add(-2 * time.Hour).UTC())
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "my-uid", Labels: map[string]string{"my-label": "1"}},
+			Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowSucceeded, StartedAt: finishedAt, FinishedAt: finishedAt},
+		}
+		assert.NoError(t, wfArchive.ArchiveWorkflow(wf))
+		// archiving again replaces the workflow
+		assert.NoError(t, wfArchive.ArchiveWorkflow(wf))
+
+		requirements, err := labels.ParseToRequirements("my-label>0")
+		if assert.NoError(t, err) {
+			wfs, err := wfArchive.ListWorkflows("my-ns", time.Time{}, time.Time{}, requirements, 0, 0)
+			if assert.NoError(t, err) && assert.Len(t, wfs, 1) {
+				assert.Equal(t, "my-wf", wfs[0].Name)
+			}
+		}
+		got, err := wfArchive.GetWorkflow("my-uid")
+		if assert.NoError(t, err) && assert.NotNil(t, got) {
+			assert.Equal(t, "my-wf", got.Name)
+		}
+		wfs, err := wfArchive.ListExpiredWorkflows(3*time.Hour, 10, 0)
+		if assert.NoError(t, err) {
+			assert.Empty(t, wfs)
+		}
+		imported := wf.DeepCopy()
+		imported.UID = "imported-uid"
+		imported.Labels = map[string]string{common.LabelKeyWorkflowImported: "true"}
+		assert.NoError(t, wfArchive.ImportWorkflow(imported))
+		labelled := imported.DeepCopy()
+		labelled.UID = "labelled-uid"
+		labelled.Status.FinishedAt = metav1.NewTime(finishedAt.Add(time.Minute))
+		assert.NoError(t, wfArchive.ArchiveWorkflow(labelled))
+		wfs, err = wfArchive.ListExpiredWorkflows(time.Hour, 10, 0)
+		if assert.NoError(t, err) && assert.Len(t, wfs, 2, "imported workflows do not expire") {
+			assert.Equal(t, "my-uid", string(wfs[0].UID))
+			assert.Equal(t, "labelled-uid", string(wfs[1].UID), "labelling a workflow does not import it")
+		}
+		wfs, err = wfArchive.ListExpiredWorkflows(time.Hour, 10, 2)
+		if assert.NoError(t, err) {
+			assert.Empty(t, wfs)
+		}
+		assert.NoError(t, wfArchive.DeleteExpiredWorkflows(time.Hour))
+		got, err = wfArchive.GetWorkflow("my-uid")
+		if assert.NoError(t, err) {
+			assert.Nil(t, got)
+		}
+		got, err = wfArchive.GetWorkflow("labelled-uid")
+		if assert.NoError(t, err) {
+			assert.Nil(t, got)
+		}
+		got, err = wfArchive.GetWorkflow("imported-uid")
+		if assert.NoError(t, err) {
+			assert.NotNil(t, got, "imported workflows are not deleted")
+		}
+		count, err := session.Collection(archiveLabelsTableName).Find().Count()
+		if assert.NoError(t, err) {
+			assert.Equal(t, 1, int(count), "labels are deleted with the workflow")
+		}
+	})
+	t.Run("OffloadNodeStatusRepo", func(t *testing.T) {
+		repo, err := NewOffloadNodeStatusRepo(session, "my-cluster", tableName)
+		if !assert.NoError(t, err) {
+			return
+		}
+		nodes := wfv1.Nodes{"my-node": wfv1.NodeStatus{Name: "my-node"}}
+		version, err := repo.Save("my-uid", "my-ns", nodes)
+		if !assert.NoError(t, err) {
+			return
+		}
+		// saving the same nodes again is idempotent
+		_, err = repo.Save("my-uid", "my-ns", nodes)
+		assert.NoError(t, err)
+		got, err := repo.Get("my-uid", "my-ns", version)
+		if assert.NoError(t, err) {
+			assert.Equal(t, nodes, got)
+		}
+		list, err := repo.List("my-ns")
+		if assert.NoError(t, err) {
+			assert.Len(t, list, 1)
+		}
+		old, err := repo.ListOldOffloads("my-ns")
+		if assert.NoError(t, err) {
+			assert.Empty(t, old)
+		}
+		assert.NoError(t, repo.Delete("my-uid", version))
+		list, err = repo.List("my-ns")
+		if assert.NoError(t, err) {
+			assert.Empty(t, list)
+		}
+	})
+	t.Run("EventDeliveryRepo", func(t *testing.T) {
+		repo := NewEventDeliveryRepo(session, "my-cluster", time.Hour)
+		old := &eventpkg.EventDelivery{Id: "my-old-id", Namespace: "my-ns", Phase: "Succeeded", ReceivedAt: &metav1.Time{Time: time.Now().Add(-2 * time.Hour).UTC()}}
+		assert.NoError(t, repo.SaveEventDelivery(old))
+		d := &eventpkg.EventDelivery{Id: "my-id", Namespace: "my-ns", Phase: "Pending", ReceivedAt: &metav1.Time{Time: time.Now().UTC()}}
+		assert.NoError(t, repo.SaveEventDelivery(d))
+		// saving again replaces the delivery
+		d.Phase = "Succeeded"
+		d.Bindings = []*eventpkg.EventBindingResult{{Name: "my-wfeb", Namespace: "my-ns", Matched: true, Workflow: "my-wf"}}
+		assert.NoError(t, repo.SaveEventDelivery(d))
+		got, err := repo.GetEventDelivery("my-id")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "Succeeded", got.Phase)
+			assert.Len(t, got.Bindings, 1)
+		}
+		_, err = repo.GetEventDelivery("my-old-id")
+		assert.Equal(t, ErrEventDeliveryNotFound, err, "expired deliveries are deleted when a new one is saved")
+		list, err := repo.ListEventDeliveries("my-ns", 0)
+		if assert.NoError(t, err) && assert.Len(t, list, 1) {
+			assert.Equal(t, "my-id", list[0].Id)
+		}
+		list, err = repo.ListEventDeliveries("other-ns", 0)
+		if assert.NoError(t, err) {
+			assert.Empty(t, list)
+		}
+	})
+}
@@ -3,7 +3,6 @@ package sqldb
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -36,6 +35,7 @@ type archivedWorkflowMetadata struct {
 type archivedWorkflowRecord struct {
 	archivedWorkflowMetadata
 	Workflow string `db:"workflow"`
+	Imported bool   `db:"imported"`
 }
 
 type archivedWorkflowLabelRecord struct {
@@ -50,11 +50,15 @@ type archivedWorkflowLabelRecord struct {
 
 type WorkflowArchive interface {
 	ArchiveWorkflow(wf *wfv1.Workflow) error
+	// import a workflow back from cold storage, imported workflows do not expire
+	ImportWorkflow(wf *wfv1.Workflow) error
 	// list workflows, with the most recently started workflows at the beginning (i.e. index 0 is the most recent)
 	ListWorkflows(namespace string, minStartAt, maxStartAt time.Time, labelRequirements labels.Requirements, limit, offset int) (wfv1.Workflows, error)
 	GetWorkflow(uid string) (*wfv1.Workflow, error)
 	DeleteWorkflow(uid string) error
 	DeleteExpiredWorkflows(ttl time.Duration) error
+	// list workflows that finished more than ttl ago, with the least recently finished workflows at the beginning
+	ListExpiredWorkflows(ttl time.Duration, limit, offset int) (wfv1.Workflows, error)
 	IsEnabled() bool
 }
 
@@ -76,7 +80,15 @@ func NewWorkflowArchive(session sqlbuilder.Database, clusterName, managedNamespa
 }
 
 func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
-	logCtx := log.WithFields(log.Fields{"uid": wf.UID, "labels": wf.GetLabels()})
+	return r.archiveWorkflow(wf, false)
+}
+
+func (r *workflowArchive) ImportWorkflow(wf *wfv1.Workflow) error {
+	return r.archiveWorkflow(wf, true)
+}
+
+func (r *workflowArchive) archiveWorkflow(wf *wfv1.Workflow, imported bool) error {
+	logCtx := log.WithFields(log.Fields{"uid": wf.UID, "labels": wf.GetLabels(), "imported": imported})
 	logCtx.Debug("Archiving workflow")
 	workflow, err := json.Marshal(wf)
 	if err != nil {
@@ -104,6 +116,7 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 					FinishedAt:  wf.Status.FinishedAt.Time,
 				},
 				Workflow: string(workflow),
+				Imported: imported,
 			})
 		if err != nil {
 			return err
@@ -189,6 +202,11 @@ func (r *workflowArchive) clusterManagedNamespaceAndInstanceID() db.Compound {
 	)
 }
 
+// notImported excludes workflows imported back from cold storage, which are kept until they are explicitly deleted
+func notImported() db.Cond {
+	return db.Cond{"imported": false}
+}
+
 func startedAtClause(from, to time.Time) db.Compound {
 	var conds []db.Compound
 	if !from.IsZero() {
@@ -247,11 +265,37 @@ func (r *workflowArchive) DeleteWorkflow(uid string) error {
 	return nil
 }
 
+func (r *workflowArchive) ListExpiredWorkflows(ttl time.Duration, limit, offset int) (wfv1.Workflows, error) {
+	var archivedWfs []archivedWorkflowRecord
+	err := r.session.
+		Select("workflow").
+		From(archiveTableName).
+		Where(r.clusterManagedNamespaceAndInstanceID()).
+		And(r.dbType.olderThan("finishedat", ttl)).
+		And(notImported()).
+		OrderBy("finishedat", "uid").
+		Limit(limit).
+		Offset(offset).
+		All(&archivedWfs)
+	if err != nil {
+		return nil, err
+	}
+	wfs := make(wfv1.Workflows, len(archivedWfs))
+	for i, archivedWf := range archivedWfs {
+		err = json.Unmarshal([]byte(archivedWf.Workflow), &wfs[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return wfs, nil
+}
+
 func (r *workflowArchive) DeleteExpiredWorkflows(ttl time.Duration) error {
 	rs, err := r.session.
 		DeleteFrom(archiveTableName).
 		Where(r.clusterManagedNamespaceAndInstanceID()).
-		And(fmt.Sprintf("finishedat < current_timestamp - interval '%d' second", int(ttl.Seconds()))).
+		And(r.dbType.olderThan("finishedat", ttl)).
+		And(notImported()).
 		Exec()
 	if err != nil {
 		return err
@@ -9,6 +9,7 @@ import (
 
 	clusterworkflowtmplpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
@@ -23,6 +24,7 @@ type Client interface {
 	NewWorkflowTemplateServiceClient() workflowtemplatepkg.WorkflowTemplateServiceClient
 	NewClusterWorkflowTemplateServiceClient() clusterworkflowtmplpkg.ClusterWorkflowTemplateServiceClient
 	NewInfoServiceClient() (infopkg.InfoServiceClient, error)
+	NewEventServiceClient() (eventpkg.EventServiceClient, error)
 }
 
 type Opts struct {
@@ -112,3 +112,11 @@ func (c *argoKubeWorkflowServiceClient) WorkflowLogs(ctx context.Context, req *w
 func (c *argoKubeWorkflowServiceClient) SubmitWorkflow(ctx context.Context, req *workflowpkg.WorkflowSubmitRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	return c.delegate.SubmitWorkflow(ctx, req)
 }
+
+func (c *argoKubeWorkflowServiceClient) DiffWorkflows(ctx context.Context, req *workflowpkg.WorkflowDiffRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowDiffResponse, error) {
+	return c.delegate.DiffWorkflows(ctx, req)
+}
+
+func (c *argoKubeWorkflowServiceClient) BulkWorkflowAction(ctx context.Context, req *workflowpkg.WorkflowBulkActionRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkActionResponse, error) {
+	return c.delegate.BulkWorkflowAction(ctx, req)
+}
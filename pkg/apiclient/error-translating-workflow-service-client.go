@@ -100,3 +100,13 @@ func (c *errorTranslatingWorkflowServiceClient) SubmitWorkflow(ctx context.Conte
 	workflow, err := c.delegate.SubmitWorkflow(ctx, req)
 	return workflow, grpcutil.TranslateError(err)
 }
+
+func (c *errorTranslatingWorkflowServiceClient) DiffWorkflows(ctx context.Context, req *workflowpkg.WorkflowDiffRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowDiffResponse, error) {
+	diff, err := c.delegate.DiffWorkflows(ctx, req)
+	return diff, grpcutil.TranslateError(err)
+}
+
+func (c *errorTranslatingWorkflowServiceClient) BulkWorkflowAction(ctx context.Context, req *workflowpkg.WorkflowBulkActionRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkActionResponse, error) {
+	res, err := c.delegate.BulkWorkflowAction(ctx, req)
+	return res, grpcutil.TranslateError(err)
+}
@@ -27,6 +27,21 @@ func (h ArchivedWorkflowsServiceClient) DeleteArchivedWorkflow(_ context.Context
 	return out, h.Delete(in, out, "/api/v1/archived-workflows/{uid}")
 }
 
+func (h ArchivedWorkflowsServiceClient) ResubmitArchivedWorkflow(_ context.Context, in *workflowarchivepkg.ResubmitArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	out := &wfv1.Workflow{}
+	return out, h.Put(in, out, "/api/v1/archived-workflows/{uid}/resubmit")
+}
+
+func (h ArchivedWorkflowsServiceClient) RetryArchivedWorkflow(_ context.Context, in *workflowarchivepkg.RetryArchivedWorkflowRequest, _ ...grpc.CallOption) (*wfv1.Workflow, error) {
+	out := &wfv1.Workflow{}
+	return out, h.Put(in, out, "/api/v1/archived-workflows/{uid}/retry")
+}
+
+func (h ArchivedWorkflowsServiceClient) ImportArchivedWorkflows(_ context.Context, in *workflowarchivepkg.ImportArchivedWorkflowsRequest, _ ...grpc.CallOption) (*workflowarchivepkg.ImportArchivedWorkflowsResponse, error) {
+	out := &workflowarchivepkg.ImportArchivedWorkflowsResponse{}
+	return out, h.Post(in, out, "/api/v1/archived-workflows/import")
+}
+
 func (h ArchivedWorkflowsServiceClient) DeleteClusterWorkflowTemplate(_ context.Context, in *clusterworkflowtemplate.ClusterWorkflowTemplateDeleteRequest, _ ...grpc.CallOption) (*clusterworkflowtemplate.ClusterWorkflowTemplateDeleteResponse, error) {
 	out := &clusterworkflowtemplate.ClusterWorkflowTemplateDeleteResponse{}
 	return out, h.Delete(in, out, "/api/v1/cluster-workflow-templates/{name}")
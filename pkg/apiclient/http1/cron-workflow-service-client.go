@@ -50,3 +50,8 @@ func (h CronWorkflowServiceClient) DeleteCronWorkflow(_ context.Context, in *cro
 	out := &cronworkflowpkg.CronWorkflowDeletedResponse{}
 	return out, h.Delete(in, out, "/api/v1/cron-workflows/{namespace}/{name}")
 }
+
+func (h CronWorkflowServiceClient) BackfillCronWorkflow(_ context.Context, in *cronworkflowpkg.CronWorkflowBackfillRequest, _ ...grpc.CallOption) (*wfv1.CronWorkflow, error) {
+	out := &wfv1.CronWorkflow{}
+	return out, h.Put(in, out, "/api/v1/cron-workflows/{namespace}/{name}/backfill")
+}
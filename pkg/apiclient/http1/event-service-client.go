@@ -0,0 +1,34 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type EventServiceClient = Facade
+
+// ReceiveEvent is not supported, as the request body is the payload, rather than the request
+func (h EventServiceClient) ReceiveEvent(context.Context, *eventpkg.EventRequest, ...grpc.CallOption) (*eventpkg.EventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "receiving events is not supported by the HTTP1 client")
+}
+
+func (h EventServiceClient) ListWorkflowEventBindings(_ context.Context, in *eventpkg.ListWorkflowEventBindingsRequest, _ ...grpc.CallOption) (*wfv1.WorkflowEventBindingList, error) {
+	out := &wfv1.WorkflowEventBindingList{}
+	return out, h.Get(in, out, "/api/v1/workflow-event-bindings/{namespace}")
+}
+
+func (h EventServiceClient) ListEventDeliveries(_ context.Context, in *eventpkg.ListEventDeliveriesRequest, _ ...grpc.CallOption) (*eventpkg.EventDeliveryList, error) {
+	out := &eventpkg.EventDeliveryList{}
+	return out, h.Get(in, out, "/api/v1/event-deliveries/{namespace}")
+}
+
+func (h EventServiceClient) ReplayEvent(_ context.Context, in *eventpkg.ReplayEventRequest, _ ...grpc.CallOption) (*eventpkg.EventDelivery, error) {
+	out := &eventpkg.EventDelivery{}
+	return out, h.Post(in, out, "/api/v1/event-deliveries/{namespace}/{id}/replay")
+}
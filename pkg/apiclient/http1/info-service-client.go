@@ -25,3 +25,18 @@ func (h InfoServiceClient) GetUserInfo(_ context.Context, in *infopkg.GetUserInf
 	out := &infopkg.GetUserInfoResponse{}
 	return out, h.Get(in, out, "/api/v1/userinfo")
 }
+
+func (h InfoServiceClient) CreateToken(_ context.Context, in *infopkg.CreateTokenRequest, _ ...grpc.CallOption) (*infopkg.CreateTokenResponse, error) {
+	out := &infopkg.CreateTokenResponse{}
+	return out, h.Post(in, out, "/api/v1/tokens")
+}
+
+func (h InfoServiceClient) ListTokens(_ context.Context, in *infopkg.ListTokensRequest, _ ...grpc.CallOption) (*infopkg.TokenList, error) {
+	out := &infopkg.TokenList{}
+	return out, h.Get(in, out, "/api/v1/tokens")
+}
+
+func (h InfoServiceClient) RevokeToken(_ context.Context, in *infopkg.RevokeTokenRequest, _ ...grpc.CallOption) (*infopkg.RevokeTokenResponse, error) {
+	out := &infopkg.RevokeTokenResponse{}
+	return out, h.Delete(in, out, "/api/v1/tokens/{name}")
+}
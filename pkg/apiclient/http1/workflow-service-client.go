@@ -107,3 +107,13 @@ func (h WorkflowServiceClient) SubmitWorkflow(_ context.Context, in *workflowpkg
 	out := &wfv1.Workflow{}
 	return out, h.Post(in, out, "/api/v1/workflows/{namespace}/submit")
 }
+
+func (h WorkflowServiceClient) DiffWorkflows(_ context.Context, in *workflowpkg.WorkflowDiffRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowDiffResponse, error) {
+	out := &workflowpkg.WorkflowDiffResponse{}
+	return out, h.Get(in, out, "/api/v1/workflows/{namespace}/{name}/diff")
+}
+
+func (h WorkflowServiceClient) BulkWorkflowAction(_ context.Context, in *workflowpkg.WorkflowBulkActionRequest, _ ...grpc.CallOption) (*workflowpkg.WorkflowBulkActionResponse, error) {
+	out := &workflowpkg.WorkflowBulkActionResponse{}
+	return out, h.Post(in, out, "/api/v1/workflows/{namespace}/bulk-action")
+}
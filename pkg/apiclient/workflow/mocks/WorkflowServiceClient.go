@@ -19,6 +19,36 @@ type WorkflowServiceClient struct {
 	mock.Mock
 }
 
+// BulkWorkflowAction provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) BulkWorkflowAction(ctx context.Context, in *workflow.WorkflowBulkActionRequest, opts ...grpc.CallOption) (*workflow.WorkflowBulkActionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *workflow.WorkflowBulkActionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowBulkActionRequest, ...grpc.CallOption) *workflow.WorkflowBulkActionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowBulkActionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowBulkActionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateWorkflow provides a mock function with given fields: ctx, in, opts
 func (_m *WorkflowServiceClient) CreateWorkflow(ctx context.Context, in *workflow.WorkflowCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	_va := make([]interface{}, len(opts))
@@ -79,6 +109,36 @@ func (_m *WorkflowServiceClient) DeleteWorkflow(ctx context.Context, in *workflo
 	return r0, r1
 }
 
+// DiffWorkflows provides a mock function with given fields: ctx, in, opts
+func (_m *WorkflowServiceClient) DiffWorkflows(ctx context.Context, in *workflow.WorkflowDiffRequest, opts ...grpc.CallOption) (*workflow.WorkflowDiffResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *workflow.WorkflowDiffResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *workflow.WorkflowDiffRequest, ...grpc.CallOption) *workflow.WorkflowDiffResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.WorkflowDiffResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *workflow.WorkflowDiffRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetWorkflow provides a mock function with given fields: ctx, in, opts
 func (_m *WorkflowServiceClient) GetWorkflow(ctx context.Context, in *workflow.WorkflowGetRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	_va := make([]interface{}, len(opts))
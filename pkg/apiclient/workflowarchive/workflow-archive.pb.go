@@ -209,11 +209,263 @@ func (m *ArchivedWorkflowDeletedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArchivedWorkflowDeletedResponse proto.InternalMessageInfo
 
+type ResubmitArchivedWorkflowRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Memoized             bool     `protobuf:"varint,3,opt,name=memoized,proto3" json:"memoized,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResubmitArchivedWorkflowRequest) Reset()         { *m = ResubmitArchivedWorkflowRequest{} }
+func (m *ResubmitArchivedWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*ResubmitArchivedWorkflowRequest) ProtoMessage()    {}
+func (*ResubmitArchivedWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{4}
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResubmitArchivedWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResubmitArchivedWorkflowRequest.Merge(m, src)
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResubmitArchivedWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResubmitArchivedWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResubmitArchivedWorkflowRequest proto.InternalMessageInfo
+
+func (m *ResubmitArchivedWorkflowRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ResubmitArchivedWorkflowRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ResubmitArchivedWorkflowRequest) GetMemoized() bool {
+	if m != nil {
+		return m.Memoized
+	}
+	return false
+}
+
+type RetryArchivedWorkflowRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	RestartSuccessful    bool     `protobuf:"varint,3,opt,name=restartSuccessful,proto3" json:"restartSuccessful,omitempty"`
+	NodeFieldSelector    string   `protobuf:"bytes,4,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryArchivedWorkflowRequest) Reset()         { *m = RetryArchivedWorkflowRequest{} }
+func (m *RetryArchivedWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryArchivedWorkflowRequest) ProtoMessage()    {}
+func (*RetryArchivedWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{5}
+}
+func (m *RetryArchivedWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryArchivedWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryArchivedWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryArchivedWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryArchivedWorkflowRequest.Merge(m, src)
+}
+func (m *RetryArchivedWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryArchivedWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryArchivedWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryArchivedWorkflowRequest proto.InternalMessageInfo
+
+func (m *RetryArchivedWorkflowRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *RetryArchivedWorkflowRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *RetryArchivedWorkflowRequest) GetRestartSuccessful() bool {
+	if m != nil {
+		return m.RestartSuccessful
+	}
+	return false
+}
+
+func (m *RetryArchivedWorkflowRequest) GetNodeFieldSelector() string {
+	if m != nil {
+		return m.NodeFieldSelector
+	}
+	return ""
+}
+
+type ImportArchivedWorkflowsRequest struct {
+	// The date (yyyy-mm-dd) of the partition to import, all objects in the partition are imported.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// The key of a single exported object to import, rather than a partition.
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportArchivedWorkflowsRequest) Reset()         { *m = ImportArchivedWorkflowsRequest{} }
+func (m *ImportArchivedWorkflowsRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportArchivedWorkflowsRequest) ProtoMessage()    {}
+func (*ImportArchivedWorkflowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{6}
+}
+func (m *ImportArchivedWorkflowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportArchivedWorkflowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportArchivedWorkflowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportArchivedWorkflowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportArchivedWorkflowsRequest.Merge(m, src)
+}
+func (m *ImportArchivedWorkflowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportArchivedWorkflowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportArchivedWorkflowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportArchivedWorkflowsRequest proto.InternalMessageInfo
+
+func (m *ImportArchivedWorkflowsRequest) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *ImportArchivedWorkflowsRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ImportArchivedWorkflowsResponse struct {
+	// The keys of the objects imported.
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// The UIDs of the workflows imported.
+	Uids                 []string `protobuf:"bytes,2,rep,name=uids,proto3" json:"uids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportArchivedWorkflowsResponse) Reset()         { *m = ImportArchivedWorkflowsResponse{} }
+func (m *ImportArchivedWorkflowsResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportArchivedWorkflowsResponse) ProtoMessage()    {}
+func (*ImportArchivedWorkflowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{7}
+}
+func (m *ImportArchivedWorkflowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportArchivedWorkflowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportArchivedWorkflowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportArchivedWorkflowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportArchivedWorkflowsResponse.Merge(m, src)
+}
+func (m *ImportArchivedWorkflowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportArchivedWorkflowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportArchivedWorkflowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportArchivedWorkflowsResponse proto.InternalMessageInfo
+
+func (m *ImportArchivedWorkflowsResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ImportArchivedWorkflowsResponse) GetUids() []string {
+	if m != nil {
+		return m.Uids
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ListArchivedWorkflowsRequest)(nil), "workflowarchive.ListArchivedWorkflowsRequest")
 	proto.RegisterType((*GetArchivedWorkflowRequest)(nil), "workflowarchive.GetArchivedWorkflowRequest")
 	proto.RegisterType((*DeleteArchivedWorkflowRequest)(nil), "workflowarchive.DeleteArchivedWorkflowRequest")
 	proto.RegisterType((*ArchivedWorkflowDeletedResponse)(nil), "workflowarchive.ArchivedWorkflowDeletedResponse")
+	proto.RegisterType((*ResubmitArchivedWorkflowRequest)(nil), "workflowarchive.ResubmitArchivedWorkflowRequest")
+	proto.RegisterType((*RetryArchivedWorkflowRequest)(nil), "workflowarchive.RetryArchivedWorkflowRequest")
+	proto.RegisterType((*ImportArchivedWorkflowsRequest)(nil), "workflowarchive.ImportArchivedWorkflowsRequest")
+	proto.RegisterType((*ImportArchivedWorkflowsResponse)(nil), "workflowarchive.ImportArchivedWorkflowsResponse")
 }
 
 func init() {
@@ -221,36 +473,51 @@ func init() {
 }
 
 var fileDescriptor_95ca9a2d33e8bb19 = []byte{
-	// 459 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcb, 0x6a, 0x14, 0x41,
-	0x14, 0x86, 0x29, 0x15, 0xc1, 0xca, 0x42, 0x29, 0xf1, 0x42, 0x33, 0x8e, 0xb1, 0x57, 0x41, 0x99,
-	0x53, 0x76, 0x92, 0x85, 0x4b, 0x15, 0x41, 0x90, 0x18, 0x61, 0xb2, 0x10, 0xdc, 0x48, 0xa5, 0xfb,
-	0xd8, 0x53, 0x4e, 0x4f, 0x57, 0x59, 0x55, 0xd3, 0x41, 0xc4, 0x8d, 0xaf, 0xe0, 0x2b, 0xf8, 0x10,
-	0xc1, 0x27, 0x70, 0x29, 0xf8, 0x02, 0x32, 0xf8, 0x20, 0xd2, 0x97, 0x4a, 0x87, 0xee, 0xce, 0x65,
-	0x91, 0x5d, 0xcd, 0x99, 0x53, 0xff, 0xff, 0x71, 0xfe, 0x53, 0x4d, 0xb7, 0xf5, 0x3c, 0xe5, 0x42,
-	0xcb, 0x38, 0x93, 0x98, 0x3b, 0x7e, 0xa0, 0xcc, 0xfc, 0x43, 0xa6, 0x0e, 0x84, 0x89, 0x67, 0xb2,
-	0xc0, 0xa3, 0xdf, 0x93, 0xa6, 0x00, 0xda, 0x28, 0xa7, 0xd8, 0xf5, 0x4e, 0x5f, 0x30, 0x4a, 0x95,
-	0x4a, 0x33, 0x2c, 0x95, 0xb8, 0xc8, 0x73, 0xe5, 0x84, 0x93, 0x2a, 0xb7, 0x75, 0x7b, 0xb0, 0x3d,
-	0x7f, 0x62, 0x41, 0xaa, 0xf2, 0xdf, 0x85, 0x88, 0x67, 0x32, 0x47, 0xf3, 0x99, 0x37, 0xc6, 0x96,
-	0x2f, 0xd0, 0x09, 0x5e, 0x44, 0x3c, 0xc5, 0x1c, 0x8d, 0x70, 0x98, 0x34, 0xb7, 0x5e, 0xa7, 0xd2,
-	0xcd, 0x96, 0xfb, 0x10, 0xab, 0x05, 0x17, 0x26, 0x55, 0xda, 0xa8, 0x8f, 0xd5, 0x61, 0xe2, 0xdd,
-	0x6d, 0x2b, 0xe2, 0x4b, 0xbc, 0x88, 0x44, 0xa6, 0x67, 0xa2, 0x27, 0x17, 0x5a, 0x3a, 0xda, 0x91,
-	0xd6, 0x3d, 0xab, 0x89, 0x93, 0xb7, 0x5e, 0x63, 0x8a, 0x9f, 0x96, 0x68, 0x1d, 0xdb, 0xa3, 0x6b,
-	0x99, 0xb4, 0xee, 0x8d, 0xae, 0xc8, 0xef, 0x92, 0x75, 0xb2, 0xb1, 0xb6, 0x19, 0x41, 0x8d, 0x0e,
-	0xc7, 0xd1, 0x41, 0xcf, 0xd3, 0xb2, 0x60, 0xa1, 0x44, 0x87, 0x22, 0x82, 0x9d, 0xf6, 0xe2, 0xf4,
-	0xb8, 0x4a, 0x08, 0x34, 0x78, 0x89, 0x3d, 0x4f, 0x6f, 0x79, 0x83, 0x5e, 0x5e, 0xca, 0xa4, 0xb2,
-	0xba, 0x36, 0x2d, 0x8f, 0x61, 0x44, 0xef, 0xbd, 0xc0, 0x0c, 0x1d, 0x9e, 0xff, 0xca, 0x03, 0x7a,
-	0xbf, 0xdb, 0x5c, 0x4b, 0x24, 0x53, 0xb4, 0x5a, 0xe5, 0x16, 0x37, 0x0f, 0xaf, 0xd0, 0x3b, 0xdd,
-	0x9e, 0x3d, 0x34, 0x85, 0x8c, 0x91, 0xfd, 0x24, 0xf4, 0xd6, 0xe0, 0x5c, 0xd8, 0x04, 0x3a, 0x29,
-	0xc3, 0x69, 0xf3, 0x0b, 0x76, 0xa1, 0xcd, 0x0b, 0x7c, 0x5e, 0xd5, 0xe1, 0xfd, 0x51, 0x5e, 0x50,
-	0x6c, 0xb5, 0xc3, 0xf3, 0x55, 0xf0, 0x91, 0x81, 0xd7, 0x2c, 0x7d, 0xc2, 0xf0, 0xdb, 0x9f, 0x7f,
-	0xdf, 0x2f, 0x8d, 0x58, 0x50, 0x2d, 0x55, 0x11, 0xf1, 0x86, 0x22, 0x69, 0xe3, 0x67, 0x87, 0x84,
-	0xde, 0x1c, 0x98, 0x2f, 0x7b, 0xd4, 0x43, 0x3f, 0x39, 0x85, 0xe0, 0xd5, 0xc5, 0x81, 0x87, 0x1b,
-	0x15, 0x74, 0xc8, 0xd6, 0x4f, 0x86, 0xe6, 0x5f, 0x96, 0x32, 0xf9, 0xca, 0x7e, 0x10, 0x7a, 0x7b,
-	0x38, 0x6a, 0x06, 0x3d, 0xfa, 0x53, 0x77, 0x22, 0x78, 0xdc, 0xeb, 0x3f, 0x63, 0x21, 0x3c, 0xe6,
-	0xc3, 0x33, 0x31, 0x9f, 0xef, 0xfe, 0x5a, 0x8d, 0xc9, 0xef, 0xd5, 0x98, 0xfc, 0x5d, 0x8d, 0xc9,
-	0xbb, 0xa7, 0xe7, 0x7f, 0x92, 0xc3, 0x1f, 0x94, 0xfd, 0xab, 0xd5, 0x63, 0xdc, 0xfa, 0x1f, 0x00,
-	0x00, 0xff, 0xff, 0xac, 0x36, 0x09, 0xd9, 0x78, 0x04, 0x00, 0x00,
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xd6, 0xb4, 0xd5, 0x55, 0x3b, 0x5d, 0xdc, 0x7b, 0xe7, 0xaa, 0xb7, 0x91, 0x15, 0xd2, 0xd6,
+	0x8b, 0xaa, 0xa4, 0xed, 0xb8, 0x69, 0xbb, 0x40, 0x5d, 0x01, 0x42, 0x45, 0x45, 0xa5, 0x48, 0xc9,
+	0x02, 0x89, 0x0d, 0x9a, 0xda, 0xa7, 0xc9, 0x10, 0xdb, 0x63, 0x66, 0xc6, 0xa9, 0x02, 0x62, 0xc3,
+	0x2b, 0xf0, 0x0a, 0xac, 0xfa, 0x04, 0x88, 0x3d, 0x12, 0x2b, 0x84, 0xc4, 0x0b, 0xa0, 0x8a, 0xe7,
+	0x40, 0x68, 0x26, 0x76, 0x53, 0xc5, 0xf9, 0x5b, 0x64, 0x77, 0x7c, 0xe6, 0xcc, 0xf7, 0x7d, 0x27,
+	0x39, 0xdf, 0xb1, 0xf1, 0x61, 0xd2, 0x6e, 0x7a, 0x2c, 0xe1, 0x7e, 0xc8, 0x21, 0xd6, 0xde, 0xa5,
+	0x90, 0xed, 0x8b, 0x50, 0x5c, 0x32, 0xe9, 0xb7, 0x78, 0x07, 0x6e, 0x9e, 0x77, 0xb3, 0x04, 0x4d,
+	0xa4, 0xd0, 0x82, 0xfc, 0x3d, 0x50, 0xe7, 0x94, 0x9b, 0x42, 0x34, 0x43, 0x30, 0x48, 0x1e, 0x8b,
+	0x63, 0xa1, 0x99, 0xe6, 0x22, 0x56, 0xbd, 0x72, 0xe7, 0xb0, 0x7d, 0x4f, 0x51, 0x2e, 0xcc, 0x69,
+	0xc4, 0xfc, 0x16, 0x8f, 0x41, 0x76, 0xbd, 0x8c, 0x58, 0x79, 0x11, 0x68, 0xe6, 0x75, 0x6a, 0x5e,
+	0x13, 0x62, 0x90, 0x4c, 0x43, 0x90, 0xdd, 0x7a, 0xda, 0xe4, 0xba, 0x95, 0x9e, 0x53, 0x5f, 0x44,
+	0x1e, 0x93, 0x4d, 0x91, 0x48, 0xf1, 0xca, 0x06, 0xbb, 0x39, 0xbb, 0xea, 0x83, 0xe4, 0x29, 0xaf,
+	0x53, 0x63, 0x61, 0xd2, 0x62, 0x05, 0x38, 0x57, 0xe1, 0xf2, 0x29, 0x57, 0xfa, 0x41, 0x4f, 0x71,
+	0xf0, 0x3c, 0xc7, 0xa8, 0xc3, 0xeb, 0x14, 0x94, 0x26, 0x0d, 0xbc, 0x1c, 0x72, 0xa5, 0x9f, 0x25,
+	0x56, 0x79, 0x09, 0xad, 0xa3, 0xad, 0xe5, 0xfd, 0x1a, 0xed, 0x49, 0xa7, 0xb7, 0xa5, 0xd3, 0xa4,
+	0xdd, 0x34, 0x09, 0x45, 0x8d, 0x74, 0xda, 0xa9, 0xd1, 0xd3, 0xfe, 0xc5, 0xfa, 0x6d, 0x14, 0x97,
+	0x62, 0xe7, 0x31, 0x14, 0x38, 0x73, 0xca, 0x7f, 0xf0, 0x7c, 0xca, 0x03, 0x4b, 0xb5, 0x54, 0x37,
+	0xa1, 0x5b, 0xc3, 0x77, 0x1e, 0x41, 0x08, 0x1a, 0xa6, 0xbf, 0xb2, 0x81, 0xd7, 0x06, 0x8b, 0x7b,
+	0x10, 0x41, 0x1d, 0x54, 0x22, 0x62, 0x05, 0x6e, 0x84, 0xd7, 0xea, 0xa0, 0xd2, 0xf3, 0x88, 0x4f,
+	0x2f, 0x85, 0x94, 0xf1, 0x52, 0xcc, 0x22, 0x50, 0x09, 0xf3, 0xa1, 0x34, 0x67, 0xf3, 0xfd, 0x04,
+	0x71, 0xf0, 0x62, 0x04, 0x91, 0xe0, 0x6f, 0x20, 0x28, 0xcd, 0xaf, 0xa3, 0xad, 0xc5, 0xfa, 0xcd,
+	0xb3, 0x7b, 0x85, 0x70, 0xb9, 0x0e, 0x5a, 0x76, 0x67, 0x45, 0xb6, 0x83, 0xff, 0x95, 0xa0, 0x34,
+	0x93, 0xba, 0x91, 0xfa, 0x3e, 0x28, 0x75, 0x91, 0x86, 0x19, 0x6b, 0xf1, 0xc0, 0x54, 0xc7, 0x22,
+	0x80, 0x63, 0x0e, 0x61, 0xd0, 0x80, 0x10, 0x7c, 0x2d, 0x64, 0x69, 0xc1, 0x62, 0x16, 0x0f, 0xdc,
+	0x63, 0x5c, 0x39, 0x89, 0x12, 0x21, 0x47, 0x0f, 0x06, 0xc1, 0x0b, 0x01, 0xd3, 0x90, 0xc9, 0xb5,
+	0xb1, 0xe9, 0xa0, 0x0d, 0xdd, 0x4c, 0xa9, 0x09, 0xdd, 0x13, 0xbc, 0x36, 0x12, 0xa7, 0xf7, 0x37,
+	0x18, 0xa0, 0x36, 0x74, 0xcd, 0x68, 0xcd, 0x1b, 0x20, 0x13, 0x9b, 0x5c, 0xca, 0x03, 0x55, 0x9a,
+	0xeb, 0xe5, 0x4c, 0xbc, 0xff, 0x7b, 0x11, 0xaf, 0x0e, 0xa2, 0x34, 0x40, 0x76, 0xb8, 0x0f, 0xe4,
+	0x33, 0xc2, 0x2b, 0x43, 0xc7, 0x98, 0xec, 0xd2, 0x01, 0x53, 0xd2, 0x71, 0xe3, 0xee, 0x9c, 0xd1,
+	0xbe, 0xbd, 0x68, 0x6e, 0x2f, 0x1b, 0xbc, 0xbc, 0xb1, 0x17, 0xed, 0x1c, 0xf4, 0x67, 0x3d, 0xcf,
+	0xd2, 0xdc, 0x61, 0x34, 0xc7, 0x34, 0x3c, 0xae, 0xfb, 0xfe, 0xc7, 0xaf, 0x0f, 0x73, 0x65, 0xe2,
+	0xd8, 0x1d, 0xd0, 0xa9, 0x79, 0x99, 0x8a, 0xa0, 0xef, 0x56, 0xf2, 0x09, 0xe1, 0xff, 0x86, 0xd8,
+	0x81, 0x6c, 0x17, 0xa4, 0x8f, 0x36, 0x8d, 0xf3, 0x64, 0x76, 0xc2, 0xdd, 0x2d, 0x2b, 0xda, 0x25,
+	0xeb, 0xa3, 0x45, 0x7b, 0x6f, 0x53, 0x1e, 0xbc, 0x23, 0x1f, 0x11, 0xfe, 0x7f, 0xb8, 0x33, 0x09,
+	0x2d, 0xa8, 0x1f, 0x6b, 0x61, 0x67, 0xaf, 0x50, 0x3f, 0xc9, 0xbf, 0x99, 0xcc, 0xea, 0x64, 0x99,
+	0xdf, 0x10, 0x2e, 0x8d, 0xb2, 0x3a, 0x29, 0x12, 0x4f, 0xd8, 0x0a, 0x33, 0xfd, 0xad, 0x0f, 0x6d,
+	0x13, 0xf4, 0x08, 0x55, 0x9d, 0xbb, 0x93, 0xfa, 0xf0, 0x64, 0x26, 0x8c, 0x7c, 0x41, 0x78, 0x65,
+	0xe8, 0x2e, 0x19, 0x32, 0xef, 0xe3, 0x76, 0xce, 0x4c, 0x5b, 0xa9, 0xd9, 0x56, 0xb6, 0x9d, 0xcd,
+	0x29, 0xfa, 0xd0, 0xb2, 0x7b, 0x84, 0xaa, 0xe4, 0x0a, 0xe1, 0xd5, 0x11, 0xfb, 0x81, 0x78, 0x85,
+	0x4e, 0xc6, 0x6f, 0x24, 0x67, 0x6f, 0xfa, 0x0b, 0xd9, 0x04, 0xed, 0x58, 0xc5, 0x9b, 0x47, 0xa8,
+	0xea, 0x6e, 0x8c, 0x11, 0xcd, 0x2d, 0xcc, 0xc3, 0xb3, 0xaf, 0xd7, 0x15, 0xf4, 0xfd, 0xba, 0x82,
+	0x7e, 0x5e, 0x57, 0xd0, 0x8b, 0xfb, 0xd3, 0xbf, 0x87, 0x87, 0x7f, 0x45, 0x9c, 0xff, 0x65, 0xdf,
+	0xc0, 0x07, 0x7f, 0x06, 0x00, 0x6c, 0x76, 0x82, 0xbf, 0x6d, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +535,9 @@ type ArchivedWorkflowServiceClient interface {
 	ListArchivedWorkflows(ctx context.Context, in *ListArchivedWorkflowsRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowList, error)
 	GetArchivedWorkflow(ctx context.Context, in *GetArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	DeleteArchivedWorkflow(ctx context.Context, in *DeleteArchivedWorkflowRequest, opts ...grpc.CallOption) (*ArchivedWorkflowDeletedResponse, error)
+	ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	RetryArchivedWorkflow(ctx context.Context, in *RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
+	ImportArchivedWorkflows(ctx context.Context, in *ImportArchivedWorkflowsRequest, opts ...grpc.CallOption) (*ImportArchivedWorkflowsResponse, error)
 }
 
 type archivedWorkflowServiceClient struct {
@@ -305,11 +575,41 @@ func (c *archivedWorkflowServiceClient) DeleteArchivedWorkflow(ctx context.Conte
 	return out, nil
 }
 
+func (c *archivedWorkflowServiceClient) ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ResubmitArchivedWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archivedWorkflowServiceClient) RetryArchivedWorkflow(ctx context.Context, in *RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
+	out := new(v1alpha1.Workflow)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/RetryArchivedWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *archivedWorkflowServiceClient) ImportArchivedWorkflows(ctx context.Context, in *ImportArchivedWorkflowsRequest, opts ...grpc.CallOption) (*ImportArchivedWorkflowsResponse, error) {
+	out := new(ImportArchivedWorkflowsResponse)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ImportArchivedWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArchivedWorkflowServiceServer is the server API for ArchivedWorkflowService service.
 type ArchivedWorkflowServiceServer interface {
 	ListArchivedWorkflows(context.Context, *ListArchivedWorkflowsRequest) (*v1alpha1.WorkflowList, error)
 	GetArchivedWorkflow(context.Context, *GetArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
 	DeleteArchivedWorkflow(context.Context, *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error)
+	ResubmitArchivedWorkflow(context.Context, *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
+	RetryArchivedWorkflow(context.Context, *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
+	ImportArchivedWorkflows(context.Context, *ImportArchivedWorkflowsRequest) (*ImportArchivedWorkflowsResponse, error)
 }
 
 // UnimplementedArchivedWorkflowServiceServer can be embedded to have forward compatible implementations.
@@ -325,6 +625,15 @@ func (*UnimplementedArchivedWorkflowServiceServer) GetArchivedWorkflow(ctx conte
 func (*UnimplementedArchivedWorkflowServiceServer) DeleteArchivedWorkflow(ctx context.Context, req *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteArchivedWorkflow not implemented")
 }
+func (*UnimplementedArchivedWorkflowServiceServer) ResubmitArchivedWorkflow(ctx context.Context, req *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitArchivedWorkflow not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) RetryArchivedWorkflow(ctx context.Context, req *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryArchivedWorkflow not implemented")
+}
+func (*UnimplementedArchivedWorkflowServiceServer) ImportArchivedWorkflows(ctx context.Context, req *ImportArchivedWorkflowsRequest) (*ImportArchivedWorkflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportArchivedWorkflows not implemented")
+}
 
 func RegisterArchivedWorkflowServiceServer(s *grpc.Server, srv ArchivedWorkflowServiceServer) {
 	s.RegisterService(&_ArchivedWorkflowService_serviceDesc, srv)
@@ -384,6 +693,60 @@ func _ArchivedWorkflowService_DeleteArchivedWorkflow_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchivedWorkflowService_ResubmitArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResubmitArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).ResubmitArchivedWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/ResubmitArchivedWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).ResubmitArchivedWorkflow(ctx, req.(*ResubmitArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchivedWorkflowService_RetryArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryArchivedWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).RetryArchivedWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/RetryArchivedWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).RetryArchivedWorkflow(ctx, req.(*RetryArchivedWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArchivedWorkflowService_ImportArchivedWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportArchivedWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).ImportArchivedWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/ImportArchivedWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).ImportArchivedWorkflows(ctx, req.(*ImportArchivedWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "workflowarchive.ArchivedWorkflowService",
 	HandlerType: (*ArchivedWorkflowServiceServer)(nil),
@@ -400,6 +763,18 @@ var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteArchivedWorkflow",
 			Handler:    _ArchivedWorkflowService_DeleteArchivedWorkflow_Handler,
 		},
+		{
+			MethodName: "ResubmitArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_ResubmitArchivedWorkflow_Handler,
+		},
+		{
+			MethodName: "RetryArchivedWorkflow",
+			Handler:    _ArchivedWorkflowService_RetryArchivedWorkflow_Handler,
+		},
+		{
+			MethodName: "ImportArchivedWorkflows",
+			Handler:    _ArchivedWorkflowService_ImportArchivedWorkflows_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/workflowarchive/workflow-archive.proto",
@@ -539,32 +914,227 @@ func (m *ArchivedWorkflowDeletedResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWorkflowArchive(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ResubmitArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ListArchivedWorkflowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ResubmitArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResubmitArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ListOptions != nil {
-		l = m.ListOptions.Size()
-		n += 1 + l + sovWorkflowArchive(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
+	if m.Memoized {
+		i--
+		if m.Memoized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryArchivedWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryArchivedWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NodeFieldSelector) > 0 {
+		i -= len(m.NodeFieldSelector)
+		copy(dAtA[i:], m.NodeFieldSelector)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.NodeFieldSelector)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RestartSuccessful {
+		i--
+		if m.RestartSuccessful {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportArchivedWorkflowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportArchivedWorkflowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportArchivedWorkflowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportArchivedWorkflowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportArchivedWorkflowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportArchivedWorkflowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Uids) > 0 {
+		for iNdEx := len(m.Uids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Uids[iNdEx])
+			copy(dAtA[i:], m.Uids[iNdEx])
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Uids[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkflowArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListArchivedWorkflowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
 
 func (m *GetArchivedWorkflowRequest) Size() (n int) {
 	if m == nil {
@@ -610,6 +1180,100 @@ func (m *ArchivedWorkflowDeletedResponse) Size() (n int) {
 	return n
 }
 
+func (m *ResubmitArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.Memoized {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RetryArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.RestartSuccessful {
+		n += 2
+	}
+	l = len(m.NodeFieldSelector)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportArchivedWorkflowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportArchivedWorkflowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	if len(m.Uids) > 0 {
+		for _, s := range m.Uids {
+			l = len(s)
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovWorkflowArchive(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -932,6 +1596,550 @@ func (m *ArchivedWorkflowDeletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResubmitArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResubmitArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResubmitArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memoized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Memoized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryArchivedWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryArchivedWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartSuccessful", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartSuccessful = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportArchivedWorkflowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportArchivedWorkflowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportArchivedWorkflowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportArchivedWorkflowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportArchivedWorkflowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uids = append(m.Uids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorkflowArchive(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
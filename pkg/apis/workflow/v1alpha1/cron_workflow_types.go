@@ -39,7 +39,7 @@ type CronWorkflowSpec struct {
 	// WorkflowSpec is the spec of the workflow to be run
 	WorkflowSpec WorkflowSpec `json:"workflowSpec" protobuf:"bytes,1,opt,name=workflowSpec,casttype=WorkflowSpec"`
 	// Schedule is a schedule to run the Workflow in Cron format
-	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,2,opt,name=schedule"`
 	// ConcurrencyPolicy is the K8s-style concurrency policy that will be used
 	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty" protobuf:"bytes,3,opt,name=concurrencyPolicy,casttype=ConcurrencyPolicy"`
 	// Suspend is a flag that will stop new CronWorkflows from running if set to true
@@ -55,6 +55,41 @@ type CronWorkflowSpec struct {
 	Timezone string `json:"timezone,omitempty" protobuf:"bytes,8,opt,name=timezone"`
 	// WorkflowMetadata contains some metadata of the workflow to be run
 	WorkflowMetadata *metav1.ObjectMeta `json:"workflowMetadata,omitempty" protobuf:"bytes,9,opt,name=workflowMeta"`
+	// Schedules are more schedules to run the Workflow at, in Cron format, e.g. a different time at month-end
+	Schedules []string `json:"schedules,omitempty" protobuf:"bytes,10,rep,name=schedules"`
+	// When is an expression evaluated before each run, the run is skipped unless it is true.
+	// The variables `scheduledTime`, `lastRun` (the phase, name, startedAt and finishedAt of the most recent completed
+	// workflow, if any) and `status` (this CronWorkflow's status) are available.
+	When string `json:"when,omitempty" protobuf:"bytes,11,opt,name=when"`
+	// Exclusions are dates on which the Workflow is not run, e.g. public holidays
+	Exclusions *CronExclusions `json:"exclusions,omitempty" protobuf:"bytes,12,opt,name=exclusions"`
+	// StopStrategy defines when the CronWorkflow is automatically suspended, e.g. after repeated failures
+	StopStrategy *StopStrategy `json:"stopStrategy,omitempty" protobuf:"bytes,13,opt,name=stopStrategy"`
+}
+
+// GetSchedules returns all the schedules the Workflow is run at
+func (c CronWorkflowSpec) GetSchedules() []string {
+	var schedules []string
+	if c.Schedule != "" {
+		schedules = append(schedules, c.Schedule)
+	}
+	return append(schedules, c.Schedules...)
+}
+
+// CronExclusions are dates on which a CronWorkflow is not run, in the CronWorkflow's timezone
+type CronExclusions struct {
+	// Dates are dates in YYYY-MM-DD format
+	Dates []string `json:"dates,omitempty" protobuf:"bytes,1,rep,name=dates"`
+	// ConfigMapKeyRef is a key of a ConfigMap in the same namespace listing more dates, one per line.
+	// Anything after the date on a line, e.g. the name of the holiday, and lines starting with "#" are ignored.
+	ConfigMapKeyRef *v1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,2,opt,name=configMapKeyRef"`
+}
+
+// StopStrategy defines when a CronWorkflow is automatically suspended.
+// It is resumed the same way as any other suspended CronWorkflow, e.g. `argo cron resume`.
+type StopStrategy struct {
+	// ConsecutiveFailures is the number of consecutive failed or errored workflows after which the CronWorkflow is suspended
+	ConsecutiveFailures int32 `json:"consecutiveFailures" protobuf:"varint,1,opt,name=consecutiveFailures"`
 }
 
 // CronWorkflowStatus is the status of a CronWorkflow
@@ -65,6 +100,59 @@ type CronWorkflowStatus struct {
 	LastScheduledTime *metav1.Time `json:"lastScheduledTime" protobuf:"bytes,2,opt,name=lastScheduledTime"`
 	// Conditions is a list of conditions the CronWorkflow may have
 	Conditions Conditions `json:"conditions" protobuf:"bytes,3,rep,name=conditions"`
+	// Backfill is the backfill in progress, if any
+	Backfill *CronWorkflowBackfill `json:"backfill,omitempty" protobuf:"bytes,4,opt,name=backfill"`
+	// Succeeded is the number of workflows that have succeeded
+	Succeeded int64 `json:"succeeded,omitempty" protobuf:"varint,5,opt,name=succeeded"`
+	// Failed is the number of workflows that have failed or errored
+	Failed int64 `json:"failed,omitempty" protobuf:"varint,6,opt,name=failed"`
+	// ConsecutiveFailures is the number of workflows that have failed or errored since the last one succeeded
+	ConsecutiveFailures int64 `json:"consecutiveFailures,omitempty" protobuf:"varint,7,opt,name=consecutiveFailures"`
+	// RecentRuns are the most recently completed workflows, latest first
+	RecentRuns []CronWorkflowRun `json:"recentRuns,omitempty" protobuf:"bytes,8,rep,name=recentRuns"`
+	// NextScheduledTime is the next time the CronWorkflow is scheduled at, unset if it is suspended
+	NextScheduledTime *metav1.Time `json:"nextScheduledTime,omitempty" protobuf:"bytes,9,opt,name=nextScheduledTime"`
+}
+
+// MaxCronWorkflowRecentRuns is the number of recent runs kept in the status
+const MaxCronWorkflowRecentRuns = 10
+
+// CronWorkflowRun is the outcome of a workflow run by a CronWorkflow
+type CronWorkflowRun struct {
+	// Name of the workflow
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Phase the workflow completed with
+	Phase WorkflowPhase `json:"phase" protobuf:"bytes,2,opt,name=phase,casttype=WorkflowPhase"`
+	// StartedAt is the time the workflow started
+	StartedAt metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,3,opt,name=startedAt"`
+	// FinishedAt is the time the workflow finished
+	FinishedAt metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,4,opt,name=finishedAt"`
+	// Message is the workflow's message, e.g. why it failed
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+}
+
+// CronWorkflowBackfill runs a workflow for each time in a range the CronWorkflow is scheduled at, e.g. to process
+// historical partitions. The scheduled time is available to the workflow as `{{workflow.scheduledTime}}`.
+type CronWorkflowBackfill struct {
+	// From is the start of the range, inclusive
+	From metav1.Time `json:"from" protobuf:"bytes,1,opt,name=from"`
+	// To is the end of the range, inclusive
+	To metav1.Time `json:"to" protobuf:"bytes,2,opt,name=to"`
+	// MaxParallel is the maximum number of backfill workflows running at once, defaults to 1.
+	// If the ConcurrencyPolicy is "Forbid" or "Replace", backfill workflows are only run one at a time, when no other
+	// workflows are running.
+	MaxParallel int32 `json:"maxParallel,omitempty" protobuf:"varint,3,opt,name=maxParallel"`
+	// Parameter is the name of a parameter to pass the scheduled time to the workflow as, in RFC3339 format
+	Parameter string `json:"parameter,omitempty" protobuf:"bytes,4,opt,name=parameter"`
+	// Next is the next scheduled time to run a workflow for, or unset if none have been run yet
+	Next *metav1.Time `json:"next,omitempty" protobuf:"bytes,5,opt,name=next"`
+}
+
+func (b *CronWorkflowBackfill) GetMaxParallel() int {
+	if b.MaxParallel > 0 {
+		return int(b.MaxParallel)
+	}
+	return 1
 }
 
 func (c *CronWorkflowStatus) HasActiveUID(uid types.UID) bool {
@@ -79,4 +167,6 @@ func (c *CronWorkflowStatus) HasActiveUID(uid types.UID) bool {
 const (
 	// ConditionTypeSubmissionError signifies that there was an error when submitting the CronWorkflow as a Workflow
 	ConditionTypeSubmissionError ConditionType = "SubmissionError"
+	// ConditionTypeAutoSuspended signifies that the CronWorkflow was suspended by its stop strategy
+	ConditionTypeAutoSuspended ConditionType = "AutoSuspended"
 )
@@ -7,26 +7,69 @@ type Data struct {
 
 	// Transformation applies a set of transformations
 	Transformation Transformation `json:"transformation" protobuf:"bytes,2,rep,name=transformation"`
+
+	// OutputArtifact is the name of an output artifact of the template to save the result to, as JSON, instead of
+	// outputs.result, e.g. when it is too large for a parameter
+	OutputArtifact string `json:"outputArtifact,omitempty" protobuf:"bytes,3,opt,name=outputArtifact"`
 }
 
 func (ds *DataSource) GetArtifactIfNeeded() (*Artifact, bool) {
 	if ds.ArtifactPaths != nil {
 		return &ds.ArtifactPaths.Artifact, true
 	}
+	if ds.ArtifactFiles != nil {
+		return &ds.ArtifactFiles.Artifact, true
+	}
 	return nil, false
 }
 
 type Transformation []TransformationStep
 
+// TransformationStep is one of the transformations of a data template
 type TransformationStep struct {
-	// Expression defines an expr expression to apply
-	Expression string `json:"expression" protobuf:"bytes,1,opt,name=expression"`
+	// Expression defines an expr expression to apply, the data is `data`
+	Expression string `json:"expression,omitempty" protobuf:"bytes,1,opt,name=expression"`
+
+	// Parse parses string data, or each string item of list data. One of: json, csv.
+	// CSV is parsed into a list of objects, keyed by the columns of its first row.
+	Parse DataFormat `json:"parse,omitempty" protobuf:"bytes,2,opt,name=parse,casttype=DataFormat"`
+
+	// Filter keeps the items of list data the expr expression is true for, the item is `item`, e.g. `item.size > 0`
+	Filter string `json:"filter,omitempty" protobuf:"bytes,3,opt,name=filter"`
+
+	// Map replaces the items of list data with the result of the expr expression, the item is `item`, e.g. `item.name`
+	Map string `json:"map,omitempty" protobuf:"bytes,4,opt,name=map"`
+
+	// GroupBy groups the items of list data into an object of lists, keyed by the result of the expr expression, the
+	// item is `item`, e.g. `item.region`
+	GroupBy string `json:"groupBy,omitempty" protobuf:"bytes,5,opt,name=groupBy"`
+
+	// Chunk splits list data into lists of at most this many items, e.g. batches to fan-out over with `withParam`
+	Chunk int32 `json:"chunk,omitempty" protobuf:"varint,6,opt,name=chunk"`
 }
 
+// DataFormat is the format of data that can be parsed
+type DataFormat string
+
+const (
+	DataFormatJSON DataFormat = "json"
+	DataFormatCSV  DataFormat = "csv"
+)
+
 // DataSource sources external data into a data template
 type DataSource struct {
 	// ArtifactPaths is a data transformation that collects a list of artifact paths
 	ArtifactPaths *ArtifactPaths `json:"artifactPaths,omitempty" protobuf:"bytes,1,opt,name=artifactPaths"`
+
+	// Value is a literal value, e.g. JSON or a parameter such as `{{inputs.parameters.items}}`. Values that are not
+	// JSON are strings.
+	Value *string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+
+	// ArtifactFiles collects the paths and contents of the files of an artifact
+	ArtifactFiles *ArtifactFiles `json:"artifactFiles,omitempty" protobuf:"bytes,3,opt,name=artifactFiles"`
+
+	// HTTP fetches JSON with an HTTP GET request
+	HTTP *HTTPDataSource `json:"http,omitempty" protobuf:"bytes,4,opt,name=http"`
 }
 
 // ArtifactPaths expands a step from a collection of artifacts
@@ -35,6 +78,27 @@ type ArtifactPaths struct {
 	Artifact `json:",inline" protobuf:"bytes,1,opt,name=artifact"`
 }
 
+// ArtifactFiles collects a list of objects with the `path` and `content` of each file of an artifact, it can be a
+// directory
+type ArtifactFiles struct {
+	// Artifact is the artifact location from which to source the files
+	Artifact `json:",inline" protobuf:"bytes,1,opt,name=artifact"`
+
+	// Format is the format to parse the content of the files as. One of: json, csv. Contents are strings by default.
+	Format DataFormat `json:"format,omitempty" protobuf:"bytes,2,opt,name=format,casttype=DataFormat"`
+}
+
+// HTTPDataSource fetches JSON with an HTTP GET request
+type HTTPDataSource struct {
+	// URL to fetch the JSON from
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+
+	// Headers are the headers of the request
+	Headers []Header `json:"headers,omitempty" protobuf:"bytes,2,rep,name=headers"`
+}
+
 type DataSourceProcessor interface {
 	ProcessArtifactPaths(*ArtifactPaths) (interface{}, error)
+	ProcessArtifactFiles(*ArtifactFiles) (interface{}, error)
+	ProcessHTTP(*HTTPDataSource) (interface{}, error)
 }
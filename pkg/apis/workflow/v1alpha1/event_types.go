@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -29,6 +31,14 @@ type WorkflowEventBindingSpec struct {
 	Event Event `json:"event" protobuf:"bytes,1,opt,name=event"`
 	// Submit is the workflow template to submit
 	Submit *Submit `json:"submit,omitempty" protobuf:"bytes,2,opt,name=submit"`
+	// Resume resumes suspended workflows, or suspended nodes of workflows
+	Resume *ResumeAction `json:"resume,omitempty" protobuf:"bytes,3,opt,name=resume"`
+	// Stop stops workflows, or fails suspended nodes of workflows
+	Stop *StopAction `json:"stop,omitempty" protobuf:"bytes,4,opt,name=stop"`
+	// Terminate terminates workflows
+	Terminate *TerminateAction `json:"terminate,omitempty" protobuf:"bytes,5,opt,name=terminate"`
+	// Set sets the phase, message, or output parameters of suspended nodes of workflows
+	Set *SetAction `json:"set,omitempty" protobuf:"bytes,6,opt,name=set"`
 }
 
 type Event struct {
@@ -45,4 +55,71 @@ type Submit struct {
 
 	// Arguments extracted from the event and then set as arguments to the workflow created.
 	Arguments *Arguments `json:"arguments,omitempty" protobuf:"bytes,2,opt,name=arguments"`
+
+	// DedupeKey is an expression that evaluates to a key identifying the event, e.g. `payload.head_commit.id`.
+	// A workflow is not submitted if one with the same key has been submitted from this binding within the dedupe window.
+	DedupeKey string `json:"dedupeKey,omitempty" protobuf:"bytes,4,opt,name=dedupeKey"`
+
+	// DedupeWindow is how long a dedupe key is remembered for, defaults to 1h
+	DedupeWindow *metav1.Duration `json:"dedupeWindow,omitempty" protobuf:"bytes,5,opt,name=dedupeWindow"`
+
+	// RateLimit limits the number of workflows submitted from this binding
+	RateLimit *EventRateLimit `json:"rateLimit,omitempty" protobuf:"bytes,6,opt,name=rateLimit"`
+}
+
+func (s *Submit) GetDedupeWindow() time.Duration {
+	if s.DedupeWindow == nil {
+		return time.Hour
+	}
+	return s.DedupeWindow.Duration
+}
+
+type EventRateLimit struct {
+	// Limit is the maximum number of workflows submitted within the period, by each Argo Server replica
+	Limit int32 `json:"limit" protobuf:"varint,1,opt,name=limit"`
+	// Period defaults to 1m
+	Period *metav1.Duration `json:"period,omitempty" protobuf:"bytes,2,opt,name=period"`
+}
+
+func (l *EventRateLimit) GetPeriod() time.Duration {
+	if l.Period == nil {
+		return time.Minute
+	}
+	return l.Period.Duration
+}
+
+type ResumeAction struct {
+	// LabelSelector is an expression that evaluates to the label selector of the workflows to resume, e.g. `"ticket=" + payload.ticket`
+	LabelSelector string `json:"labelSelector" protobuf:"bytes,1,opt,name=labelSelector"`
+	// NodeFieldSelector selects the suspended nodes to resume, e.g. `displayName=approve`. If empty, the whole workflow is resumed.
+	NodeFieldSelector string `json:"nodeFieldSelector,omitempty" protobuf:"bytes,2,opt,name=nodeFieldSelector"`
+	// OutputParameters extracted from the event and then set as the output parameters of the resumed nodes.
+	OutputParameters []Parameter `json:"outputParameters,omitempty" protobuf:"bytes,3,rep,name=outputParameters"`
+}
+
+type StopAction struct {
+	// LabelSelector is an expression that evaluates to the label selector of the workflows to stop, e.g. `"ticket=" + payload.ticket`
+	LabelSelector string `json:"labelSelector" protobuf:"bytes,1,opt,name=labelSelector"`
+	// NodeFieldSelector selects the suspended nodes to fail, e.g. `displayName=approve`. If empty, the whole workflow is stopped.
+	NodeFieldSelector string `json:"nodeFieldSelector,omitempty" protobuf:"bytes,2,opt,name=nodeFieldSelector"`
+	// Message is an expression that evaluates to the message of the failed nodes, e.g. `"rejected by " + payload.user`
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+}
+
+type TerminateAction struct {
+	// LabelSelector is an expression that evaluates to the label selector of the workflows to terminate, e.g. `"ticket=" + payload.ticket`
+	LabelSelector string `json:"labelSelector" protobuf:"bytes,1,opt,name=labelSelector"`
+}
+
+type SetAction struct {
+	// LabelSelector is an expression that evaluates to the label selector of the workflows to update, e.g. `"ticket=" + payload.ticket`
+	LabelSelector string `json:"labelSelector" protobuf:"bytes,1,opt,name=labelSelector"`
+	// NodeFieldSelector selects the suspended nodes to update, e.g. `displayName=approve`
+	NodeFieldSelector string `json:"nodeFieldSelector" protobuf:"bytes,2,opt,name=nodeFieldSelector"`
+	// Phase is the phase to set the nodes to, e.g. `Succeeded`
+	Phase NodePhase `json:"phase,omitempty" protobuf:"bytes,3,opt,name=phase,casttype=NodePhase"`
+	// Message is an expression that evaluates to the message of the nodes
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// OutputParameters extracted from the event and then set as the output parameters of the nodes.
+	OutputParameters []Parameter `json:"outputParameters,omitempty" protobuf:"bytes,5,rep,name=outputParameters"`
 }
@@ -14,6 +14,7 @@ import (
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	v1beta1 "k8s.io/api/policy/v1beta1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	k8s_io_apimachinery_pkg_apis_meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -148,10 +149,38 @@ func (m *Artifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Artifact proto.InternalMessageInfo
 
+func (m *ArtifactFiles) Reset()      { *m = ArtifactFiles{} }
+func (*ArtifactFiles) ProtoMessage() {}
+func (*ArtifactFiles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{4}
+}
+func (m *ArtifactFiles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactFiles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactFiles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactFiles.Merge(m, src)
+}
+func (m *ArtifactFiles) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactFiles) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactFiles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactFiles proto.InternalMessageInfo
+
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{4}
+	return fileDescriptor_724696e352c3df5f, []int{5}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +208,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *ArtifactPaths) Reset()      { *m = ArtifactPaths{} }
 func (*ArtifactPaths) ProtoMessage() {}
 func (*ArtifactPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{5}
+	return fileDescriptor_724696e352c3df5f, []int{6}
 }
 func (m *ArtifactPaths) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +236,7 @@ var xxx_messageInfo_ArtifactPaths proto.InternalMessageInfo
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{6}
+	return fileDescriptor_724696e352c3df5f, []int{7}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +264,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactRepositoryRefStatus) Reset()      { *m = ArtifactRepositoryRefStatus{} }
 func (*ArtifactRepositoryRefStatus) ProtoMessage() {}
 func (*ArtifactRepositoryRefStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{7}
+	return fileDescriptor_724696e352c3df5f, []int{8}
 }
 func (m *ArtifactRepositoryRefStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -260,10 +289,38 @@ func (m *ArtifactRepositoryRefStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArtifactRepositoryRefStatus proto.InternalMessageInfo
 
+func (m *ArtifactStreaming) Reset()      { *m = ArtifactStreaming{} }
+func (*ArtifactStreaming) ProtoMessage() {}
+func (*ArtifactStreaming) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{9}
+}
+func (m *ArtifactStreaming) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactStreaming) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactStreaming) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactStreaming.Merge(m, src)
+}
+func (m *ArtifactStreaming) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactStreaming) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactStreaming.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactStreaming proto.InternalMessageInfo
+
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{8}
+	return fileDescriptor_724696e352c3df5f, []int{10}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +348,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{9}
+	return fileDescriptor_724696e352c3df5f, []int{11}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +376,7 @@ var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{10}
+	return fileDescriptor_724696e352c3df5f, []int{12}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +404,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *Cache) Reset()      { *m = Cache{} }
 func (*Cache) ProtoMessage() {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{11}
+	return fileDescriptor_724696e352c3df5f, []int{13}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +432,7 @@ var xxx_messageInfo_Cache proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{12}
+	return fileDescriptor_724696e352c3df5f, []int{14}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +460,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{13}
+	return fileDescriptor_724696e352c3df5f, []int{15}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +488,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{14}
+	return fileDescriptor_724696e352c3df5f, []int{16}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +516,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
 func (*ContainerNode) ProtoMessage() {}
 func (*ContainerNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{15}
+	return fileDescriptor_724696e352c3df5f, []int{17}
 }
 func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +544,7 @@ var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
 func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
 func (*ContainerSetTemplate) ProtoMessage() {}
 func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{16}
+	return fileDescriptor_724696e352c3df5f, []int{18}
 }
 func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +572,7 @@ var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{17}
+	return fileDescriptor_724696e352c3df5f, []int{19}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +600,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{18}
+	return fileDescriptor_724696e352c3df5f, []int{20}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +628,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CreateS3BucketOptions) Reset()      { *m = CreateS3BucketOptions{} }
 func (*CreateS3BucketOptions) ProtoMessage() {}
 func (*CreateS3BucketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{19}
+	return fileDescriptor_724696e352c3df5f, []int{21}
 }
 func (m *CreateS3BucketOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -596,10 +653,38 @@ func (m *CreateS3BucketOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateS3BucketOptions proto.InternalMessageInfo
 
+func (m *CronExclusions) Reset()      { *m = CronExclusions{} }
+func (*CronExclusions) ProtoMessage() {}
+func (*CronExclusions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{22}
+}
+func (m *CronExclusions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronExclusions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronExclusions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronExclusions.Merge(m, src)
+}
+func (m *CronExclusions) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronExclusions) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronExclusions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronExclusions proto.InternalMessageInfo
+
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{20}
+	return fileDescriptor_724696e352c3df5f, []int{23}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,10 +709,38 @@ func (m *CronWorkflow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 
+func (m *CronWorkflowBackfill) Reset()      { *m = CronWorkflowBackfill{} }
+func (*CronWorkflowBackfill) ProtoMessage() {}
+func (*CronWorkflowBackfill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{24}
+}
+func (m *CronWorkflowBackfill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowBackfill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronWorkflowBackfill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowBackfill.Merge(m, src)
+}
+func (m *CronWorkflowBackfill) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowBackfill) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowBackfill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowBackfill proto.InternalMessageInfo
+
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{21}
+	return fileDescriptor_724696e352c3df5f, []int{25}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,10 +765,38 @@ func (m *CronWorkflowList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 
+func (m *CronWorkflowRun) Reset()      { *m = CronWorkflowRun{} }
+func (*CronWorkflowRun) ProtoMessage() {}
+func (*CronWorkflowRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{26}
+}
+func (m *CronWorkflowRun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronWorkflowRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowRun.Merge(m, src)
+}
+func (m *CronWorkflowRun) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowRun proto.InternalMessageInfo
+
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{22}
+	return fileDescriptor_724696e352c3df5f, []int{27}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +824,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{23}
+	return fileDescriptor_724696e352c3df5f, []int{28}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +852,7 @@ var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{24}
+	return fileDescriptor_724696e352c3df5f, []int{29}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +880,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{25}
+	return fileDescriptor_724696e352c3df5f, []int{30}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +908,7 @@ var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 func (m *Data) Reset()      { *m = Data{} }
 func (*Data) ProtoMessage() {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{26}
+	return fileDescriptor_724696e352c3df5f, []int{31}
 }
 func (m *Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +936,7 @@ var xxx_messageInfo_Data proto.InternalMessageInfo
 func (m *DataSource) Reset()      { *m = DataSource{} }
 func (*DataSource) ProtoMessage() {}
 func (*DataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{27}
+	return fileDescriptor_724696e352c3df5f, []int{32}
 }
 func (m *DataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +964,7 @@ var xxx_messageInfo_DataSource proto.InternalMessageInfo
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{28}
+	return fileDescriptor_724696e352c3df5f, []int{33}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -848,10 +989,38 @@ func (m *Event) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Event proto.InternalMessageInfo
 
+func (m *EventRateLimit) Reset()      { *m = EventRateLimit{} }
+func (*EventRateLimit) ProtoMessage() {}
+func (*EventRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{34}
+}
+func (m *EventRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRateLimit.Merge(m, src)
+}
+func (m *EventRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRateLimit proto.InternalMessageInfo
+
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{29}
+	return fileDescriptor_724696e352c3df5f, []int{35}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1048,7 @@ var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{30}
+	return fileDescriptor_724696e352c3df5f, []int{36}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +1076,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{31}
+	return fileDescriptor_724696e352c3df5f, []int{37}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1104,7 @@ var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{32}
+	return fileDescriptor_724696e352c3df5f, []int{38}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1132,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{33}
+	return fileDescriptor_724696e352c3df5f, []int{39}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1160,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{34}
+	return fileDescriptor_724696e352c3df5f, []int{40}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1188,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{35}
+	return fileDescriptor_724696e352c3df5f, []int{41}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1216,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{36}
+	return fileDescriptor_724696e352c3df5f, []int{42}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1244,7 @@ var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{37}
+	return fileDescriptor_724696e352c3df5f, []int{43}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1100,10 +1269,38 @@ func (m *HTTPArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 
+func (m *HTTPDataSource) Reset()      { *m = HTTPDataSource{} }
+func (*HTTPDataSource) ProtoMessage() {}
+func (*HTTPDataSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{44}
+}
+func (m *HTTPDataSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPDataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPDataSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPDataSource.Merge(m, src)
+}
+func (m *HTTPDataSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPDataSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPDataSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPDataSource proto.InternalMessageInfo
+
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{38}
+	return fileDescriptor_724696e352c3df5f, []int{45}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1328,7 @@ var xxx_messageInfo_Header proto.InternalMessageInfo
 func (m *Histogram) Reset()      { *m = Histogram{} }
 func (*Histogram) ProtoMessage() {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{39}
+	return fileDescriptor_724696e352c3df5f, []int{46}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1356,7 @@ var xxx_messageInfo_Histogram proto.InternalMessageInfo
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{40}
+	return fileDescriptor_724696e352c3df5f, []int{47}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1384,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{41}
+	return fileDescriptor_724696e352c3df5f, []int{48}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1412,7 @@ var xxx_messageInfo_Item proto.InternalMessageInfo
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{42}
+	return fileDescriptor_724696e352c3df5f, []int{49}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1440,7 @@ var xxx_messageInfo_Link proto.InternalMessageInfo
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{43}
+	return fileDescriptor_724696e352c3df5f, []int{50}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1468,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{44}
+	return fileDescriptor_724696e352c3df5f, []int{51}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1496,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{45}
+	return fileDescriptor_724696e352c3df5f, []int{52}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1524,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{46}
+	return fileDescriptor_724696e352c3df5f, []int{53}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1552,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{47}
+	return fileDescriptor_724696e352c3df5f, []int{54}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1580,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{48}
+	return fileDescriptor_724696e352c3df5f, []int{55}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1608,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{49}
+	return fileDescriptor_724696e352c3df5f, []int{56}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1636,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{50}
+	return fileDescriptor_724696e352c3df5f, []int{57}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1664,7 @@ var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{51}
+	return fileDescriptor_724696e352c3df5f, []int{58}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1692,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
 func (*NodeSynchronizationStatus) ProtoMessage() {}
 func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{52}
+	return fileDescriptor_724696e352c3df5f, []int{59}
 }
 func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1720,7 @@ var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{53}
+	return fileDescriptor_724696e352c3df5f, []int{60}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1748,7 @@ var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{54}
+	return fileDescriptor_724696e352c3df5f, []int{61}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1776,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{55}
+	return fileDescriptor_724696e352c3df5f, []int{62}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1804,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{56}
+	return fileDescriptor_724696e352c3df5f, []int{63}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1832,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{57}
+	return fileDescriptor_724696e352c3df5f, []int{64}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +1860,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{58}
+	return fileDescriptor_724696e352c3df5f, []int{65}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1688,10 +1885,38 @@ func (m *Parameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Parameter proto.InternalMessageInfo
 
+func (m *ParameterSchema) Reset()      { *m = ParameterSchema{} }
+func (*ParameterSchema) ProtoMessage() {}
+func (*ParameterSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{66}
+}
+func (m *ParameterSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParameterSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParameterSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterSchema.Merge(m, src)
+}
+func (m *ParameterSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParameterSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParameterSchema proto.InternalMessageInfo
+
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{59}
+	return fileDescriptor_724696e352c3df5f, []int{67}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +1944,7 @@ var xxx_messageInfo_PodGC proto.InternalMessageInfo
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{60}
+	return fileDescriptor_724696e352c3df5f, []int{68}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1972,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{61}
+	return fileDescriptor_724696e352c3df5f, []int{69}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +2000,7 @@ var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{62}
+	return fileDescriptor_724696e352c3df5f, []int{70}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1800,10 +2025,38 @@ func (m *ResourceTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 
-func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
-func (*RetryAffinity) ProtoMessage() {}
+func (m *ResumeAction) Reset()      { *m = ResumeAction{} }
+func (*ResumeAction) ProtoMessage() {}
+func (*ResumeAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{71}
+}
+func (m *ResumeAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResumeAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeAction.Merge(m, src)
+}
+func (m *ResumeAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeAction proto.InternalMessageInfo
+
+func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
+func (*RetryAffinity) ProtoMessage() {}
 func (*RetryAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{63}
+	return fileDescriptor_724696e352c3df5f, []int{72}
 }
 func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +2084,7 @@ var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
 func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
 func (*RetryNodeAntiAffinity) ProtoMessage() {}
 func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{64}
+	return fileDescriptor_724696e352c3df5f, []int{73}
 }
 func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2112,7 @@ var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{65}
+	return fileDescriptor_724696e352c3df5f, []int{74}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2140,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{66}
+	return fileDescriptor_724696e352c3df5f, []int{75}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2168,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{67}
+	return fileDescriptor_724696e352c3df5f, []int{76}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2196,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{68}
+	return fileDescriptor_724696e352c3df5f, []int{77}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2224,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{69}
+	return fileDescriptor_724696e352c3df5f, []int{78}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2252,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{70}
+	return fileDescriptor_724696e352c3df5f, []int{79}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2280,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{71}
+	return fileDescriptor_724696e352c3df5f, []int{80}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2308,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{72}
+	return fileDescriptor_724696e352c3df5f, []int{81}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2080,10 +2333,94 @@ func (m *Sequence) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Sequence proto.InternalMessageInfo
 
+func (m *SetAction) Reset()      { *m = SetAction{} }
+func (*SetAction) ProtoMessage() {}
+func (*SetAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{82}
+}
+func (m *SetAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SetAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAction.Merge(m, src)
+}
+func (m *SetAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAction proto.InternalMessageInfo
+
+func (m *StopAction) Reset()      { *m = StopAction{} }
+func (*StopAction) ProtoMessage() {}
+func (*StopAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{83}
+}
+func (m *StopAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StopAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopAction.Merge(m, src)
+}
+func (m *StopAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopAction proto.InternalMessageInfo
+
+func (m *StopStrategy) Reset()      { *m = StopStrategy{} }
+func (*StopStrategy) ProtoMessage() {}
+func (*StopStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{84}
+}
+func (m *StopStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StopStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopStrategy.Merge(m, src)
+}
+func (m *StopStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopStrategy proto.InternalMessageInfo
+
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{73}
+	return fileDescriptor_724696e352c3df5f, []int{85}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2448,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{74}
+	return fileDescriptor_724696e352c3df5f, []int{86}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2476,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{75}
+	return fileDescriptor_724696e352c3df5f, []int{87}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2504,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{76}
+	return fileDescriptor_724696e352c3df5f, []int{88}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2532,7 @@ var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{77}
+	return fileDescriptor_724696e352c3df5f, []int{89}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2560,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{78}
+	return fileDescriptor_724696e352c3df5f, []int{90}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2588,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{79}
+	return fileDescriptor_724696e352c3df5f, []int{91}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2616,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{80}
+	return fileDescriptor_724696e352c3df5f, []int{92}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2644,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{81}
+	return fileDescriptor_724696e352c3df5f, []int{93}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +2672,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{82}
+	return fileDescriptor_724696e352c3df5f, []int{94}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2360,10 +2697,38 @@ func (m *TemplateRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 
+func (m *TerminateAction) Reset()      { *m = TerminateAction{} }
+func (*TerminateAction) ProtoMessage() {}
+func (*TerminateAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{95}
+}
+func (m *TerminateAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerminateAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TerminateAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateAction.Merge(m, src)
+}
+func (m *TerminateAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerminateAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminateAction proto.InternalMessageInfo
+
 func (m *TransformationStep) Reset()      { *m = TransformationStep{} }
 func (*TransformationStep) ProtoMessage() {}
 func (*TransformationStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{83}
+	return fileDescriptor_724696e352c3df5f, []int{96}
 }
 func (m *TransformationStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2756,7 @@ var xxx_messageInfo_TransformationStep proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{84}
+	return fileDescriptor_724696e352c3df5f, []int{97}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2784,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{85}
+	return fileDescriptor_724696e352c3df5f, []int{98}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2812,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{86}
+	return fileDescriptor_724696e352c3df5f, []int{99}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +2840,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{87}
+	return fileDescriptor_724696e352c3df5f, []int{100}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +2868,7 @@ var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{88}
+	return fileDescriptor_724696e352c3df5f, []int{101}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2531,7 +2896,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{89}
+	return fileDescriptor_724696e352c3df5f, []int{102}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +2924,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{90}
+	return fileDescriptor_724696e352c3df5f, []int{103}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2587,7 +2952,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{91}
+	return fileDescriptor_724696e352c3df5f, []int{104}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +2980,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{92}
+	return fileDescriptor_724696e352c3df5f, []int{105}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2643,7 +3008,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{93}
+	return fileDescriptor_724696e352c3df5f, []int{106}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2671,7 +3036,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{94}
+	return fileDescriptor_724696e352c3df5f, []int{107}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +3064,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{95}
+	return fileDescriptor_724696e352c3df5f, []int{108}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2727,7 +3092,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{96}
+	return fileDescriptor_724696e352c3df5f, []int{109}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2755,7 +3120,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{97}
+	return fileDescriptor_724696e352c3df5f, []int{110}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2783,7 +3148,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{98}
+	return fileDescriptor_724696e352c3df5f, []int{111}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2811,7 +3176,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *WorkflowTemplateSpec) Reset()      { *m = WorkflowTemplateSpec{} }
 func (*WorkflowTemplateSpec) ProtoMessage() {}
 func (*WorkflowTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{99}
+	return fileDescriptor_724696e352c3df5f, []int{112}
 }
 func (m *WorkflowTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2839,7 +3204,7 @@ var xxx_messageInfo_WorkflowTemplateSpec proto.InternalMessageInfo
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{100}
+	return fileDescriptor_724696e352c3df5f, []int{113}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2869,10 +3234,12 @@ func init() {
 	proto.RegisterType((*ArchiveStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArchiveStrategy")
 	proto.RegisterType((*Arguments)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Arguments")
 	proto.RegisterType((*Artifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Artifact")
+	proto.RegisterType((*ArtifactFiles)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactFiles")
 	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactLocation")
 	proto.RegisterType((*ArtifactPaths)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactPaths")
 	proto.RegisterType((*ArtifactRepositoryRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRef")
 	proto.RegisterType((*ArtifactRepositoryRefStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRefStatus")
+	proto.RegisterType((*ArtifactStreaming)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactStreaming")
 	proto.RegisterType((*ArtifactoryArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactoryArtifact")
 	proto.RegisterType((*ArtifactoryAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactoryAuth")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Backoff")
@@ -2885,8 +3252,11 @@ func init() {
 	proto.RegisterType((*ContinueOn)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContinueOn")
 	proto.RegisterType((*Counter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Counter")
 	proto.RegisterType((*CreateS3BucketOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CreateS3BucketOptions")
+	proto.RegisterType((*CronExclusions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronExclusions")
 	proto.RegisterType((*CronWorkflow)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflow")
+	proto.RegisterType((*CronWorkflowBackfill)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowBackfill")
 	proto.RegisterType((*CronWorkflowList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowList")
+	proto.RegisterType((*CronWorkflowRun)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowRun")
 	proto.RegisterType((*CronWorkflowSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowSpec")
 	proto.RegisterType((*CronWorkflowStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowStatus")
 	proto.RegisterType((*DAGTask)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTask")
@@ -2894,6 +3264,7 @@ func init() {
 	proto.RegisterType((*Data)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Data")
 	proto.RegisterType((*DataSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DataSource")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Event")
+	proto.RegisterType((*EventRateLimit)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.EventRateLimit")
 	proto.RegisterType((*ExecutorConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ExecutorConfig")
 	proto.RegisterType((*GCSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifact")
 	proto.RegisterType((*GCSBucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSBucket")
@@ -2903,6 +3274,7 @@ func init() {
 	proto.RegisterType((*HDFSConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSConfig")
 	proto.RegisterType((*HDFSKrbConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSKrbConfig")
 	proto.RegisterType((*HTTPArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPArtifact")
+	proto.RegisterType((*HTTPDataSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPDataSource")
 	proto.RegisterType((*Header)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Header")
 	proto.RegisterType((*Histogram)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Histogram")
 	proto.RegisterType((*Inputs)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Inputs")
@@ -2927,10 +3299,13 @@ func init() {
 	proto.RegisterType((*Outputs)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Outputs")
 	proto.RegisterType((*ParallelSteps)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParallelSteps")
 	proto.RegisterType((*Parameter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Parameter")
+	proto.RegisterType((*ParameterSchema)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParameterSchema")
+	proto.RegisterMapType((map[string]ParameterSchema)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParameterSchema.PropertiesEntry")
 	proto.RegisterType((*PodGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PodGC")
 	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Prometheus")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RawArtifact")
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceTemplate")
+	proto.RegisterType((*ResumeAction)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResumeAction")
 	proto.RegisterType((*RetryAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryAffinity")
 	proto.RegisterType((*RetryNodeAntiAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryNodeAntiAffinity")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryStrategy")
@@ -2941,6 +3316,9 @@ func init() {
 	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreRef")
 	proto.RegisterType((*SemaphoreStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreStatus")
 	proto.RegisterType((*Sequence)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Sequence")
+	proto.RegisterType((*SetAction)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SetAction")
+	proto.RegisterType((*StopAction)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.StopAction")
+	proto.RegisterType((*StopStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.StopStrategy")
 	proto.RegisterType((*Submit)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Submit")
 	proto.RegisterType((*SubmitOpts)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SubmitOpts")
 	proto.RegisterType((*SuppliedValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SuppliedValueFrom")
@@ -2952,6 +3330,7 @@ func init() {
 	proto.RegisterType((*Template)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Template")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Template.NodeSelectorEntry")
 	proto.RegisterType((*TemplateRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TemplateRef")
+	proto.RegisterType((*TerminateAction)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TerminateAction")
 	proto.RegisterType((*TransformationStep)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TransformationStep")
 	proto.RegisterType((*UserContainer)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.UserContainer")
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ValueFrom")
@@ -2981,502 +3360,575 @@ func init() {
 }
 
 var fileDescriptor_724696e352c3df5f = []byte{
-	// 7909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x70, 0x24, 0xd9,
-	0x95, 0xd0, 0x64, 0x49, 0x25, 0x55, 0x9d, 0x92, 0x5a, 0xea, 0xdb, 0xaf, 0x1a, 0x4d, 0x4f, 0xab,
-	0x37, 0x67, 0x67, 0x98, 0x86, 0xb1, 0xb4, 0xd3, 0xed, 0x81, 0x01, 0x07, 0x5e, 0xab, 0xa4, 0x96,
-	0xba, 0x47, 0xad, 0xc7, 0x9c, 0x52, 0xf7, 0x84, 0xc7, 0x83, 0x71, 0xaa, 0xea, 0xaa, 0x2a, 0x47,
-	0x55, 0x99, 0x35, 0x99, 0x59, 0x52, 0xcb, 0x33, 0xe3, 0x35, 0xbb, 0xb0, 0x6b, 0x83, 0x97, 0xe5,
-	0x61, 0x76, 0xbd, 0x0b, 0x1f, 0x0e, 0xc0, 0x2c, 0x01, 0x1b, 0x04, 0x4b, 0xf0, 0xb5, 0xfc, 0x12,
-	0x84, 0x09, 0x3e, 0xd8, 0x88, 0x85, 0x58, 0x7f, 0x80, 0x16, 0x8b, 0x47, 0x10, 0x44, 0xc0, 0xdf,
-	0x7a, 0x37, 0x84, 0x3f, 0x88, 0xfb, 0xcc, 0x9b, 0x59, 0x59, 0x6a, 0x69, 0x3a, 0xd5, 0x76, 0x84,
-	0xf9, 0xab, 0x3a, 0xe7, 0xdc, 0x73, 0xee, 0xfb, 0x9e, 0x7b, 0xce, 0xb9, 0x27, 0x61, 0xb3, 0xe5,
-	0x46, 0xed, 0xfe, 0xf6, 0x5c, 0xc3, 0xef, 0xce, 0x3b, 0x41, 0xcb, 0xef, 0x05, 0xfe, 0xfb, 0xfc,
-	0xc7, 0xa7, 0xf6, 0xfd, 0x60, 0x77, 0xa7, 0xe3, 0xef, 0x87, 0xf3, 0x7b, 0x77, 0xe6, 0x7b, 0xbb,
-	0xad, 0x79, 0xa7, 0xe7, 0x86, 0xf3, 0x0a, 0x3a, 0xbf, 0xf7, 0xba, 0xd3, 0xe9, 0xb5, 0x9d, 0xd7,
-	0xe7, 0x5b, 0xd4, 0xa3, 0x81, 0x13, 0xd1, 0xe6, 0x5c, 0x2f, 0xf0, 0x23, 0x9f, 0x7c, 0x2e, 0xe6,
-	0x38, 0xa7, 0x38, 0xf2, 0x1f, 0x7f, 0x51, 0x73, 0x9c, 0xdb, 0xbb, 0x33, 0xd7, 0xdb, 0x6d, 0xcd,
-	0x31, 0x8e, 0x73, 0x0a, 0x3a, 0xa7, 0x38, 0xce, 0x7c, 0xca, 0xa8, 0x53, 0xcb, 0x6f, 0xf9, 0xf3,
-	0x9c, 0xf1, 0x76, 0x7f, 0x87, 0xff, 0xe3, 0x7f, 0xf8, 0x2f, 0x21, 0x70, 0xc6, 0xde, 0x7d, 0x33,
-	0x9c, 0x73, 0x7d, 0x56, 0xbf, 0xf9, 0x86, 0x1f, 0xd0, 0xf9, 0xbd, 0x81, 0x4a, 0xcd, 0xdc, 0x32,
-	0x68, 0x7a, 0x7e, 0xc7, 0x6d, 0x1c, 0xcc, 0xef, 0xbd, 0xbe, 0x4d, 0xa3, 0xc1, 0xfa, 0xcf, 0x7c,
-	0x3a, 0x26, 0xed, 0x3a, 0x8d, 0xb6, 0xeb, 0xd1, 0xe0, 0x20, 0x6e, 0x7f, 0x97, 0x46, 0x4e, 0x96,
-	0x80, 0xf9, 0x61, 0xa5, 0x82, 0xbe, 0x17, 0xb9, 0x5d, 0x3a, 0x50, 0xe0, 0x4f, 0x3f, 0xa9, 0x40,
-	0xd8, 0x68, 0xd3, 0xae, 0x33, 0x50, 0xee, 0xce, 0xb0, 0x72, 0xfd, 0xc8, 0xed, 0xcc, 0xbb, 0x5e,
-	0x14, 0x46, 0x41, 0xba, 0x90, 0x7d, 0x17, 0xc6, 0x16, 0xba, 0x7e, 0xdf, 0x8b, 0xc8, 0x67, 0xa0,
-	0xb8, 0xe7, 0x74, 0xfa, 0xb4, 0x6a, 0xdd, 0xb4, 0x5e, 0x2d, 0xd7, 0x5e, 0xfe, 0xee, 0xe1, 0xec,
-	0x73, 0x47, 0x87, 0xb3, 0xc5, 0x47, 0x0c, 0x78, 0x7c, 0x38, 0x7b, 0x99, 0x7a, 0x0d, 0xbf, 0xe9,
-	0x7a, 0xad, 0xf9, 0xf7, 0x43, 0xdf, 0x9b, 0x5b, 0xef, 0x77, 0xb7, 0x69, 0x80, 0xa2, 0x8c, 0xfd,
-	0x7b, 0x05, 0x98, 0x5a, 0x08, 0x1a, 0x6d, 0x77, 0x8f, 0xd6, 0x23, 0xc6, 0xbf, 0x75, 0x40, 0xda,
-	0x30, 0x12, 0x39, 0x01, 0x67, 0x57, 0xb9, 0xbd, 0x36, 0xf7, 0xb4, 0x83, 0x3f, 0xb7, 0xe5, 0x04,
-	0x8a, 0x77, 0x6d, 0xfc, 0xe8, 0x70, 0x76, 0x64, 0xcb, 0x09, 0x90, 0x89, 0x20, 0x1d, 0x18, 0xf5,
-	0x7c, 0x8f, 0x56, 0x0b, 0x5c, 0xd4, 0xfa, 0xd3, 0x8b, 0x5a, 0xf7, 0x3d, 0xdd, 0x8e, 0x5a, 0xe9,
-	0xe8, 0x70, 0x76, 0x94, 0x41, 0x90, 0x4b, 0x61, 0xed, 0xfa, 0xb2, 0xdb, 0xab, 0x8e, 0xe4, 0xd5,
-	0xae, 0x77, 0xdd, 0x5e, 0xb2, 0x5d, 0xef, 0xba, 0x3d, 0x64, 0x22, 0xec, 0xaf, 0x17, 0xa0, 0xbc,
-	0x10, 0xb4, 0xfa, 0x5d, 0xea, 0x45, 0x21, 0xf9, 0x39, 0x80, 0x9e, 0x13, 0x38, 0x5d, 0x1a, 0xd1,
-	0x20, 0xac, 0x5a, 0x37, 0x47, 0x5e, 0xad, 0xdc, 0x5e, 0x7d, 0x7a, 0xf1, 0x9b, 0x8a, 0x67, 0x8d,
-	0xc8, 0x21, 0x07, 0x0d, 0x0a, 0xd1, 0x10, 0x49, 0x3e, 0x84, 0xb2, 0x13, 0x44, 0xee, 0x8e, 0xd3,
-	0x88, 0xc2, 0x6a, 0x81, 0xcb, 0x7f, 0xeb, 0xe9, 0xe5, 0x2f, 0x48, 0x96, 0xb5, 0x8b, 0x52, 0x7c,
-	0x59, 0x41, 0x42, 0x8c, 0xe5, 0xd9, 0xbf, 0x59, 0x84, 0x92, 0x42, 0x90, 0x9b, 0x30, 0xea, 0x39,
-	0x5d, 0x35, 0x55, 0x27, 0x64, 0xc1, 0xd1, 0x75, 0xa7, 0xcb, 0x06, 0xc9, 0xe9, 0x52, 0x46, 0xd1,
-	0x73, 0xa2, 0x36, 0x9f, 0x12, 0x06, 0xc5, 0xa6, 0x13, 0xb5, 0x91, 0x63, 0xc8, 0x75, 0x18, 0xed,
-	0xfa, 0x4d, 0xca, 0xc7, 0xb1, 0x28, 0x06, 0x79, 0xcd, 0x6f, 0x52, 0xe4, 0x50, 0x56, 0x7e, 0x27,
-	0xf0, 0xbb, 0xd5, 0xd1, 0x64, 0xf9, 0xe5, 0xc0, 0xef, 0x22, 0xc7, 0x90, 0x6f, 0x59, 0x30, 0xad,
-	0xaa, 0xf7, 0xc0, 0x6f, 0x38, 0x91, 0xeb, 0x7b, 0xd5, 0x22, 0x9f, 0x14, 0x98, 0x5f, 0xaf, 0x28,
-	0xce, 0xb5, 0xaa, 0xac, 0xc2, 0x74, 0x1a, 0x83, 0x03, 0xb5, 0x20, 0xb7, 0x01, 0x5a, 0x1d, 0x7f,
-	0xdb, 0xe9, 0xb0, 0x0e, 0xa9, 0x8e, 0xf1, 0x26, 0xe8, 0xc1, 0x5d, 0xd1, 0x18, 0x34, 0xa8, 0xc8,
-	0x63, 0x18, 0x77, 0xc4, 0x02, 0xae, 0x8e, 0xf3, 0x46, 0xbc, 0x9d, 0x47, 0x23, 0x12, 0x3b, 0x42,
-	0xad, 0x72, 0x74, 0x38, 0x3b, 0x2e, 0x81, 0xa8, 0xc4, 0x91, 0xd7, 0xa0, 0xe4, 0xf7, 0x58, 0xbd,
-	0x9d, 0x4e, 0xb5, 0x74, 0xd3, 0x7a, 0xb5, 0x54, 0x9b, 0x96, 0x75, 0x2d, 0x6d, 0x48, 0x38, 0x6a,
-	0x0a, 0x72, 0x0b, 0xc6, 0xc3, 0xfe, 0x36, 0x1b, 0xc7, 0x6a, 0x99, 0x37, 0x6c, 0x4a, 0x12, 0x8f,
-	0xd7, 0x05, 0x18, 0x15, 0x9e, 0xbc, 0x01, 0x95, 0x80, 0x36, 0xfa, 0x41, 0x48, 0xd9, 0xc0, 0x56,
-	0x81, 0xf3, 0xbe, 0x24, 0xc9, 0x2b, 0x18, 0xa3, 0xd0, 0xa4, 0x23, 0x9f, 0x85, 0x0b, 0x6c, 0x80,
-	0xef, 0x3e, 0xee, 0x05, 0x34, 0x0c, 0xd9, 0xa8, 0x56, 0xb8, 0xa0, 0xab, 0xb2, 0xe4, 0x85, 0xe5,
-	0x04, 0x16, 0x53, 0xd4, 0xf6, 0xef, 0x8c, 0xc3, 0xc0, 0x20, 0x91, 0xd7, 0xa1, 0x22, 0xdb, 0xfb,
-	0xc0, 0x6f, 0x85, 0x7c, 0xe2, 0x96, 0x6a, 0x53, 0xac, 0x1e, 0x0b, 0x31, 0x18, 0x4d, 0x1a, 0xd2,
-	0x84, 0x42, 0x78, 0x47, 0xee, 0x69, 0x0f, 0x9e, 0x7e, 0x30, 0xea, 0x77, 0xf4, 0x4a, 0x1b, 0x3b,
-	0x3a, 0x9c, 0x2d, 0xd4, 0xef, 0x60, 0x21, 0xbc, 0xc3, 0x76, 0xb3, 0x96, 0x1b, 0xe5, 0xb7, 0x9b,
-	0xad, 0xb8, 0x91, 0x96, 0xc3, 0x77, 0xb3, 0x15, 0x37, 0x42, 0x26, 0x82, 0xed, 0xd2, 0xed, 0x28,
-	0xea, 0xf1, 0x25, 0x95, 0xcb, 0x2e, 0x7d, 0x6f, 0x6b, 0x6b, 0x53, 0xcb, 0xe2, 0x0b, 0x98, 0x41,
-	0x90, 0x4b, 0x21, 0x5f, 0xb3, 0x58, 0x8f, 0x0b, 0xa4, 0x1f, 0x1c, 0xc8, 0x95, 0xf9, 0x30, 0xbf,
-	0x95, 0xe9, 0x07, 0x07, 0x5a, 0xb8, 0x1c, 0x48, 0x8d, 0x40, 0x53, 0x34, 0x6f, 0x78, 0x73, 0x27,
-	0xe4, 0x0b, 0x31, 0x9f, 0x86, 0x2f, 0x2d, 0xd7, 0x53, 0x0d, 0x5f, 0x5a, 0xae, 0x23, 0x97, 0xc2,
-	0x06, 0x34, 0x70, 0xf6, 0xe5, 0x22, 0xce, 0x61, 0x40, 0xd1, 0xd9, 0x4f, 0x0e, 0x28, 0x3a, 0xfb,
-	0xc8, 0x44, 0x30, 0x49, 0x7e, 0x18, 0xf2, 0x35, 0x9b, 0x8b, 0xa4, 0x8d, 0x7a, 0x3d, 0x29, 0x69,
-	0xa3, 0x5e, 0x47, 0x26, 0x82, 0x4f, 0xd2, 0x46, 0xc8, 0x17, 0x7c, 0x3e, 0x93, 0x74, 0x31, 0x25,
-	0x69, 0x65, 0xb1, 0x8e, 0x4c, 0x84, 0xfd, 0x75, 0x0b, 0x26, 0x15, 0x8a, 0x6d, 0x22, 0x21, 0x79,
-	0x0c, 0x25, 0x35, 0x98, 0x52, 0x97, 0xc9, 0xf3, 0xd0, 0xd3, 0x5b, 0x9d, 0x82, 0xa0, 0x96, 0x66,
-	0x7f, 0x00, 0x57, 0x34, 0x94, 0xf6, 0xfc, 0xd0, 0xe5, 0x53, 0x8b, 0xee, 0x90, 0x79, 0x28, 0x37,
-	0x7c, 0x6f, 0xc7, 0x6d, 0xad, 0x39, 0x3d, 0x79, 0x06, 0xea, 0xc3, 0x73, 0x51, 0x21, 0x30, 0xa6,
-	0x21, 0x2f, 0xc2, 0xc8, 0x2e, 0x3d, 0x90, 0x87, 0x61, 0x45, 0x92, 0x8e, 0xac, 0xd2, 0x03, 0x64,
-	0xf0, 0x3f, 0x57, 0xfa, 0xd6, 0xb7, 0x67, 0x9f, 0xfb, 0xea, 0x7f, 0xba, 0xf9, 0x9c, 0xfd, 0x2f,
-	0x0a, 0xf0, 0x42, 0xa6, 0xcc, 0x7a, 0xe4, 0x44, 0xfd, 0x90, 0xfc, 0x96, 0x05, 0x57, 0x9c, 0x2c,
-	0xbc, 0xec, 0x9a, 0x77, 0xf2, 0xeb, 0x9a, 0x04, 0xfb, 0xda, 0x8b, 0xb2, 0xd2, 0xd9, 0x3d, 0x82,
-	0xd9, 0x95, 0x62, 0x1d, 0xc5, 0xb4, 0x81, 0xb0, 0xe7, 0x34, 0xa8, 0x6c, 0xbd, 0xee, 0xa8, 0x75,
-	0x85, 0xc0, 0x98, 0x86, 0x9d, 0x2e, 0x4d, 0xba, 0xe3, 0xf4, 0x3b, 0x62, 0x47, 0x2c, 0xc5, 0xa7,
-	0xcb, 0x92, 0x00, 0xa3, 0xc2, 0x1b, 0x9d, 0xf6, 0xef, 0x2c, 0xb8, 0x94, 0xb1, 0x2b, 0xb0, 0x5e,
-	0xef, 0x07, 0x1d, 0x39, 0x40, 0xba, 0xd7, 0x1f, 0xe2, 0x03, 0x64, 0x70, 0xf2, 0x4d, 0x0b, 0xa6,
-	0x8c, 0x6d, 0x62, 0xa1, 0x2f, 0xd5, 0x95, 0x9c, 0x8e, 0xde, 0x04, 0xe3, 0xda, 0x35, 0x29, 0x7e,
-	0x2a, 0x85, 0xc0, 0x74, 0x15, 0xec, 0xdf, 0xb7, 0x20, 0x4d, 0x44, 0x1c, 0xb8, 0xd0, 0x0f, 0x69,
-	0xc0, 0xfa, 0xa9, 0x4e, 0x1b, 0x01, 0x55, 0x2b, 0xe1, 0xe5, 0x39, 0x71, 0xe7, 0x60, 0xb5, 0x98,
-	0x63, 0x37, 0xac, 0xb9, 0xbd, 0xd7, 0xe7, 0x04, 0xc5, 0x2a, 0x3d, 0xa8, 0xd3, 0x0e, 0x65, 0x3c,
-	0x6a, 0x84, 0x9d, 0x9a, 0x0f, 0x13, 0x0c, 0x30, 0xc5, 0x90, 0x89, 0xe8, 0x39, 0x61, 0xb8, 0xef,
-	0x07, 0x4d, 0x29, 0xa2, 0x70, 0x66, 0x11, 0x9b, 0x09, 0x06, 0x98, 0x62, 0x68, 0xff, 0x6b, 0x0b,
-	0xc6, 0x6b, 0x4e, 0x63, 0xd7, 0xdf, 0xd9, 0x61, 0x4a, 0x47, 0xb3, 0x1f, 0x08, 0xa5, 0x4d, 0x0c,
-	0x90, 0x5e, 0x89, 0x4b, 0x12, 0x8e, 0x9a, 0x82, 0x6c, 0xc1, 0x98, 0xe8, 0x0e, 0x59, 0xa9, 0x9f,
-	0x31, 0x2a, 0xa5, 0xef, 0x5a, 0x7c, 0x38, 0xd8, 0x5d, 0x6b, 0x4e, 0xdc, 0xb5, 0xe6, 0xee, 0x7b,
-	0xd1, 0x06, 0xbb, 0xb2, 0xb8, 0x5e, 0xab, 0x06, 0x47, 0x87, 0xb3, 0x63, 0xcb, 0x9c, 0x07, 0x4a,
-	0x5e, 0x4c, 0x3f, 0xe9, 0x3a, 0x8f, 0x95, 0x38, 0x3e, 0xe1, 0xca, 0xb1, 0x7e, 0xb2, 0x16, 0xa3,
-	0xd0, 0xa4, 0xb3, 0xbf, 0x08, 0xc5, 0x45, 0xa7, 0xd1, 0xa6, 0xe4, 0x61, 0x7a, 0x1b, 0xa8, 0xdc,
-	0x7e, 0x35, 0xab, 0xb7, 0xf4, 0x96, 0x60, 0x76, 0xd8, 0xe4, 0xb0, 0xcd, 0xc2, 0xfe, 0x43, 0x0b,
-	0xae, 0x2d, 0x76, 0xfa, 0x61, 0x44, 0x83, 0x77, 0xe4, 0xbc, 0xda, 0xa2, 0xdd, 0x5e, 0xc7, 0x89,
-	0x28, 0xf9, 0x12, 0x94, 0xd8, 0x3d, 0xb7, 0xe9, 0x44, 0x8e, 0x94, 0x38, 0xbc, 0x2b, 0xf8, 0xcc,
-	0x64, 0xd4, 0xac, 0x0e, 0x1b, 0xdb, 0xef, 0xd3, 0x46, 0xb4, 0x46, 0x23, 0x27, 0xd6, 0x44, 0x63,
-	0x18, 0x6a, 0xae, 0xe4, 0x31, 0x8c, 0x86, 0x3d, 0xda, 0x90, 0x1d, 0xfd, 0xe8, 0xe9, 0x57, 0x42,
-	0xba, 0x0d, 0xf5, 0x1e, 0x6d, 0xc4, 0x0a, 0x3d, 0xfb, 0x87, 0x5c, 0xa2, 0xfd, 0x7f, 0x2d, 0x78,
-	0x61, 0x48, 0xbb, 0x1f, 0xb8, 0x61, 0x44, 0xde, 0x1b, 0x68, 0xfb, 0xdc, 0xe9, 0xda, 0xce, 0x4a,
-	0xf3, 0x96, 0xeb, 0x29, 0xa6, 0x20, 0x46, 0xbb, 0xbf, 0x02, 0x45, 0x37, 0xa2, 0x5d, 0x75, 0xb1,
-	0xfa, 0xfc, 0xd3, 0x37, 0x7c, 0x48, 0x5b, 0x6a, 0x93, 0xea, 0x66, 0x7f, 0x9f, 0xc9, 0x43, 0x21,
-	0xd6, 0xfe, 0xb7, 0x16, 0xb0, 0xe9, 0xd0, 0x74, 0xa5, 0xba, 0x3a, 0x1a, 0x1d, 0xf4, 0xd4, 0x05,
-	0x4b, 0x6d, 0xbe, 0xa3, 0x5b, 0x07, 0x3d, 0x7a, 0x7c, 0x38, 0x3b, 0xa9, 0x09, 0x19, 0x00, 0x39,
-	0x29, 0xf9, 0x22, 0x8c, 0x85, 0xfc, 0x90, 0x90, 0x1b, 0xed, 0xb2, 0x2c, 0x34, 0x26, 0x8e, 0x8e,
-	0xe3, 0xc3, 0xd9, 0x53, 0xd9, 0x4f, 0xe6, 0x34, 0x6f, 0x51, 0x0e, 0x25, 0x57, 0xb6, 0x35, 0x77,
-	0x69, 0x18, 0x3a, 0x2d, 0x2a, 0x57, 0x8a, 0xde, 0x9a, 0xd7, 0x04, 0x18, 0x15, 0xde, 0xfe, 0x3b,
-	0x16, 0xb0, 0x2a, 0x46, 0x0e, 0x13, 0xb1, 0xce, 0x74, 0xfa, 0x75, 0xbe, 0x54, 0x04, 0x40, 0x0e,
-	0xde, 0x8b, 0x43, 0x96, 0x8a, 0x20, 0x4a, 0x1c, 0xa8, 0x02, 0x84, 0x31, 0x0b, 0xf2, 0x69, 0x98,
-	0x68, 0xd2, 0x1e, 0xf5, 0x9a, 0xd4, 0x6b, 0xb8, 0x54, 0x0c, 0x5a, 0xb9, 0x36, 0x7d, 0x74, 0x38,
-	0x3b, 0xb1, 0x64, 0xc0, 0x31, 0x41, 0x65, 0xff, 0x91, 0x05, 0x97, 0x35, 0xbb, 0x3a, 0x8d, 0xf4,
-	0xb2, 0xfa, 0x05, 0x0b, 0x40, 0x33, 0x0f, 0xab, 0xa3, 0x7c, 0x0a, 0x6c, 0xe4, 0x30, 0x05, 0xcc,
-	0x4e, 0x88, 0x17, 0x9e, 0x06, 0x87, 0x68, 0x88, 0x25, 0x9f, 0x87, 0x89, 0x3d, 0xbf, 0xd3, 0xef,
-	0xd2, 0x35, 0xbf, 0xef, 0x45, 0x61, 0x75, 0x84, 0x57, 0x63, 0x36, 0xab, 0x9f, 0x1e, 0xc5, 0x74,
-	0xb5, 0xcb, 0x92, 0xed, 0x84, 0x01, 0x0c, 0x31, 0xc1, 0xca, 0xfe, 0x3c, 0x70, 0xa1, 0xae, 0xd7,
-	0xa7, 0x1b, 0x1e, 0x79, 0x09, 0x8a, 0x34, 0x08, 0xfc, 0x40, 0x5e, 0x83, 0xf4, 0x84, 0xbc, 0xcb,
-	0x80, 0x28, 0x70, 0xe4, 0x15, 0xb6, 0xe7, 0xba, 0x1d, 0xda, 0xe4, 0xf3, 0xa9, 0x54, 0xbb, 0xa0,
-	0xe6, 0xd3, 0x32, 0x87, 0xa2, 0xc4, 0xda, 0x73, 0x30, 0xbe, 0xc8, 0x84, 0xd0, 0x80, 0xf1, 0x35,
-	0x4d, 0x58, 0x93, 0x09, 0x13, 0x96, 0x32, 0x55, 0x6d, 0xc1, 0x95, 0xc5, 0x80, 0xb2, 0x8d, 0xe0,
-	0x4e, 0xad, 0xdf, 0xd8, 0xa5, 0x91, 0xb8, 0x64, 0x86, 0xe4, 0x33, 0x30, 0xe9, 0xf3, 0x1d, 0xe9,
-	0x81, 0xdf, 0xd8, 0x75, 0xbd, 0x96, 0xd4, 0x00, 0xae, 0x48, 0x2e, 0x93, 0x1b, 0x26, 0x12, 0x93,
-	0xb4, 0xf6, 0x7f, 0x2b, 0xc0, 0xc4, 0x62, 0xe0, 0x7b, 0x6a, 0xb5, 0x3d, 0x83, 0x9d, 0x32, 0x4a,
-	0xec, 0x94, 0x39, 0xd8, 0x1c, 0xcc, 0xfa, 0x0f, 0xdb, 0x25, 0xc9, 0x47, 0x7a, 0x99, 0x8b, 0x2b,
-	0xe3, 0x56, 0xce, 0x72, 0x39, 0xef, 0x78, 0xb0, 0x93, 0x9b, 0x80, 0xfd, 0xdf, 0x2d, 0x98, 0x36,
-	0xc9, 0x9f, 0xc1, 0xc6, 0x1c, 0x26, 0x37, 0xe6, 0xf5, 0x7c, 0xdb, 0x3b, 0x64, 0x37, 0xfe, 0xfa,
-	0x58, 0xb2, 0x9d, 0x6c, 0x00, 0xc8, 0xb7, 0x2c, 0x98, 0xd8, 0x37, 0x00, 0xb2, 0xb1, 0xeb, 0xf9,
-	0x9d, 0x91, 0x7c, 0xd4, 0x7f, 0x5a, 0xad, 0x67, 0x13, 0x7a, 0x9c, 0xfa, 0x8f, 0x89, 0x9a, 0x30,
-	0x75, 0x2a, 0x6c, 0xb4, 0x69, 0xb3, 0xdf, 0x51, 0x7a, 0xb6, 0xee, 0xd2, 0xba, 0x84, 0xa3, 0xa6,
-	0x20, 0xef, 0xc1, 0xc5, 0x86, 0xef, 0x35, 0xfa, 0x41, 0x40, 0xbd, 0xc6, 0xc1, 0x26, 0xb7, 0xba,
-	0xcb, 0x4d, 0x7d, 0x4e, 0x16, 0xbb, 0xb8, 0x98, 0x26, 0x38, 0xce, 0x02, 0xe2, 0x20, 0x23, 0x61,
-	0x21, 0x0a, 0xd9, 0xb6, 0xcb, 0x4d, 0x0d, 0x25, 0xd3, 0x42, 0xc4, 0xc1, 0xa8, 0xf0, 0xe4, 0x21,
-	0x5c, 0x0b, 0x23, 0xa6, 0x00, 0x7b, 0xad, 0x25, 0xea, 0x34, 0x3b, 0xae, 0xc7, 0xd4, 0x51, 0xdf,
-	0x6b, 0x86, 0xdc, 0x5e, 0x30, 0x52, 0x7b, 0xe1, 0xe8, 0x70, 0xf6, 0x5a, 0x3d, 0x9b, 0x04, 0x87,
-	0x95, 0x25, 0x5f, 0x84, 0x99, 0xb0, 0xdf, 0x68, 0xd0, 0x30, 0xdc, 0xe9, 0x77, 0xde, 0xf2, 0xb7,
-	0xc3, 0x7b, 0x6e, 0xc8, 0x74, 0xe9, 0x07, 0x6e, 0xd7, 0x8d, 0xb8, 0x19, 0xa0, 0x58, 0xbb, 0x71,
-	0x74, 0x38, 0x3b, 0x53, 0x1f, 0x4a, 0x85, 0x27, 0x70, 0x20, 0x08, 0x57, 0xc5, 0xe6, 0x37, 0xc0,
-	0x7b, 0x9c, 0xf3, 0x9e, 0x39, 0x3a, 0x9c, 0xbd, 0xba, 0x9c, 0x49, 0x81, 0x43, 0x4a, 0xb2, 0x11,
-	0x8c, 0xdc, 0x2e, 0xfd, 0xb2, 0xef, 0x51, 0x7e, 0xa3, 0x37, 0x46, 0x70, 0x4b, 0xc2, 0x51, 0x53,
-	0x90, 0xf7, 0xe3, 0x99, 0xc8, 0x96, 0x8b, 0xbc, 0x99, 0x9f, 0x7d, 0x87, 0xbb, 0x7c, 0x74, 0x38,
-	0x3b, 0xfd, 0x8e, 0xc1, 0x89, 0x2d, 0x39, 0x4c, 0xf0, 0xb6, 0x7f, 0xaf, 0x00, 0x64, 0x70, 0x8b,
-	0x20, 0xab, 0x30, 0xe6, 0x34, 0x22, 0x77, 0x8f, 0x4a, 0x53, 0xf8, 0x4b, 0x59, 0xe7, 0x94, 0x10,
-	0x85, 0x74, 0x87, 0xb2, 0x19, 0x42, 0xe3, 0x7d, 0x65, 0x81, 0x17, 0x45, 0xc9, 0x82, 0xf8, 0x70,
-	0xb1, 0xe3, 0x84, 0x91, 0x9a, 0xab, 0x4d, 0xd6, 0x64, 0xb9, 0xb1, 0xfe, 0xc9, 0xd3, 0x35, 0x8a,
-	0x95, 0xa8, 0x5d, 0x61, 0x33, 0xf7, 0x41, 0x9a, 0x11, 0x0e, 0xf2, 0x26, 0x3f, 0xc7, 0x0f, 0x7c,
-	0xa1, 0xe8, 0xa8, 0x93, 0x76, 0x35, 0x97, 0x03, 0x5f, 0xf0, 0x4c, 0x1c, 0xf6, 0x52, 0x0c, 0x1a,
-	0x22, 0xed, 0x7f, 0x3e, 0x0e, 0xe3, 0x4b, 0x0b, 0x2b, 0x5b, 0x4e, 0xb8, 0x7b, 0x0a, 0x73, 0x3a,
-	0x9b, 0x1d, 0x52, 0x59, 0x49, 0xaf, 0x6f, 0xa5, 0xc4, 0xa0, 0xa6, 0x20, 0x1f, 0x41, 0xd9, 0x51,
-	0x6e, 0x0b, 0x79, 0x4c, 0xac, 0xe6, 0x71, 0xa5, 0x95, 0x2c, 0x4d, 0x4f, 0x81, 0x04, 0x61, 0x2c,
-	0x90, 0x7c, 0xd5, 0x82, 0x8a, 0xaa, 0x0a, 0xd2, 0x1d, 0x69, 0x6f, 0xcc, 0xc3, 0x01, 0x15, 0x33,
-	0x15, 0x16, 0x3f, 0x03, 0x80, 0xa6, 0xc8, 0x01, 0xf5, 0xb0, 0x78, 0x1a, 0xf5, 0x90, 0xec, 0x43,
-	0x79, 0xdf, 0x8d, 0xda, 0xfc, 0x20, 0xa8, 0x8e, 0xf1, 0x29, 0xb1, 0xfc, 0xf4, 0xb5, 0x66, 0xec,
-	0xe2, 0x1e, 0x7b, 0x47, 0x09, 0xc0, 0x58, 0x16, 0x99, 0x17, 0x82, 0xb9, 0xdb, 0x87, 0x6f, 0x21,
-	0xe5, 0x64, 0x01, 0x8e, 0xc0, 0x98, 0x86, 0x75, 0xf1, 0x04, 0xfb, 0x57, 0xa7, 0x1f, 0xf4, 0xd9,
-	0xba, 0x92, 0x36, 0xc0, 0x1c, 0x0c, 0x63, 0x8a, 0xa3, 0xe8, 0xac, 0x77, 0x0c, 0x19, 0x98, 0x90,
-	0xc8, 0xe6, 0xec, 0x7e, 0x9b, 0x7a, 0xd2, 0x09, 0xa0, 0xe7, 0xec, 0x3b, 0x6d, 0xea, 0x21, 0xc7,
-	0x90, 0x8f, 0x84, 0x4e, 0x2d, 0x74, 0x4e, 0x6e, 0xfd, 0xcf, 0xc5, 0x8e, 0x1e, 0xeb, 0xb1, 0xb5,
-	0x0b, 0x4a, 0x99, 0x16, 0xff, 0xd1, 0x90, 0xc7, 0xd4, 0x57, 0xdf, 0xbb, 0xfb, 0xd8, 0x8d, 0xa4,
-	0xf7, 0x40, 0xef, 0x3c, 0x1b, 0x1c, 0x8a, 0x12, 0x2b, 0x2c, 0x4e, 0x6c, 0x12, 0x84, 0xd5, 0x89,
-	0xe4, 0xb5, 0x46, 0xcc, 0x94, 0x10, 0x15, 0xde, 0xfe, 0xf7, 0x16, 0x54, 0xd8, 0x92, 0x55, 0xcb,
-	0xec, 0x15, 0x18, 0x8b, 0x9c, 0xa0, 0x25, 0xad, 0x31, 0x86, 0x88, 0x2d, 0x0e, 0x45, 0x89, 0x25,
-	0x1e, 0x14, 0x23, 0x27, 0xdc, 0x55, 0x1a, 0xcc, 0xfd, 0xa7, 0xef, 0x03, 0xb9, 0x71, 0xc4, 0xca,
-	0x0b, 0xfb, 0x17, 0xa2, 0x10, 0x43, 0x5e, 0x85, 0x12, 0x3b, 0x64, 0x96, 0x9d, 0x50, 0x59, 0xd1,
-	0x26, 0xd8, 0x46, 0xb1, 0x2c, 0x61, 0xa8, 0xb1, 0xf6, 0xdf, 0x2e, 0xc0, 0xe8, 0x92, 0xd0, 0x65,
-	0xc7, 0x42, 0xbf, 0x1f, 0x34, 0xa8, 0xd4, 0x69, 0x72, 0x18, 0x27, 0xc6, 0xb7, 0xce, 0x79, 0x1a,
-	0xda, 0x24, 0xff, 0x8f, 0x52, 0x16, 0xf9, 0xa6, 0x05, 0x17, 0xa2, 0xc0, 0xf1, 0xc2, 0x1d, 0x3f,
-	0xe8, 0x0a, 0x23, 0x8c, 0xe8, 0xa2, 0x1c, 0x94, 0xda, 0xad, 0x04, 0xdf, 0x7a, 0x44, 0x7b, 0xb1,
-	0x03, 0x29, 0x89, 0xc3, 0x54, 0x1d, 0xec, 0x5f, 0xb3, 0x00, 0xe2, 0xda, 0x93, 0xaf, 0x59, 0x30,
-	0xe9, 0x98, 0x26, 0x69, 0xd9, 0x47, 0x1b, 0xf9, 0x59, 0x09, 0x39, 0xdb, 0xda, 0x45, 0x76, 0xcb,
-	0x49, 0x80, 0x30, 0x29, 0xd8, 0x7e, 0x03, 0x8a, 0x77, 0xf7, 0xa8, 0xc7, 0xb5, 0x85, 0x50, 0x5a,
-	0x92, 0xd2, 0xe6, 0x33, 0x65, 0x61, 0x42, 0x4d, 0x61, 0xbf, 0x07, 0x17, 0xee, 0x3e, 0xa6, 0x8d,
-	0x7e, 0xe4, 0x07, 0xc2, 0xe2, 0x44, 0xde, 0x02, 0x12, 0xd2, 0x60, 0xcf, 0x6d, 0xd0, 0x85, 0x46,
-	0x83, 0xdd, 0xde, 0xd6, 0xe3, 0xf3, 0x67, 0x46, 0x72, 0x22, 0xf5, 0x01, 0x0a, 0xcc, 0x28, 0x65,
-	0xff, 0x13, 0x0b, 0x2a, 0x86, 0x41, 0x9f, 0x9d, 0x3e, 0xad, 0xc5, 0xba, 0xb8, 0xdb, 0xc9, 0xae,
-	0x5a, 0xcd, 0xc5, 0x65, 0x20, 0x58, 0xc6, 0x5b, 0xa3, 0x06, 0x61, 0x2c, 0xf0, 0x09, 0xa6, 0x76,
-	0xfb, 0xb7, 0x2d, 0x88, 0xcb, 0xb1, 0x15, 0xbc, 0x1d, 0xd7, 0xd3, 0x58, 0xc1, 0x92, 0xaf, 0xc4,
-	0x92, 0x8f, 0xe0, 0x5a, 0xb2, 0xe1, 0xdc, 0x92, 0x77, 0x76, 0x2b, 0xa9, 0x50, 0x67, 0xb3, 0x39,
-	0xe1, 0x30, 0x11, 0xf6, 0x23, 0x28, 0xae, 0x38, 0xfd, 0x16, 0x3d, 0xd5, 0xfd, 0x9a, 0xad, 0xfe,
-	0x80, 0x3a, 0x9d, 0x48, 0x69, 0x50, 0x72, 0xf5, 0xa3, 0x84, 0xa1, 0xc6, 0xda, 0xbf, 0x35, 0x0a,
-	0x15, 0xc3, 0x5d, 0xc8, 0xb6, 0xf4, 0x80, 0xf6, 0xfc, 0xb4, 0x1a, 0x82, 0xb4, 0xe7, 0x23, 0xc7,
-	0xb0, 0x69, 0x17, 0xd0, 0x3d, 0x37, 0x14, 0x2b, 0x35, 0x31, 0xed, 0x50, 0xc2, 0x51, 0x53, 0x90,
-	0x59, 0x28, 0x36, 0x69, 0x2f, 0x6a, 0xf3, 0x4d, 0x68, 0xb4, 0x56, 0x66, 0x55, 0x5d, 0x62, 0x00,
-	0x14, 0x70, 0x46, 0xb0, 0x43, 0xa3, 0x46, 0x9b, 0x1b, 0x5c, 0xca, 0x82, 0x60, 0x99, 0x01, 0x50,
-	0xc0, 0x33, 0xec, 0xde, 0xc5, 0xf3, 0xb7, 0x7b, 0x8f, 0xe5, 0x6c, 0xf7, 0x26, 0x3d, 0xb8, 0x14,
-	0x86, 0xed, 0xcd, 0xc0, 0xdd, 0x73, 0x22, 0x1a, 0xcf, 0x9c, 0xf1, 0xb3, 0xc8, 0xb9, 0x76, 0x74,
-	0x38, 0x7b, 0xa9, 0x5e, 0xbf, 0x97, 0xe6, 0x82, 0x59, 0xac, 0x49, 0x1d, 0xae, 0xb8, 0x5e, 0x48,
-	0x1b, 0xfd, 0x80, 0xde, 0x6f, 0x79, 0x7e, 0x40, 0xef, 0xf9, 0x21, 0x63, 0x27, 0xfd, 0xfb, 0xda,
-	0x99, 0x73, 0x3f, 0x8b, 0x08, 0xb3, 0xcb, 0xda, 0xdf, 0xb3, 0x60, 0xc2, 0xf4, 0x7c, 0x32, 0x2d,
-	0x04, 0xda, 0x4b, 0xcb, 0x75, 0xb1, 0xa7, 0xe4, 0x77, 0x72, 0xdc, 0xd3, 0x3c, 0x63, 0x2d, 0x3a,
-	0x86, 0xa1, 0x21, 0xf3, 0x14, 0x61, 0x26, 0x2f, 0x41, 0x71, 0xc7, 0x67, 0x07, 0xdb, 0x48, 0xd2,
-	0xd6, 0xb5, 0xcc, 0x80, 0x28, 0x70, 0xf6, 0x0f, 0x2c, 0x30, 0x24, 0x90, 0x6f, 0x58, 0x30, 0xc9,
-	0x84, 0xac, 0x06, 0xdb, 0x89, 0xb6, 0x6d, 0xe4, 0xd3, 0x36, 0xcd, 0x36, 0xb6, 0x6d, 0x25, 0xc0,
-	0x98, 0x14, 0x4e, 0xfe, 0x14, 0x94, 0x9d, 0x66, 0x33, 0xa0, 0x61, 0xa8, 0x2d, 0x9d, 0xdc, 0x7b,
-	0xb0, 0xa0, 0x80, 0x18, 0xe3, 0xd9, 0x12, 0x6d, 0x37, 0x77, 0x42, 0x36, 0xeb, 0xe5, 0x95, 0x5e,
-	0x2f, 0x51, 0x26, 0x84, 0xc1, 0x51, 0x53, 0xd8, 0xbf, 0x3c, 0x0a, 0x49, 0xd9, 0xa4, 0x09, 0x53,
-	0xbb, 0xc1, 0xf6, 0x22, 0xf7, 0x70, 0x7c, 0x12, 0x5f, 0xd3, 0xa5, 0xa3, 0xc3, 0xd9, 0xa9, 0xd5,
-	0x24, 0x07, 0x4c, 0xb3, 0x94, 0x52, 0x56, 0xe9, 0x41, 0xe4, 0x6c, 0x7f, 0x92, 0x8d, 0x54, 0x49,
-	0x31, 0x39, 0x60, 0x9a, 0x25, 0x79, 0x03, 0x2a, 0xbb, 0xc1, 0xb6, 0xda, 0x00, 0xd2, 0x0e, 0x9e,
-	0xd5, 0x18, 0x85, 0x26, 0x1d, 0xeb, 0xc2, 0xdd, 0x60, 0x9b, 0x6d, 0x98, 0x2a, 0xfe, 0x48, 0x77,
-	0xe1, 0xaa, 0x84, 0xa3, 0xa6, 0x20, 0x3d, 0x20, 0xbb, 0xaa, 0xf7, 0xb4, 0x3f, 0x47, 0xee, 0x53,
-	0xa7, 0x77, 0x07, 0x5d, 0x65, 0x07, 0xee, 0xea, 0x00, 0x1f, 0xcc, 0xe0, 0x4d, 0x3e, 0x0f, 0xd7,
-	0x76, 0x83, 0x6d, 0x79, 0x8c, 0x6c, 0x06, 0xae, 0xd7, 0x70, 0x7b, 0x89, 0x58, 0xa3, 0x59, 0x59,
-	0xdd, 0x6b, 0xab, 0xd9, 0x64, 0x38, 0xac, 0xbc, 0xfd, 0xf7, 0xd9, 0x1a, 0x37, 0xc2, 0x3a, 0x9e,
-	0xe4, 0x43, 0x0d, 0x61, 0xbc, 0x4d, 0x9d, 0x26, 0x0d, 0x94, 0x72, 0x7b, 0x2f, 0x87, 0x25, 0xc2,
-	0x19, 0xc6, 0x7a, 0xb8, 0xf8, 0x1f, 0xa2, 0x92, 0x64, 0x6f, 0xc0, 0x98, 0x80, 0x9d, 0xe2, 0xe2,
-	0xac, 0x8f, 0xcc, 0xc2, 0x09, 0x26, 0xe9, 0xef, 0x58, 0x50, 0xe6, 0xc6, 0x98, 0x16, 0xbb, 0x5c,
-	0xe9, 0x22, 0x23, 0x27, 0x9c, 0xb2, 0x21, 0x8c, 0x0b, 0xdd, 0x40, 0x79, 0x0b, 0x72, 0x68, 0xb8,
-	0x08, 0x04, 0x8d, 0x1b, 0x2e, 0x94, 0x90, 0x10, 0x95, 0x24, 0xfb, 0x17, 0x0b, 0x30, 0x76, 0xdf,
-	0xeb, 0xf5, 0x7f, 0xe2, 0x83, 0x11, 0xd7, 0x60, 0x94, 0xdd, 0x9c, 0x93, 0x31, 0xb3, 0x13, 0xb5,
-	0x97, 0xcd, 0x78, 0xd9, 0x6a, 0x32, 0x5e, 0x16, 0x9d, 0x7d, 0xe5, 0xa7, 0x12, 0x65, 0x8c, 0x00,
-	0x82, 0x0e, 0x8c, 0x3e, 0x70, 0xbd, 0xdd, 0xd3, 0x4d, 0xa7, 0xb0, 0xe1, 0xf7, 0x06, 0xa6, 0x53,
-	0x9d, 0x01, 0x51, 0xe0, 0xd4, 0x9a, 0x19, 0xc9, 0x5e, 0x33, 0xf6, 0xcf, 0x5b, 0x70, 0x71, 0x8d,
-	0x76, 0x7d, 0xf7, 0xcb, 0x4e, 0xec, 0x66, 0x63, 0x85, 0xda, 0x6e, 0x24, 0x3d, 0x32, 0xba, 0xd0,
-	0x3d, 0x37, 0x42, 0x06, 0x7f, 0x82, 0x5a, 0xcb, 0x23, 0x52, 0xd8, 0xf6, 0xba, 0x1e, 0xef, 0x73,
-	0xb1, 0x03, 0x4d, 0x21, 0x30, 0xa6, 0xb1, 0x7f, 0xc7, 0x82, 0x71, 0x51, 0x09, 0xaa, 0x78, 0x5b,
-	0x43, 0x78, 0xb7, 0xa1, 0xc8, 0xcb, 0xc9, 0x1d, 0x7a, 0x25, 0x87, 0x2b, 0x3c, 0x63, 0x27, 0xd4,
-	0x3d, 0xfe, 0x13, 0x85, 0x00, 0xa6, 0x8e, 0x77, 0x9d, 0xc7, 0x0b, 0xda, 0xc3, 0xa8, 0xd5, 0xf1,
-	0x35, 0x0e, 0x45, 0x89, 0xb5, 0x7f, 0x63, 0x04, 0x4a, 0xca, 0x58, 0x49, 0xfe, 0x96, 0x05, 0x15,
-	0xc7, 0xf3, 0xfc, 0xc8, 0x11, 0xb6, 0x3c, 0xb1, 0x16, 0xbe, 0xf0, 0xf4, 0xb5, 0x54, 0x12, 0xe6,
-	0x16, 0x62, 0xee, 0x77, 0xbd, 0x28, 0x38, 0x88, 0x8f, 0x10, 0x03, 0x83, 0x66, 0x25, 0xc8, 0x57,
-	0x60, 0xac, 0xe3, 0x6c, 0xd3, 0x8e, 0x5a, 0x1a, 0x8f, 0x72, 0xac, 0xce, 0x03, 0xce, 0x58, 0xd4,
-	0x44, 0xf7, 0x90, 0x00, 0xa2, 0x94, 0x3a, 0xf3, 0x59, 0x98, 0x4e, 0xd7, 0x9a, 0x4c, 0x1b, 0xc3,
-	0x2c, 0x46, 0xf6, 0x72, 0x62, 0x73, 0x54, 0xeb, 0xa2, 0xf0, 0xa6, 0x35, 0xf3, 0x67, 0xa1, 0x62,
-	0x88, 0x39, 0x4b, 0x51, 0xfb, 0x6d, 0xa8, 0xac, 0xd1, 0x28, 0x70, 0x1b, 0x9c, 0xc1, 0x93, 0x26,
-	0xd7, 0xa9, 0xf6, 0xe7, 0x5f, 0xe2, 0x93, 0x95, 0xf1, 0x0c, 0xc9, 0x47, 0x00, 0xbd, 0xc0, 0xef,
-	0xd2, 0xa8, 0x4d, 0xfb, 0x6a, 0xb0, 0x73, 0xd0, 0x39, 0x37, 0x35, 0x4f, 0x61, 0x55, 0x8a, 0xff,
-	0xa3, 0x21, 0xcf, 0xbe, 0x05, 0xc5, 0xb5, 0x7e, 0x44, 0x1f, 0x3f, 0x79, 0xab, 0xb0, 0xbf, 0x00,
-	0x13, 0x9c, 0xf4, 0x9e, 0xdf, 0x61, 0xbb, 0x10, 0x6b, 0x69, 0x97, 0xfd, 0x4f, 0x5f, 0xde, 0x38,
-	0x11, 0x0a, 0x1c, 0x5b, 0x01, 0x6d, 0xbf, 0xd3, 0xa4, 0x81, 0xec, 0x0f, 0x3d, 0xbe, 0xf7, 0x38,
-	0x14, 0x25, 0xd6, 0xfe, 0x85, 0x02, 0x54, 0x78, 0x41, 0xb9, 0x7b, 0x1c, 0xc0, 0x78, 0x5b, 0xc8,
-	0x91, 0x5d, 0x92, 0x83, 0x53, 0xca, 0xac, 0xbd, 0x71, 0x1a, 0x0b, 0x00, 0x2a, 0x79, 0x4c, 0xf4,
-	0xbe, 0xe3, 0x46, 0x4c, 0x74, 0xe1, 0x7c, 0x45, 0xbf, 0x23, 0xc4, 0xa0, 0x92, 0x67, 0xff, 0x8f,
-	0x29, 0x80, 0x75, 0xbf, 0x49, 0x65, 0x27, 0xcc, 0x40, 0xc1, 0x6d, 0xca, 0xee, 0x05, 0x59, 0xa8,
-	0x70, 0x7f, 0x09, 0x0b, 0x6e, 0x53, 0x8f, 0x57, 0x61, 0xe8, 0xd6, 0xfe, 0x06, 0x54, 0x9a, 0x6e,
-	0xd8, 0xeb, 0x38, 0x07, 0xeb, 0x19, 0xca, 0xe2, 0x52, 0x8c, 0x42, 0x93, 0x8e, 0xbc, 0x26, 0x23,
-	0x35, 0x84, 0xa2, 0x58, 0x4d, 0x45, 0x6a, 0x94, 0x58, 0xf5, 0x8c, 0x20, 0x8d, 0x37, 0x61, 0x42,
-	0xd9, 0xa9, 0xb9, 0x94, 0x22, 0x2f, 0xa5, 0x3d, 0xf8, 0x5b, 0x06, 0x0e, 0x13, 0x94, 0x03, 0x56,
-	0xf5, 0xb1, 0x67, 0x6f, 0x55, 0xff, 0x0c, 0x4c, 0xaa, 0xbf, 0xfc, 0xbc, 0xab, 0x5e, 0xe6, 0xb5,
-	0xd7, 0x97, 0x98, 0x2d, 0x13, 0x89, 0x49, 0x5a, 0xf2, 0x33, 0x50, 0xec, 0xb5, 0x9d, 0x90, 0x4a,
-	0xfb, 0xb6, 0x32, 0x32, 0x15, 0x37, 0x19, 0xf0, 0xf8, 0x70, 0xb6, 0xcc, 0x7a, 0x8a, 0xff, 0x41,
-	0x41, 0x48, 0x6e, 0x03, 0x6c, 0xfb, 0x7d, 0xaf, 0xe9, 0x04, 0x07, 0xf7, 0x97, 0xa4, 0x4f, 0x4c,
-	0x6b, 0x25, 0x35, 0x8d, 0x41, 0x83, 0xca, 0x0c, 0x52, 0x29, 0x9f, 0x1c, 0xa4, 0x42, 0xbe, 0x00,
-	0x65, 0xee, 0x3f, 0xa4, 0xcd, 0x85, 0x48, 0x5a, 0xa7, 0xcf, 0xe2, 0x6a, 0xd2, 0xc7, 0x6b, 0x5d,
-	0x31, 0xc1, 0x98, 0x1f, 0xf9, 0x22, 0xc0, 0x8e, 0xeb, 0xb9, 0x61, 0x9b, 0x73, 0xaf, 0x9c, 0x99,
-	0xbb, 0x6e, 0xe7, 0xb2, 0xe6, 0x82, 0x06, 0x47, 0xf2, 0x1e, 0x5c, 0xa4, 0x61, 0xe4, 0x76, 0x9d,
-	0x88, 0x36, 0x75, 0x00, 0x5b, 0x95, 0xbb, 0x4c, 0xb5, 0x07, 0xf7, 0x6e, 0x9a, 0xe0, 0x38, 0x0b,
-	0x88, 0x83, 0x8c, 0xc8, 0x9b, 0x50, 0xea, 0x05, 0x7e, 0x8b, 0xdd, 0x28, 0xab, 0x33, 0xbc, 0x1b,
-	0xaf, 0xab, 0x0b, 0xd0, 0xa6, 0x84, 0x1f, 0x1b, 0xbf, 0x51, 0x53, 0x93, 0x3f, 0xb6, 0xe0, 0x62,
-	0x40, 0x85, 0x79, 0x37, 0xd4, 0x15, 0xbb, 0xc2, 0xf7, 0x85, 0x46, 0x1e, 0xef, 0x82, 0xd4, 0x62,
-	0x9f, 0xc3, 0xb4, 0x14, 0x71, 0x20, 0x52, 0xd5, 0xfa, 0x01, 0xfc, 0x71, 0x16, 0xf0, 0xe7, 0xff,
-	0x60, 0x76, 0x76, 0xf0, 0x91, 0x9a, 0x66, 0xce, 0x56, 0xde, 0x5f, 0xfd, 0x83, 0xd9, 0x69, 0xf5,
-	0x3f, 0xee, 0xb4, 0x81, 0x46, 0xb2, 0xfd, 0xbd, 0xe7, 0x37, 0xef, 0x6f, 0x4a, 0x37, 0x82, 0xde,
-	0xdf, 0x37, 0x19, 0x10, 0x05, 0x8e, 0xbc, 0x0a, 0xa5, 0xa6, 0x43, 0xbb, 0xbe, 0x47, 0x9b, 0xd5,
-	0xc9, 0xd8, 0x38, 0xb7, 0x24, 0x61, 0xa8, 0xb1, 0xa4, 0x03, 0x63, 0x2e, 0x57, 0xf5, 0xab, 0x17,
-	0xf8, 0xec, 0xc9, 0xe1, 0x7e, 0x21, 0xae, 0x0e, 0x22, 0x14, 0x52, 0xfc, 0x46, 0x29, 0x83, 0xf4,
-	0x60, 0xdc, 0xef, 0x47, 0x5c, 0xdc, 0x14, 0x17, 0x97, 0x83, 0x93, 0x62, 0x43, 0x30, 0x14, 0xaf,
-	0x4e, 0xe4, 0x1f, 0x54, 0x62, 0x58, 0x4f, 0x34, 0xda, 0x6e, 0xa7, 0x19, 0x50, 0xaf, 0x3a, 0xcd,
-	0x6d, 0x1a, 0xbc, 0x27, 0x16, 0x25, 0x0c, 0x35, 0x96, 0xfc, 0x19, 0x98, 0xf4, 0xfb, 0x11, 0x5f,
-	0xe4, 0x6c, 0xfc, 0xc3, 0xea, 0x45, 0x4e, 0xce, 0xad, 0xe5, 0x1b, 0x26, 0x02, 0x93, 0x74, 0x6c,
-	0xb3, 0x6d, 0xfb, 0x61, 0xc4, 0xfe, 0xf0, 0xcd, 0xf6, 0x6a, 0x72, 0xb3, 0xbd, 0x67, 0xe0, 0x30,
-	0x41, 0x49, 0xbe, 0x65, 0xc1, 0xc5, 0x6e, 0x5a, 0x45, 0xaf, 0x5e, 0xe3, 0x3d, 0x53, 0xcf, 0x43,
-	0x95, 0x4b, 0xb1, 0x16, 0x8e, 0xeb, 0x01, 0x30, 0x0e, 0x56, 0x82, 0x47, 0x80, 0x87, 0x07, 0x5e,
-	0xa3, 0x1d, 0xf8, 0x5e, 0xb2, 0x7a, 0xcf, 0xf3, 0xea, 0x7d, 0x21, 0xa7, 0x55, 0x96, 0x25, 0xa2,
-	0xf6, 0xfc, 0xd1, 0xe1, 0xec, 0x95, 0x4c, 0x14, 0x66, 0x57, 0x6a, 0x66, 0x09, 0xae, 0x66, 0xaf,
-	0xd4, 0x27, 0xe9, 0x94, 0x23, 0xa6, 0x4e, 0xb9, 0x0c, 0xcf, 0x0f, 0xad, 0x14, 0xdb, 0xf3, 0x95,
-	0x02, 0x62, 0x25, 0xf7, 0xfc, 0x01, 0x85, 0xe1, 0x02, 0x4c, 0x98, 0x4f, 0x0b, 0xb9, 0xeb, 0xc2,
-	0x78, 0xf5, 0x40, 0x3e, 0x82, 0xb2, 0x5f, 0xcf, 0xdd, 0x75, 0xb1, 0x51, 0x1f, 0x70, 0x5d, 0x68,
-	0x10, 0xc6, 0x02, 0x9f, 0xe4, 0xba, 0xf8, 0xee, 0x08, 0xc4, 0xe5, 0xc8, 0x6b, 0x50, 0xa2, 0x5e,
-	0xb3, 0xe7, 0xbb, 0x5e, 0x94, 0xf6, 0x00, 0xdd, 0x95, 0x70, 0xd4, 0x14, 0x86, 0xa3, 0xa3, 0x70,
-	0xa2, 0xa3, 0xa3, 0x09, 0x53, 0x0e, 0x0f, 0x7b, 0x89, 0xcd, 0xd4, 0x23, 0x67, 0xb6, 0xcb, 0x2d,
-	0x24, 0x39, 0x60, 0x9a, 0x25, 0x93, 0x12, 0xc6, 0x45, 0xb9, 0x94, 0xd1, 0x33, 0x4b, 0xa9, 0x27,
-	0x39, 0x60, 0x9a, 0x25, 0x79, 0x0f, 0xaa, 0x0d, 0x1e, 0x68, 0x28, 0xda, 0x78, 0x7f, 0x67, 0xdd,
-	0x8f, 0x36, 0x03, 0x1a, 0x52, 0x4f, 0xb8, 0x11, 0x4a, 0xb5, 0x9b, 0xb2, 0x17, 0xaa, 0x8b, 0x43,
-	0xe8, 0x70, 0x28, 0x07, 0xa6, 0x0c, 0x71, 0x23, 0xb9, 0x1b, 0x1d, 0x6c, 0xf9, 0xbb, 0xd4, 0x93,
-	0xa6, 0x37, 0xad, 0x0c, 0xd5, 0x4d, 0x24, 0x26, 0x69, 0xed, 0xff, 0x58, 0x00, 0xb5, 0x23, 0xfe,
-	0x64, 0x5b, 0x72, 0x88, 0x0d, 0x63, 0x01, 0x0d, 0xd5, 0x7b, 0x8f, 0xb2, 0x38, 0x9c, 0x90, 0x43,
-	0x50, 0x62, 0xd8, 0x51, 0x41, 0x1f, 0xbb, 0xd1, 0xa2, 0xdf, 0x54, 0x6a, 0x36, 0x3f, 0x2a, 0xee,
-	0x4a, 0x18, 0x6a, 0xac, 0xfd, 0x97, 0x2d, 0x98, 0x64, 0xad, 0xec, 0x74, 0x68, 0xa7, 0x1e, 0xd1,
-	0x5e, 0x48, 0x42, 0x28, 0x86, 0xec, 0x47, 0x7e, 0xd7, 0xa2, 0x38, 0x0c, 0x8a, 0xf6, 0x0c, 0x03,
-	0x10, 0x13, 0x82, 0x42, 0x96, 0xfd, 0x3f, 0x0b, 0x50, 0xd6, 0x9d, 0x7d, 0x0a, 0xab, 0xd2, 0xed,
-	0xf8, 0xd5, 0x8b, 0x58, 0x9e, 0x55, 0xe3, 0xc5, 0x0b, 0xd3, 0x8d, 0x17, 0xbc, 0x03, 0xf1, 0x8e,
-	0x41, 0x3f, 0x7f, 0x21, 0xaf, 0x25, 0xad, 0x94, 0x57, 0x4d, 0xd3, 0x97, 0x41, 0x2f, 0xcd, 0x95,
-	0x8f, 0xa1, 0xcc, 0x7f, 0x2c, 0xab, 0x37, 0xb5, 0xb9, 0xcc, 0xb1, 0x47, 0x8a, 0xa5, 0xf0, 0x47,
-	0xe8, 0xbf, 0x18, 0x0b, 0x4b, 0xbd, 0x85, 0x2d, 0x9e, 0xea, 0x2d, 0xec, 0x2d, 0x18, 0xa5, 0x5e,
-	0xbf, 0xcb, 0x63, 0x70, 0xca, 0xfc, 0x6c, 0x1c, 0xbd, 0xeb, 0xf5, 0xbb, 0xc9, 0x96, 0x71, 0x12,
-	0xfb, 0x5f, 0x5a, 0xc0, 0x34, 0xac, 0x95, 0x45, 0xf2, 0xe7, 0xa1, 0x14, 0xca, 0x7d, 0x5d, 0x76,
-	0xf5, 0x4f, 0x69, 0x97, 0xb8, 0x84, 0x1f, 0x1f, 0xce, 0x4e, 0x72, 0x62, 0x05, 0x40, 0x5d, 0x84,
-	0x74, 0x60, 0x92, 0xdb, 0x4e, 0xd4, 0x26, 0x23, 0xad, 0x5d, 0x77, 0x4e, 0x19, 0xc9, 0x6a, 0x16,
-	0x15, 0xaa, 0x49, 0x02, 0x84, 0x49, 0xe6, 0xf6, 0xbf, 0x1a, 0x05, 0xc3, 0xc4, 0x70, 0x8a, 0x29,
-	0xf2, 0x41, 0xca, 0xa0, 0xb4, 0x96, 0x8b, 0x41, 0x49, 0x59, 0x69, 0xc4, 0xb2, 0x4b, 0xda, 0x90,
-	0x58, 0xa5, 0xda, 0xb4, 0xd3, 0x93, 0x13, 0x4c, 0x57, 0xea, 0x1e, 0xed, 0xf4, 0x90, 0x63, 0x74,
-	0x0c, 0xd0, 0xe8, 0xd0, 0x18, 0xa0, 0x36, 0x14, 0x5b, 0x4e, 0xbf, 0x45, 0xa5, 0x3f, 0x24, 0x07,
-	0xdb, 0x21, 0xf7, 0x84, 0x0b, 0xdb, 0x21, 0xff, 0x89, 0x42, 0x00, 0x9b, 0xe1, 0x6d, 0x65, 0xc2,
-	0x97, 0x97, 0xe3, 0x1c, 0x66, 0xb8, 0xf6, 0x0a, 0x88, 0x19, 0xae, 0xff, 0x62, 0x2c, 0x8c, 0xe9,
-	0xce, 0x0d, 0x11, 0x00, 0x2f, 0x5d, 0xba, 0xf7, 0xf3, 0x08, 0x72, 0xe2, 0x0c, 0x85, 0xee, 0x2c,
-	0xff, 0xa0, 0x12, 0x63, 0xcf, 0x43, 0xc5, 0x78, 0x15, 0xca, 0x86, 0x41, 0xc7, 0x5e, 0x1b, 0xc3,
-	0xb0, 0xe4, 0x44, 0x0e, 0x72, 0x8c, 0xfd, 0x77, 0x47, 0x40, 0xdf, 0x61, 0xcc, 0xf0, 0x25, 0xa7,
-	0x61, 0x3c, 0xc0, 0x4a, 0xc4, 0x66, 0xfa, 0x1e, 0x4a, 0x2c, 0x3b, 0xe9, 0xba, 0x34, 0x68, 0x69,
-	0xad, 0x49, 0xee, 0x51, 0xfa, 0xa4, 0x5b, 0x33, 0x91, 0x98, 0xa4, 0x65, 0x6a, 0x4a, 0xd7, 0xf1,
-	0xdc, 0x1d, 0x1a, 0x46, 0x69, 0x77, 0xe4, 0x9a, 0x84, 0xa3, 0xa6, 0x20, 0x2b, 0x70, 0x31, 0xa4,
-	0xd1, 0xc6, 0xbe, 0x47, 0x03, 0x1d, 0x33, 0x2a, 0x83, 0x88, 0x9f, 0x57, 0x17, 0xbb, 0x7a, 0x9a,
-	0x00, 0x07, 0xcb, 0x90, 0x25, 0x98, 0x96, 0xf1, 0xbb, 0x3a, 0xfc, 0x52, 0xee, 0x3d, 0xfa, 0x1d,
-	0x7f, 0x3d, 0x85, 0xc7, 0x81, 0x12, 0x8c, 0xcb, 0x8e, 0xe3, 0x76, 0xfa, 0x01, 0x8d, 0xb9, 0x8c,
-	0x25, 0xb9, 0x2c, 0xa7, 0xf0, 0x38, 0x50, 0x82, 0x47, 0x39, 0x74, 0x9c, 0x56, 0x58, 0x1d, 0x37,
-	0xa2, 0x1c, 0x18, 0x00, 0x05, 0xdc, 0xfe, 0xa7, 0x16, 0x4c, 0x22, 0x8d, 0x82, 0x83, 0x85, 0x1d,
-	0x76, 0xc5, 0x8f, 0x0e, 0xc8, 0xaf, 0x5b, 0x30, 0xed, 0xf9, 0x4d, 0xba, 0xe0, 0x45, 0xae, 0x02,
-	0xe6, 0xf7, 0xc2, 0x93, 0xcb, 0x5a, 0x4f, 0xb1, 0x17, 0xa1, 0xc0, 0x69, 0x28, 0x0e, 0x54, 0xc3,
-	0xbe, 0x06, 0x57, 0x32, 0x19, 0xd8, 0xdf, 0x1e, 0x91, 0xcd, 0xd0, 0x83, 0xff, 0x36, 0x14, 0x3b,
-	0x3c, 0x2c, 0xda, 0xfa, 0x84, 0xaf, 0xf6, 0x78, 0x5f, 0x89, 0xb8, 0x69, 0xc1, 0x89, 0x2c, 0x41,
-	0x25, 0x60, 0x32, 0x64, 0xd0, 0xba, 0x98, 0x8a, 0x76, 0x9c, 0x53, 0x40, 0xa3, 0x8e, 0x93, 0x7f,
-	0xd1, 0x2c, 0x46, 0x3e, 0x84, 0xf1, 0x6d, 0xf1, 0x10, 0x51, 0xaa, 0xb7, 0x39, 0x2c, 0x59, 0xf9,
-	0xb2, 0x91, 0x9f, 0xc4, 0xea, 0x99, 0xe3, 0x71, 0xfc, 0x13, 0x95, 0x44, 0x72, 0x00, 0x25, 0x47,
-	0x8d, 0xe9, 0x68, 0x5e, 0x71, 0x05, 0x89, 0xf9, 0x23, 0xf4, 0x23, 0x3d, 0x86, 0x5a, 0x9c, 0xfd,
-	0x1d, 0x0b, 0x20, 0xce, 0x43, 0x40, 0x1e, 0x43, 0x29, 0xbc, 0x93, 0xb8, 0xed, 0xe4, 0x11, 0x41,
-	0x2a, 0x39, 0x1a, 0x11, 0x69, 0x12, 0x82, 0x5a, 0xda, 0x93, 0xae, 0x3a, 0xdf, 0x2c, 0x82, 0x2e,
-	0x75, 0x4e, 0x37, 0x9d, 0x57, 0x98, 0xe2, 0xd9, 0x8a, 0xdf, 0x7d, 0x6a, 0x3a, 0xe4, 0x50, 0x94,
-	0x58, 0xa6, 0x7c, 0xaa, 0x70, 0x18, 0xb9, 0x13, 0xf1, 0xce, 0x55, 0x91, 0x33, 0xa8, 0xb1, 0x59,
-	0x77, 0xa7, 0xe2, 0x33, 0xb9, 0x3b, 0x8d, 0xe5, 0x7f, 0x77, 0xba, 0x05, 0xe3, 0x81, 0xdf, 0xa1,
-	0x0b, 0xb8, 0x2e, 0xed, 0xb5, 0xfa, 0x4e, 0x8d, 0x02, 0x8c, 0x0a, 0x4f, 0xde, 0x80, 0x4a, 0x3f,
-	0xa4, 0xf5, 0xa5, 0xd5, 0xc5, 0x80, 0x36, 0x43, 0x19, 0x61, 0xa4, 0xed, 0xe6, 0x0f, 0x63, 0x14,
-	0x9a, 0x74, 0xe4, 0xb7, 0xad, 0x13, 0xae, 0x67, 0xe5, 0xbc, 0xb6, 0xba, 0xcc, 0x97, 0x66, 0xb5,
-	0xeb, 0x9f, 0xec, 0xce, 0x67, 0x7f, 0xcd, 0x82, 0x0b, 0xf5, 0x46, 0xe0, 0xf6, 0xe2, 0x97, 0x83,
-	0x79, 0x3f, 0x6c, 0x7c, 0x45, 0x07, 0xe2, 0xa6, 0xa6, 0x6f, 0x32, 0x74, 0xd6, 0x7e, 0x1f, 0xa6,
-	0xeb, 0xb4, 0xeb, 0xf4, 0xda, 0x3c, 0x40, 0x4b, 0x78, 0x62, 0xe6, 0xa1, 0x1c, 0x2a, 0x58, 0x3a,
-	0x2d, 0x81, 0x26, 0xc6, 0x98, 0x86, 0xbc, 0x2c, 0xbc, 0x46, 0x2a, 0x7a, 0xa3, 0x2c, 0xd4, 0x0d,
-	0xe1, 0x6a, 0x0a, 0x51, 0xe1, 0xec, 0x7d, 0x98, 0x88, 0x8b, 0xd3, 0x1d, 0xd2, 0x82, 0xa9, 0x86,
-	0x11, 0xc3, 0x12, 0x67, 0x1f, 0x38, 0x7d, 0xb8, 0x0b, 0x9f, 0x85, 0x8b, 0x49, 0x26, 0x98, 0xe6,
-	0x6a, 0xff, 0x4a, 0x01, 0xa6, 0xb4, 0x64, 0x69, 0xed, 0xf9, 0x38, 0xed, 0xe9, 0xc2, 0x3c, 0x82,
-	0xde, 0x93, 0x3d, 0x79, 0x82, 0xb7, 0xeb, 0xe3, 0xb4, 0xb7, 0xeb, 0x5c, 0xc5, 0x0f, 0x18, 0xb0,
-	0xbe, 0x53, 0x80, 0x92, 0x0e, 0xc1, 0x7f, 0x1b, 0x8a, 0x5c, 0x23, 0x7c, 0xba, 0xe3, 0x95, 0x6b,
-	0x97, 0x28, 0x38, 0x31, 0x96, 0xdc, 0x89, 0xf1, 0x89, 0xdf, 0xd9, 0x97, 0xc5, 0x65, 0xd8, 0x09,
-	0x22, 0x14, 0x9c, 0xc8, 0x2a, 0x8c, 0x50, 0xaf, 0x29, 0xcf, 0xd9, 0xb3, 0x33, 0xe4, 0xe9, 0x41,
-	0xee, 0x7a, 0x4d, 0x64, 0x5c, 0xf8, 0xa3, 0x54, 0x1e, 0xa7, 0x2d, 0xef, 0x1c, 0xf1, 0xa3, 0x54,
-	0x0e, 0x45, 0x89, 0xb5, 0xff, 0xda, 0x08, 0x8c, 0xd5, 0xfb, 0xdb, 0x4c, 0x63, 0xf8, 0x87, 0x16,
-	0x5c, 0xda, 0x4f, 0xbd, 0xc1, 0x8e, 0xa7, 0xec, 0xc3, 0xfc, 0x1f, 0xb8, 0x23, 0xdd, 0xa9, 0xbd,
-	0x20, 0xeb, 0x75, 0x29, 0x03, 0x89, 0x59, 0xd5, 0x49, 0xbc, 0x57, 0x1d, 0x39, 0xa7, 0x97, 0xfd,
-	0xc6, 0xab, 0xa0, 0x42, 0xfe, 0xaf, 0x82, 0x26, 0x87, 0xbd, 0x08, 0xb2, 0x7f, 0x38, 0x0a, 0x20,
-	0x46, 0x63, 0xa3, 0x17, 0x9d, 0xe6, 0xb6, 0xfb, 0x26, 0x4c, 0xa8, 0x44, 0x79, 0xeb, 0xb1, 0xd7,
-	0x56, 0x5b, 0xee, 0x57, 0x0c, 0x1c, 0x26, 0x28, 0xc9, 0x6d, 0x00, 0xea, 0x45, 0xc1, 0x81, 0x50,
-	0x17, 0x46, 0x93, 0xe6, 0x86, 0xbb, 0x1a, 0x83, 0x06, 0x15, 0x99, 0x4b, 0x58, 0xe0, 0xc4, 0x5b,
-	0xa1, 0x0b, 0x27, 0x18, 0xcc, 0x3e, 0x03, 0x93, 0xfa, 0xdf, 0xb2, 0xdb, 0xa1, 0x69, 0xd3, 0xdf,
-	0xa6, 0x89, 0xc4, 0x24, 0x2d, 0xf9, 0x2c, 0x5c, 0x48, 0xc6, 0x79, 0xcb, 0x03, 0x56, 0x3f, 0x4e,
-	0x48, 0x86, 0x87, 0x63, 0x8a, 0x9a, 0xad, 0x80, 0x66, 0x70, 0x80, 0x7d, 0x4f, 0x9e, 0xb4, 0x7a,
-	0x05, 0x2c, 0x71, 0x28, 0x4a, 0x2c, 0xeb, 0x42, 0x56, 0x92, 0x06, 0x02, 0xce, 0x8f, 0xd4, 0x52,
-	0xdc, 0x85, 0x75, 0x03, 0x87, 0x09, 0x4a, 0x26, 0x41, 0x9a, 0x1a, 0x20, 0xb9, 0xc6, 0x52, 0xf6,
-	0x81, 0x1e, 0x5c, 0xf0, 0x93, 0x37, 0x35, 0xe1, 0xe7, 0xfc, 0xf4, 0x29, 0xe7, 0x6d, 0xa2, 0xac,
-	0x08, 0xa4, 0x4e, 0x5d, 0xec, 0x52, 0xfc, 0x99, 0xaa, 0x61, 0x46, 0xfa, 0x4c, 0x24, 0x5d, 0xf4,
-	0xc3, 0x82, 0x71, 0xec, 0x4b, 0x70, 0xb1, 0xde, 0xef, 0xf5, 0x3a, 0x2e, 0x6d, 0x6a, 0x13, 0x95,
-	0xfd, 0xb3, 0x30, 0x25, 0x9f, 0xa3, 0xea, 0xb3, 0xfc, 0x4c, 0x39, 0x49, 0xec, 0x3f, 0xb6, 0x60,
-	0x2a, 0xe5, 0x90, 0x20, 0x1f, 0xa6, 0x4f, 0xe0, 0x5c, 0x2c, 0x8e, 0xe6, 0xe1, 0x2b, 0x56, 0x59,
-	0xe6, 0x69, 0xde, 0x56, 0x01, 0x26, 0xb9, 0xc5, 0x69, 0xf1, 0x30, 0x0c, 0xb1, 0xa5, 0x9b, 0x51,
-	0x2a, 0xf6, 0x2f, 0x15, 0x20, 0xdb, 0x0b, 0x44, 0xbe, 0x32, 0xd8, 0x01, 0x6f, 0xe7, 0xd8, 0x01,
-	0xd2, 0x0d, 0x35, 0xbc, 0x0f, 0xbc, 0x64, 0x1f, 0xac, 0xe5, 0xd4, 0x07, 0x52, 0xee, 0x60, 0x4f,
-	0xfc, 0x91, 0x05, 0x95, 0xad, 0xad, 0x07, 0xfa, 0xc6, 0x8b, 0x70, 0x35, 0x14, 0x8f, 0x90, 0x17,
-	0x76, 0x22, 0x1a, 0x2c, 0xfa, 0xdd, 0x5e, 0x87, 0xea, 0x09, 0x25, 0x5f, 0x06, 0xd7, 0x33, 0x29,
-	0x70, 0x48, 0x49, 0x72, 0x1f, 0x2e, 0x99, 0x18, 0x69, 0xb7, 0xe0, 0x2d, 0x2c, 0xca, 0x77, 0x01,
-	0x83, 0x68, 0xcc, 0x2a, 0x93, 0x66, 0x25, 0x8d, 0x17, 0x32, 0x05, 0xe3, 0x00, 0x2b, 0x89, 0xc6,
-	0xac, 0x32, 0xf6, 0x06, 0x54, 0x8c, 0x84, 0xa0, 0xe4, 0x73, 0x30, 0xdd, 0xf0, 0xbb, 0x2a, 0x07,
-	0xdf, 0x03, 0xba, 0x47, 0x3b, 0xb2, 0xc9, 0xdc, 0xae, 0xb0, 0x98, 0xc2, 0xe1, 0x00, 0xb5, 0xfd,
-	0xf7, 0x5e, 0x04, 0xfd, 0x96, 0xf5, 0x14, 0x47, 0x44, 0x4f, 0xfb, 0xc7, 0x8b, 0x39, 0xfb, 0xc7,
-	0xf5, 0x7e, 0x97, 0xf2, 0x91, 0x47, 0xb1, 0x8f, 0x7c, 0x2c, 0x6f, 0x1f, 0xb9, 0xd6, 0xf8, 0x06,
-	0xfc, 0xe4, 0xbf, 0x6a, 0xc1, 0x84, 0xe7, 0x37, 0xa9, 0xb6, 0x4b, 0x8f, 0x73, 0xb5, 0xf3, 0xbd,
-	0xfc, 0x02, 0x7f, 0x84, 0xbf, 0x57, 0xb2, 0x17, 0x51, 0x14, 0xfa, 0x98, 0x30, 0x51, 0x98, 0xa8,
-	0x07, 0x59, 0x36, 0xcc, 0x18, 0xe2, 0xf9, 0xe9, 0xf5, 0x2c, 0xf5, 0xff, 0x49, 0x36, 0x09, 0xf2,
-	0xd8, 0x50, 0x7c, 0xca, 0x79, 0x19, 0x21, 0x54, 0xb0, 0xa4, 0x61, 0x6d, 0x54, 0x8f, 0xdb, 0x63,
-	0x85, 0xc8, 0x86, 0x31, 0x11, 0x6e, 0x21, 0x53, 0x53, 0x72, 0x23, 0xb8, 0x08, 0xc5, 0x40, 0x89,
-	0x21, 0x91, 0xf2, 0x1f, 0x55, 0xf2, 0xca, 0x09, 0x93, 0xf0, 0x4f, 0x65, 0x3b, 0x90, 0xc8, 0x5b,
-	0xe6, 0xad, 0x72, 0xe2, 0x34, 0xb7, 0xca, 0xc9, 0xa1, 0x37, 0xca, 0x6f, 0x58, 0x30, 0xd1, 0x30,
-	0x92, 0xde, 0x54, 0x5f, 0xcd, 0x2b, 0xb3, 0x53, 0x56, 0x2a, 0x1d, 0xf1, 0x6e, 0xd8, 0xc4, 0x60,
-	0x42, 0x3a, 0x7f, 0x69, 0xca, 0xaf, 0xd0, 0x3c, 0xfe, 0xa5, 0x72, 0x7b, 0x33, 0x87, 0xe3, 0x21,
-	0x71, 0x25, 0x17, 0xc3, 0x28, 0x60, 0x28, 0x65, 0x91, 0x8f, 0xa0, 0xa4, 0x22, 0x76, 0x64, 0x3c,
-	0x0d, 0xe6, 0x61, 0x73, 0x4b, 0x5a, 0xd4, 0xd5, 0x43, 0x3b, 0x01, 0x45, 0x2d, 0x91, 0xb4, 0x61,
-	0xa4, 0xe9, 0xb4, 0x64, 0x64, 0xcd, 0x5a, 0x3e, 0xcf, 0x7f, 0x95, 0x4c, 0x7e, 0x3f, 0x5a, 0x5a,
-	0x58, 0x41, 0x26, 0x82, 0x3c, 0x8e, 0x73, 0x6f, 0x4c, 0xe7, 0x76, 0xfa, 0x26, 0xd5, 0x24, 0x61,
-	0x24, 0x18, 0x48, 0xe5, 0xd1, 0x94, 0x4e, 0x88, 0x3f, 0xc1, 0xc5, 0x2e, 0xe7, 0xf3, 0x7e, 0x58,
-	0x24, 0xd7, 0x8c, 0x1d, 0x19, 0xe4, 0x2e, 0x8c, 0x8b, 0xbc, 0x46, 0x22, 0x0a, 0xa8, 0x72, 0x7b,
-	0x66, 0x78, 0x76, 0xa4, 0x78, 0x53, 0x15, 0xff, 0x43, 0x54, 0x65, 0xc9, 0xaf, 0x58, 0x70, 0x81,
-	0xed, 0x3e, 0x71, 0x22, 0xa6, 0x2a, 0xc9, 0x6b, 0x7d, 0x3f, 0x0c, 0xd9, 0xe9, 0xad, 0xd6, 0xa5,
-	0x56, 0xeb, 0xef, 0x27, 0xc4, 0x61, 0x4a, 0x3c, 0xf9, 0x18, 0x4a, 0xa1, 0xdb, 0xa4, 0x0d, 0x27,
-	0x08, 0xab, 0x97, 0xce, 0xa7, 0x2a, 0xb1, 0x3d, 0x56, 0x0a, 0x42, 0x2d, 0x92, 0xfc, 0x4d, 0x9e,
-	0x0b, 0x51, 0xe6, 0xbe, 0x95, 0xb9, 0x94, 0x2f, 0x9f, 0x5b, 0x2e, 0x65, 0x61, 0xe9, 0x4c, 0x8a,
-	0xc3, 0xb4, 0x7c, 0xf2, 0x97, 0x2c, 0xb8, 0x22, 0xd2, 0x83, 0xa4, 0x73, 0xc3, 0x5c, 0xf9, 0x84,
-	0xb6, 0x04, 0x1e, 0xbe, 0xb4, 0x90, 0xc5, 0x12, 0xb3, 0x25, 0xf1, 0xb7, 0xdf, 0x81, 0xe9, 0xd3,
-	0xe0, 0x41, 0x64, 0xf9, 0x59, 0xec, 0x75, 0x6a, 0x66, 0xee, 0x32, 0x4e, 0x80, 0x30, 0x29, 0x98,
-	0xbc, 0x0e, 0x95, 0x9e, 0x3c, 0x3a, 0xdc, 0xb0, 0xcb, 0x83, 0xd1, 0x46, 0x44, 0xc0, 0xee, 0x66,
-	0x0c, 0x46, 0x93, 0x26, 0x91, 0x08, 0xe0, 0xd6, 0x49, 0x89, 0x00, 0xc8, 0x43, 0xa8, 0x44, 0x7e,
-	0x87, 0x06, 0xf2, 0x66, 0x55, 0xe5, 0x33, 0xf0, 0x46, 0xd6, 0xda, 0xda, 0xd2, 0x64, 0xf1, 0xcd,
-	0x2b, 0x86, 0x85, 0x68, 0xf2, 0xe1, 0x41, 0x32, 0x32, 0xed, 0x4a, 0xc0, 0x2f, 0xf2, 0xcf, 0xa7,
-	0x82, 0x64, 0x4c, 0x24, 0x26, 0x69, 0xc9, 0x0a, 0x5c, 0xec, 0x05, 0xae, 0x1f, 0xb8, 0xd1, 0xc1,
-	0x62, 0xc7, 0x09, 0x43, 0xce, 0x40, 0x84, 0xa3, 0x6a, 0x67, 0xe0, 0x66, 0x9a, 0x00, 0x07, 0xcb,
-	0xb0, 0x6e, 0x50, 0xc0, 0xea, 0x0b, 0x5c, 0x27, 0x9d, 0x10, 0xa1, 0xac, 0x02, 0x86, 0x1a, 0x3b,
-	0xe4, 0x59, 0xfc, 0xf5, 0x4f, 0xf2, 0x2c, 0x9e, 0x34, 0xe1, 0xba, 0xd3, 0x8f, 0x7c, 0xfe, 0xa6,
-	0x2b, 0x59, 0x44, 0xc4, 0x0b, 0xdd, 0x14, 0x21, 0x48, 0x47, 0x87, 0xb3, 0xd7, 0x17, 0x4e, 0xa0,
-	0xc3, 0x13, 0xb9, 0x90, 0x2f, 0x43, 0x89, 0xca, 0xa7, 0xfd, 0xd5, 0x9f, 0xca, 0xeb, 0x40, 0x4d,
-	0x26, 0x0b, 0x50, 0xd1, 0x36, 0x02, 0x86, 0x5a, 0x1e, 0xd9, 0x82, 0x4a, 0xdb, 0x0f, 0xa3, 0x85,
-	0x8e, 0xeb, 0x84, 0x34, 0xac, 0xbe, 0xc8, 0x27, 0x4d, 0xa6, 0x9e, 0x72, 0x4f, 0x91, 0xc5, 0x73,
-	0xe6, 0x5e, 0x5c, 0x12, 0x4d, 0x36, 0x84, 0x72, 0x07, 0x07, 0x0f, 0x96, 0x62, 0x7b, 0x17, 0x7d,
-	0x1c, 0x55, 0x6f, 0xf0, 0x86, 0xbd, 0x92, 0xc5, 0x79, 0xd3, 0x6f, 0xd6, 0x93, 0xd4, 0xda, 0xc3,
-	0x61, 0x02, 0x31, 0xcd, 0x93, 0xbc, 0x09, 0x13, 0x3d, 0xbf, 0x59, 0xef, 0xd1, 0xc6, 0xa6, 0x13,
-	0x35, 0xda, 0xd5, 0xd9, 0xa4, 0x89, 0x69, 0xd3, 0xc0, 0x61, 0x82, 0x92, 0xf4, 0x60, 0xbc, 0x2b,
-	0x1e, 0xa3, 0x54, 0x5f, 0xca, 0xeb, 0x1e, 0x20, 0x5f, 0xb7, 0x88, 0xb3, 0x55, 0xfe, 0x41, 0x25,
-	0x86, 0xfc, 0x03, 0x0b, 0xa6, 0x52, 0xe1, 0x95, 0xd5, 0x9f, 0xce, 0xed, 0x78, 0x4f, 0x32, 0xae,
-	0xbd, 0xc2, 0xbb, 0x2f, 0x09, 0x3c, 0x1e, 0x04, 0x61, 0xba, 0x46, 0xa2, 0x5f, 0xf8, 0x8b, 0xb2,
-	0xea, 0xcb, 0xf9, 0xf5, 0x0b, 0x67, 0xa8, 0xfa, 0x85, 0xff, 0x41, 0x25, 0x86, 0xdc, 0x82, 0xf1,
-	0xc8, 0xed, 0x52, 0xbf, 0x1f, 0x55, 0x5f, 0x49, 0x7a, 0xa9, 0xb6, 0x04, 0x18, 0x15, 0x7e, 0xe6,
-	0x67, 0xe1, 0xe2, 0xc0, 0x35, 0xe7, 0x4c, 0xcf, 0x9a, 0x7e, 0x8d, 0xdd, 0xf4, 0x0d, 0x7b, 0x6d,
-	0xde, 0x39, 0x9b, 0xde, 0x84, 0x89, 0x86, 0x48, 0x18, 0x2a, 0xde, 0x56, 0x8c, 0x26, 0xed, 0x75,
-	0x8b, 0x06, 0x0e, 0x13, 0x94, 0xf6, 0x3d, 0x20, 0x83, 0xc9, 0x4e, 0xb8, 0x21, 0x34, 0xce, 0xa0,
-	0x6f, 0xa5, 0x0c, 0xa1, 0x71, 0xf6, 0x7c, 0x83, 0xca, 0xfe, 0x4d, 0x0b, 0x26, 0x13, 0x3a, 0x43,
-	0xee, 0xee, 0xad, 0x65, 0x20, 0x5d, 0x37, 0x08, 0xfc, 0xc0, 0xcc, 0x55, 0x29, 0xd3, 0x54, 0xf0,
-	0x27, 0xd0, 0x6b, 0x03, 0x58, 0xcc, 0x28, 0x61, 0xff, 0x9b, 0x11, 0x88, 0xc3, 0xcd, 0x74, 0x16,
-	0x00, 0x6b, 0x68, 0x16, 0x80, 0xd7, 0xa0, 0xf4, 0x7e, 0xe8, 0x7b, 0x9b, 0x71, 0xae, 0x00, 0x3d,
-	0x16, 0x6f, 0xd5, 0x37, 0xd6, 0x39, 0xa5, 0xa6, 0xe0, 0xd4, 0x1f, 0x2c, 0xbb, 0x9d, 0x68, 0xf0,
-	0x0d, 0xfd, 0x5b, 0x6f, 0x0b, 0x38, 0x6a, 0x0a, 0x9e, 0x4d, 0x73, 0x8f, 0x6a, 0x43, 0x6e, 0x9c,
-	0x4d, 0x93, 0x01, 0x51, 0xe0, 0xc8, 0x3c, 0x94, 0xb5, 0x1d, 0x58, 0x9a, 0xa5, 0x75, 0x4f, 0x69,
-	0x7b, 0x31, 0xc6, 0x34, 0x5c, 0x21, 0x94, 0x46, 0x4b, 0x69, 0x6e, 0xa8, 0xe7, 0xa1, 0xca, 0xa7,
-	0xcc, 0xa0, 0x62, 0x6f, 0x57, 0x60, 0xd4, 0x22, 0xcd, 0x90, 0xc4, 0xe2, 0x69, 0x43, 0x12, 0x93,
-	0x53, 0xae, 0x74, 0xaa, 0x29, 0xf7, 0x57, 0x46, 0x60, 0xfc, 0x11, 0x0d, 0x78, 0xbe, 0x90, 0x5b,
-	0x30, 0xbe, 0x27, 0x7e, 0xa6, 0x03, 0xb9, 0x25, 0x05, 0x2a, 0x3c, 0xeb, 0xce, 0xed, 0xbe, 0xdb,
-	0x69, 0x2e, 0xc5, 0x8b, 0x4b, 0x77, 0x67, 0x4d, 0x21, 0x30, 0xa6, 0x61, 0x05, 0x5a, 0x4c, 0xe1,
-	0xee, 0x76, 0xdd, 0x28, 0xfd, 0x40, 0x76, 0x45, 0x21, 0x30, 0xa6, 0x21, 0xaf, 0xc0, 0x58, 0xcb,
-	0x8d, 0xb6, 0x9c, 0x56, 0xda, 0xd3, 0xb4, 0xc2, 0xa1, 0x28, 0xb1, 0xdc, 0x55, 0xe1, 0x46, 0x5b,
-	0x01, 0xe5, 0xc6, 0xc9, 0x81, 0x17, 0x5d, 0x2b, 0x06, 0x0e, 0x13, 0x94, 0xbc, 0x4a, 0xbe, 0x6c,
-	0x99, 0x74, 0x21, 0xc4, 0x55, 0x52, 0x08, 0x8c, 0x69, 0xd8, 0xb4, 0x6c, 0xf8, 0xdd, 0x9e, 0xdb,
-	0x91, 0x91, 0x66, 0xc6, 0xb4, 0x5c, 0x94, 0x70, 0xd4, 0x14, 0x8c, 0x9a, 0xed, 0x2c, 0x6c, 0x57,
-	0x48, 0x27, 0x14, 0xdc, 0x94, 0x70, 0xd4, 0x14, 0xf6, 0x23, 0x98, 0x14, 0x0b, 0x6c, 0xb1, 0xe3,
-	0xb8, 0xdd, 0x95, 0x45, 0x72, 0x77, 0x20, 0x9c, 0xf2, 0x56, 0x46, 0x38, 0xe5, 0x95, 0x44, 0xa1,
-	0xc1, 0xb0, 0x4a, 0xfb, 0x7b, 0x05, 0x28, 0x3d, 0xc3, 0x9c, 0xac, 0xbd, 0x44, 0x4e, 0xd6, 0xbc,
-	0x33, 0x73, 0x66, 0xe5, 0x63, 0x7d, 0x9c, 0xca, 0xc7, 0xba, 0x99, 0x67, 0x84, 0xf1, 0x89, 0xb9,
-	0x58, 0x7f, 0x60, 0xc1, 0x65, 0x45, 0xca, 0xf7, 0x9a, 0x9a, 0xeb, 0x71, 0x1f, 0xf5, 0xf9, 0x77,
-	0xf3, 0x47, 0x89, 0x6e, 0x7e, 0x37, 0xbf, 0x26, 0x9b, 0xed, 0x18, 0x9a, 0x28, 0xfc, 0x0f, 0x2d,
-	0xa8, 0x66, 0x15, 0x78, 0x06, 0xc9, 0x68, 0x3f, 0x4c, 0x26, 0xa3, 0x7d, 0x74, 0x3e, 0x2d, 0x1f,
-	0x92, 0x94, 0xf6, 0x07, 0x43, 0xda, 0xcd, 0x33, 0xc0, 0x76, 0xd4, 0x29, 0x64, 0xe5, 0xe5, 0xfd,
-	0x11, 0x22, 0xb2, 0x8f, 0xb3, 0x0e, 0x8c, 0x85, 0xdc, 0xa1, 0x2b, 0xa7, 0xc0, 0xbd, 0x3c, 0xce,
-	0x26, 0xc6, 0x4f, 0x5a, 0xef, 0xf8, 0x6f, 0x94, 0x32, 0xec, 0xff, 0x6c, 0xc1, 0xc4, 0x33, 0xcc,
-	0x38, 0xec, 0x27, 0x07, 0xf9, 0xad, 0xfc, 0x06, 0x79, 0xc8, 0xc0, 0xfe, 0xaf, 0x17, 0x21, 0x91,
-	0xdc, 0x97, 0x7c, 0x08, 0x65, 0xa5, 0x18, 0xaa, 0x97, 0x0b, 0x6f, 0xe5, 0x67, 0xf0, 0x8f, 0x8f,
-	0x19, 0x05, 0x09, 0x31, 0x96, 0x97, 0x72, 0xa1, 0x17, 0x4e, 0xe5, 0x42, 0xff, 0xd1, 0x66, 0x1c,
-	0xcd, 0xbe, 0xb6, 0x8f, 0x9e, 0xcb, 0xb5, 0xfd, 0x7a, 0xee, 0xd7, 0xf6, 0x17, 0x9f, 0xf1, 0xb5,
-	0xdd, 0xb0, 0xa1, 0x16, 0x9f, 0xc2, 0x86, 0xfa, 0x21, 0x5c, 0xde, 0x8b, 0x0f, 0x7f, 0x3d, 0x93,
-	0x64, 0xe2, 0xd4, 0x5b, 0x99, 0x97, 0x75, 0xa6, 0xc8, 0x84, 0x11, 0xf5, 0x22, 0x43, 0x6d, 0xd0,
-	0x6f, 0x8b, 0x2f, 0x3f, 0xca, 0x60, 0x87, 0x99, 0x42, 0xd2, 0xc6, 0xb0, 0xf1, 0x53, 0x18, 0xc3,
-	0xfe, 0xf1, 0xd0, 0x4f, 0xe7, 0x94, 0xce, 0xf7, 0xd3, 0x39, 0xcf, 0x9f, 0xf9, 0xb3, 0x39, 0x2f,
-	0xc7, 0x56, 0x7c, 0x11, 0xb6, 0x91, 0x6d, 0x72, 0xff, 0x8d, 0xb4, 0x6b, 0x10, 0x78, 0xd7, 0x7f,
-	0x29, 0x5f, 0xad, 0x27, 0x07, 0xf7, 0x60, 0xe5, 0x29, 0xdc, 0x83, 0x29, 0xcb, 0xe4, 0x44, 0x4e,
-	0x96, 0x49, 0x0f, 0xa6, 0xdd, 0xae, 0xd3, 0xa2, 0x9b, 0xfd, 0x4e, 0x47, 0xc4, 0xbc, 0x86, 0xd5,
-	0x49, 0xce, 0x3b, 0x33, 0x88, 0xf1, 0x81, 0xdf, 0x70, 0x3a, 0xe9, 0x64, 0xd6, 0xfa, 0x11, 0xc0,
-	0xfd, 0x14, 0x27, 0x1c, 0xe0, 0xcd, 0x26, 0x2c, 0x7f, 0x61, 0x4c, 0x23, 0xd6, 0xdb, 0xdc, 0x07,
-	0x25, 0xbf, 0x3f, 0x77, 0x2f, 0x06, 0xa3, 0x49, 0x43, 0x56, 0xa1, 0xdc, 0xf4, 0x42, 0x19, 0xe8,
-	0x3e, 0xc5, 0x37, 0xb3, 0x4f, 0xb1, 0x2d, 0x70, 0x69, 0xbd, 0xae, 0x43, 0xdc, 0xaf, 0x67, 0x3c,
-	0x5e, 0xd7, 0x78, 0x8c, 0xcb, 0x93, 0x35, 0xce, 0x4c, 0x66, 0xb3, 0x13, 0xae, 0xa1, 0x9b, 0x43,
-	0xec, 0x69, 0x4b, 0xeb, 0x2a, 0xfb, 0xde, 0xa4, 0x14, 0x27, 0xd3, 0xd2, 0xc5, 0x1c, 0x8c, 0xec,
-	0xba, 0x17, 0x4f, 0xcc, 0xae, 0xcb, 0xb3, 0x56, 0x44, 0x1d, 0x6d, 0x3d, 0xbf, 0x91, 0x5b, 0xd6,
-	0x8a, 0x38, 0xe8, 0x42, 0x66, 0xad, 0x88, 0x01, 0x68, 0x8a, 0x24, 0x1b, 0xc3, 0xbc, 0x08, 0x97,
-	0xf8, 0xa6, 0x71, 0x76, 0x9f, 0x80, 0x69, 0x4e, 0xbe, 0x7c, 0xa2, 0x39, 0x79, 0xc0, 0xfc, 0x7d,
-	0xe5, 0x0c, 0xe6, 0xef, 0x36, 0xcf, 0x27, 0xb0, 0xb2, 0x28, 0x3d, 0x0e, 0x39, 0x28, 0x74, 0xfc,
-	0xe9, 0x9b, 0x08, 0x62, 0xe1, 0x3f, 0x51, 0x08, 0x20, 0x9b, 0x70, 0xb9, 0xe7, 0x37, 0x07, 0x4c,
-	0xe9, 0xdc, 0xc5, 0x10, 0xa7, 0x7e, 0xb8, 0xbc, 0x99, 0x41, 0x83, 0x99, 0x25, 0xf9, 0xf6, 0x1c,
-	0xc3, 0x79, 0x62, 0x8a, 0xa2, 0xdc, 0x9e, 0x63, 0x30, 0x9a, 0x34, 0x69, 0x63, 0xf2, 0xf3, 0xe7,
-	0x66, 0x4c, 0x9e, 0x79, 0x06, 0xc6, 0xe4, 0x17, 0x4e, 0x6d, 0x4c, 0xfe, 0x18, 0x2e, 0xf5, 0xfc,
-	0xe6, 0x92, 0x1b, 0x06, 0x7d, 0x1e, 0x9c, 0x5e, 0xeb, 0x37, 0x5b, 0x34, 0xe2, 0xd6, 0xe8, 0xca,
-	0xed, 0xdb, 0x66, 0x25, 0xc5, 0xc7, 0x91, 0xe7, 0xe4, 0xc7, 0x91, 0xf9, 0x42, 0x4d, 0x95, 0xe2,
-	0x17, 0x26, 0x1e, 0xc5, 0x93, 0x81, 0xc4, 0x2c, 0x39, 0xa6, 0x2d, 0xfb, 0xe6, 0xb3, 0xb1, 0x65,
-	0x7f, 0x0e, 0x4a, 0x61, 0xbb, 0x1f, 0x35, 0xfd, 0x7d, 0x8f, 0x3b, 0x2c, 0xca, 0xfa, 0x7b, 0x17,
-	0xa5, 0xba, 0x84, 0x1f, 0x1f, 0xce, 0x4e, 0xab, 0xdf, 0x86, 0x49, 0x41, 0x42, 0xc8, 0xb7, 0x87,
-	0x04, 0xf4, 0xda, 0xe7, 0x19, 0xd0, 0x7b, 0xed, 0x4c, 0xc1, 0xbc, 0x59, 0x06, 0xfb, 0x97, 0x7e,
-	0xec, 0x0c, 0xf6, 0xbf, 0x6e, 0xc1, 0xe4, 0x9e, 0x69, 0xbf, 0x91, 0x4e, 0x85, 0x1c, 0x9c, 0x9b,
-	0x09, 0xb3, 0x50, 0xcd, 0x66, 0x9b, 0x5d, 0x02, 0x74, 0x9c, 0x06, 0x60, 0xb2, 0x26, 0x19, 0x8e,
-	0xd7, 0x97, 0x7f, 0x54, 0x8e, 0xd7, 0x8f, 0xf9, 0x66, 0xa6, 0xe2, 0x87, 0xb8, 0xa7, 0x21, 0xdf,
-	0x18, 0x25, 0xb5, 0x31, 0xea, 0x10, 0x25, 0x53, 0x1e, 0xf9, 0x86, 0x05, 0xd3, 0xea, 0x72, 0x26,
-	0xed, 0xaf, 0xa1, 0x8c, 0xb2, 0xc8, 0xf3, 0x4e, 0xc8, 0xc3, 0xf4, 0xb6, 0x52, 0x72, 0x70, 0x40,
-	0xf2, 0xd3, 0x3b, 0x52, 0x7e, 0x9f, 0xc0, 0x85, 0xd4, 0xa7, 0x44, 0x3e, 0xad, 0x72, 0x43, 0x09,
-	0x43, 0xe3, 0x8d, 0x74, 0x6e, 0xa8, 0x49, 0x45, 0x9f, 0xc8, 0x0f, 0x95, 0x48, 0xe0, 0x54, 0x38,
-	0xd7, 0x04, 0x4e, 0x23, 0xcf, 0x26, 0x81, 0xd3, 0xf4, 0x79, 0x24, 0x70, 0xba, 0x78, 0xa6, 0x04,
-	0x4e, 0x46, 0x02, 0xad, 0xd1, 0x27, 0x24, 0xd0, 0x5a, 0x80, 0x29, 0x15, 0xc4, 0x49, 0x65, 0x66,
-	0x1e, 0x61, 0xfc, 0xd6, 0xdf, 0xba, 0x5c, 0x4c, 0xa2, 0x31, 0x4d, 0x4f, 0xbe, 0x6e, 0x41, 0xd1,
-	0xe3, 0x25, 0xc7, 0xf2, 0xca, 0xda, 0x98, 0x9c, 0x5a, 0xfc, 0xf2, 0x22, 0x73, 0x25, 0xaa, 0x50,
-	0x9c, 0x22, 0x87, 0x1d, 0xab, 0x1f, 0x28, 0x6a, 0x40, 0xde, 0x83, 0xaa, 0xbf, 0xb3, 0xd3, 0xf1,
-	0x9d, 0x66, 0x9c, 0x65, 0x4a, 0x59, 0xe7, 0x45, 0x20, 0xbc, 0x4e, 0x17, 0xb2, 0x31, 0x84, 0x0e,
-	0x87, 0x72, 0x60, 0xb7, 0xcf, 0xa9, 0x30, 0xf2, 0x03, 0xda, 0x8c, 0x6f, 0xca, 0x65, 0xde, 0x66,
-	0x9a, 0x7b, 0x9b, 0xeb, 0x49, 0x39, 0xa2, 0xf5, 0x7a, 0x50, 0x52, 0x58, 0x4c, 0x57, 0x8b, 0x04,
-	0x70, 0xb5, 0x97, 0x75, 0x51, 0x0f, 0x65, 0xe8, 0xe9, 0x49, 0xe6, 0x02, 0xb5, 0x74, 0xaf, 0x66,
-	0x5e, 0xf5, 0x43, 0x1c, 0xc2, 0xd9, 0xcc, 0x3f, 0x55, 0x7a, 0x36, 0xf9, 0xa7, 0x92, 0x1f, 0x00,
-	0x9a, 0x7c, 0xe6, 0x1f, 0x00, 0x22, 0x3f, 0xcc, 0x4c, 0x95, 0x26, 0xee, 0xb7, 0xad, 0xdc, 0xe7,
-	0xc4, 0x8f, 0x5d, 0xba, 0xb4, 0x7f, 0x64, 0xc1, 0x8c, 0x98, 0x79, 0x59, 0x1f, 0x08, 0x95, 0x41,
-	0x9a, 0x79, 0x3b, 0x70, 0xb8, 0x8b, 0xb9, 0x9e, 0x90, 0xca, 0xfd, 0x0a, 0x27, 0xd4, 0x84, 0xfc,
-	0x6a, 0x86, 0x2e, 0x37, 0x95, 0x97, 0xc5, 0x28, 0x3b, 0xcd, 0xd6, 0xa5, 0xa3, 0xd3, 0xa8, 0x6f,
-	0xff, 0x6c, 0xa8, 0x41, 0x8b, 0xf0, 0xea, 0xfd, 0x85, 0x73, 0x32, 0x68, 0x99, 0xb9, 0xc0, 0xce,
-	0x62, 0xd6, 0x9a, 0xf9, 0x45, 0x4b, 0xa4, 0xeb, 0x1c, 0x9a, 0x54, 0x76, 0xdb, 0x54, 0x1a, 0x72,
-	0x49, 0xeb, 0x1a, 0x6f, 0xc4, 0x66, 0x76, 0xdb, 0xbf, 0x6e, 0xc1, 0xe5, 0xac, 0x4d, 0x32, 0xa3,
-	0x4a, 0x5f, 0x4a, 0x56, 0x29, 0x47, 0x8d, 0xcb, 0xac, 0x50, 0x3e, 0x59, 0xd2, 0xfe, 0xc3, 0x98,
-	0xe1, 0x46, 0x88, 0x68, 0xef, 0xff, 0x7f, 0x57, 0x2c, 0xef, 0x0c, 0xa8, 0x89, 0x2f, 0x84, 0x15,
-	0x7f, 0x54, 0x5f, 0x08, 0x1b, 0xfb, 0x24, 0x5f, 0x08, 0x1b, 0xff, 0x91, 0x7d, 0x21, 0xac, 0x74,
-	0xca, 0x2f, 0x84, 0x95, 0x7f, 0x3c, 0xbf, 0x10, 0x66, 0xff, 0x1f, 0x0b, 0xa6, 0x7f, 0xa2, 0x3e,
-	0xc4, 0xfd, 0xbf, 0x8d, 0xc0, 0x82, 0x67, 0xf8, 0x05, 0xee, 0xfd, 0xa4, 0xdb, 0x15, 0xf3, 0x6f,
-	0xf1, 0x10, 0xf7, 0xeb, 0x07, 0x90, 0x65, 0xd9, 0x39, 0xdd, 0x33, 0xe5, 0x44, 0xcc, 0x5e, 0xe1,
-	0xd4, 0x31, 0x7b, 0xbf, 0x5c, 0x18, 0xec, 0x62, 0xae, 0x6d, 0x7c, 0xed, 0xd9, 0x7c, 0x63, 0xf6,
-	0x72, 0xd6, 0x37, 0x66, 0x53, 0xdf, 0x94, 0x4d, 0x7f, 0x63, 0xb4, 0x70, 0x8e, 0xdf, 0x18, 0x9d,
-	0x84, 0xca, 0xbb, 0x6e, 0x4f, 0x1b, 0x65, 0xe6, 0xbe, 0xfb, 0xfd, 0x1b, 0xcf, 0xfd, 0xee, 0xf7,
-	0x6f, 0x3c, 0xf7, 0xbd, 0xef, 0xdf, 0x78, 0xee, 0xab, 0x47, 0x37, 0xac, 0xef, 0x1e, 0xdd, 0xb0,
-	0x7e, 0xf7, 0xe8, 0x86, 0xf5, 0xbd, 0xa3, 0x1b, 0xd6, 0x7f, 0x39, 0xba, 0x61, 0xfd, 0x8d, 0xff,
-	0x7a, 0xe3, 0xb9, 0x77, 0x4b, 0xaa, 0x6d, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x86, 0xca,
-	0x70, 0x2c, 0x8f, 0x00, 0x00,
+	// 9082 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x5d, 0x70, 0x1c, 0xd9,
+	0x75, 0x18, 0xbc, 0x3d, 0x7f, 0x98, 0xb9, 0x00, 0x08, 0xb0, 0xf9, 0xd7, 0x8b, 0xe5, 0x12, 0x74,
+	0xaf, 0x76, 0xbd, 0xfc, 0x3e, 0x09, 0xf4, 0x92, 0xab, 0x64, 0x13, 0x55, 0x2c, 0x61, 0x00, 0x02,
+	0xe4, 0x92, 0xf8, 0xd9, 0x33, 0x58, 0x32, 0x5a, 0xad, 0x15, 0x35, 0x66, 0x2e, 0x06, 0xbd, 0x9c,
+	0xe9, 0x9e, 0xed, 0xee, 0x01, 0x81, 0xd5, 0xae, 0xa2, 0xc8, 0x7f, 0x52, 0xca, 0x8e, 0x15, 0x57,
+	0x1c, 0xdb, 0x4a, 0x1e, 0x94, 0xc4, 0x4e, 0x5c, 0x89, 0x2b, 0x95, 0xa4, 0x5c, 0x95, 0x2a, 0xa7,
+	0x92, 0xbc, 0xa4, 0x52, 0x4a, 0xf9, 0x21, 0x4e, 0x25, 0x29, 0xeb, 0x21, 0xa1, 0x23, 0xe6, 0xe7,
+	0x21, 0x55, 0xc9, 0x9b, 0x2d, 0x17, 0xe3, 0x87, 0xd4, 0xb9, 0x7f, 0x7d, 0x6f, 0x4f, 0x0f, 0x09,
+	0x90, 0x0d, 0xee, 0x56, 0x39, 0x2f, 0x28, 0xcc, 0x39, 0xe7, 0x9e, 0x73, 0xfb, 0xfe, 0x9e, 0x7b,
+	0xce, 0xb9, 0xe7, 0x92, 0xcd, 0xae, 0x9f, 0xec, 0x0e, 0xb7, 0x17, 0xda, 0x61, 0xff, 0xb2, 0x17,
+	0x75, 0xc3, 0x41, 0x14, 0xbe, 0xc7, 0xfe, 0xf9, 0xcc, 0xbd, 0x30, 0xba, 0xbb, 0xd3, 0x0b, 0xef,
+	0xc5, 0x97, 0xf7, 0xae, 0x5e, 0x1e, 0xdc, 0xed, 0x5e, 0xf6, 0x06, 0x7e, 0x7c, 0x59, 0x42, 0x2f,
+	0xef, 0xbd, 0xe6, 0xf5, 0x06, 0xbb, 0xde, 0x6b, 0x97, 0xbb, 0x34, 0xa0, 0x91, 0x97, 0xd0, 0xce,
+	0xc2, 0x20, 0x0a, 0x93, 0xd0, 0xfe, 0x42, 0xca, 0x71, 0x41, 0x72, 0x64, 0xff, 0xfc, 0x05, 0xc5,
+	0x71, 0x61, 0xef, 0xea, 0xc2, 0xe0, 0x6e, 0x77, 0x01, 0x39, 0x2e, 0x48, 0xe8, 0x82, 0xe4, 0x38,
+	0xf7, 0x19, 0xad, 0x4e, 0xdd, 0xb0, 0x1b, 0x5e, 0x66, 0x8c, 0xb7, 0x87, 0x3b, 0xec, 0x17, 0xfb,
+	0xc1, 0xfe, 0xe3, 0x02, 0xe7, 0xdc, 0xbb, 0x6f, 0xc4, 0x0b, 0x7e, 0x88, 0xf5, 0xbb, 0xdc, 0x0e,
+	0x23, 0x7a, 0x79, 0x6f, 0xa4, 0x52, 0x73, 0x97, 0x34, 0x9a, 0x41, 0xd8, 0xf3, 0xdb, 0x07, 0x97,
+	0xf7, 0x5e, 0xdb, 0xa6, 0xc9, 0x68, 0xfd, 0xe7, 0x5e, 0x4f, 0x49, 0xfb, 0x5e, 0x7b, 0xd7, 0x0f,
+	0x68, 0x74, 0x20, 0xbf, 0xff, 0x72, 0x44, 0xe3, 0x70, 0x18, 0xb5, 0xe9, 0x91, 0x4a, 0xc5, 0x97,
+	0xfb, 0x34, 0xf1, 0xf2, 0xaa, 0x75, 0x79, 0x5c, 0xa9, 0x68, 0x18, 0x24, 0x7e, 0x7f, 0x54, 0xcc,
+	0x9f, 0x7a, 0x5c, 0x81, 0xb8, 0xbd, 0x4b, 0xfb, 0xde, 0x48, 0xb9, 0xab, 0xe3, 0xca, 0x0d, 0x13,
+	0xbf, 0x77, 0xd9, 0x0f, 0x92, 0x38, 0x89, 0xb2, 0x85, 0xdc, 0x6b, 0xa4, 0xb6, 0xd8, 0x0f, 0x87,
+	0x41, 0x62, 0x7f, 0x8e, 0x54, 0xf7, 0xbc, 0xde, 0x90, 0x3a, 0xd6, 0x45, 0xeb, 0xd5, 0x46, 0xf3,
+	0xe5, 0xef, 0xdd, 0x9f, 0x7f, 0xee, 0xc1, 0xfd, 0xf9, 0xea, 0x6d, 0x04, 0x3e, 0xbc, 0x3f, 0x7f,
+	0x9a, 0x06, 0xed, 0xb0, 0xe3, 0x07, 0xdd, 0xcb, 0xef, 0xc5, 0x61, 0xb0, 0xb0, 0x3e, 0xec, 0x6f,
+	0xd3, 0x08, 0x78, 0x19, 0xf7, 0xdf, 0x97, 0xc8, 0xcc, 0x62, 0xd4, 0xde, 0xf5, 0xf7, 0x68, 0x2b,
+	0x41, 0xfe, 0xdd, 0x03, 0x7b, 0x97, 0x94, 0x13, 0x2f, 0x62, 0xec, 0x26, 0xaf, 0xac, 0x2d, 0x3c,
+	0xed, 0x90, 0x59, 0xd8, 0xf2, 0x22, 0xc9, 0xbb, 0x39, 0xf1, 0xe0, 0xfe, 0x7c, 0x79, 0xcb, 0x8b,
+	0x00, 0x45, 0xd8, 0x3d, 0x52, 0x09, 0xc2, 0x80, 0x3a, 0x25, 0x26, 0x6a, 0xfd, 0xe9, 0x45, 0xad,
+	0x87, 0x81, 0xfa, 0x8e, 0x66, 0xfd, 0xc1, 0xfd, 0xf9, 0x0a, 0x42, 0x80, 0x49, 0xc1, 0xef, 0xfa,
+	0xc0, 0x1f, 0x38, 0xe5, 0xa2, 0xbe, 0xeb, 0x1d, 0x7f, 0x60, 0x7e, 0xd7, 0x3b, 0xfe, 0x00, 0x50,
+	0x84, 0xfb, 0xad, 0x12, 0x69, 0x2c, 0x46, 0xdd, 0x61, 0x9f, 0x06, 0x49, 0x6c, 0xff, 0x45, 0x42,
+	0x06, 0x5e, 0xe4, 0xf5, 0x69, 0x42, 0xa3, 0xd8, 0xb1, 0x2e, 0x96, 0x5f, 0x9d, 0xbc, 0x72, 0xf3,
+	0xe9, 0xc5, 0x6f, 0x4a, 0x9e, 0x4d, 0x5b, 0x74, 0x39, 0x51, 0xa0, 0x18, 0x34, 0x91, 0xf6, 0x57,
+	0x49, 0xc3, 0x8b, 0x12, 0x7f, 0xc7, 0x6b, 0x27, 0xb1, 0x53, 0x62, 0xf2, 0xdf, 0x7c, 0x7a, 0xf9,
+	0x8b, 0x82, 0x65, 0xf3, 0xa4, 0x10, 0xdf, 0x90, 0x90, 0x18, 0x52, 0x79, 0xee, 0xd7, 0x6b, 0xa4,
+	0x2e, 0x11, 0xf6, 0x45, 0x52, 0x09, 0xbc, 0xbe, 0x1c, 0xaa, 0x53, 0xa2, 0x60, 0x65, 0xdd, 0xeb,
+	0x63, 0x27, 0x79, 0x7d, 0x8a, 0x14, 0x03, 0x2f, 0xd9, 0x75, 0x4a, 0x26, 0xc5, 0xa6, 0x97, 0xec,
+	0x02, 0xc3, 0xd8, 0xe7, 0x49, 0xa5, 0x1f, 0x76, 0x28, 0xeb, 0xc7, 0x2a, 0xef, 0xe4, 0xb5, 0xb0,
+	0x43, 0x81, 0x41, 0xb1, 0xfc, 0x4e, 0x14, 0xf6, 0x9d, 0x8a, 0x59, 0x7e, 0x25, 0x0a, 0xfb, 0xc0,
+	0x30, 0xf6, 0xaf, 0x58, 0x64, 0x56, 0x56, 0xef, 0x56, 0xd8, 0xf6, 0x12, 0x3f, 0x0c, 0x9c, 0x2a,
+	0x1b, 0x14, 0x50, 0x5c, 0xab, 0x48, 0xce, 0x4d, 0x47, 0x54, 0x61, 0x36, 0x8b, 0x81, 0x91, 0x5a,
+	0xd8, 0x57, 0x08, 0xe9, 0xf6, 0xc2, 0x6d, 0xaf, 0x87, 0x0d, 0xe2, 0xd4, 0xd8, 0x27, 0xa8, 0xce,
+	0x5d, 0x55, 0x18, 0xd0, 0xa8, 0xec, 0x7d, 0x32, 0xe1, 0xf1, 0x09, 0xec, 0x4c, 0xb0, 0x8f, 0x78,
+	0xab, 0x88, 0x8f, 0x30, 0x56, 0x84, 0xe6, 0xe4, 0x83, 0xfb, 0xf3, 0x13, 0x02, 0x08, 0x52, 0x9c,
+	0xfd, 0x69, 0x52, 0x0f, 0x07, 0x58, 0x6f, 0xaf, 0xe7, 0xd4, 0x2f, 0x5a, 0xaf, 0xd6, 0x9b, 0xb3,
+	0xa2, 0xae, 0xf5, 0x0d, 0x01, 0x07, 0x45, 0x61, 0x5f, 0x22, 0x13, 0xf1, 0x70, 0x1b, 0xfb, 0xd1,
+	0x69, 0xb0, 0x0f, 0x9b, 0x11, 0xc4, 0x13, 0x2d, 0x0e, 0x06, 0x89, 0xb7, 0x3f, 0x4b, 0x26, 0x23,
+	0xda, 0x1e, 0x46, 0x31, 0xc5, 0x8e, 0x75, 0x08, 0xe3, 0x7d, 0x4a, 0x90, 0x4f, 0x42, 0x8a, 0x02,
+	0x9d, 0xce, 0xfe, 0x71, 0x72, 0x02, 0x3b, 0xf8, 0xda, 0xfe, 0x20, 0xa2, 0x71, 0x8c, 0xbd, 0x3a,
+	0xc9, 0x04, 0x9d, 0x15, 0x25, 0x4f, 0xac, 0x18, 0x58, 0xc8, 0x50, 0xdb, 0xaf, 0x90, 0x5a, 0xc7,
+	0xef, 0xd2, 0x38, 0x71, 0xa6, 0x58, 0xb9, 0x13, 0xa2, 0x5c, 0x6d, 0x99, 0x41, 0x41, 0x60, 0xed,
+	0xcb, 0xa4, 0x11, 0xfb, 0x1f, 0xd0, 0xe6, 0x41, 0x42, 0x63, 0x67, 0xfa, 0xa2, 0xf5, 0x6a, 0x39,
+	0x9d, 0x02, 0x2d, 0x89, 0x80, 0x94, 0xc6, 0xfd, 0x97, 0x16, 0x99, 0x96, 0xbd, 0xbf, 0xe2, 0xf7,
+	0x68, 0x6c, 0xef, 0x93, 0xba, 0xec, 0x7c, 0xb1, 0xce, 0x16, 0x39, 0x21, 0x55, 0x37, 0x48, 0x08,
+	0x28, 0x69, 0xf6, 0xeb, 0xa4, 0xb6, 0x13, 0x46, 0x7d, 0x2f, 0x11, 0x33, 0xec, 0xbc, 0xfc, 0xc8,
+	0x15, 0x06, 0x7d, 0x78, 0x7f, 0x9e, 0x2c, 0x7b, 0x89, 0xc7, 0x7f, 0x81, 0xa0, 0x75, 0x7f, 0x7b,
+	0x82, 0x8c, 0x8c, 0x5f, 0xfb, 0x35, 0x32, 0x29, 0x86, 0xc2, 0xad, 0xb0, 0x1b, 0xb3, 0xef, 0xa8,
+	0x37, 0x67, 0xb0, 0x8b, 0x16, 0x53, 0x30, 0xe8, 0x34, 0x76, 0x87, 0x94, 0xe2, 0xab, 0x62, 0xb9,
+	0xbf, 0xf5, 0xf4, 0x5f, 0xdc, 0xba, 0xaa, 0xbe, 0xb9, 0xf6, 0xe0, 0xfe, 0x7c, 0xa9, 0x75, 0x15,
+	0x4a, 0xf1, 0x55, 0x5c, 0xe8, 0xbb, 0x7e, 0x52, 0xdc, 0x42, 0xbf, 0xea, 0x27, 0x4a, 0x0e, 0x5b,
+	0xe8, 0x57, 0xfd, 0x04, 0x50, 0x04, 0x6e, 0x60, 0xbb, 0x49, 0x32, 0x70, 0x2a, 0x45, 0x6d, 0x60,
+	0xd7, 0xb7, 0xb6, 0x36, 0x95, 0x2c, 0xb6, 0xb6, 0x21, 0x04, 0x98, 0x14, 0xfb, 0x9b, 0x16, 0xb6,
+	0x38, 0x47, 0x86, 0xd1, 0x81, 0x58, 0xb4, 0xde, 0x2e, 0x6e, 0xe4, 0x84, 0xd1, 0x81, 0x12, 0x2e,
+	0x3a, 0x52, 0x21, 0x40, 0x17, 0xcd, 0x3e, 0xbc, 0xb3, 0x13, 0x3b, 0xb5, 0xc2, 0x3e, 0x7c, 0x79,
+	0xa5, 0x95, 0xf9, 0xf0, 0xe5, 0x95, 0x16, 0x30, 0x29, 0xd8, 0xa1, 0x91, 0x77, 0xcf, 0x99, 0x28,
+	0xaa, 0x43, 0xc1, 0xbb, 0x67, 0x76, 0x28, 0x78, 0xf7, 0x00, 0x45, 0xa0, 0xa4, 0x30, 0x8e, 0x9d,
+	0x7a, 0x51, 0x92, 0x36, 0x5a, 0x2d, 0x53, 0xd2, 0x46, 0xab, 0x05, 0x28, 0x82, 0x0d, 0xd2, 0x76,
+	0xec, 0x34, 0x8a, 0x92, 0xb4, 0xba, 0x94, 0x91, 0xb4, 0xba, 0xd4, 0x02, 0x14, 0xe1, 0x7e, 0x4b,
+	0x5b, 0x7e, 0x70, 0x7d, 0xfd, 0x18, 0x97, 0x1f, 0xf7, 0x7d, 0x72, 0x46, 0x41, 0xe9, 0x20, 0x8c,
+	0x7d, 0x36, 0xb4, 0xe8, 0x0e, 0x2e, 0xaa, 0xed, 0x30, 0xd8, 0xf1, 0xbb, 0x6b, 0xde, 0x40, 0xa8,
+	0x07, 0x6a, 0x51, 0x5d, 0x92, 0x08, 0x48, 0x69, 0xec, 0x17, 0x49, 0xf9, 0x2e, 0x3d, 0x10, 0xab,
+	0xd8, 0xa4, 0x20, 0x2d, 0xdf, 0xa4, 0x07, 0x80, 0xf0, 0x3f, 0x5b, 0xff, 0x95, 0xef, 0xce, 0x3f,
+	0xf7, 0xf5, 0xff, 0x74, 0xf1, 0x39, 0xf7, 0x9f, 0x94, 0xc8, 0x0b, 0xb9, 0x32, 0x5b, 0x89, 0x97,
+	0x0c, 0x63, 0xfb, 0x37, 0x2d, 0x72, 0xc6, 0xcb, 0xc3, 0x8b, 0xa6, 0xb9, 0x53, 0x5c, 0xd3, 0x18,
+	0xec, 0x9b, 0x2f, 0x8a, 0x4a, 0xe7, 0xb7, 0x08, 0x9c, 0xf1, 0xc6, 0x35, 0x14, 0x2a, 0x4a, 0xf1,
+	0xc0, 0x6b, 0x53, 0xa7, 0x64, 0x36, 0xd4, 0xba, 0x44, 0x40, 0x4a, 0x83, 0x1b, 0x6f, 0x87, 0xee,
+	0x78, 0xc3, 0x1e, 0x5f, 0x11, 0xeb, 0xe9, 0xc6, 0xbb, 0xcc, 0xc1, 0x20, 0xf1, 0x5a, 0xa3, 0xfd,
+	0x9a, 0x45, 0x4e, 0xca, 0x6a, 0xb5, 0x92, 0x88, 0x7a, 0x7d, 0x3f, 0xe8, 0xda, 0x7f, 0x9e, 0xd4,
+	0x07, 0x5e, 0x94, 0xe0, 0x26, 0x27, 0x1a, 0x67, 0x61, 0x81, 0x1f, 0x5e, 0x16, 0xf4, 0xc3, 0x8b,
+	0x6c, 0x8a, 0x05, 0x79, 0x22, 0x5b, 0x78, 0x6b, 0xe8, 0x05, 0x89, 0x9f, 0x1c, 0x34, 0xa7, 0x70,
+	0x5c, 0x6c, 0x0a, 0x1e, 0xa0, 0xb8, 0xe1, 0x96, 0xdf, 0x0e, 0x83, 0xf6, 0x30, 0x8a, 0x68, 0xd0,
+	0xe6, 0xbd, 0x5a, 0x4d, 0xb7, 0xfc, 0xa5, 0x14, 0x05, 0x3a, 0x9d, 0xfb, 0x3b, 0x16, 0x39, 0x95,
+	0xb3, 0x78, 0xe1, 0xe0, 0x18, 0x46, 0x3d, 0xc7, 0x32, 0x07, 0xc7, 0xdb, 0x70, 0x0b, 0x10, 0x6e,
+	0xff, 0x35, 0x8b, 0xcc, 0x68, 0xab, 0xd9, 0xe2, 0x50, 0x28, 0x9c, 0x05, 0x29, 0x4f, 0x06, 0xe3,
+	0xe6, 0x39, 0x21, 0x7e, 0x26, 0x83, 0x80, 0x6c, 0x15, 0xdc, 0xdf, 0xb3, 0x48, 0x96, 0xc8, 0xf6,
+	0xc8, 0x89, 0x61, 0x4c, 0x23, 0xec, 0xce, 0x16, 0x6d, 0x47, 0x54, 0x4e, 0xd8, 0x97, 0xb5, 0x86,
+	0x5f, 0x68, 0x87, 0x11, 0x5d, 0xd8, 0x7b, 0x6d, 0x81, 0x53, 0xdc, 0xa4, 0x07, 0x2d, 0xda, 0xa3,
+	0xc8, 0xa3, 0x69, 0xa3, 0xde, 0xf3, 0xb6, 0xc1, 0x00, 0x32, 0x0c, 0x51, 0xc4, 0xc0, 0x8b, 0xe3,
+	0x7b, 0x61, 0xd4, 0x11, 0x22, 0x4a, 0x47, 0x16, 0xb1, 0x69, 0x30, 0x80, 0x0c, 0x43, 0xf7, 0x5f,
+	0x59, 0x64, 0xa2, 0xe9, 0xb5, 0xef, 0x86, 0x3b, 0x3b, 0xa8, 0x36, 0x76, 0x86, 0x11, 0x57, 0xbb,
+	0x79, 0x07, 0xa9, 0x05, 0x63, 0x59, 0xc0, 0x41, 0x51, 0xd8, 0x5b, 0xa4, 0xc6, 0x9b, 0x43, 0x54,
+	0xea, 0xc7, 0xc6, 0x0e, 0x38, 0x3c, 0x2d, 0x2f, 0xf0, 0xd3, 0xf2, 0xc2, 0x8d, 0x20, 0xd9, 0xc0,
+	0x43, 0xa7, 0x1f, 0x74, 0x9b, 0x84, 0x69, 0x37, 0x8c, 0x07, 0x08, 0x5e, 0x38, 0xdc, 0xfa, 0xde,
+	0xbe, 0x14, 0xc7, 0xe6, 0x45, 0x23, 0x1d, 0x6e, 0x6b, 0x29, 0x0a, 0x74, 0x3a, 0xf7, 0xcb, 0xa4,
+	0xba, 0xe4, 0xb5, 0x77, 0xa9, 0xfd, 0x76, 0x76, 0xb5, 0x9a, 0xbc, 0xf2, 0x6a, 0x5e, 0x6b, 0xa9,
+	0x95, 0x4b, 0x6f, 0xb0, 0xe9, 0x71, 0x6b, 0x9a, 0xfb, 0x07, 0x16, 0x39, 0xb7, 0xd4, 0x1b, 0xc6,
+	0x09, 0x8d, 0xee, 0x88, 0x71, 0xb5, 0x45, 0xfb, 0x83, 0x9e, 0x97, 0x50, 0xfb, 0x2b, 0xa4, 0x8e,
+	0x96, 0x8a, 0x8e, 0x97, 0x78, 0x8e, 0xf5, 0x98, 0xa6, 0x60, 0x23, 0x13, 0xa9, 0xb1, 0x0e, 0x1b,
+	0xdb, 0xef, 0xd1, 0x76, 0xb2, 0x46, 0x13, 0x2f, 0x3d, 0x4b, 0xa4, 0x30, 0x50, 0x5c, 0xed, 0x7d,
+	0x52, 0x89, 0x07, 0xb4, 0x2d, 0x1a, 0xfa, 0xf6, 0xd3, 0xcf, 0x84, 0xec, 0x37, 0xb4, 0x06, 0xb4,
+	0x9d, 0x1e, 0xc9, 0xf0, 0x17, 0x30, 0x89, 0xee, 0xff, 0xb1, 0xc8, 0x0b, 0x63, 0xbe, 0xfb, 0x96,
+	0x1f, 0x27, 0xf6, 0xbb, 0x23, 0xdf, 0xbe, 0x70, 0xb8, 0x6f, 0xc7, 0xd2, 0xec, 0xcb, 0xd5, 0x10,
+	0x93, 0x10, 0xed, 0xbb, 0xbf, 0x46, 0xaa, 0x7e, 0x42, 0xfb, 0xf2, 0x68, 0xfc, 0xc5, 0xa7, 0xff,
+	0xf0, 0x31, 0xdf, 0xd2, 0x9c, 0x96, 0xb6, 0x99, 0x1b, 0x28, 0x0f, 0xb8, 0x58, 0xf7, 0xdf, 0x58,
+	0x04, 0x87, 0x43, 0xc7, 0x17, 0x5a, 0x75, 0x25, 0x39, 0x18, 0xc8, 0x23, 0xb2, 0xdc, 0x23, 0x2a,
+	0x5b, 0x07, 0x03, 0x34, 0xe6, 0x4c, 0x2b, 0x42, 0x04, 0x00, 0x23, 0xb5, 0xbf, 0x4c, 0x6a, 0x31,
+	0xdb, 0xcb, 0xc4, 0x7e, 0xb0, 0x22, 0x75, 0x7a, 0xbe, 0xc3, 0x3d, 0xbc, 0x3f, 0x7f, 0x28, 0x0b,
+	0xd8, 0x82, 0xe2, 0xcd, 0xcb, 0x81, 0xe0, 0x8a, 0x3b, 0x48, 0x9f, 0xc6, 0xb1, 0xd7, 0xa5, 0x62,
+	0xa6, 0xa8, 0x1d, 0x64, 0x8d, 0x83, 0x41, 0xe2, 0xdd, 0x5f, 0xb2, 0x08, 0x56, 0x31, 0xf1, 0x50,
+	0xc4, 0x3a, 0x9e, 0xca, 0xd6, 0xd9, 0x54, 0xe1, 0x00, 0xd1, 0x79, 0x2f, 0x8e, 0x99, 0x2a, 0x9c,
+	0xc8, 0xd8, 0xf7, 0x39, 0x08, 0x52, 0x16, 0xf6, 0xeb, 0x64, 0xaa, 0x43, 0x07, 0x34, 0xe8, 0xd0,
+	0xa0, 0xed, 0x53, 0xde, 0x69, 0x8d, 0xe6, 0xec, 0x83, 0xfb, 0xf3, 0x53, 0xcb, 0x1a, 0x1c, 0x0c,
+	0x2a, 0xf7, 0x87, 0x16, 0x39, 0xad, 0xd8, 0xb5, 0x68, 0xa2, 0xa6, 0xd5, 0x4f, 0x5a, 0x84, 0x28,
+	0xe6, 0xb1, 0x53, 0x61, 0x43, 0x60, 0xa3, 0x80, 0x21, 0xa0, 0x37, 0x42, 0x3a, 0xf1, 0x14, 0x38,
+	0x06, 0x4d, 0xac, 0xfd, 0x45, 0x32, 0xb5, 0x17, 0xf6, 0x86, 0x7d, 0xba, 0x86, 0x26, 0xbd, 0xd8,
+	0x29, 0xb3, 0x6a, 0xcc, 0xe7, 0xb5, 0xd3, 0xed, 0x94, 0xae, 0x79, 0x5a, 0xb0, 0x9d, 0xd2, 0x80,
+	0x31, 0x18, 0xac, 0xdc, 0x2f, 0x12, 0x26, 0xd4, 0x0f, 0x86, 0x74, 0x23, 0xb0, 0x5f, 0x22, 0x55,
+	0x1a, 0x45, 0x61, 0x24, 0x4e, 0x6b, 0x6a, 0x40, 0x5e, 0x43, 0x20, 0x70, 0x1c, 0x1e, 0x84, 0x77,
+	0x3c, 0xbf, 0x47, 0x3b, 0x6c, 0x3c, 0xd5, 0xd3, 0x83, 0xf0, 0x0a, 0x83, 0x82, 0xc0, 0xba, 0x0b,
+	0x64, 0x62, 0x09, 0x85, 0xd0, 0x08, 0xf9, 0xea, 0x46, 0xc8, 0x69, 0xc3, 0x08, 0x29, 0x8d, 0x8d,
+	0x5b, 0xe4, 0xcc, 0x52, 0x44, 0x71, 0x21, 0xb8, 0xda, 0x1c, 0xb6, 0xef, 0xd2, 0x84, 0x9b, 0x09,
+	0x62, 0xfb, 0x73, 0x64, 0x3a, 0x64, 0x2b, 0xd2, 0xad, 0xb0, 0x7d, 0xd7, 0x0f, 0xba, 0x42, 0x51,
+	0x39, 0x23, 0xb8, 0x4c, 0x6f, 0xe8, 0x48, 0x30, 0x69, 0xdd, 0xef, 0x58, 0xe4, 0xc4, 0x52, 0x14,
+	0x06, 0xd7, 0xf6, 0xdb, 0xbd, 0x61, 0xcc, 0xf8, 0xcd, 0x93, 0x6a, 0xc7, 0x4b, 0x28, 0x37, 0xb6,
+	0x35, 0x9a, 0x0d, 0xac, 0xc9, 0x32, 0x02, 0x80, 0xc3, 0xed, 0x2e, 0x99, 0x69, 0x6b, 0x4b, 0x33,
+	0x2a, 0x7b, 0xa5, 0x23, 0xae, 0xe2, 0xa7, 0x70, 0x4b, 0x5f, 0x32, 0x99, 0x40, 0x96, 0xab, 0xfb,
+	0xdf, 0x4a, 0x64, 0x0a, 0x2b, 0x27, 0x97, 0x82, 0x67, 0xb0, 0x8c, 0x27, 0xc6, 0x32, 0x5e, 0x80,
+	0x49, 0x4b, 0xaf, 0xff, 0xb8, 0x25, 0xdc, 0xfe, 0x50, 0xad, 0x41, 0xfc, 0xd8, 0xbd, 0x55, 0xb0,
+	0x5c, 0xc6, 0x3b, 0x1d, 0x89, 0xe6, 0x0a, 0xe5, 0xde, 0x2f, 0x91, 0xd3, 0x3a, 0x39, 0xea, 0x1a,
+	0x3b, 0x7e, 0xaf, 0x67, 0xdf, 0x12, 0xe6, 0x40, 0xde, 0xd4, 0xff, 0xdf, 0xe1, 0x9a, 0x7a, 0xcb,
+	0xef, 0xd3, 0x5c, 0xd3, 0xe1, 0x0a, 0x29, 0x25, 0xa1, 0x53, 0x3a, 0x32, 0x2f, 0x22, 0x78, 0x95,
+	0xb6, 0x42, 0x28, 0x25, 0xa1, 0x50, 0x3f, 0xd0, 0x5a, 0xdb, 0xeb, 0xd1, 0x9e, 0xb0, 0x64, 0xea,
+	0xea, 0x87, 0x44, 0x81, 0x4e, 0x87, 0xaa, 0xbf, 0xb2, 0xea, 0x0a, 0x03, 0xa7, 0x5a, 0x2b, 0x95,
+	0xe9, 0x17, 0x52, 0x1a, 0xfb, 0x3a, 0xa9, 0x04, 0x74, 0x3f, 0x71, 0xaa, 0x47, 0xae, 0x31, 0xb7,
+	0x9d, 0xd3, 0xfd, 0x04, 0x18, 0x07, 0xf7, 0xbf, 0x5b, 0x64, 0x56, 0x6f, 0xe0, 0x67, 0xb0, 0x2d,
+	0xc7, 0xe6, 0xb6, 0xbc, 0x5e, 0xec, 0x80, 0x1a, 0xb3, 0x17, 0xff, 0xbb, 0x12, 0x99, 0xd1, 0xc9,
+	0x60, 0x18, 0x1c, 0xc2, 0x68, 0xfd, 0x3a, 0xa9, 0x0e, 0x76, 0xbd, 0x58, 0x9e, 0xc7, 0x2e, 0x48,
+	0xd6, 0x9b, 0x08, 0xc4, 0x5d, 0x5b, 0xb2, 0x63, 0x00, 0xe0, 0xc4, 0xf6, 0x97, 0x48, 0x23, 0x4e,
+	0xbc, 0x28, 0xa1, 0x9d, 0x45, 0x69, 0xac, 0x3a, 0x4a, 0x17, 0xa5, 0x36, 0x47, 0xc9, 0x04, 0x52,
+	0x7e, 0xf6, 0x97, 0x09, 0xd9, 0xf1, 0x03, 0x3f, 0xde, 0x65, 0xdc, 0x2b, 0x47, 0xe6, 0xae, 0xd6,
+	0x98, 0x15, 0xc5, 0x05, 0x34, 0x8e, 0xba, 0x4e, 0x50, 0x7d, 0x8c, 0x4e, 0xf0, 0xa0, 0x6e, 0x8e,
+	0x1d, 0x5c, 0x35, 0xd0, 0x0a, 0x3f, 0x75, 0x4f, 0x03, 0x88, 0x01, 0xb4, 0x5e, 0x9c, 0xd6, 0xc9,
+	0x96, 0xaa, 0x4f, 0xc9, 0x1d, 0x52, 0x87, 0x3e, 0xcc, 0xfc, 0x06, 0xa3, 0x26, 0x78, 0x40, 0x41,
+	0x4f, 0x5d, 0x67, 0xd8, 0x93, 0x1d, 0xaa, 0x86, 0x69, 0x4b, 0xc0, 0x41, 0x51, 0xd8, 0xef, 0x92,
+	0x93, 0xda, 0x89, 0x74, 0x93, 0xf9, 0x2f, 0x85, 0x9a, 0xb4, 0x20, 0x8a, 0x9d, 0x5c, 0xca, 0x12,
+	0x3c, 0xcc, 0x03, 0xc2, 0x28, 0x23, 0x6e, 0x35, 0x8f, 0x51, 0x91, 0x71, 0x2a, 0xe6, 0xe1, 0xbd,
+	0xc5, 0xc1, 0x20, 0xf1, 0xf6, 0xdb, 0xe4, 0x1c, 0xeb, 0x7e, 0x3f, 0xe8, 0x2e, 0x53, 0xaf, 0xd3,
+	0xf3, 0x03, 0x3c, 0xe0, 0x85, 0x41, 0x27, 0x66, 0x3d, 0x54, 0x6e, 0xbe, 0xf0, 0xe0, 0xfe, 0xfc,
+	0xb9, 0x56, 0x3e, 0x09, 0x8c, 0x2b, 0x6b, 0x7f, 0x99, 0xcc, 0xc5, 0xc3, 0x76, 0x9b, 0xc6, 0xf1,
+	0xce, 0xb0, 0xf7, 0x66, 0xb8, 0x1d, 0x5f, 0xf7, 0x63, 0x3c, 0x9d, 0xde, 0xf2, 0xfb, 0x7e, 0xc2,
+	0xec, 0x7f, 0xd5, 0xe6, 0x85, 0x07, 0xf7, 0xe7, 0xe7, 0x5a, 0x63, 0xa9, 0xe0, 0x11, 0x1c, 0x6c,
+	0x20, 0x67, 0xb9, 0x3a, 0x31, 0xc2, 0x7b, 0x82, 0xf1, 0x9e, 0x7b, 0x70, 0x7f, 0xfe, 0xec, 0x4a,
+	0x2e, 0x05, 0x8c, 0x29, 0x89, 0x3d, 0x88, 0x0e, 0xd7, 0x0f, 0xd0, 0xb7, 0x58, 0x37, 0x7b, 0x70,
+	0x4b, 0xc0, 0x41, 0x51, 0xd8, 0xef, 0xa5, 0x23, 0x11, 0x97, 0x20, 0xa7, 0xf1, 0x84, 0xdb, 0xf2,
+	0x69, 0xf4, 0xf2, 0xdc, 0xd1, 0x38, 0xe1, 0x32, 0x06, 0x06, 0x6f, 0xfb, 0xff, 0x27, 0x0d, 0x39,
+	0x72, 0x62, 0x87, 0x30, 0xed, 0x84, 0x1d, 0x07, 0xe5, 0xc0, 0x42, 0xbf, 0x81, 0xfc, 0x17, 0x17,
+	0x9e, 0x7b, 0xbb, 0x54, 0xba, 0x31, 0xd4, 0xc2, 0x73, 0x67, 0x97, 0x06, 0xc0, 0x30, 0xf6, 0xd7,
+	0x2d, 0x42, 0xa8, 0xd2, 0x7b, 0x98, 0xdf, 0x62, 0xf2, 0xca, 0x66, 0x31, 0x2b, 0x65, 0xaa, 0x4f,
+	0x35, 0x4f, 0xe0, 0x42, 0x90, 0xfe, 0x06, 0x4d, 0xa6, 0xfd, 0x53, 0x16, 0x99, 0x8a, 0x93, 0x50,
+	0xb9, 0x42, 0x9d, 0xe9, 0xa2, 0x26, 0x72, 0x4b, 0xe3, 0xca, 0x15, 0x7c, 0x1d, 0x02, 0x86, 0x54,
+	0xf7, 0x87, 0x35, 0x62, 0x8f, 0x2a, 0x0c, 0xf6, 0x4d, 0x52, 0xf3, 0xda, 0x09, 0x3a, 0xc7, 0xb8,
+	0xdf, 0xf5, 0xa5, 0x3c, 0xfd, 0x8e, 0xf7, 0x21, 0xd0, 0x1d, 0x8a, 0x53, 0x8f, 0xa6, 0x5a, 0xc6,
+	0x22, 0x2b, 0x0a, 0x82, 0x85, 0x1d, 0x92, 0x93, 0x3d, 0x2f, 0x4e, 0x64, 0x5f, 0x75, 0x70, 0x2c,
+	0x3d, 0x81, 0x36, 0x70, 0x06, 0x97, 0x84, 0x5b, 0x59, 0x46, 0x30, 0xca, 0x1b, 0x3d, 0xc7, 0x6d,
+	0x79, 0x26, 0x93, 0x87, 0x82, 0x9b, 0x85, 0x9c, 0x4d, 0x38, 0x4f, 0xe3, 0x5c, 0x22, 0xc4, 0x80,
+	0x26, 0x12, 0xc7, 0x57, 0x7d, 0x5b, 0xe8, 0x52, 0x4e, 0xa5, 0x28, 0xbb, 0x40, 0x9e, 0xa6, 0xc6,
+	0x2d, 0x83, 0xf2, 0x17, 0x28, 0xa9, 0xcc, 0xdb, 0x86, 0xab, 0x07, 0xed, 0xd0, 0x8e, 0x58, 0xc8,
+	0xd2, 0x9d, 0x4f, 0x22, 0x20, 0xa5, 0xd1, 0x4e, 0x2f, 0x35, 0x46, 0x3d, 0xe6, 0xf4, 0x62, 0xaf,
+	0x91, 0x53, 0xed, 0x30, 0x88, 0x69, 0x7b, 0x88, 0x9d, 0x8b, 0xc8, 0x61, 0x44, 0x63, 0xb6, 0xea,
+	0x94, 0x9b, 0x2f, 0x88, 0x42, 0xa7, 0x96, 0x46, 0x49, 0x20, 0xaf, 0x9c, 0xfd, 0xd3, 0x16, 0x21,
+	0x11, 0x6d, 0xd3, 0x20, 0x81, 0x61, 0x80, 0x1e, 0x84, 0x72, 0x31, 0xe6, 0xc4, 0x8c, 0x36, 0x92,
+	0x76, 0x19, 0x28, 0x61, 0xa0, 0x09, 0xc6, 0x41, 0x8a, 0x1a, 0x9b, 0x39, 0x48, 0x1b, 0x4f, 0x36,
+	0x48, 0xd7, 0xb3, 0x8c, 0x60, 0x94, 0xb7, 0xfb, 0x8f, 0x26, 0xc8, 0xc4, 0xf2, 0xe2, 0xea, 0x96,
+	0x17, 0xdf, 0x3d, 0x84, 0xaa, 0x84, 0x4b, 0xb3, 0x38, 0x7b, 0x67, 0x37, 0x57, 0x79, 0x26, 0x07,
+	0x45, 0x61, 0x7f, 0x88, 0x91, 0x0b, 0x22, 0x8e, 0x42, 0xa8, 0x48, 0x37, 0x8b, 0xb0, 0xd0, 0x0a,
+	0x96, 0x7a, 0xe8, 0x82, 0x00, 0x41, 0x2a, 0x10, 0x47, 0xff, 0xa4, 0xac, 0x0a, 0x1e, 0x11, 0x2b,
+	0x85, 0x45, 0xc4, 0xa4, 0x4c, 0xb9, 0x9f, 0x4d, 0x03, 0x80, 0x2e, 0x72, 0xc4, 0xda, 0x51, 0x3d,
+	0x8c, 0xb5, 0xc3, 0xbe, 0x47, 0x1a, 0xf7, 0xfc, 0x64, 0x97, 0x69, 0xb6, 0x4e, 0x8d, 0x8d, 0xc4,
+	0x95, 0xa7, 0xaf, 0x35, 0xb2, 0x4b, 0x5b, 0xec, 0x8e, 0x14, 0x00, 0xa9, 0x2c, 0x9c, 0xac, 0xf8,
+	0x83, 0x1d, 0x46, 0x9c, 0x09, 0xf3, 0x84, 0x72, 0x47, 0x22, 0x20, 0xa5, 0xc1, 0x26, 0x9e, 0xc2,
+	0x5f, 0x2d, 0xfa, 0xfe, 0x10, 0xd7, 0x5e, 0xa7, 0x5e, 0x94, 0x3b, 0x4a, 0x72, 0xe4, 0x8d, 0x75,
+	0x47, 0x93, 0x01, 0x86, 0x44, 0xb5, 0xcb, 0x36, 0xc6, 0xee, 0xb2, 0x1f, 0x72, 0x13, 0x11, 0x37,
+	0xa1, 0x38, 0xa4, 0x28, 0xef, 0x75, 0x6a, 0x96, 0xe1, 0x1b, 0x6c, 0xfa, 0x1b, 0x34, 0x79, 0xb8,
+	0x9e, 0xe1, 0x66, 0xec, 0x27, 0x42, 0x0f, 0x50, 0xeb, 0xd9, 0x06, 0x83, 0x82, 0xc0, 0x72, 0x3f,
+	0x0f, 0x0e, 0x82, 0x58, 0xc4, 0x2f, 0x68, 0x7e, 0x1e, 0x06, 0x06, 0x89, 0x77, 0xff, 0xad, 0x45,
+	0x26, 0x71, 0xca, 0xca, 0x69, 0xf6, 0x0a, 0xa9, 0x25, 0x5e, 0xd4, 0x15, 0xce, 0x05, 0x4d, 0xc4,
+	0x16, 0x83, 0x82, 0xc0, 0xda, 0x01, 0xa9, 0x26, 0x5e, 0x7c, 0x57, 0x1e, 0xc9, 0x6e, 0x3c, 0x7d,
+	0x1b, 0x88, 0x85, 0x23, 0x3d, 0x8d, 0xe1, 0xaf, 0x18, 0xb8, 0x18, 0xfb, 0x55, 0x52, 0xc7, 0xc5,
+	0x7a, 0xc5, 0x8b, 0xa5, 0xef, 0x8a, 0xed, 0x12, 0x2b, 0x02, 0x06, 0x0a, 0x8b, 0x71, 0x6c, 0x95,
+	0x65, 0x6e, 0xfd, 0xa8, 0x71, 0x9f, 0x93, 0x63, 0x15, 0xd5, 0x4f, 0xc8, 0xb7, 0xc5, 0x78, 0x6a,
+	0xf6, 0x07, 0xf6, 0x1b, 0x84, 0x2c, 0x74, 0x28, 0x9d, 0x48, 0x22, 0x2f, 0x88, 0x79, 0xbc, 0x04,
+	0xfa, 0x14, 0x78, 0x13, 0x15, 0x60, 0x06, 0xd9, 0x32, 0xf8, 0xb6, 0x12, 0x3a, 0x48, 0x23, 0x5a,
+	0x4c, 0x1c, 0x64, 0xea, 0x80, 0x11, 0x31, 0xe1, 0x30, 0x19, 0x0c, 0x55, 0xf8, 0x82, 0x38, 0x98,
+	0xa8, 0xf2, 0x1b, 0x06, 0x16, 0x32, 0xd4, 0xee, 0x3f, 0x2d, 0x13, 0x92, 0x7e, 0x3d, 0xc6, 0x1f,
+	0x4c, 0x7b, 0xba, 0x23, 0x59, 0xb4, 0xf1, 0x46, 0x71, 0x4e, 0x33, 0xc6, 0xb6, 0x79, 0x12, 0x8d,
+	0x7e, 0x06, 0x08, 0x4c, 0xc1, 0xa9, 0xbd, 0xb1, 0x34, 0xde, 0xde, 0x68, 0xd4, 0x97, 0xc5, 0xdd,
+	0x38, 0xe5, 0xa2, 0xeb, 0xcb, 0xd8, 0x9a, 0xf5, 0x65, 0x20, 0x30, 0x05, 0xdb, 0x81, 0x11, 0x28,
+	0xb2, 0x59, 0x4c, 0xa0, 0x88, 0x36, 0x30, 0x33, 0xa1, 0x22, 0xee, 0x67, 0x49, 0xf5, 0xda, 0x1e,
+	0x0d, 0xd8, 0x51, 0x28, 0x16, 0x26, 0xcb, 0xac, 0xb7, 0x4d, 0x9a, 0x32, 0x41, 0x51, 0x60, 0xa8,
+	0xc0, 0x09, 0x56, 0x0e, 0x98, 0xeb, 0x05, 0xcf, 0x52, 0x2f, 0x91, 0x6a, 0x0f, 0xff, 0x61, 0xa5,
+	0xab, 0x69, 0x4b, 0x33, 0x2c, 0x70, 0x9c, 0x0d, 0xa4, 0x36, 0xa0, 0x91, 0x1f, 0x76, 0x9c, 0xd2,
+	0x51, 0xec, 0x40, 0xd2, 0xb1, 0xc6, 0x7d, 0x74, 0x9b, 0x8c, 0x03, 0x08, 0x4e, 0xee, 0xbb, 0xe4,
+	0xc4, 0xb5, 0x7d, 0xd4, 0xb2, 0xc2, 0x88, 0x9b, 0x59, 0xed, 0x37, 0x89, 0x1d, 0xd3, 0x68, 0xcf,
+	0x6f, 0xd3, 0xc5, 0x76, 0x1b, 0x0d, 0xcf, 0xeb, 0xa9, 0xae, 0x31, 0x27, 0xea, 0x65, 0xb7, 0x46,
+	0x28, 0x20, 0xa7, 0x94, 0xfb, 0xf7, 0x2d, 0x32, 0xa9, 0x85, 0x4c, 0xa0, 0xa6, 0xd1, 0x5d, 0x6a,
+	0x71, 0xb3, 0xb4, 0x63, 0x15, 0xa5, 0x69, 0xac, 0x4a, 0x96, 0xe9, 0x36, 0xa8, 0x40, 0x90, 0x0a,
+	0x7c, 0x4c, 0x30, 0x83, 0xfb, 0x5b, 0x25, 0x92, 0x96, 0xc3, 0xd5, 0x7a, 0x3b, 0xad, 0xa7, 0xb6,
+	0x5a, 0x0b, 0xbe, 0x02, 0x6b, 0x7f, 0x48, 0xce, 0x99, 0x1f, 0xce, 0xcc, 0xd7, 0x47, 0x77, 0xf0,
+	0x72, 0xbb, 0x41, 0x3e, 0x27, 0x18, 0x27, 0x02, 0x77, 0xf6, 0x46, 0x2c, 0x23, 0x07, 0xc4, 0xc4,
+	0x6b, 0x15, 0x37, 0xf1, 0x54, 0x50, 0x82, 0x38, 0x3f, 0xcb, 0x9f, 0x90, 0x0a, 0x75, 0x6f, 0x93,
+	0xea, 0xaa, 0x37, 0xec, 0xd2, 0x43, 0x79, 0x27, 0x70, 0xb3, 0x89, 0xa8, 0xd7, 0x4b, 0xe4, 0xa1,
+	0x4e, 0x6c, 0x36, 0x20, 0x60, 0xa0, 0xb0, 0xee, 0x6f, 0x56, 0xc8, 0xa4, 0x16, 0x13, 0x86, 0x1a,
+	0x44, 0x44, 0x07, 0x61, 0x56, 0xeb, 0x05, 0x3a, 0x08, 0x81, 0x61, 0x70, 0x16, 0x46, 0x74, 0xcf,
+	0x8f, 0xf9, 0xc6, 0x60, 0xcc, 0x42, 0x10, 0x70, 0x50, 0x14, 0xcc, 0x7d, 0x41, 0x07, 0xc9, 0x2e,
+	0x6b, 0xb5, 0x8a, 0x70, 0x5f, 0x20, 0x00, 0x38, 0x1c, 0x09, 0x76, 0x68, 0xd2, 0xde, 0x75, 0x2a,
+	0xa9, 0x7f, 0x63, 0x05, 0x01, 0xc0, 0xe1, 0x39, 0x51, 0x03, 0xd5, 0xe3, 0x8f, 0x1a, 0xa8, 0x15,
+	0x1c, 0x35, 0x60, 0x0f, 0xc8, 0xa9, 0x38, 0xde, 0xdd, 0x8c, 0xfc, 0x3d, 0x2f, 0xa1, 0xe9, 0xe0,
+	0x9d, 0x38, 0x8a, 0x9c, 0x73, 0x78, 0x88, 0x6b, 0xb5, 0xae, 0x67, 0xb9, 0x40, 0x1e, 0x6b, 0xbb,
+	0x45, 0xce, 0xf8, 0xec, 0x68, 0x17, 0xd1, 0x1b, 0xdd, 0x20, 0x8c, 0xe8, 0xf5, 0x30, 0x46, 0x76,
+	0x22, 0xbe, 0x55, 0x45, 0xec, 0xdc, 0xc8, 0x23, 0x82, 0xfc, 0xb2, 0xee, 0xf7, 0x2d, 0x32, 0xa5,
+	0x87, 0xb7, 0x31, 0xab, 0xcd, 0xee, 0xf2, 0x4a, 0x8b, 0x2f, 0x6b, 0xc5, 0x29, 0x2a, 0xd7, 0x15,
+	0xcf, 0xf4, 0x94, 0x98, 0xc2, 0x40, 0x93, 0x79, 0x88, 0x30, 0xeb, 0x97, 0x48, 0x75, 0x27, 0x44,
+	0x3d, 0xaa, 0x6c, 0x7a, 0x0a, 0x57, 0x10, 0x08, 0x1c, 0xe7, 0xfe, 0xa1, 0x45, 0x34, 0x09, 0xf6,
+	0xcf, 0x59, 0x64, 0x1a, 0x85, 0xdc, 0x8c, 0xb6, 0x8d, 0x6f, 0xdb, 0x28, 0xe6, 0xdb, 0x14, 0xdb,
+	0xd4, 0x33, 0x68, 0x80, 0xc1, 0x14, 0x8e, 0xc6, 0x36, 0xaf, 0xd3, 0x89, 0x68, 0x1c, 0x2b, 0x3f,
+	0x31, 0x5b, 0x2c, 0x16, 0x25, 0x10, 0x52, 0x3c, 0x4e, 0x51, 0x8c, 0x35, 0xc4, 0x51, 0xef, 0x94,
+	0xcd, 0x29, 0x8a, 0x42, 0x10, 0x0e, 0x8a, 0xc2, 0xfd, 0xf9, 0x0a, 0x31, 0x65, 0xdb, 0x1d, 0x32,
+	0x73, 0x37, 0xda, 0x5e, 0x62, 0xf1, 0x21, 0x4f, 0x12, 0xa9, 0xc3, 0xfc, 0x89, 0x37, 0x4d, 0x0e,
+	0x90, 0x65, 0x29, 0xa4, 0xdc, 0xa4, 0x07, 0x89, 0xb7, 0xfd, 0x24, 0x6b, 0xb9, 0x94, 0xa2, 0x73,
+	0x80, 0x2c, 0x4b, 0xf4, 0x4f, 0xdd, 0x8d, 0xb6, 0xe5, 0x02, 0x90, 0x0d, 0x8f, 0xb9, 0x99, 0xa2,
+	0x40, 0xa7, 0xc3, 0x26, 0xbc, 0x1b, 0x6d, 0xe3, 0x82, 0x29, 0xe3, 0xef, 0x55, 0x13, 0xde, 0x14,
+	0x70, 0x50, 0x14, 0xf6, 0x80, 0xd8, 0x77, 0x65, 0xeb, 0x29, 0x3f, 0xaa, 0x53, 0x3d, 0xa2, 0x1b,
+	0xf6, 0x2c, 0xee, 0xf9, 0x37, 0x47, 0xf8, 0x40, 0x0e, 0x6f, 0xfb, 0x8b, 0xe4, 0xdc, 0xdd, 0x68,
+	0x5b, 0xec, 0x64, 0x9b, 0x91, 0x1f, 0xb4, 0xfd, 0x81, 0x11, 0x6b, 0x3f, 0x2f, 0xaa, 0x7b, 0xee,
+	0x66, 0x3e, 0x19, 0x8c, 0x2b, 0xef, 0xfe, 0x6d, 0x9c, 0xe3, 0x5a, 0xec, 0xee, 0xe3, 0x22, 0xd0,
+	0x62, 0x32, 0xb1, 0x4b, 0xbd, 0x0e, 0x8d, 0xe4, 0x59, 0xea, 0x7a, 0x01, 0x53, 0x84, 0x31, 0x4c,
+	0x8f, 0x7d, 0xfc, 0x77, 0x0c, 0x52, 0x12, 0x06, 0xf5, 0x9d, 0x30, 0xf5, 0xc6, 0x4f, 0x64, 0x35,
+	0x37, 0x48, 0x8d, 0xc3, 0x0e, 0x61, 0x4e, 0x3a, 0xcc, 0x39, 0xc0, 0xfd, 0x75, 0x8b, 0x34, 0x98,
+	0x7f, 0xa0, 0x8b, 0x26, 0x07, 0x55, 0xa4, 0x3c, 0xbe, 0x08, 0x7e, 0x38, 0xd7, 0xa2, 0x64, 0x48,
+	0x48, 0x01, 0x1f, 0xce, 0xef, 0x6b, 0xa5, 0x1f, 0xce, 0xd5, 0xb5, 0x18, 0xa4, 0x24, 0xf7, 0x67,
+	0x4a, 0xa4, 0x76, 0x23, 0x18, 0x0c, 0xff, 0xc4, 0xdf, 0x19, 0x7a, 0x8b, 0x54, 0xd0, 0x9e, 0x64,
+	0x7f, 0x5e, 0xd7, 0xdb, 0xa6, 0x9a, 0x97, 0xf4, 0x6b, 0x6d, 0xe7, 0x8d, 0x6b, 0x6d, 0xec, 0x4f,
+	0x42, 0xf7, 0x93, 0x05, 0xbd, 0x1b, 0xb5, 0x80, 0xd6, 0x1e, 0xa9, 0xdc, 0xf2, 0x83, 0xbb, 0x87,
+	0x1b, 0x52, 0x71, 0x3b, 0x1c, 0x8c, 0x0c, 0xa9, 0x16, 0x02, 0x81, 0xe3, 0xe4, 0xbc, 0x29, 0xe7,
+	0xcf, 0x1b, 0xf7, 0x1b, 0x16, 0x39, 0xb9, 0x46, 0xfb, 0xa1, 0xff, 0x81, 0x97, 0xc6, 0x53, 0x61,
+	0xa1, 0x5d, 0x71, 0x90, 0xaa, 0xa7, 0x85, 0xae, 0xe3, 0x65, 0x82, 0x5d, 0xff, 0x71, 0x87, 0x00,
+	0x16, 0x21, 0x8d, 0x3b, 0xc1, 0x7a, 0xba, 0x24, 0xa7, 0x91, 0x52, 0x12, 0x01, 0x29, 0x8d, 0xfb,
+	0xdb, 0x16, 0x99, 0xe0, 0x95, 0xa0, 0x92, 0xb7, 0x35, 0x86, 0xf7, 0x2e, 0xa9, 0xb2, 0x72, 0x62,
+	0x33, 0x59, 0x2d, 0xc0, 0xb8, 0x85, 0xec, 0xb8, 0x66, 0xca, 0xfe, 0x05, 0x2e, 0x00, 0x0f, 0x2f,
+	0x7d, 0x6f, 0x7f, 0x51, 0x85, 0x92, 0xa9, 0xc3, 0xcb, 0x1a, 0x83, 0x82, 0xc0, 0xba, 0xdf, 0x29,
+	0x93, 0xba, 0xf4, 0xa1, 0xd9, 0xbf, 0x88, 0x17, 0x1f, 0x82, 0x20, 0x4c, 0x3c, 0xee, 0x09, 0xe1,
+	0xf3, 0xe1, 0x4b, 0x4f, 0x5f, 0x4b, 0x29, 0x61, 0x61, 0x31, 0xe5, 0x7e, 0x2d, 0x48, 0xa2, 0x83,
+	0x74, 0xb7, 0xd3, 0x30, 0xa0, 0x57, 0xc2, 0xfe, 0x1a, 0xa9, 0xf5, 0xbc, 0x6d, 0xda, 0x93, 0xd3,
+	0xe3, 0x76, 0x81, 0xd5, 0xb9, 0xc5, 0x18, 0xf3, 0x9a, 0xa8, 0x16, 0xe2, 0x40, 0x10, 0x52, 0xe7,
+	0x7e, 0x9c, 0xcc, 0x66, 0x6b, 0x6d, 0xcf, 0x6a, 0xdd, 0xcc, 0x7b, 0xf6, 0xb4, 0xb1, 0x40, 0xca,
+	0x79, 0x51, 0x7a, 0xc3, 0x9a, 0xfb, 0x33, 0x64, 0x52, 0x13, 0x73, 0x94, 0xa2, 0xee, 0x5b, 0x64,
+	0x72, 0x8d, 0x26, 0x91, 0xdf, 0x66, 0x0c, 0x1e, 0x37, 0xb8, 0x0e, 0xb5, 0x46, 0xff, 0x2c, 0x1b,
+	0xac, 0xc8, 0x33, 0x46, 0x7b, 0xeb, 0x20, 0x0a, 0xfb, 0x34, 0xd9, 0xa5, 0x43, 0xd9, 0xd9, 0x05,
+	0xa8, 0xc7, 0x9b, 0x8a, 0x27, 0xb7, 0xb7, 0xa6, 0xbf, 0x41, 0x93, 0xe7, 0x5e, 0x22, 0xd5, 0xb5,
+	0x61, 0x42, 0xf7, 0x1f, 0xbf, 0x54, 0xb8, 0x5f, 0x22, 0x53, 0x8c, 0xf4, 0x7a, 0xd8, 0xc3, 0x95,
+	0x08, 0xbf, 0xb4, 0x8f, 0xbf, 0xb3, 0xe7, 0x4c, 0x46, 0x04, 0x1c, 0x87, 0x33, 0x60, 0x37, 0xec,
+	0x75, 0x68, 0x24, 0xda, 0x43, 0xf5, 0xef, 0x75, 0x06, 0x05, 0x81, 0x75, 0x7f, 0xb2, 0x44, 0x26,
+	0x59, 0x41, 0xb1, 0x7a, 0x1c, 0x90, 0x89, 0x5d, 0x2e, 0x47, 0x34, 0x49, 0x01, 0x2e, 0x56, 0xbd,
+	0xf6, 0xda, 0x8e, 0xcc, 0x01, 0x20, 0xe5, 0xa1, 0xe8, 0x7b, 0x9e, 0x8f, 0xd1, 0x01, 0x4e, 0xe9,
+	0x78, 0x45, 0xdf, 0xe1, 0x62, 0x40, 0xca, 0x73, 0xff, 0xc7, 0x0c, 0x21, 0x18, 0x42, 0x29, 0x1a,
+	0x61, 0x8e, 0x94, 0xfc, 0x8e, 0x68, 0x5e, 0x15, 0x55, 0x75, 0x63, 0x19, 0x4a, 0x7e, 0x47, 0xf5,
+	0x57, 0x69, 0xec, 0xd2, 0xfe, 0x59, 0x32, 0xd9, 0xf1, 0xe3, 0x41, 0xcf, 0x3b, 0x58, 0xcf, 0xd1,
+	0x6b, 0x97, 0x53, 0x14, 0xe8, 0x74, 0xf6, 0xa7, 0x45, 0x48, 0x2e, 0xd7, 0x69, 0x9d, 0x4c, 0x48,
+	0x6e, 0x1d, 0xab, 0xa7, 0x45, 0xe3, 0xbe, 0x41, 0xa6, 0xa4, 0x07, 0x87, 0x49, 0xe1, 0xe1, 0x31,
+	0x2a, 0x54, 0x73, 0x4b, 0xc3, 0x81, 0x41, 0x39, 0xe2, 0x6f, 0xaa, 0x3d, 0x7b, 0x7f, 0xd3, 0xe7,
+	0xc8, 0xb4, 0xfc, 0xc9, 0xf6, 0x3b, 0xe7, 0x34, 0xab, 0xbd, 0x3a, 0x6f, 0x6d, 0xe9, 0x48, 0x30,
+	0x69, 0xed, 0x1f, 0x93, 0x61, 0x50, 0x13, 0x86, 0x49, 0x4e, 0x85, 0x41, 0x35, 0xb0, 0xa5, 0x8c,
+	0x10, 0xa8, 0x2b, 0x84, 0x6c, 0x87, 0xc3, 0xa0, 0xe3, 0x45, 0x07, 0x37, 0x96, 0x45, 0xa8, 0x86,
+	0xd2, 0x4c, 0x9a, 0x0a, 0x03, 0x1a, 0x95, 0x1e, 0x79, 0xd4, 0x78, 0x74, 0xe4, 0x91, 0x19, 0x61,
+	0x45, 0x8e, 0x35, 0xc2, 0x6a, 0xb2, 0xf0, 0x08, 0xab, 0x77, 0xc9, 0x49, 0x1a, 0x27, 0x7e, 0x1f,
+	0x2f, 0xfd, 0xab, 0x9b, 0x0a, 0x0e, 0xf3, 0x4e, 0xab, 0xc0, 0xa2, 0x6b, 0x59, 0x82, 0x87, 0x79,
+	0x40, 0x18, 0x65, 0x64, 0xbf, 0x41, 0xea, 0x83, 0x28, 0xec, 0xe2, 0xe1, 0xd7, 0x99, 0x33, 0x6e,
+	0x82, 0xd6, 0x37, 0x05, 0xfc, 0xa1, 0xf6, 0x3f, 0x28, 0x6a, 0xfb, 0x8f, 0x2c, 0x72, 0x52, 0x5e,
+	0xec, 0x89, 0x55, 0xc5, 0xce, 0xb0, 0x75, 0xa1, 0x5d, 0xc4, 0x15, 0x7e, 0x39, 0xd9, 0x17, 0x20,
+	0x2b, 0x85, 0x6f, 0x88, 0x54, 0x7e, 0xfd, 0x08, 0xfe, 0x61, 0x1e, 0xf0, 0x1b, 0xbf, 0x3f, 0x3f,
+	0x3f, 0x9a, 0x85, 0x42, 0x31, 0xc7, 0x99, 0xf7, 0x97, 0x7f, 0x7f, 0x7e, 0x56, 0xfe, 0x4e, 0x1b,
+	0x6d, 0xe4, 0x23, 0x71, 0x7d, 0x1f, 0x84, 0x9d, 0x1b, 0x9b, 0xce, 0x94, 0xb9, 0xbe, 0x6f, 0x22,
+	0x10, 0x38, 0x0e, 0xed, 0x88, 0x1d, 0x8f, 0xf6, 0xc3, 0x80, 0x76, 0x9c, 0xe9, 0xd4, 0x8e, 0xb8,
+	0x2c, 0x60, 0xa0, 0xb0, 0x76, 0x8f, 0xd4, 0x7c, 0xa6, 0xee, 0x3b, 0x27, 0x2e, 0x5a, 0xc5, 0x9c,
+	0x31, 0xf8, 0xf1, 0x81, 0xdb, 0xd3, 0xf9, 0xff, 0x20, 0x64, 0xd8, 0x03, 0x32, 0xc1, 0xdd, 0x3b,
+	0xb1, 0x33, 0x73, 0xd1, 0x2a, 0xc6, 0x7d, 0xc7, 0xfd, 0x47, 0x31, 0xbf, 0x20, 0x2e, 0x7e, 0x80,
+	0x14, 0x83, 0x2d, 0xd1, 0xde, 0xf5, 0x7b, 0x9d, 0x88, 0x06, 0xce, 0x2c, 0x33, 0xbf, 0xb0, 0x96,
+	0x58, 0x12, 0x30, 0x50, 0x58, 0xfb, 0x4f, 0x93, 0xe9, 0x70, 0x98, 0xb0, 0x49, 0x8e, 0xfd, 0x1f,
+	0x3b, 0x27, 0x19, 0x39, 0xf3, 0xab, 0x6c, 0xe8, 0x08, 0x30, 0xe9, 0x70, 0xb1, 0xdd, 0x0d, 0xe3,
+	0x04, 0x7f, 0xb0, 0xc5, 0xf6, 0xac, 0xb9, 0xd8, 0x5e, 0xd7, 0x70, 0x60, 0x50, 0x62, 0x00, 0xe2,
+	0xc9, 0x7e, 0x56, 0x45, 0x77, 0xce, 0x15, 0x65, 0xa7, 0x1e, 0xd1, 0xfe, 0x79, 0x44, 0xc5, 0x08,
+	0x18, 0x46, 0x2b, 0xc1, 0x6e, 0x24, 0xc6, 0x07, 0x41, 0x7b, 0x37, 0x0a, 0x03, 0xb3, 0x7a, 0xcf,
+	0x5f, 0xb4, 0x8a, 0x51, 0x7c, 0xd9, 0x2c, 0xcb, 0x13, 0xd1, 0x7c, 0x1e, 0xed, 0x9b, 0xb9, 0x28,
+	0xc8, 0xaf, 0xd4, 0xdc, 0x32, 0x39, 0x9b, 0x3f, 0x53, 0x1f, 0xa7, 0x53, 0x96, 0x75, 0x9d, 0x72,
+	0x85, 0x3c, 0x3f, 0xb6, 0x52, 0xb8, 0xe6, 0x4b, 0x05, 0xc4, 0x32, 0xd7, 0xfc, 0x11, 0x85, 0xe1,
+	0x04, 0x99, 0xd2, 0xb3, 0x80, 0x30, 0x47, 0xcf, 0x46, 0xcb, 0x70, 0xf4, 0x84, 0xad, 0xc2, 0x1d,
+	0x3d, 0x1b, 0xad, 0x11, 0x47, 0x8f, 0x02, 0x41, 0x2a, 0xf0, 0x71, 0x8e, 0x9e, 0xdf, 0xa9, 0x90,
+	0xb4, 0x1c, 0xda, 0xd3, 0x68, 0xd0, 0x19, 0x84, 0x7e, 0x90, 0x64, 0x7d, 0x77, 0xd7, 0x04, 0x1c,
+	0x14, 0x85, 0xe6, 0x16, 0x2a, 0x3d, 0xd2, 0x2d, 0xd4, 0x21, 0x33, 0x1e, 0x8b, 0xc6, 0x4c, 0x2d,
+	0xea, 0xe5, 0x23, 0x9b, 0x10, 0x17, 0x4d, 0x0e, 0x90, 0x65, 0x89, 0x52, 0xe2, 0xb4, 0x28, 0x93,
+	0x52, 0x39, 0xb2, 0x94, 0x96, 0xc9, 0x01, 0xb2, 0x2c, 0xed, 0x77, 0x89, 0xd3, 0x66, 0x37, 0x4a,
+	0xf8, 0x37, 0xde, 0xd8, 0x59, 0x0f, 0x93, 0xcd, 0x88, 0xc6, 0x34, 0xe0, 0x1e, 0x8f, 0x7a, 0xf3,
+	0xa2, 0x68, 0x05, 0x67, 0x69, 0x0c, 0x1d, 0x8c, 0xe5, 0x80, 0xca, 0x10, 0xb3, 0xe7, 0xfb, 0xc9,
+	0xc1, 0x56, 0x78, 0x97, 0x06, 0x4e, 0xcd, 0x54, 0x86, 0x5a, 0x3a, 0x12, 0x4c, 0xda, 0x8c, 0xff,
+	0x6b, 0xe2, 0xe3, 0xf0, 0x7f, 0xfd, 0xc7, 0x12, 0x91, 0x8b, 0xf2, 0x9f, 0x6c, 0x83, 0x92, 0xed,
+	0x92, 0x5a, 0x44, 0x63, 0x79, 0x05, 0xba, 0xc1, 0xf7, 0x47, 0x60, 0x10, 0x10, 0x18, 0xdc, 0xad,
+	0xe8, 0xbe, 0x9f, 0x2c, 0x61, 0xca, 0x11, 0x91, 0x3d, 0x86, 0xcd, 0x34, 0x01, 0x03, 0x85, 0x75,
+	0x7f, 0xca, 0x22, 0xd3, 0xf2, 0x52, 0x06, 0xc6, 0x63, 0xc4, 0x78, 0x57, 0x21, 0xc6, 0x7f, 0x8a,
+	0x3b, 0x99, 0xa5, 0x71, 0xac, 0x74, 0xa0, 0xd9, 0xa0, 0x50, 0x08, 0x70, 0x59, 0xee, 0xb7, 0x2b,
+	0x24, 0xbd, 0xf6, 0x71, 0x08, 0xc3, 0xd6, 0x95, 0xf4, 0x22, 0x38, 0x5f, 0x21, 0x1c, 0xed, 0x12,
+	0x38, 0xaa, 0xe7, 0x8b, 0xc1, 0x01, 0xbf, 0x33, 0xab, 0x6e, 0x84, 0xdb, 0x9f, 0x36, 0x8d, 0xa5,
+	0x67, 0x75, 0x0b, 0x9c, 0x46, 0xcf, 0x89, 0xec, 0x7d, 0xd2, 0x60, 0xff, 0xac, 0xc8, 0x0c, 0x3c,
+	0x85, 0x8c, 0xb1, 0xdb, 0x92, 0x25, 0x1f, 0xea, 0xea, 0x27, 0xa4, 0xc2, 0x32, 0x99, 0x73, 0xaa,
+	0x87, 0xca, 0x9c, 0x73, 0x89, 0x54, 0x68, 0x30, 0xec, 0xb3, 0x00, 0xb9, 0x06, 0xdb, 0x9e, 0x2b,
+	0xd7, 0x82, 0x61, 0xdf, 0xfc, 0x32, 0x46, 0x62, 0x0f, 0x49, 0x8d, 0x27, 0xef, 0x2a, 0x2e, 0xc7,
+	0x8e, 0xea, 0xb9, 0x16, 0x63, 0xcc, 0x87, 0x24, 0xff, 0x1f, 0x84, 0x30, 0x16, 0xfb, 0x4a, 0x83,
+	0xd8, 0x67, 0x01, 0xcc, 0xdc, 0x05, 0x99, 0x9e, 0x49, 0x24, 0x02, 0x52, 0x1a, 0xf7, 0x7b, 0x13,
+	0x64, 0x26, 0xc3, 0xf8, 0x71, 0x17, 0x4a, 0x15, 0xb9, 0x76, 0x84, 0xbd, 0x44, 0x26, 0x06, 0x5e,
+	0x92, 0xd0, 0x48, 0x7a, 0xab, 0xd5, 0x76, 0xbb, 0xc9, 0xc1, 0x20, 0xf1, 0xe8, 0x63, 0xeb, 0xfb,
+	0xc1, 0x2d, 0x1a, 0x74, 0x85, 0xbf, 0xba, 0xcc, 0x7b, 0x69, 0x4d, 0x02, 0x21, 0xc5, 0x33, 0x62,
+	0x6f, 0x5f, 0x10, 0x57, 0x34, 0x62, 0x09, 0x84, 0x14, 0x6f, 0x87, 0x64, 0xa2, 0xef, 0x07, 0x7e,
+	0x7f, 0xd8, 0x77, 0xaa, 0x45, 0xa9, 0xc7, 0xc2, 0x04, 0xcf, 0xd4, 0xd5, 0x35, 0xce, 0x1c, 0xa4,
+	0x14, 0x26, 0xd0, 0xdb, 0x67, 0x02, 0x6b, 0xc7, 0x22, 0x90, 0x33, 0x07, 0x29, 0x05, 0x57, 0x9c,
+	0xbe, 0x1f, 0xf0, 0x28, 0x4d, 0x1e, 0x76, 0xcc, 0x56, 0x9c, 0x35, 0x01, 0x03, 0x85, 0x65, 0x94,
+	0xde, 0x3e, 0xa7, 0xac, 0x6b, 0x94, 0x02, 0x06, 0x0a, 0x6b, 0x47, 0xf2, 0xd6, 0x54, 0xe3, 0xb8,
+	0x06, 0x6a, 0x23, 0x7b, 0x69, 0xca, 0xfe, 0x25, 0x8b, 0x19, 0xec, 0x06, 0x34, 0x4a, 0x7c, 0x71,
+	0xad, 0x61, 0xf2, 0x8a, 0x57, 0xb8, 0xe4, 0x85, 0x4d, 0x25, 0x83, 0x1f, 0x04, 0xd3, 0x2d, 0x47,
+	0x21, 0x40, 0xab, 0x08, 0x8f, 0xe8, 0x78, 0x7f, 0xe8, 0x47, 0xb4, 0xe3, 0x4c, 0xa6, 0xe7, 0x0f,
+	0x10, 0x30, 0x50, 0xd8, 0xb9, 0x6f, 0x5b, 0x64, 0x26, 0xc3, 0x3d, 0x47, 0x79, 0xed, 0xea, 0xca,
+	0xeb, 0x71, 0xb4, 0xad, 0xae, 0x0f, 0xff, 0x96, 0x45, 0xf0, 0x5c, 0xb9, 0xba, 0x64, 0xff, 0x39,
+	0x52, 0x8f, 0xe5, 0xe5, 0x0a, 0x3e, 0x89, 0x7f, 0x44, 0x85, 0x70, 0x09, 0x38, 0x9b, 0xc8, 0x48,
+	0x2c, 0x01, 0xa0, 0x8a, 0xd8, 0x3d, 0x32, 0xcd, 0x2c, 0xc6, 0x52, 0xb5, 0x12, 0xb5, 0xbf, 0x7a,
+	0xc8, 0xab, 0x7a, 0x7a, 0x51, 0x7e, 0x20, 0x33, 0x40, 0x60, 0x32, 0x77, 0xff, 0x59, 0x85, 0x68,
+	0x86, 0xd5, 0x43, 0xec, 0x4a, 0xef, 0x67, 0xcc, 0xe8, 0x6b, 0x85, 0x98, 0xd1, 0xa5, 0x6d, 0x9a,
+	0x2f, 0xab, 0xa6, 0xe5, 0x1c, 0x2b, 0xb5, 0x4b, 0x7b, 0x03, 0xa7, 0x6c, 0x56, 0xea, 0x3a, 0xed,
+	0x0d, 0x80, 0x61, 0x54, 0x4c, 0x70, 0x65, 0x6c, 0x4c, 0xf0, 0x2e, 0xa9, 0x76, 0x31, 0xb6, 0xc8,
+	0xa9, 0x16, 0xe5, 0x31, 0x61, 0xa1, 0x4a, 0x7c, 0x76, 0xb1, 0x7f, 0x81, 0x0b, 0xc0, 0x4d, 0x75,
+	0x57, 0x3a, 0x2f, 0x9d, 0x5a, 0x51, 0x9b, 0xaa, 0xf2, 0x87, 0xf2, 0x15, 0x58, 0xfd, 0x84, 0x54,
+	0x18, 0x5a, 0x0c, 0xda, 0xfc, 0x7e, 0xb7, 0x33, 0x51, 0x94, 0xc5, 0x40, 0x5c, 0x18, 0xe7, 0x2b,
+	0xa2, 0xf8, 0x01, 0x52, 0x8c, 0x7b, 0x99, 0x4c, 0x6a, 0xb9, 0x99, 0xb0, 0x1b, 0xd4, 0xe5, 0x52,
+	0xad, 0x1b, 0xd0, 0x7f, 0x0d, 0x0c, 0xe3, 0xfe, 0x8d, 0x32, 0x51, 0x96, 0x1b, 0x3d, 0x9c, 0xd9,
+	0x6b, 0x6b, 0xf9, 0x45, 0x8c, 0xfb, 0x3c, 0x61, 0x00, 0x02, 0x8b, 0xfa, 0x7d, 0x9f, 0x46, 0x5d,
+	0x75, 0x56, 0x74, 0x4a, 0xa6, 0x7e, 0xbf, 0xa6, 0x23, 0xc1, 0xa4, 0xc5, 0xc3, 0x59, 0xdf, 0x0b,
+	0xfc, 0x1d, 0x1a, 0x27, 0xd9, 0x78, 0x91, 0x35, 0x01, 0x07, 0x45, 0x61, 0xaf, 0x92, 0x93, 0x31,
+	0x4d, 0x36, 0xee, 0x05, 0x34, 0x52, 0xf7, 0x8c, 0xc4, 0x8d, 0xbe, 0xe7, 0xa5, 0x39, 0xab, 0x95,
+	0x25, 0x80, 0xd1, 0x32, 0xf6, 0x32, 0x99, 0x15, 0x97, 0xe9, 0xd4, 0x95, 0x1d, 0xa7, 0x6a, 0xd8,
+	0xa5, 0x67, 0x5b, 0x19, 0x3c, 0x8c, 0x94, 0x40, 0x2e, 0x3b, 0xfc, 0xe2, 0x4a, 0xca, 0xa5, 0x66,
+	0x72, 0x59, 0xc9, 0xe0, 0x61, 0xa4, 0x04, 0x0b, 0x43, 0xeb, 0x79, 0x5d, 0xdc, 0xbc, 0xd2, 0x30,
+	0x34, 0x04, 0x00, 0x87, 0xbb, 0xbf, 0x51, 0x22, 0x53, 0xa8, 0x65, 0xf7, 0xe9, 0xa2, 0x6a, 0x71,
+	0x73, 0x2d, 0xb2, 0xcc, 0x16, 0x7f, 0xd4, 0xd2, 0x82, 0x6d, 0x18, 0x84, 0x1d, 0xba, 0xe2, 0xd3,
+	0x5e, 0xc7, 0x58, 0xcc, 0x1a, 0x69, 0x1b, 0xae, 0x67, 0x09, 0x60, 0xb4, 0x8c, 0xfd, 0x57, 0x2d,
+	0x32, 0xcb, 0x6d, 0x54, 0xe9, 0x59, 0xa5, 0xb8, 0xdb, 0x55, 0xe9, 0x91, 0x48, 0xb5, 0xe5, 0x46,
+	0x46, 0x18, 0x8c, 0x88, 0x77, 0xff, 0x81, 0x45, 0xa6, 0x81, 0x26, 0xd1, 0xc1, 0xe2, 0x0e, 0xda,
+	0x80, 0x93, 0x03, 0xfb, 0x57, 0x2d, 0x32, 0x8b, 0x75, 0x5f, 0x0c, 0x12, 0x5f, 0x02, 0x8b, 0x4b,
+	0x49, 0xc5, 0x64, 0xad, 0x67, 0xd8, 0xf3, 0x2b, 0x8c, 0x59, 0x28, 0x8c, 0x54, 0xc3, 0x3d, 0x47,
+	0xce, 0xe4, 0x32, 0x70, 0xbf, 0x5b, 0x16, 0x9f, 0xa1, 0xe6, 0xc9, 0x5b, 0x7a, 0xfc, 0xf0, 0x93,
+	0xe4, 0xef, 0x69, 0x8c, 0x44, 0x1b, 0x2f, 0x63, 0x7e, 0xc8, 0x24, 0x92, 0x97, 0x6d, 0xf9, 0x10,
+	0x70, 0xd3, 0xfc, 0x90, 0x0a, 0xf5, 0xd0, 0xfc, 0x09, 0x7a, 0x31, 0xfb, 0xab, 0x64, 0x62, 0x9b,
+	0xa7, 0x24, 0x72, 0xca, 0x45, 0xad, 0x6e, 0x22, 0xc7, 0x11, 0x3b, 0x27, 0xc9, 0x84, 0x47, 0x0f,
+	0xd3, 0x7f, 0x41, 0x4a, 0xb4, 0x0f, 0x48, 0xdd, 0x93, 0x7d, 0x5a, 0x29, 0x2a, 0x46, 0xce, 0x18,
+	0x3f, 0x5c, 0xd7, 0x51, 0x7d, 0xa8, 0xc4, 0x61, 0x34, 0x0c, 0x49, 0x13, 0x27, 0x62, 0x2e, 0xb8,
+	0xf8, 0xaa, 0x61, 0x0e, 0x2b, 0xe2, 0xf2, 0x8d, 0xe0, 0xa8, 0x05, 0x9b, 0x0b, 0x08, 0x28, 0x69,
+	0x8f, 0xb3, 0x85, 0xfd, 0x72, 0x8d, 0xa8, 0x52, 0xc7, 0x64, 0x0a, 0x7b, 0x05, 0xcd, 0x02, 0xdd,
+	0x34, 0x03, 0x94, 0xa2, 0x03, 0x06, 0x05, 0x81, 0x45, 0x45, 0x52, 0x86, 0x76, 0x8a, 0x45, 0x9b,
+	0x35, 0xae, 0x8c, 0x02, 0x05, 0x85, 0xcd, 0x33, 0xae, 0x55, 0x9f, 0x89, 0x71, 0xad, 0x56, 0xbc,
+	0x71, 0xed, 0x12, 0x99, 0x88, 0xc2, 0x1e, 0x5d, 0x84, 0x75, 0x67, 0xc2, 0x3c, 0x05, 0x02, 0x07,
+	0x83, 0xc4, 0xa3, 0x63, 0x75, 0x18, 0xd3, 0xd6, 0xf2, 0xcd, 0xa5, 0x88, 0x76, 0x62, 0x71, 0x54,
+	0x55, 0x8e, 0xd5, 0xb7, 0x53, 0x14, 0xe8, 0x74, 0xf6, 0x3f, 0xb6, 0x1e, 0x61, 0xbf, 0x6b, 0x14,
+	0xb5, 0xd4, 0xe5, 0xe6, 0x9c, 0x69, 0x9e, 0x7f, 0x42, 0xa3, 0xa0, 0x69, 0xd7, 0x23, 0x1f, 0x87,
+	0x5d, 0xef, 0x9b, 0x16, 0x39, 0xd1, 0x6a, 0x47, 0xfe, 0x20, 0x4d, 0x63, 0x54, 0x74, 0x96, 0xa5,
+	0x57, 0xd4, 0x35, 0xaa, 0xcc, 0x0c, 0x32, 0x2f, 0x3e, 0xb9, 0xef, 0x91, 0xd9, 0x16, 0xed, 0x7b,
+	0x83, 0x5d, 0x16, 0xef, 0xcc, 0xa3, 0x05, 0x98, 0xd5, 0x42, 0xc0, 0xb2, 0xa9, 0x1c, 0x15, 0x31,
+	0xa4, 0x34, 0xf6, 0xcb, 0x3c, 0xb2, 0x41, 0x46, 0x19, 0x36, 0xb8, 0x72, 0xc8, 0xc3, 0x21, 0x62,
+	0x90, 0x38, 0xf7, 0x1e, 0x99, 0x4a, 0x8b, 0xd3, 0x9d, 0xbc, 0x24, 0x3e, 0xd6, 0xb1, 0x24, 0xf1,
+	0xf9, 0x85, 0x12, 0x99, 0x51, 0x92, 0x85, 0x47, 0xe2, 0xa3, 0x6c, 0x34, 0x06, 0x14, 0x71, 0x65,
+	0xd1, 0x6c, 0xc9, 0x47, 0x44, 0x64, 0x7c, 0x94, 0x8d, 0xc8, 0x38, 0x56, 0xf1, 0x23, 0x4e, 0x96,
+	0x5f, 0x2f, 0x91, 0xba, 0xba, 0x40, 0xf9, 0x16, 0xa9, 0x32, 0xfd, 0xfd, 0xe9, 0x76, 0x78, 0x76,
+	0x16, 0x00, 0xce, 0x09, 0x59, 0x32, 0x47, 0xbb, 0x53, 0x7a, 0x1a, 0x96, 0xcc, 0x6d, 0x0f, 0x9c,
+	0x93, 0x7d, 0x93, 0x94, 0x31, 0x8b, 0x46, 0xf9, 0x09, 0x19, 0xb2, 0x94, 0xaa, 0xd7, 0x82, 0x0e,
+	0x20, 0x17, 0x76, 0xc7, 0x9c, 0x67, 0x51, 0xae, 0x98, 0xd3, 0x23, 0x93, 0x37, 0xf9, 0x1b, 0x65,
+	0xd2, 0x68, 0xd1, 0xe4, 0x13, 0xa5, 0xfd, 0xaa, 0x28, 0x8d, 0xf2, 0x61, 0xa3, 0x34, 0xb4, 0x88,
+	0x8b, 0xca, 0x63, 0x22, 0x2e, 0x72, 0x55, 0xeb, 0xea, 0xc7, 0xab, 0x5a, 0xff, 0x73, 0x54, 0x78,
+	0x92, 0x70, 0xf0, 0x89, 0xea, 0x85, 0x23, 0xe4, 0xd4, 0xfb, 0x09, 0x62, 0x24, 0xbe, 0x18, 0x97,
+	0xb8, 0x80, 0xdf, 0xcf, 0x3b, 0x72, 0xe2, 0x02, 0xf7, 0x6f, 0x55, 0x49, 0xad, 0x35, 0xdc, 0x46,
+	0xc5, 0xfa, 0xd7, 0x2c, 0x72, 0xea, 0x5e, 0x26, 0x69, 0x61, 0xba, 0xac, 0xbe, 0x5d, 0x7c, 0x46,
+	0x48, 0x0c, 0x48, 0x52, 0x35, 0xce, 0x41, 0x42, 0x5e, 0x75, 0x8c, 0x1c, 0x6a, 0xe5, 0x63, 0x4a,
+	0x85, 0xa9, 0xe5, 0x1d, 0x28, 0x15, 0x9f, 0x77, 0x60, 0x7a, 0x6c, 0xce, 0x81, 0xcb, 0xa4, 0xd1,
+	0xa1, 0x9d, 0xe1, 0x00, 0x2f, 0x25, 0x65, 0x73, 0x7c, 0x2d, 0x4b, 0x04, 0xa4, 0x34, 0x76, 0x87,
+	0x4c, 0xf1, 0x1f, 0x77, 0xfc, 0xa0, 0x13, 0xde, 0x73, 0xaa, 0x4f, 0x74, 0x01, 0x53, 0x64, 0x14,
+	0x48, 0xf9, 0x80, 0xc1, 0xd5, 0xfe, 0x88, 0x34, 0x22, 0x79, 0x25, 0xd4, 0xa9, 0x15, 0x75, 0x89,
+	0xd5, 0xbc, 0x6a, 0xca, 0x5b, 0x45, 0xfd, 0x84, 0x54, 0xa2, 0xfb, 0xc7, 0x15, 0x42, 0xf8, 0x18,
+	0xdd, 0x18, 0x24, 0x87, 0xb1, 0x2a, 0xbe, 0x41, 0xa6, 0xe4, 0x8b, 0x29, 0xeb, 0x69, 0x4c, 0xa0,
+	0x8a, 0x0b, 0x59, 0xd5, 0x70, 0x60, 0x50, 0xa2, 0x27, 0x89, 0xa2, 0xfd, 0x97, 0x9f, 0x35, 0x2a,
+	0xa6, 0x27, 0xe9, 0x9a, 0xc2, 0x80, 0x46, 0x65, 0x2f, 0x18, 0xce, 0x55, 0x9e, 0xa3, 0xe1, 0xc4,
+	0x23, 0x7c, 0xa1, 0x9f, 0x23, 0xd3, 0xea, 0x17, 0xde, 0x0f, 0xce, 0x3a, 0x96, 0x37, 0x75, 0x24,
+	0x98, 0xb4, 0x78, 0xa9, 0xdb, 0xbc, 0x73, 0xe9, 0x4c, 0x98, 0x97, 0xba, 0xcd, 0xab, 0x9a, 0x90,
+	0xa1, 0x66, 0xcf, 0x1c, 0x44, 0x07, 0x30, 0x0c, 0x84, 0x9a, 0x9e, 0x3e, 0x73, 0xc0, 0xa0, 0x20,
+	0xb0, 0xd8, 0x84, 0x58, 0x92, 0x46, 0x1c, 0xce, 0xf4, 0xf1, 0x7a, 0xda, 0x84, 0x2d, 0x0d, 0x07,
+	0x06, 0x25, 0x4a, 0x10, 0x26, 0x5d, 0x62, 0xee, 0x8e, 0x19, 0x3b, 0xec, 0x80, 0x9c, 0x08, 0x4d,
+	0x8b, 0x18, 0x8f, 0xa2, 0x7b, 0xfd, 0x90, 0xb3, 0xd9, 0x28, 0xcb, 0x6f, 0x14, 0x9a, 0x30, 0xc8,
+	0xf0, 0xc7, 0x73, 0x8a, 0x1e, 0x47, 0x3e, 0x65, 0x06, 0x80, 0x8e, 0x0b, 0xf5, 0x76, 0x4f, 0x91,
+	0x93, 0xad, 0xe1, 0x60, 0xd0, 0xf3, 0x69, 0x47, 0x79, 0x1f, 0xdd, 0xcf, 0x93, 0x19, 0x91, 0x83,
+	0x4b, 0x69, 0xe1, 0x47, 0x4a, 0x6d, 0xec, 0xfe, 0x91, 0x45, 0x66, 0x32, 0xe1, 0x2e, 0xe8, 0x25,
+	0x37, 0x75, 0xe7, 0x62, 0x32, 0x29, 0x69, 0x6a, 0xb3, 0x38, 0x57, 0xe4, 0xe9, 0xe1, 0xbb, 0x32,
+	0x7c, 0xb9, 0xb0, 0x5b, 0x00, 0x2c, 0xc8, 0x97, 0x2b, 0x63, 0x7a, 0x0c, 0xb4, 0xfb, 0xb3, 0x25,
+	0x92, 0x1f, 0x63, 0x64, 0x7f, 0x6d, 0xb4, 0x01, 0xde, 0x2a, 0xb0, 0x01, 0xb8, 0x94, 0x47, 0xb4,
+	0x41, 0x60, 0xb6, 0xc1, 0x5a, 0x41, 0x6d, 0x20, 0xe4, 0x8e, 0xb6, 0xc4, 0x0f, 0x2d, 0x32, 0xb9,
+	0xb5, 0x75, 0x4b, 0x6d, 0xee, 0x40, 0xce, 0xc6, 0x3c, 0xf3, 0xda, 0xe2, 0x4e, 0x42, 0xa3, 0xa5,
+	0xb0, 0x3f, 0xe8, 0x51, 0x35, 0xa0, 0x44, 0x3a, 0xb4, 0x56, 0x2e, 0x05, 0x8c, 0x29, 0x69, 0xdf,
+	0x20, 0xa7, 0x74, 0x8c, 0xb0, 0x0f, 0x8b, 0x24, 0xeb, 0xfc, 0x82, 0xec, 0x28, 0x1a, 0xf2, 0xca,
+	0x64, 0x59, 0x09, 0x25, 0xc2, 0x29, 0xe7, 0xb3, 0x12, 0x68, 0xc8, 0x2b, 0xe3, 0x6e, 0x90, 0x49,
+	0xed, 0x65, 0x28, 0xfb, 0x0b, 0x64, 0xb6, 0x1d, 0xf6, 0xe5, 0x63, 0x2c, 0xb7, 0xe8, 0x1e, 0xed,
+	0x89, 0x4f, 0x66, 0x46, 0xc9, 0xa5, 0x0c, 0x0e, 0x46, 0xa8, 0xdd, 0xbf, 0xf9, 0x22, 0x51, 0x39,
+	0x84, 0x0e, 0xb1, 0x45, 0x0c, 0x54, 0xf4, 0x65, 0xb5, 0xe0, 0xe8, 0x4b, 0xb5, 0xde, 0x65, 0x22,
+	0x30, 0x93, 0x34, 0x02, 0xb3, 0x56, 0x74, 0x04, 0xa6, 0x52, 0x1f, 0x47, 0xa2, 0x30, 0xff, 0xba,
+	0x45, 0xa6, 0x50, 0xff, 0x54, 0xea, 0xea, 0x04, 0x53, 0xc7, 0xdf, 0x2d, 0x2e, 0xac, 0x7c, 0x61,
+	0x5d, 0x63, 0xcf, 0x5d, 0xb3, 0x6a, 0x9b, 0xd0, 0x51, 0x60, 0xd4, 0xc3, 0x5e, 0xd1, 0x6c, 0xa0,
+	0x3c, 0xed, 0xcf, 0xf9, 0xbc, 0x83, 0xfb, 0xe3, 0x0c, 0x9a, 0x68, 0xc1, 0x54, 0xea, 0x60, 0xa3,
+	0x28, 0x0b, 0xa6, 0xbc, 0x8a, 0xa3, 0x79, 0x75, 0x04, 0x44, 0x53, 0x13, 0x5d, 0x52, 0xe3, 0xc1,
+	0xbc, 0xe2, 0x8d, 0x22, 0xe6, 0x6c, 0xe4, 0x81, 0xbe, 0x20, 0x30, 0x76, 0x22, 0x43, 0x83, 0x26,
+	0x8b, 0x4a, 0x2d, 0x6d, 0x84, 0x1e, 0xe5, 0xc7, 0x06, 0xd9, 0x6f, 0xea, 0xf6, 0xa0, 0xa9, 0xc3,
+	0xd8, 0x83, 0xa6, 0xc7, 0xda, 0x82, 0x7e, 0xce, 0x22, 0x53, 0x6d, 0x2d, 0x77, 0xb6, 0xf3, 0x6a,
+	0x61, 0x89, 0xe0, 0x72, 0x32, 0x72, 0x73, 0x55, 0x54, 0xc7, 0x80, 0x21, 0x9d, 0x65, 0xf8, 0x61,
+	0xc6, 0x2f, 0x67, 0xba, 0x28, 0x3d, 0xd4, 0x34, 0xa6, 0xc9, 0x50, 0x1c, 0x84, 0x81, 0x90, 0x65,
+	0x7f, 0x88, 0xb1, 0x04, 0xc2, 0x24, 0x76, 0xa2, 0xa8, 0xcc, 0xca, 0x59, 0xcf, 0xa5, 0x8c, 0x4f,
+	0xe0, 0x50, 0x50, 0x12, 0xf1, 0xb1, 0x98, 0x8e, 0xd7, 0x75, 0x66, 0x8a, 0xda, 0x93, 0xb4, 0xe4,
+	0x4f, 0xdc, 0xb2, 0xb1, 0xbc, 0xb8, 0x0a, 0x28, 0x02, 0x9f, 0x13, 0x93, 0x09, 0x47, 0x67, 0x0b,
+	0xdb, 0x7d, 0x4d, 0x35, 0x89, 0x9b, 0xf7, 0x46, 0xf2, 0x97, 0x76, 0x84, 0xb3, 0xf7, 0x47, 0x2f,
+	0x5a, 0xc5, 0xe4, 0x2b, 0x43, 0x37, 0x31, 0x4f, 0x8c, 0x93, 0x3a, 0x8c, 0xed, 0x6b, 0x64, 0x82,
+	0xa7, 0x47, 0xe7, 0x31, 0xe6, 0x93, 0x57, 0xe6, 0xc6, 0x27, 0x59, 0x4f, 0x17, 0x55, 0xfe, 0x3b,
+	0x06, 0x59, 0xd6, 0xfe, 0x05, 0x8b, 0x9c, 0xc0, 0xd5, 0x67, 0x29, 0x4d, 0x1d, 0x6f, 0x17, 0x35,
+	0xbf, 0xf1, 0x3e, 0x7d, 0x3a, 0x2f, 0x95, 0x5a, 0x7f, 0xc3, 0x10, 0x07, 0x19, 0xf1, 0xf6, 0x47,
+	0xa4, 0x1e, 0xfb, 0x1d, 0xda, 0xf6, 0xa2, 0xd8, 0x39, 0x75, 0x3c, 0x55, 0x49, 0x9d, 0x39, 0x42,
+	0x10, 0x28, 0x91, 0x68, 0xf8, 0x99, 0x51, 0x2f, 0x7d, 0x89, 0x47, 0xf5, 0x4e, 0x1f, 0xdb, 0xa3,
+	0x7a, 0xdc, 0x4d, 0x62, 0x8a, 0x83, 0xac, 0x7c, 0xfb, 0x2f, 0xe1, 0xcb, 0x3e, 0x2c, 0x75, 0x67,
+	0x36, 0x21, 0xee, 0x99, 0x27, 0xb4, 0x02, 0xb2, 0xe0, 0xf8, 0xc5, 0x3c, 0x96, 0x90, 0x2f, 0x89,
+	0xe5, 0xa0, 0x8a, 0x74, 0x87, 0x28, 0xbb, 0xa2, 0x50, 0x9c, 0xbb, 0x4f, 0xb2, 0xe5, 0xa1, 0x39,
+	0x06, 0x08, 0x4c, 0xc1, 0xf8, 0x5e, 0xdb, 0x40, 0x6c, 0x1d, 0x7e, 0xdc, 0x67, 0x57, 0x1d, 0xca,
+	0xfc, 0x3a, 0xd8, 0x66, 0x0a, 0x06, 0x9d, 0xc6, 0x48, 0xc0, 0x76, 0xe9, 0x51, 0x09, 0xd8, 0xec,
+	0xb7, 0xc9, 0x64, 0x12, 0xf6, 0x68, 0x24, 0x4e, 0x56, 0x0e, 0x1b, 0x81, 0x17, 0xf2, 0xe6, 0xd6,
+	0x96, 0x22, 0x4b, 0x4f, 0x5e, 0x29, 0x2c, 0x06, 0x9d, 0x0f, 0x0b, 0xc1, 0x16, 0xd9, 0x26, 0x23,
+	0x76, 0x90, 0x7f, 0x3e, 0x13, 0x82, 0xad, 0x23, 0xc1, 0xa4, 0x45, 0x63, 0xdd, 0x20, 0xf2, 0x43,
+	0x8c, 0xc9, 0x5e, 0xea, 0x79, 0x71, 0xcc, 0x18, 0xcc, 0x99, 0xc6, 0xba, 0xcd, 0x2c, 0x01, 0x8c,
+	0x96, 0xc1, 0x66, 0x90, 0x40, 0xe7, 0x05, 0xa6, 0x93, 0xf2, 0x77, 0x8c, 0x04, 0x0c, 0x14, 0x76,
+	0x4c, 0x8a, 0xaa, 0xf3, 0x4f, 0x92, 0xa2, 0xca, 0xee, 0x90, 0xf3, 0xde, 0x30, 0x09, 0x59, 0x04,
+	0xa1, 0x59, 0x84, 0x47, 0xa3, 0x5f, 0xe4, 0x01, 0xee, 0x0f, 0xee, 0xcf, 0x9f, 0x5f, 0x7c, 0x04,
+	0x1d, 0x3c, 0x92, 0x8b, 0xfd, 0x01, 0x86, 0x3d, 0xf3, 0x34, 0x5b, 0xce, 0x8f, 0x14, 0x66, 0xd8,
+	0x31, 0x12, 0x77, 0xc9, 0x40, 0x6a, 0x0e, 0x03, 0x25, 0xcf, 0xde, 0x22, 0x93, 0x78, 0x27, 0x67,
+	0xb1, 0xe7, 0x7b, 0x31, 0x8d, 0x9d, 0x17, 0x2f, 0x96, 0xc7, 0xe9, 0x29, 0xd7, 0x25, 0x59, 0x3a,
+	0x66, 0xae, 0xa7, 0x25, 0x41, 0x67, 0x63, 0x53, 0x32, 0x23, 0x43, 0xf1, 0x97, 0x78, 0x52, 0x00,
+	0xe7, 0x02, 0xfb, 0xb0, 0x57, 0xf2, 0x38, 0x6f, 0x86, 0x9d, 0x96, 0x49, 0xad, 0xdc, 0xa3, 0x3a,
+	0x10, 0xb2, 0x3c, 0xd1, 0x3e, 0x32, 0x08, 0x3b, 0x98, 0x31, 0x7c, 0xd3, 0xc3, 0x5c, 0x4c, 0xf3,
+	0xa6, 0x89, 0x69, 0x53, 0xc3, 0x81, 0x41, 0x89, 0x71, 0x55, 0x7d, 0x7e, 0xd5, 0xd9, 0x79, 0xa9,
+	0xa8, 0x73, 0x80, 0xb8, 0x3b, 0x2d, 0x22, 0x4d, 0xf9, 0x0f, 0x90, 0x62, 0xec, 0xbf, 0x63, 0x91,
+	0x99, 0xcc, 0xe5, 0x1d, 0xe7, 0x53, 0x85, 0x6d, 0xef, 0x26, 0xe3, 0xe6, 0x2b, 0xac, 0xf9, 0x4c,
+	0xe0, 0xc3, 0x51, 0x10, 0x64, 0x6b, 0xc4, 0xdb, 0x85, 0xe5, 0x2b, 0x70, 0x5e, 0x2e, 0xae, 0x5d,
+	0x18, 0x43, 0xd9, 0x2e, 0xec, 0x07, 0x48, 0x31, 0x68, 0x85, 0x4f, 0xfc, 0x3e, 0x0d, 0x87, 0x89,
+	0xf3, 0x8a, 0x69, 0x85, 0xdf, 0xe2, 0x60, 0x90, 0xf8, 0xb9, 0xcf, 0x93, 0x93, 0x23, 0xc7, 0x9c,
+	0x23, 0x5d, 0x9a, 0xff, 0x65, 0x3c, 0xe9, 0x6b, 0x56, 0xec, 0xa2, 0x73, 0xe5, 0xbe, 0x41, 0xa6,
+	0xda, 0xfc, 0xdd, 0x21, 0x7e, 0x73, 0xb7, 0x62, 0xda, 0xeb, 0x96, 0x34, 0x1c, 0x18, 0x94, 0xee,
+	0x3a, 0x99, 0xd9, 0xa2, 0x51, 0xdf, 0x0f, 0xbc, 0xa4, 0x88, 0x40, 0x2d, 0xf7, 0x17, 0x4b, 0xc4,
+	0x1e, 0xcd, 0x5a, 0xc9, 0x2c, 0xab, 0xe9, 0xdb, 0xac, 0x56, 0xc6, 0xb2, 0xaa, 0x30, 0xa0, 0x51,
+	0xd9, 0xaf, 0x91, 0xea, 0xc0, 0x8b, 0xd4, 0xcb, 0x0a, 0x2f, 0x28, 0x67, 0x15, 0x02, 0x33, 0x8f,
+	0x95, 0x72, 0x4a, 0xe6, 0x9b, 0xf3, 0x7b, 0x89, 0x4a, 0xe3, 0x94, 0xfa, 0xe6, 0x18, 0x14, 0x04,
+	0x16, 0xc3, 0x4f, 0xfa, 0xde, 0xc0, 0xa9, 0x98, 0xe1, 0x27, 0x98, 0x3b, 0x08, 0xe1, 0x38, 0x34,
+	0xba, 0x51, 0x38, 0x1c, 0x34, 0x0f, 0xb2, 0x0f, 0x1c, 0xac, 0x72, 0x30, 0x48, 0x3c, 0x5e, 0x0b,
+	0x6d, 0xef, 0x0e, 0x83, 0xbb, 0x22, 0x1b, 0xbe, 0x3a, 0x91, 0x2d, 0x21, 0x10, 0x38, 0xce, 0xfd,
+	0x7b, 0x16, 0x99, 0x36, 0xd4, 0xa9, 0xc2, 0x7d, 0xf6, 0x2b, 0xc4, 0xee, 0xfb, 0x51, 0x14, 0x46,
+	0xfa, 0x6b, 0x40, 0x22, 0x95, 0x1d, 0x4b, 0x93, 0xb4, 0x36, 0x82, 0x85, 0x9c, 0x12, 0xee, 0xbf,
+	0x2e, 0x93, 0xf4, 0x92, 0x85, 0xca, 0x14, 0x66, 0x8d, 0xcd, 0x14, 0xf6, 0x69, 0x52, 0xc7, 0xec,
+	0x2b, 0x9b, 0x69, 0x3e, 0x31, 0x35, 0x4c, 0xdf, 0x6c, 0x6d, 0xac, 0x33, 0x4a, 0x45, 0xc1, 0xa8,
+	0xdf, 0x5f, 0xd1, 0x3b, 0x28, 0xa5, 0x7e, 0x4b, 0x74, 0x91, 0xa2, 0xc0, 0xa6, 0xa5, 0x7b, 0x54,
+	0xd9, 0xb8, 0xd3, 0xf7, 0x8a, 0x10, 0x08, 0x1c, 0x77, 0xf4, 0x77, 0x51, 0x50, 0x57, 0x16, 0xf6,
+	0x5c, 0xa7, 0x56, 0x54, 0x04, 0xc7, 0x88, 0x85, 0x98, 0x6f, 0x7b, 0x12, 0x0c, 0x4a, 0xa4, 0x7e,
+	0x11, 0xa7, 0x7a, 0xd8, 0x8b, 0x38, 0xe6, 0xe4, 0xa9, 0x1f, 0x66, 0xf2, 0xb8, 0x3f, 0x5d, 0x26,
+	0x13, 0xb7, 0x69, 0x84, 0xff, 0xe3, 0x70, 0xde, 0xe3, 0xff, 0x66, 0x6f, 0x50, 0x0a, 0x0a, 0x90,
+	0x78, 0x6c, 0xce, 0xed, 0xa1, 0xdf, 0xeb, 0x2c, 0xa7, 0xeb, 0x8e, 0x6a, 0xce, 0xa6, 0x44, 0x40,
+	0x4a, 0x83, 0x05, 0xba, 0x78, 0x16, 0xe9, 0xf7, 0xfd, 0x24, 0x9b, 0x99, 0x66, 0x55, 0x22, 0x20,
+	0xa5, 0xc1, 0x29, 0xda, 0xf5, 0x93, 0x2d, 0xaf, 0x9b, 0x75, 0x9f, 0xaf, 0x32, 0x28, 0x08, 0x2c,
+	0xf3, 0xe2, 0xf8, 0xc9, 0x56, 0x44, 0x99, 0xdd, 0x76, 0x24, 0x95, 0xc2, 0xaa, 0x86, 0x03, 0x83,
+	0x92, 0x55, 0x29, 0x14, 0x5f, 0xe6, 0xd4, 0x32, 0x55, 0x92, 0x08, 0x48, 0x69, 0x70, 0x58, 0xa2,
+	0x41, 0xd1, 0xef, 0x89, 0x60, 0x67, 0x6d, 0x58, 0x2e, 0x09, 0x38, 0x28, 0x0a, 0xa4, 0xc6, 0x45,
+	0x17, 0xd7, 0xb7, 0xec, 0x03, 0x13, 0x9b, 0x02, 0x0e, 0x8a, 0xc2, 0xbd, 0x4d, 0xa6, 0xf9, 0x04,
+	0x5b, 0xea, 0x79, 0x7e, 0x7f, 0x75, 0xc9, 0xbe, 0x36, 0x12, 0xd1, 0x7f, 0x29, 0x27, 0xa2, 0xff,
+	0x8c, 0x51, 0x68, 0x34, 0xb2, 0xdf, 0xfd, 0x7e, 0x89, 0xd4, 0x9f, 0xe1, 0xc3, 0x52, 0x03, 0xe3,
+	0x61, 0xa9, 0xa2, 0x5f, 0x6a, 0xc9, 0x7b, 0x54, 0x6a, 0x3f, 0xf3, 0xa8, 0xd4, 0x66, 0x81, 0x32,
+	0x1f, 0xfd, 0xa0, 0xd4, 0x1f, 0x5a, 0xe4, 0xb4, 0x24, 0x65, 0x6b, 0x4d, 0xd3, 0x0f, 0x58, 0xe0,
+	0xcd, 0xf1, 0x37, 0xf3, 0x87, 0x46, 0x33, 0xbf, 0x53, 0xdc, 0x27, 0xeb, 0xdf, 0x31, 0xf6, 0x29,
+	0xc6, 0x3f, 0xb0, 0x88, 0x93, 0x57, 0xe0, 0x19, 0x3c, 0xf8, 0xf4, 0x55, 0xf3, 0xc1, 0xa7, 0xdb,
+	0xc7, 0xf3, 0xe5, 0x63, 0x1e, 0x7e, 0xfa, 0x17, 0xd5, 0xfc, 0xef, 0xc6, 0xa6, 0xb1, 0x7b, 0x72,
+	0x17, 0xb2, 0x8a, 0x72, 0x8c, 0x71, 0x11, 0xf9, 0xdb, 0x59, 0x8f, 0xd4, 0x62, 0xe6, 0xeb, 0x76,
+	0x4a, 0x45, 0x39, 0x26, 0xb8, 0xef, 0x5c, 0x18, 0x36, 0xd9, 0xff, 0x20, 0x64, 0xd8, 0x11, 0xbf,
+	0x1a, 0x2b, 0xd2, 0xe1, 0x14, 0x32, 0xaf, 0xf5, 0x90, 0xff, 0xf4, 0xaa, 0x6d, 0x9f, 0x82, 0x90,
+	0x64, 0xbf, 0x47, 0x2a, 0x71, 0x12, 0xca, 0x6c, 0xd8, 0xb7, 0x8a, 0x79, 0x2a, 0x46, 0xc8, 0x63,
+	0x06, 0x3f, 0xfc, 0x0d, 0x4c, 0x06, 0x3a, 0x14, 0x13, 0xa9, 0xdc, 0x3a, 0xd5, 0xa2, 0xce, 0x3c,
+	0x19, 0x7d, 0x99, 0x5b, 0xcf, 0x15, 0x10, 0x52, 0x91, 0xf6, 0x0e, 0x29, 0xc7, 0x2a, 0x3e, 0xb7,
+	0x80, 0x20, 0x12, 0x15, 0x4e, 0xc6, 0x0d, 0xb7, 0x68, 0x1f, 0x47, 0x01, 0xee, 0x7f, 0xb6, 0xc8,
+	0xd4, 0x33, 0x7c, 0x9d, 0x2d, 0x34, 0x27, 0xeb, 0x9b, 0xc5, 0x4d, 0xd6, 0x31, 0x13, 0xf4, 0x7f,
+	0xbe, 0x48, 0x8c, 0x47, 0xbb, 0xd0, 0x55, 0x2e, 0xcf, 0x3e, 0xf2, 0xde, 0xf5, 0x9b, 0xc5, 0xf9,
+	0xb4, 0x52, 0x75, 0x41, 0x42, 0x62, 0x48, 0xe5, 0x65, 0xa2, 0x44, 0x4a, 0x87, 0x8a, 0x12, 0xf9,
+	0x78, 0x1f, 0x33, 0xc9, 0xb7, 0x4c, 0x55, 0x8e, 0xc5, 0x32, 0x75, 0xbe, 0x70, 0xcb, 0xd4, 0x8b,
+	0xcf, 0xd8, 0x32, 0xa5, 0xb9, 0x09, 0xaa, 0x4f, 0xe1, 0x26, 0xf8, 0x2a, 0x39, 0xbd, 0x97, 0x2a,
+	0x71, 0x6a, 0x24, 0x89, 0x37, 0x59, 0x2e, 0xe5, 0xda, 0xa3, 0x50, 0x21, 0x8d, 0x13, 0x1a, 0x24,
+	0x9a, 0xfa, 0xa7, 0x92, 0x33, 0x9d, 0xbe, 0x9d, 0xc3, 0x0e, 0x72, 0x85, 0x64, 0xed, 0xbd, 0x13,
+	0x87, 0xb0, 0xf7, 0xfe, 0xc6, 0xd8, 0xb7, 0xf0, 0xeb, 0xc7, 0xfb, 0x16, 0xfe, 0xf3, 0x47, 0x7e,
+	0x07, 0xff, 0xe5, 0xd4, 0x51, 0xc5, 0x23, 0x93, 0xf2, 0xbd, 0x4a, 0xdf, 0xc9, 0x7a, 0xbf, 0xf9,
+	0xed, 0xe4, 0xaf, 0x14, 0xab, 0xbd, 0x16, 0xe0, 0x01, 0x9f, 0x7c, 0x0a, 0x0f, 0x78, 0xc6, 0xf8,
+	0x3e, 0x55, 0x90, 0xf1, 0x3d, 0x20, 0xb3, 0x7e, 0xdf, 0xeb, 0xd2, 0xcd, 0x61, 0xaf, 0xc7, 0xef,
+	0x84, 0xc4, 0xce, 0xf4, 0xc5, 0xf2, 0xb8, 0x08, 0x7b, 0xf4, 0xbb, 0xf4, 0xb2, 0x6f, 0xa9, 0xa9,
+	0x40, 0xdd, 0x1b, 0x19, 0x4e, 0x30, 0xc2, 0x1b, 0x07, 0x2c, 0x4b, 0xd1, 0x44, 0x13, 0x6c, 0x6d,
+	0xe6, 0x66, 0xad, 0x37, 0x67, 0xa4, 0xad, 0x57, 0x80, 0x41, 0xa7, 0xb1, 0x6f, 0x92, 0x46, 0x27,
+	0x88, 0xc5, 0x45, 0xb0, 0x19, 0xb6, 0x98, 0x7d, 0x86, 0x85, 0x4a, 0xae, 0xb7, 0xd4, 0x15, 0xb0,
+	0xf3, 0x39, 0xd9, 0xbf, 0x14, 0x1e, 0xd2, 0xf2, 0xf6, 0x1a, 0x63, 0x26, 0x32, 0x97, 0x73, 0xef,
+	0xe7, 0xc5, 0x31, 0x26, 0xe3, 0xe5, 0x75, 0x99, 0x69, 0x7d, 0x5a, 0x88, 0xe3, 0x3f, 0x21, 0xe5,
+	0xa0, 0x3d, 0xdc, 0x73, 0xf2, 0x91, 0x0f, 0xf7, 0xb0, 0xb4, 0x7f, 0x49, 0x4f, 0x39, 0x88, 0x2e,
+	0x14, 0x96, 0xf6, 0x2f, 0x8d, 0x2b, 0x12, 0x69, 0xff, 0x52, 0x00, 0xe8, 0x22, 0xed, 0x8d, 0x71,
+	0x8e, 0xb2, 0x53, 0x6c, 0xd1, 0x38, 0xba, 0xdb, 0x4b, 0xf7, 0x98, 0x9c, 0x7e, 0xa4, 0xc7, 0x64,
+	0xc4, 0xc3, 0x73, 0xe6, 0x08, 0x1e, 0x9e, 0x5d, 0x96, 0x90, 0x6d, 0x75, 0xc9, 0x39, 0x5b, 0x94,
+	0x62, 0xce, 0x6e, 0xd1, 0xf3, 0x38, 0x2d, 0xf6, 0x2f, 0x70, 0x01, 0xf6, 0x26, 0x39, 0x3d, 0x08,
+	0x3b, 0x23, 0xde, 0x22, 0xe7, 0x9c, 0x91, 0x3b, 0xef, 0xf4, 0x66, 0x0e, 0x0d, 0xe4, 0x96, 0x64,
+	0xcb, 0x73, 0x0a, 0x67, 0x99, 0xfd, 0xaa, 0x62, 0x79, 0x4e, 0xc1, 0xa0, 0xd3, 0x64, 0xfd, 0x25,
+	0xcf, 0x1f, 0x9b, 0xbf, 0x64, 0xee, 0x19, 0xf8, 0x4b, 0x5e, 0x38, 0xb4, 0xbf, 0xe4, 0x23, 0x72,
+	0x6a, 0x10, 0x76, 0x96, 0xfd, 0x38, 0x1a, 0xb2, 0xcb, 0x5b, 0xcd, 0x61, 0x07, 0xdf, 0xaa, 0x9a,
+	0x67, 0x95, 0xbc, 0xa2, 0x57, 0x72, 0xc0, 0x26, 0xf2, 0xc2, 0xde, 0x6b, 0xdb, 0x34, 0xe1, 0x9d,
+	0x99, 0x2d, 0xc5, 0x0e, 0xbe, 0x2c, 0x50, 0x2d, 0x07, 0x09, 0x79, 0x72, 0x74, 0x77, 0xcd, 0xc5,
+	0x67, 0xe3, 0xae, 0xf9, 0x02, 0xa9, 0xc7, 0xbb, 0xc3, 0xa4, 0x13, 0xde, 0x0b, 0x98, 0x4f, 0xae,
+	0xa1, 0xde, 0xb1, 0xad, 0xb7, 0x04, 0xfc, 0x21, 0x5e, 0xf4, 0x16, 0xff, 0x6b, 0xa6, 0x21, 0x01,
+	0xb1, 0xbf, 0x3b, 0x26, 0x92, 0xdf, 0x3d, 0xce, 0x48, 0xfe, 0x73, 0x47, 0x8a, 0xe2, 0xcf, 0xf3,
+	0x49, 0xbd, 0xf4, 0x89, 0xf3, 0x49, 0xfd, 0xaa, 0x45, 0xa6, 0xf7, 0x74, 0x3b, 0x9c, 0xf3, 0xa9,
+	0xa2, 0xfc, 0xf7, 0x86, 0x79, 0xaf, 0xe9, 0xe2, 0x62, 0x67, 0x80, 0x1e, 0x66, 0x01, 0x60, 0xd6,
+	0x24, 0x27, 0xb6, 0xe0, 0xe5, 0x8f, 0x2b, 0xb6, 0xe0, 0x23, 0xb6, 0x98, 0xc9, 0x10, 0x39, 0xe6,
+	0x4c, 0x2b, 0x36, 0x0c, 0x4f, 0x2e, 0x8c, 0x12, 0x00, 0xba, 0x3c, 0x0c, 0x51, 0x9b, 0x95, 0x87,
+	0x33, 0x61, 0x47, 0x8f, 0x9d, 0x1f, 0x2d, 0xaa, 0x12, 0xea, 0x4c, 0xc8, 0x22, 0x51, 0xb7, 0x32,
+	0x72, 0x60, 0x44, 0xf2, 0xd3, 0xfb, 0x0a, 0x7f, 0xcf, 0x26, 0x27, 0x32, 0x2f, 0xd9, 0xaa, 0x37,
+	0xc6, 0xad, 0x27, 0x7e, 0x63, 0xbc, 0x74, 0xac, 0x19, 0x70, 0xcb, 0xcf, 0x26, 0x03, 0xee, 0xec,
+	0x71, 0x64, 0xc0, 0x3d, 0x79, 0xa4, 0x0c, 0xb8, 0x47, 0xb8, 0x0f, 0xb7, 0x48, 0x66, 0x64, 0x9c,
+	0x32, 0x15, 0xa9, 0x4d, 0xb9, 0x13, 0xe3, 0x9c, 0x28, 0x32, 0xb3, 0x64, 0xa2, 0x21, 0x4b, 0x6f,
+	0x7f, 0xcb, 0x22, 0xd5, 0x20, 0xec, 0xa8, 0x53, 0xe3, 0x97, 0x8a, 0x36, 0x82, 0xb3, 0xc3, 0x8b,
+	0x48, 0xa9, 0x24, 0xa3, 0xcd, 0xaa, 0x0c, 0xf6, 0x50, 0xfe, 0x03, 0xbc, 0x06, 0x98, 0x6f, 0x31,
+	0xdc, 0xd9, 0xe9, 0x85, 0x5e, 0x27, 0x4d, 0xd3, 0x2b, 0xbd, 0x2c, 0xfc, 0xae, 0x87, 0xca, 0xb7,
+	0xb8, 0x31, 0x86, 0x0e, 0xc6, 0x72, 0xc0, 0xd3, 0xe7, 0x4c, 0x9c, 0x84, 0x11, 0xed, 0xa4, 0x27,
+	0xe5, 0x06, 0xfb, 0x66, 0x5a, 0xf8, 0x37, 0xb7, 0x4c, 0x39, 0xfc, 0xeb, 0x55, 0xa7, 0x64, 0xb0,
+	0x90, 0xad, 0x96, 0x1d, 0x91, 0xb3, 0x83, 0xbc, 0x83, 0x7a, 0xec, 0x4c, 0x3c, 0xd6, 0x5c, 0x20,
+	0xa7, 0xee, 0xd9, 0xdc, 0xa3, 0x7e, 0x0c, 0x63, 0x38, 0xeb, 0x09, 0x7c, 0xeb, 0xcf, 0x26, 0x81,
+	0xaf, 0xf9, 0xfe, 0xf4, 0xf4, 0xb3, 0x7f, 0x7f, 0xfa, 0x8f, 0x73, 0x73, 0x4d, 0xf3, 0xf3, 0x6d,
+	0xb7, 0xf0, 0x31, 0xf1, 0x89, 0xcb, 0x37, 0xfd, 0x77, 0x2d, 0x32, 0xc7, 0x47, 0x5e, 0x56, 0xab,
+	0x42, 0x35, 0xd6, 0x39, 0x51, 0x94, 0xc1, 0xde, 0x70, 0xc4, 0xb1, 0x50, 0x81, 0x96, 0x21, 0x15,
+	0xe1, 0xf0, 0x88, 0x9a, 0xe0, 0x1d, 0x83, 0x11, 0x5d, 0x6e, 0xa6, 0x28, 0x8b, 0x51, 0x7e, 0x9e,
+	0xe2, 0x53, 0x0f, 0x0e, 0xa3, 0xbe, 0xfd, 0xc3, 0xb1, 0x06, 0x2d, 0x9b, 0x55, 0xef, 0x27, 0x8e,
+	0xc9, 0xa0, 0xa5, 0x27, 0x53, 0x3e, 0x8a, 0x59, 0x6b, 0xee, 0x67, 0x2c, 0xfe, 0xde, 0xc1, 0xd8,
+	0x24, 0x74, 0xdb, 0x66, 0x12, 0xba, 0x5b, 0x45, 0x66, 0x5c, 0xd7, 0x9f, 0x07, 0xf9, 0x2b, 0x16,
+	0x39, 0x9d, 0xb7, 0x48, 0xe6, 0x54, 0xe9, 0x2b, 0x66, 0x95, 0x0a, 0xd4, 0xb8, 0xf4, 0x0a, 0x15,
+	0x93, 0x66, 0xfa, 0x3f, 0xd4, 0x34, 0x37, 0x02, 0x46, 0x25, 0xfd, 0xbf, 0x27, 0xcb, 0x0b, 0x7e,
+	0x42, 0xc2, 0x78, 0x7c, 0xbc, 0xfa, 0x71, 0x3d, 0x3e, 0x5e, 0x7b, 0x92, 0xc7, 0xc7, 0x27, 0x3e,
+	0xb6, 0xc7, 0xc7, 0xeb, 0x87, 0x7c, 0x7c, 0xbc, 0xf1, 0xc9, 0x7c, 0x7c, 0xdc, 0xfd, 0xdf, 0x16,
+	0x99, 0xcd, 0xee, 0x0c, 0xcf, 0x20, 0x56, 0x62, 0xdf, 0x88, 0x95, 0xb8, 0x5d, 0xbc, 0x59, 0x63,
+	0x6c, 0x9c, 0xc4, 0xff, 0xd2, 0x02, 0x44, 0x24, 0xf1, 0x33, 0x70, 0xbb, 0xde, 0x33, 0xdd, 0xae,
+	0x50, 0xfc, 0x17, 0x8f, 0x71, 0xbf, 0xbe, 0x4f, 0xf2, 0x2c, 0x3b, 0x87, 0xbb, 0x89, 0x6f, 0x84,
+	0xa5, 0x96, 0x0e, 0x1d, 0x96, 0xfa, 0xf3, 0xa5, 0xd1, 0x26, 0x66, 0xda, 0xc6, 0x37, 0x71, 0xde,
+	0x6a, 0xaa, 0x49, 0x71, 0x17, 0xa5, 0x0d, 0x45, 0x48, 0xd5, 0x51, 0x87, 0x82, 0x21, 0xd9, 0x7e,
+	0x2f, 0xad, 0x09, 0xf6, 0xd4, 0x63, 0xf3, 0xd5, 0x8c, 0x1b, 0xe6, 0xcc, 0xb2, 0x70, 0x47, 0xe3,
+	0xc4, 0x6c, 0x1c, 0x06, 0x6f, 0x77, 0x9a, 0x4c, 0xbe, 0xe3, 0xab, 0x34, 0x20, 0xcd, 0x85, 0xef,
+	0xfd, 0xe0, 0xc2, 0x73, 0xbf, 0xfb, 0x83, 0x0b, 0xcf, 0x7d, 0xff, 0x07, 0x17, 0x9e, 0xfb, 0xfa,
+	0x83, 0x0b, 0xd6, 0xf7, 0x1e, 0x5c, 0xb0, 0x7e, 0xf7, 0xc1, 0x05, 0xeb, 0xfb, 0x0f, 0x2e, 0x58,
+	0xff, 0xe5, 0xc1, 0x05, 0xeb, 0xdb, 0xff, 0xf5, 0xc2, 0x73, 0xef, 0xd4, 0xe5, 0xb7, 0xfd, 0xdf,
+	0x01, 0x00, 0x01, 0xfc, 0x66, 0x63, 0x4e, 0xa8, 0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -3637,6 +4089,14 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SizeBytes))
+	i--
+	dAtA[i] = 0x68
+	i -= len(m.Digest)
+	copy(dAtA[i:], m.Digest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
+	i--
+	dAtA[i] = 0x62
 	i -= len(m.FromExpression)
 	copy(dAtA[i:], m.FromExpression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FromExpression)))
@@ -3713,6 +4173,44 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactFiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactFiles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactFiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Artifact.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactLocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3954,6 +4452,44 @@ func (m *ArtifactRepositoryRefStatus) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactStreaming) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactStreaming) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactStreaming) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Concurrency))
+	i--
+	dAtA[i] = 0x10
+	if m.PartSize != nil {
+		{
+			size, err := m.PartSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactoryArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4438,6 +4974,50 @@ func (m *CreateS3BucketOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CronExclusions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronExclusions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronExclusions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfigMapKeyRef != nil {
+		{
+			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Dates) > 0 {
+		for iNdEx := len(m.Dates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dates[iNdEx])
+			copy(dAtA[i:], m.Dates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CronWorkflow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4491,6 +5071,69 @@ func (m *CronWorkflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CronWorkflowBackfill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowBackfill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowBackfill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Next != nil {
+		{
+			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.Parameter)
+	copy(dAtA[i:], m.Parameter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parameter)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxParallel))
+	i--
+	dAtA[i] = 0x18
+	{
+		size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CronWorkflowList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4538,6 +5181,64 @@ func (m *CronWorkflowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CronWorkflowRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CronWorkflowSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4558,6 +5259,44 @@ func (m *CronWorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StopStrategy != nil {
+		{
+			size, err := m.StopStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Exclusions != nil {
+		{
+			size, err := m.Exclusions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Schedules[iNdEx])
+			copy(dAtA[i:], m.Schedules[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedules[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.WorkflowMetadata != nil {
 		{
 			size, err := m.WorkflowMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -4641,6 +5380,53 @@ func (m *CronWorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextScheduledTime != nil {
+		{
+			size, err := m.NextScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RecentRuns) > 0 {
+		for iNdEx := len(m.RecentRuns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentRuns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveFailures))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Failed))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Succeeded))
+	i--
+	dAtA[i] = 0x28
+	if m.Backfill != nil {
+		{
+			size, err := m.Backfill.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Conditions) > 0 {
 		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4878,6 +5664,11 @@ func (m *Data) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.OutputArtifact)
+	copy(dAtA[i:], m.OutputArtifact)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OutputArtifact)))
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Transformation) > 0 {
 		for iNdEx := len(m.Transformation) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4925,6 +5716,37 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ArtifactFiles != nil {
+		{
+			size, err := m.ArtifactFiles.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Value != nil {
+		i -= len(*m.Value)
+		copy(dAtA[i:], *m.Value)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ArtifactPaths != nil {
 		{
 			size, err := m.ArtifactPaths.MarshalToSizedBuffer(dAtA[:i])
@@ -4968,6 +5790,44 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != nil {
+		{
+			size, err := m.Period.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *ExecutorConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5054,6 +5914,18 @@ func (m *GCSBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Streaming != nil {
+		{
+			size, err := m.Streaming.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.ServiceAccountKeySecret != nil {
 		{
 			size, err := m.ServiceAccountKeySecret.MarshalToSizedBuffer(dAtA[:i])
@@ -5412,7 +6284,7 @@ func (m *HTTPArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Header) Marshal() (dAtA []byte, err error) {
+func (m *HTTPDataSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5422,23 +6294,65 @@ func (m *Header) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPDataSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTPDataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
 	dAtA[i] = 0xa
@@ -6288,6 +7202,18 @@ func (m *OSSBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Streaming != nil {
+		{
+			size, err := m.Streaming.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	i -= len(m.SecurityToken)
 	copy(dAtA[i:], m.SecurityToken)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SecurityToken)))
@@ -6460,6 +7386,26 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Sensitive {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Enum) > 0 {
 		for iNdEx := len(m.Enum) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Enum[iNdEx])
@@ -6508,6 +7454,133 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParameterSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParameterSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParameterSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Required) > 0 {
+		for iNdEx := len(m.Required) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Required[iNdEx])
+			copy(dAtA[i:], m.Required[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Required[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Properties) > 0 {
+		keysForProperties := make([]string, 0, len(m.Properties))
+		for k := range m.Properties {
+			keysForProperties = append(keysForProperties, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForProperties)
+		for iNdEx := len(keysForProperties) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Properties[string(keysForProperties[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForProperties[iNdEx])
+			copy(dAtA[i:], keysForProperties[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForProperties[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Items != nil {
+		{
+			size, err := m.Items.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxItems != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxItems))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinItems != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MinItems))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Maximum != nil {
+		{
+			size, err := m.Maximum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Minimum != nil {
+		{
+			size, err := m.Minimum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxLength != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinLength != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MinLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= len(m.Pattern)
+	copy(dAtA[i:], m.Pattern)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Pattern)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PodGC) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6729,6 +7802,53 @@ func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResumeAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutputParameters) > 0 {
+		for iNdEx := len(m.OutputParameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutputParameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.NodeFieldSelector)
+	copy(dAtA[i:], m.NodeFieldSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeFieldSelector)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.LabelSelector)
+	copy(dAtA[i:], m.LabelSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelSelector)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryAffinity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6909,6 +8029,18 @@ func (m *S3Bucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Streaming != nil {
+		{
+			size, err := m.Streaming.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.CreateBucketIfNotPresent != nil {
 		{
 			size, err := m.CreateBucketIfNotPresent.MarshalToSizedBuffer(dAtA[:i])
@@ -7211,7 +8343,7 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Submit) Marshal() (dAtA []byte, err error) {
+func (m *SetAction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7221,50 +8353,200 @@ func (m *Submit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Submit) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetAction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Submit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Arguments != nil {
-		{
-			size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.OutputParameters) > 0 {
+		for iNdEx := len(m.OutputParameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutputParameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.WorkflowTemplateRef.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+			i--
+			dAtA[i] = 0x2a
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+	dAtA[i] = 0x22
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.NodeFieldSelector)
+	copy(dAtA[i:], m.NodeFieldSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeFieldSelector)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.LabelSelector)
+	copy(dAtA[i:], m.LabelSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelSelector)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StopAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.NodeFieldSelector)
+	copy(dAtA[i:], m.NodeFieldSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeFieldSelector)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.LabelSelector)
+	copy(dAtA[i:], m.LabelSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelSelector)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StopStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveFailures))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Submit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Submit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Submit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DedupeWindow != nil {
+		{
+			size, err := m.DedupeWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.DedupeKey)
+	copy(dAtA[i:], m.DedupeKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DedupeKey)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Arguments != nil {
+		{
+			size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.WorkflowTemplateRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
 
 func (m *SubmitOpts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -8086,6 +9368,34 @@ func (m *TemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TerminateAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminateAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminateAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.LabelSelector)
+	copy(dAtA[i:], m.LabelSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelSelector)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TransformationStep) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8106,6 +9416,29 @@ func (m *TransformationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Chunk))
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.GroupBy)
+	copy(dAtA[i:], m.GroupBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GroupBy)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Map)
+	copy(dAtA[i:], m.Map)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Map)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Filter)
+	copy(dAtA[i:], m.Filter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Filter)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Parse)
+	copy(dAtA[i:], m.Parse)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parse)))
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.Expression)
 	copy(dAtA[i:], m.Expression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
@@ -8483,6 +9816,54 @@ func (m *WorkflowEventBindingSpec) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Set != nil {
+		{
+			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Terminate != nil {
+		{
+			size, err := m.Terminate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Stop != nil {
+		{
+			size, err := m.Stop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Resume != nil {
+		{
+			size, err := m.Resume.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Submit != nil {
 		{
 			size, err := m.Submit.MarshalToSizedBuffer(dAtA[:i])
@@ -9642,6 +11023,22 @@ func (m *Artifact) Size() (n int) {
 	n += 2
 	l = len(m.FromExpression)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.SizeBytes))
+	return n
+}
+
+func (m *ArtifactFiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Artifact.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9727,6 +11124,20 @@ func (m *ArtifactRepositoryRefStatus) Size() (n int) {
 	return n
 }
 
+func (m *ArtifactStreaming) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartSize != nil {
+		l = m.PartSize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Concurrency))
+	return n
+}
+
 func (m *ArtifactoryArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9902,6 +11313,25 @@ func (m *CreateS3BucketOptions) Size() (n int) {
 	return n
 }
 
+func (m *CronExclusions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dates) > 0 {
+		for _, s := range m.Dates {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ConfigMapKeyRef != nil {
+		l = m.ConfigMapKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *CronWorkflow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9917,23 +11347,62 @@ func (m *CronWorkflow) Size() (n int) {
 	return n
 }
 
-func (m *CronWorkflowList) Size() (n int) {
+func (m *CronWorkflowBackfill) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = m.From.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	l = m.To.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxParallel))
+	l = len(m.Parameter)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Next != nil {
+		l = m.Next.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *CronWorkflowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
+func (m *CronWorkflowRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.FinishedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *CronWorkflowSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9962,6 +11431,22 @@ func (m *CronWorkflowSpec) Size() (n int) {
 		l = m.WorkflowMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Schedules) > 0 {
+		for _, s := range m.Schedules {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Exclusions != nil {
+		l = m.Exclusions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.StopStrategy != nil {
+		l = m.StopStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9987,6 +11472,23 @@ func (m *CronWorkflowStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Backfill != nil {
+		l = m.Backfill.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Succeeded))
+	n += 1 + sovGenerated(uint64(m.Failed))
+	n += 1 + sovGenerated(uint64(m.ConsecutiveFailures))
+	if len(m.RecentRuns) > 0 {
+		for _, e := range m.RecentRuns {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.NextScheduledTime != nil {
+		l = m.NextScheduledTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10071,6 +11573,8 @@ func (m *Data) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.OutputArtifact)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -10084,6 +11588,18 @@ func (m *DataSource) Size() (n int) {
 		l = m.ArtifactPaths.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Value != nil {
+		l = len(*m.Value)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ArtifactFiles != nil {
+		l = m.ArtifactFiles.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10098,6 +11614,20 @@ func (m *Event) Size() (n int) {
 	return n
 }
 
+func (m *EventRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Limit))
+	if m.Period != nil {
+		l = m.Period.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ExecutorConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10134,6 +11664,10 @@ func (m *GCSBucket) Size() (n int) {
 		l = m.ServiceAccountKeySecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Streaming != nil {
+		l = m.Streaming.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10263,6 +11797,23 @@ func (m *HTTPArtifact) Size() (n int) {
 	return n
 }
 
+func (m *HTTPDataSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Header) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10606,6 +12157,10 @@ func (m *OSSBucket) Size() (n int) {
 	n += 2
 	l = len(m.SecurityToken)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Streaming != nil {
+		l = m.Streaming.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10681,6 +12236,63 @@ func (m *Parameter) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *ParameterSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Pattern)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MinLength != nil {
+		n += 1 + sovGenerated(uint64(*m.MinLength))
+	}
+	if m.MaxLength != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxLength))
+	}
+	if m.Minimum != nil {
+		l = m.Minimum.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Maximum != nil {
+		l = m.Maximum.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MinItems != nil {
+		n += 1 + sovGenerated(uint64(*m.MinItems))
+	}
+	if m.MaxItems != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxItems))
+	}
+	if m.Items != nil {
+		l = m.Items.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Properties) > 0 {
+		for k, v := range m.Properties {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Required) > 0 {
+		for _, s := range m.Required {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -10769,6 +12381,25 @@ func (m *ResourceTemplate) Size() (n int) {
 	return n
 }
 
+func (m *ResumeAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LabelSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.NodeFieldSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.OutputParameters) > 0 {
+		for _, e := range m.OutputParameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *RetryAffinity) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10857,6 +12488,10 @@ func (m *S3Bucket) Size() (n int) {
 		l = m.CreateBucketIfNotPresent.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Streaming != nil {
+		l = m.Streaming.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10947,6 +12582,54 @@ func (m *Sequence) Size() (n int) {
 	return n
 }
 
+func (m *SetAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LabelSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.NodeFieldSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.OutputParameters) > 0 {
+		for _, e := range m.OutputParameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StopAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LabelSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.NodeFieldSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *StopStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ConsecutiveFailures))
+	return n
+}
+
 func (m *Submit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10961,6 +12644,16 @@ func (m *Submit) Size() (n int) {
 	}
 	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DedupeKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DedupeWindow != nil {
+		l = m.DedupeWindow.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11247,6 +12940,17 @@ func (m *TemplateRef) Size() (n int) {
 	return n
 }
 
+func (m *TerminateAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LabelSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *TransformationStep) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11255,6 +12959,15 @@ func (m *TransformationStep) Size() (n int) {
 	_ = l
 	l = len(m.Expression)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Parse)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Filter)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Map)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GroupBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Chunk))
 	return n
 }
 
@@ -11394,6 +13107,22 @@ func (m *WorkflowEventBindingSpec) Size() (n int) {
 		l = m.Submit.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Resume != nil {
+		l = m.Resume.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Stop != nil {
+		l = m.Stop.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Terminate != nil {
+		l = m.Terminate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Set != nil {
+		l = m.Set.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11816,6 +13545,19 @@ func (this *Artifact) String() string {
 		`SubPath:` + fmt.Sprintf("%v", this.SubPath) + `,`,
 		`RecurseMode:` + fmt.Sprintf("%v", this.RecurseMode) + `,`,
 		`FromExpression:` + fmt.Sprintf("%v", this.FromExpression) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`SizeBytes:` + fmt.Sprintf("%v", this.SizeBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactFiles) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactFiles{`,
+		`Artifact:` + strings.Replace(strings.Replace(this.Artifact.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11848,6 +13590,17 @@ func (this *ArtifactPaths) String() string {
 	}, "")
 	return s
 }
+func (this *ArtifactStreaming) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactStreaming{`,
+		`PartSize:` + strings.Replace(fmt.Sprintf("%v", this.PartSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`Concurrency:` + fmt.Sprintf("%v", this.Concurrency) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ArtifactoryArtifact) String() string {
 	if this == nil {
 		return "nil"
@@ -11994,6 +13747,17 @@ func (this *CreateS3BucketOptions) String() string {
 	}, "")
 	return s
 }
+func (this *CronExclusions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronExclusions{`,
+		`Dates:` + fmt.Sprintf("%v", this.Dates) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CronWorkflow) String() string {
 	if this == nil {
 		return "nil"
@@ -12006,6 +13770,20 @@ func (this *CronWorkflow) String() string {
 	}, "")
 	return s
 }
+func (this *CronWorkflowBackfill) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflowBackfill{`,
+		`From:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.From), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`To:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.To), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`MaxParallel:` + fmt.Sprintf("%v", this.MaxParallel) + `,`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
+		`Next:` + strings.Replace(fmt.Sprintf("%v", this.Next), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CronWorkflowList) String() string {
 	if this == nil {
 		return "nil"
@@ -12022,6 +13800,20 @@ func (this *CronWorkflowList) String() string {
 	}, "")
 	return s
 }
+func (this *CronWorkflowRun) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflowRun{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CronWorkflowSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -12036,6 +13828,10 @@ func (this *CronWorkflowSpec) String() string {
 		`FailedJobsHistoryLimit:` + valueToStringGenerated(this.FailedJobsHistoryLimit) + `,`,
 		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
 		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v11.ObjectMeta", 1) + `,`,
+		`Schedules:` + fmt.Sprintf("%v", this.Schedules) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`Exclusions:` + strings.Replace(this.Exclusions.String(), "CronExclusions", "CronExclusions", 1) + `,`,
+		`StopStrategy:` + strings.Replace(this.StopStrategy.String(), "StopStrategy", "StopStrategy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12054,10 +13850,21 @@ func (this *CronWorkflowStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForRecentRuns := "[]CronWorkflowRun{"
+	for _, f := range this.RecentRuns {
+		repeatedStringForRecentRuns += strings.Replace(strings.Replace(f.String(), "CronWorkflowRun", "CronWorkflowRun", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRecentRuns += "}"
 	s := strings.Join([]string{`&CronWorkflowStatus{`,
 		`Active:` + repeatedStringForActive + `,`,
 		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v11.Time", 1) + `,`,
 		`Conditions:` + repeatedStringForConditions + `,`,
+		`Backfill:` + strings.Replace(this.Backfill.String(), "CronWorkflowBackfill", "CronWorkflowBackfill", 1) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
+		`RecentRuns:` + repeatedStringForRecentRuns + `,`,
+		`NextScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.NextScheduledTime), "Time", "v11.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12117,6 +13924,7 @@ func (this *Data) String() string {
 	s := strings.Join([]string{`&Data{`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "DataSource", "DataSource", 1), `&`, ``, 1) + `,`,
 		`Transformation:` + repeatedStringForTransformation + `,`,
+		`OutputArtifact:` + fmt.Sprintf("%v", this.OutputArtifact) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12127,6 +13935,9 @@ func (this *DataSource) String() string {
 	}
 	s := strings.Join([]string{`&DataSource{`,
 		`ArtifactPaths:` + strings.Replace(this.ArtifactPaths.String(), "ArtifactPaths", "ArtifactPaths", 1) + `,`,
+		`Value:` + valueToStringGenerated(this.Value) + `,`,
+		`ArtifactFiles:` + strings.Replace(this.ArtifactFiles.String(), "ArtifactFiles", "ArtifactFiles", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPDataSource", "HTTPDataSource", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12141,6 +13952,17 @@ func (this *Event) String() string {
 	}, "")
 	return s
 }
+func (this *EventRateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventRateLimit{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Period:` + strings.Replace(fmt.Sprintf("%v", this.Period), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ExecutorConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -12169,6 +13991,7 @@ func (this *GCSBucket) String() string {
 	s := strings.Join([]string{`&GCSBucket{`,
 		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
 		`ServiceAccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServiceAccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Streaming:` + strings.Replace(this.Streaming.String(), "ArtifactStreaming", "ArtifactStreaming", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12256,6 +14079,22 @@ func (this *HTTPArtifact) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPDataSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]Header{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "Header", "Header", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTPDataSource{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Header) String() string {
 	if this == nil {
 		return "nil"
@@ -12523,6 +14362,7 @@ func (this *OSSBucket) String() string {
 		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`CreateBucketIfNotPresent:` + fmt.Sprintf("%v", this.CreateBucketIfNotPresent) + `,`,
 		`SecurityToken:` + fmt.Sprintf("%v", this.SecurityToken) + `,`,
+		`Streaming:` + strings.Replace(this.Streaming.String(), "ArtifactStreaming", "ArtifactStreaming", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12576,6 +14416,38 @@ func (this *Parameter) String() string {
 		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "ValueFrom", "ValueFrom", 1) + `,`,
 		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
 		`Enum:` + fmt.Sprintf("%v", this.Enum) + `,`,
+		`Schema:` + strings.Replace(this.Schema.String(), "ParameterSchema", "ParameterSchema", 1) + `,`,
+		`Sensitive:` + fmt.Sprintf("%v", this.Sensitive) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParameterSchema) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForProperties := make([]string, 0, len(this.Properties))
+	for k := range this.Properties {
+		keysForProperties = append(keysForProperties, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForProperties)
+	mapStringForProperties := "map[string]ParameterSchema{"
+	for _, k := range keysForProperties {
+		mapStringForProperties += fmt.Sprintf("%v: %v,", k, this.Properties[k])
+	}
+	mapStringForProperties += "}"
+	s := strings.Join([]string{`&ParameterSchema{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Pattern:` + fmt.Sprintf("%v", this.Pattern) + `,`,
+		`MinLength:` + valueToStringGenerated(this.MinLength) + `,`,
+		`MaxLength:` + valueToStringGenerated(this.MaxLength) + `,`,
+		`Minimum:` + strings.Replace(this.Minimum.String(), "Amount", "Amount", 1) + `,`,
+		`Maximum:` + strings.Replace(this.Maximum.String(), "Amount", "Amount", 1) + `,`,
+		`MinItems:` + valueToStringGenerated(this.MinItems) + `,`,
+		`MaxItems:` + valueToStringGenerated(this.MaxItems) + `,`,
+		`Items:` + strings.Replace(this.Items.String(), "ParameterSchema", "ParameterSchema", 1) + `,`,
+		`Properties:` + mapStringForProperties + `,`,
+		`Required:` + fmt.Sprintf("%v", this.Required) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12638,6 +14510,23 @@ func (this *ResourceTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *ResumeAction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOutputParameters := "[]Parameter{"
+	for _, f := range this.OutputParameters {
+		repeatedStringForOutputParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOutputParameters += "}"
+	s := strings.Join([]string{`&ResumeAction{`,
+		`LabelSelector:` + fmt.Sprintf("%v", this.LabelSelector) + `,`,
+		`NodeFieldSelector:` + fmt.Sprintf("%v", this.NodeFieldSelector) + `,`,
+		`OutputParameters:` + repeatedStringForOutputParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RetryAffinity) String() string {
 	if this == nil {
 		return "nil"
@@ -12695,6 +14584,7 @@ func (this *S3Bucket) String() string {
 		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
 		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
 		`CreateBucketIfNotPresent:` + strings.Replace(this.CreateBucketIfNotPresent.String(), "CreateS3BucketOptions", "CreateS3BucketOptions", 1) + `,`,
+		`Streaming:` + strings.Replace(this.Streaming.String(), "ArtifactStreaming", "ArtifactStreaming", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12765,6 +14655,47 @@ func (this *Sequence) String() string {
 	}, "")
 	return s
 }
+func (this *SetAction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOutputParameters := "[]Parameter{"
+	for _, f := range this.OutputParameters {
+		repeatedStringForOutputParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOutputParameters += "}"
+	s := strings.Join([]string{`&SetAction{`,
+		`LabelSelector:` + fmt.Sprintf("%v", this.LabelSelector) + `,`,
+		`NodeFieldSelector:` + fmt.Sprintf("%v", this.NodeFieldSelector) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`OutputParameters:` + repeatedStringForOutputParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopAction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopAction{`,
+		`LabelSelector:` + fmt.Sprintf("%v", this.LabelSelector) + `,`,
+		`NodeFieldSelector:` + fmt.Sprintf("%v", this.NodeFieldSelector) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopStrategy{`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Submit) String() string {
 	if this == nil {
 		return "nil"
@@ -12773,6 +14704,9 @@ func (this *Submit) String() string {
 		`WorkflowTemplateRef:` + strings.Replace(strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1), `&`, ``, 1) + `,`,
 		`Arguments:` + strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1) + `,`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`DedupeKey:` + fmt.Sprintf("%v", this.DedupeKey) + `,`,
+		`DedupeWindow:` + strings.Replace(fmt.Sprintf("%v", this.DedupeWindow), "Duration", "v11.Duration", 1) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "EventRateLimit", "EventRateLimit", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12958,12 +14892,27 @@ func (this *TemplateRef) String() string {
 	}, "")
 	return s
 }
+func (this *TerminateAction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TerminateAction{`,
+		`LabelSelector:` + fmt.Sprintf("%v", this.LabelSelector) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TransformationStep) String() string {
 	if this == nil {
 		return "nil"
 	}
 	s := strings.Join([]string{`&TransformationStep{`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Parse:` + fmt.Sprintf("%v", this.Parse) + `,`,
+		`Filter:` + fmt.Sprintf("%v", this.Filter) + `,`,
+		`Map:` + fmt.Sprintf("%v", this.Map) + `,`,
+		`GroupBy:` + fmt.Sprintf("%v", this.GroupBy) + `,`,
+		`Chunk:` + fmt.Sprintf("%v", this.Chunk) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13069,6 +15018,10 @@ func (this *WorkflowEventBindingSpec) String() string {
 	s := strings.Join([]string{`&WorkflowEventBindingSpec{`,
 		`Event:` + strings.Replace(strings.Replace(this.Event.String(), "Event", "Event", 1), `&`, ``, 1) + `,`,
 		`Submit:` + strings.Replace(this.Submit.String(), "Submit", "Submit", 1) + `,`,
+		`Resume:` + strings.Replace(this.Resume.String(), "ResumeAction", "ResumeAction", 1) + `,`,
+		`Stop:` + strings.Replace(this.Stop.String(), "StopAction", "StopAction", 1) + `,`,
+		`Terminate:` + strings.Replace(this.Terminate.String(), "TerminateAction", "TerminateAction", 1) + `,`,
+		`Set:` + strings.Replace(this.Set.String(), "SetAction", "SetAction", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14049,6 +16002,175 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			}
 			m.FromExpression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactFiles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactFiles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactFiles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = DataFormat(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14776,7 +16898,7 @@ func (m *ArtifactRepositoryRefStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
+func (m *ArtifactStreaming) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14799,17 +16921,17 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactoryArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactStreaming: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactoryArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactStreaming: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartSize", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14819,29 +16941,137 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.PartSize == nil {
+				m.PartSize = &resource.Quantity{}
+			}
+			if err := m.PartSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
 			}
-			var msglen int
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactoryArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactoryArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16139,7 +18369,7 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
+func (m *CronExclusions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16162,17 +18392,17 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronExclusions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronExclusions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dates", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16182,28 +18412,27 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Dates = append(m.Dates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16230,40 +18459,10 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16291,7 +18490,7 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16314,15 +18513,15 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16349,13 +18548,13 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16382,8 +18581,40 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CronWorkflow{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16411,7 +18642,7 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowBackfill) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16434,15 +18665,15 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowBackfill: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowBackfill: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16469,15 +18700,15 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16487,27 +18718,47 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxParallel", wireType)
+			}
+			m.MaxParallel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxParallel |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16535,13 +18786,13 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			m.Parameter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16551,119 +18802,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Suspend = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.StartingDeadlineSeconds = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SuccessfulJobsHistoryLimit = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FailedJobsHistoryLimit = &v
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16678,10 +18817,10 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowMetadata == nil {
-				m.WorkflowMetadata = &v11.ObjectMeta{}
+			if m.Next == nil {
+				m.Next = &v11.Time{}
 			}
-			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16709,7 +18848,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16732,15 +18871,15 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16767,50 +18906,13 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Active = append(m.Active, v1.ObjectReference{})
-			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastScheduledTime == nil {
-				m.LastScheduledTime = &v11.Time{}
-			}
-			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16837,8 +18939,8 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, CronWorkflow{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16866,7 +18968,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTask) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowRun) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16889,10 +18991,10 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowRun: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowRun: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16929,7 +19031,7 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16957,11 +19059,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.Phase = WorkflowPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16988,13 +19090,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17021,16 +19123,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
-			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17058,11 +19157,64 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17089,14 +19241,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithItems = append(m.WithItems, Item{})
-			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17124,13 +19275,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithParam = string(dAtA[iNdEx:postIndex])
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17140,31 +19291,107 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithSequence == nil {
-				m.WithSequence = &Sequence{}
+			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
 			}
-			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 9:
+			m.Suspend = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StartingDeadlineSeconds = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuccessfulJobsHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedJobsHistoryLimit = &v
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17192,11 +19419,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			m.Timezone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17223,16 +19450,16 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContinueOn == nil {
-				m.ContinueOn = &ContinueOn{}
+			if m.WorkflowMetadata == nil {
+				m.WorkflowMetadata = &v11.ObjectMeta{}
 			}
-			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17260,11 +19487,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnExit = string(dAtA[iNdEx:postIndex])
+			m.Schedules = append(m.Schedules, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 12:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17292,66 +19519,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depends = string(dAtA[iNdEx:postIndex])
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclusions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17361,27 +19535,31 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			if m.Exclusions == nil {
+				m.Exclusions = &CronExclusions{}
+			}
+			if err := m.Exclusions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StopStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17408,32 +19586,13 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, DAGTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StopStrategy == nil {
+				m.StopStrategy = &StopStrategy{}
+			}
+			if err := m.StopStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.FailFast = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17458,7 +19617,7 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Data) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17481,15 +19640,15 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Data: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17516,13 +19675,14 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Active = append(m.Active, v1.ObjectReference{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transformation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17549,67 +19709,50 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transformation = append(m.Transformation, TransformationStep{})
-			if err := m.Transformation[len(m.Transformation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.LastScheduledTime == nil {
+				m.LastScheduledTime = &v11.Time{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DataSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactPaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backfill", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17636,71 +19779,75 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArtifactPaths == nil {
-				m.ArtifactPaths = &ArtifactPaths{}
+			if m.Backfill == nil {
+				m.Backfill = &CronWorkflowBackfill{}
 			}
-			if err := m.ArtifactPaths.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Backfill.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Event) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentRuns", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17710,82 +19857,31 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.RecentRuns = append(m.RecentRuns, CronWorkflowRun{})
+			if err := m.RecentRuns[len(m.RecentRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17795,23 +19891,27 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			if m.NextScheduledTime == nil {
+				m.NextScheduledTime = &v11.Time{}
+			}
+			if err := m.NextScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17837,7 +19937,7 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
+func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17860,17 +19960,17 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17880,28 +19980,27 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17929,66 +20028,13 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GCSBucket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17998,27 +20044,28 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18045,69 +20092,16 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServiceAccountKeySecret == nil {
-				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
 			}
-			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Gauge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18135,13 +20129,13 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18151,69 +20145,29 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Realtime = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GitArtifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WithItems = append(m.WithItems, Item{})
+			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18241,13 +20195,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.WithParam = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18257,29 +20211,33 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			if m.WithSequence == nil {
+				m.WithSequence = &Sequence{}
+			}
+			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18289,34 +20247,14 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Depth = &v
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
@@ -18325,11 +20263,11 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18356,18 +20294,18 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
+			if m.ContinueOn == nil {
+				m.ContinueOn = &ContinueOn{}
 			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18377,33 +20315,29 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OnExit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18413,48 +20347,24 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depends = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18479,7 +20389,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
+func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18502,17 +20412,17 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18522,30 +20432,29 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18555,27 +20464,29 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Tasks = append(m.Tasks, DAGTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -18592,7 +20503,8 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			b := bool(v != 0)
+			m.FailFast = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18617,7 +20529,7 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
+func (m *Data) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18640,15 +20552,15 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Data: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18675,15 +20587,15 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transformation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18693,27 +20605,29 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Transformation = append(m.Transformation, TransformationStep{})
+			if err := m.Transformation[len(m.Transformation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputArtifact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18741,7 +20655,7 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HDFSUser = string(dAtA[iNdEx:postIndex])
+			m.OutputArtifact = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18767,7 +20681,7 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
+func (m *DataSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18790,15 +20704,15 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactPaths", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18825,52 +20739,16 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			if m.ArtifactPaths == nil {
+				m.ArtifactPaths = &ArtifactPaths{}
 			}
-			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactPaths.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18898,13 +20776,14 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Value = &s
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactFiles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18914,27 +20793,31 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			if m.ArtifactFiles == nil {
+				m.ArtifactFiles = &ArtifactFiles{}
+			}
+			if err := m.ArtifactFiles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18961,28 +20844,81 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPDataSource{}
 			}
-			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -18998,7 +20934,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19024,7 +20960,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
+func (m *EventRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19047,17 +20983,17 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19067,27 +21003,14 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19114,8 +21037,10 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, Header{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Period == nil {
+				m.Period = &v11.Duration{}
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19143,7 +21068,7 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Header) Unmarshal(dAtA []byte) error {
+func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19166,47 +21091,15 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19234,7 +21127,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19260,7 +21153,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Histogram) Unmarshal(dAtA []byte) error {
+func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19283,17 +21176,17 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19303,29 +21196,30 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19335,25 +21229,23 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buckets = append(m.Buckets, Amount{})
-			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19379,7 +21271,7 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Inputs) Unmarshal(dAtA []byte) error {
+func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19402,15 +21294,47 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19437,14 +21361,16 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ServiceAccountKeySecret == nil {
+				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19471,8 +21397,10 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Streaming == nil {
+				m.Streaming = &ArtifactStreaming{}
+			}
+			if err := m.Streaming.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19500,7 +21428,7 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Item) Unmarshal(dAtA []byte) error {
+func (m *Gauge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19523,17 +21451,17 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19543,34 +21471,53 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Realtime = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
 			}
 			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
@@ -19587,7 +21534,7 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Link) Unmarshal(dAtA []byte) error {
+func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19610,15 +21557,15 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Link: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19646,11 +21593,11 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19678,11 +21625,31 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scope = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Depth = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19710,66 +21677,13 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19779,17 +21693,33 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hit = bool(v != 0)
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19799,29 +21729,33 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19831,24 +21765,48 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacheName = string(dAtA[iNdEx:postIndex])
+			if m.SSHPrivateKeySecret == nil {
+				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureIgnoreHostKey = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19873,7 +21831,7 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Memoize) Unmarshal(dAtA []byte) error {
+func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19896,17 +21854,17 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Memoize: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Memoize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19916,29 +21874,30 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19948,33 +21907,29 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cache == nil {
-				m.Cache = &Cache{}
-			}
-			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19984,24 +21939,12 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MaxAge = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20026,7 +21969,7 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20049,15 +21992,15 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20084,109 +22027,15 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20196,118 +22045,55 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HDFSUser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20333,7 +22119,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricLabel) Unmarshal(dAtA []byte) error {
+func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20356,17 +22142,17 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricLabel: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20376,27 +22162,67 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.KrbCCacheSecret == nil {
+				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KrbKeytabSecret == nil {
+				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20424,64 +22250,43 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.KrbUsername = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Metrics) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20508,67 +22313,16 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prometheus = append(m.Prometheus, &Prometheus{})
-			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.KrbConfigConfigMap == nil {
+				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Mutex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20596,7 +22350,7 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20622,7 +22376,7 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MutexHolding) Unmarshal(dAtA []byte) error {
+func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20645,15 +22399,15 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MutexHolding: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MutexHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20681,13 +22435,13 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mutex = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20697,23 +22451,25 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.Headers = append(m.Headers, Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20739,7 +22495,7 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MutexStatus) Unmarshal(dAtA []byte) error {
+func (m *HTTPDataSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20762,17 +22518,17 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MutexStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPDataSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MutexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20782,29 +22538,27 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holding = append(m.Holding, MutexHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20831,8 +22585,8 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = append(m.Waiting, MutexHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20860,7 +22614,7 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+func (m *Header) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20883,15 +22637,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20919,11 +22673,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20951,11 +22705,64 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Histogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20983,13 +22790,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20999,59 +22806,82 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = NodeType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			m.Buckets = append(m.Buckets, Amount{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Inputs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21078,18 +22908,16 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
-			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21099,29 +22927,84 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Item) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21131,61 +23014,84 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BoundaryID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Link) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Link: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21195,30 +23101,29 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21228,28 +23133,27 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21277,70 +23181,66 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodIP = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Daemoned", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Daemoned = &b
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inputs == nil {
-				m.Inputs = &Inputs{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21350,31 +23250,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Outputs == nil {
-				m.Outputs = &Outputs{}
-			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
+			m.Hit = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21402,11 +23286,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21434,24 +23318,77 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutboundNodes = append(m.OutboundNodes, string(dAtA[iNdEx:postIndex]))
+			m.CacheName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateScope", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Memoize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Memoize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Memoize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
 					break
 				}
 			}
@@ -21466,11 +23403,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateScope = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21497,11 +23434,132 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourcesDuration == nil {
-				m.ResourcesDuration = make(ResourcesDuration)
+			if m.Cache == nil {
+				m.Cache = &Cache{}
 			}
-			var mapkey k8s_io_api_core_v1.ResourceName
-			var mapvalue int64
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -21547,9 +23605,10 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -21559,11 +23618,24 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= int64(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21579,13 +23651,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(mapkey)] = ((ResourceDuration)(mapvalue))
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 22:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostNodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21595,27 +23667,1958 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricLabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricLabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metrics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prometheus = append(m.Prometheus, &Prometheus{})
+			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Mutex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MutexHolding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MutexHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MutexHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MutexStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MutexStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MutexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holding = append(m.Holding, MutexHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waiting = append(m.Waiting, MutexHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = NodeType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BoundaryID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Daemoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Daemoned = &b
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Inputs == nil {
+				m.Inputs = &Inputs{}
+			}
+			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundNodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundNodes = append(m.OutboundNodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateScope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateScope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourcesDuration == nil {
+				m.ResourcesDuration = make(ResourcesDuration)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(mapkey)] = ((ResourceDuration)(mapvalue))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostNodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostNodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoizationStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemoizationStatus == nil {
+				m.MemoizationStatus = &MemoizationStatus{}
+			}
+			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+			}
+			m.EstimatedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SynchronizationStatus == nil {
+				m.SynchronizationStatus = &NodeSynchronizationStatus{}
+			}
+			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waiting = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostNodeName = string(dAtA[iNdEx:postIndex])
+			if m.AccessKeySecret == nil {
+				m.AccessKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 23:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoizationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21642,18 +25645,18 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemoizationStatus == nil {
-				m.MemoizationStatus = &MemoizationStatus{}
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
 			}
-			m.EstimatedDuration = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21663,16 +25666,17 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 25:
+			m.CreateBucketIfNotPresent = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21682,33 +25686,29 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SynchronizationStatus == nil {
-				m.SynchronizationStatus = &NodeSynchronizationStatus{}
-			}
-			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SecurityToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21718,23 +25718,27 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			if m.Streaming == nil {
+				m.Streaming = &ArtifactStreaming{}
+			}
+			if err := m.Streaming.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21760,7 +25764,7 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
+func (m *Outputs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21783,15 +25787,83 @@ func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21819,61 +25891,42 @@ func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Result = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			s := string(dAtA[iNdEx:postIndex])
+			m.ExitCode = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21898,7 +25951,7 @@ func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
+func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21921,50 +25974,17 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21974,23 +25994,25 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Steps = append(m.Steps, WorkflowStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22016,7 +26038,7 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSBucket) Unmarshal(dAtA []byte) error {
+func (m *Parameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22039,15 +26061,15 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22075,11 +26097,11 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22107,13 +26129,14 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Default = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22123,31 +26146,28 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Value = &s
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22174,18 +26194,18 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ValueFrom{}
 			}
-			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22195,15 +26215,27 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CreateBucketIfNotPresent = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22231,8 +26263,64 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &ParameterSchema{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sensitive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sensitive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22257,7 +26345,7 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Outputs) Unmarshal(dAtA []byte) error {
+func (m *ParameterSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22280,15 +26368,155 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParameterSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParameterSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = ParameterType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLength", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinLength = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLength", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxLength = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minimum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Minimum == nil {
+				m.Minimum = &Amount{}
+			}
+			if err := m.Minimum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maximum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22315,14 +26543,56 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Maximum == nil {
+				m.Maximum = &Amount{}
+			}
+			if err := m.Maximum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinItems", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinItems = &v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxItems", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxItems = &v
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22349,16 +26619,18 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Items == nil {
+				m.Items = &ParameterSchema{}
+			}
+			if err := m.Items.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22368,28 +26640,124 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Result = &s
+			if m.Properties == nil {
+				m.Properties = make(map[string]ParameterSchema)
+			}
+			var mapkey string
+			mapvalue := &ParameterSchema{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ParameterSchema{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Properties[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22417,8 +26785,7 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ExitCode = &s
+			m.Required = append(m.Required, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22444,7 +26811,7 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
+func (m *PodGC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22467,15 +26834,47 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22502,8 +26901,10 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, WorkflowStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v11.LabelSelector{}
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22531,7 +26932,7 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Parameter) Unmarshal(dAtA []byte) error {
+func (m *Prometheus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22550,21 +26951,53 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22574,27 +27007,29 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Labels = append(m.Labels, &MetricLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22622,12 +27057,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Default = &s
+			m.Help = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22655,12 +27089,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Value = &s
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22687,18 +27120,18 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ValueFrom{}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
 			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22708,29 +27141,33 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			if m.Histogram == nil {
+				m.Histogram = &Histogram{}
+			}
+			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22740,23 +27177,27 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			if m.Counter == nil {
+				m.Counter = &Counter{}
+			}
+			if err := m.Counter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22782,7 +27223,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodGC) Unmarshal(dAtA []byte) error {
+func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22805,15 +27246,15 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22841,43 +27282,7 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = &v11.LabelSelector{}
-			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22903,7 +27308,7 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Prometheus) Unmarshal(dAtA []byte) error {
+func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22926,15 +27331,15 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22962,13 +27367,13 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22978,29 +27383,27 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, &MetricLabel{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MergeStrategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23028,11 +27431,95 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Help = string(dAtA[iNdEx:postIndex])
+			m.Manifest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetOwnerReference", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetOwnerReference = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23060,13 +27547,66 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23076,33 +27616,29 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &Gauge{}
-			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23112,31 +27648,27 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Histogram == nil {
-				m.Histogram = &Histogram{}
-			}
-			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputParameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23163,10 +27695,8 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Counter == nil {
-				m.Counter = &Counter{}
-			}
-			if err := m.Counter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutputParameters = append(m.OutputParameters, Parameter{})
+			if err := m.OutputParameters[len(m.OutputParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23194,7 +27724,7 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawArtifact) Unmarshal(dAtA []byte) error {
+func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23217,17 +27747,17 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryAffinity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAntiAffinity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23237,23 +27767,27 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			if m.NodeAntiAffinity == nil {
+				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
+			}
+			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23279,7 +27813,7 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
+func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23302,81 +27836,70 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeStrategy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23386,47 +27909,31 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetOwnerReference", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Limit == nil {
+				m.Limit = &intstr.IntOrString{}
 			}
-			m.SetOwnerReference = bool(v != 0)
-		case 5:
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23454,13 +27961,13 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			m.RetryPolicy = RetryPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23470,29 +27977,33 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23502,23 +28013,27 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
+			if m.Affinity == nil {
+				m.Affinity = &RetryAffinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23544,7 +28059,7 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
+func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23567,15 +28082,15 @@ func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryAffinity: wiretype end group for non-group")
+			return fmt.Errorf("proto: S3Artifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: S3Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAntiAffinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23602,13 +28117,42 @@ func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeAntiAffinity == nil {
-				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
-			}
-			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23633,7 +28177,7 @@ func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
+func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23656,68 +28200,168 @@ func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
+			return fmt.Errorf("proto: S3Bucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: S3Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Insecure = &b
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.AccessKeySecret == nil {
+				m.AccessKeySecret = &v1.SecretKeySelector{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23744,16 +28388,16 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limit == nil {
-				m.Limit = &intstr.IntOrString{}
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23781,11 +28425,31 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetryPolicy = RetryPolicy(dAtA[iNdEx:postIndex])
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseSDKCreds = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23812,16 +28476,16 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Backoff == nil {
-				m.Backoff = &Backoff{}
+			if m.CreateBucketIfNotPresent == nil {
+				m.CreateBucketIfNotPresent = &CreateS3BucketOptions{}
 			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreateBucketIfNotPresent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23848,10 +28512,10 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &RetryAffinity{}
+			if m.Streaming == nil {
+				m.Streaming = &ArtifactStreaming{}
 			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Streaming.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23879,7 +28543,7 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3Artifact) Unmarshal(dAtA []byte) error {
+func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23902,15 +28566,15 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3Artifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23937,13 +28601,13 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.S3Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23971,7 +28635,7 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23997,7 +28661,7 @@ func (m *S3Artifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3Bucket) Unmarshal(dAtA []byte) error {
+func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24020,15 +28684,15 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3Bucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24056,11 +28720,11 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.Semaphore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24088,64 +28752,64 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			b := bool(v != 0)
-			m.Insecure = &b
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24172,16 +28836,69 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
 			}
-			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24208,68 +28925,14 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holding = append(m.Holding, SemaphoreHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseSDKCreds = bool(v != 0)
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24296,10 +28959,8 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateBucketIfNotPresent == nil {
-				m.CreateBucketIfNotPresent = &CreateS3BucketOptions{}
-			}
-			if err := m.CreateBucketIfNotPresent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Waiting = append(m.Waiting, SemaphoreHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24327,7 +28988,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
+func (m *Sequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24350,15 +29011,15 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24385,13 +29046,88 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Count == nil {
+				m.Count = &intstr.IntOrString{}
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &intstr.IntOrString{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &intstr.IntOrString{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24419,7 +29155,7 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Format = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24445,7 +29181,7 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
+func (m *SetAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24468,15 +29204,15 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24504,11 +29240,11 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Semaphore = string(dAtA[iNdEx:postIndex])
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24536,64 +29272,75 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputParameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24620,10 +29367,8 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutputParameters = append(m.OutputParameters, Parameter{})
+			if err := m.OutputParameters[len(m.OutputParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24651,7 +29396,7 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
+func (m *StopAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24674,17 +29419,17 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24694,31 +29439,29 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holding = append(m.Holding, SemaphoreHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24728,25 +29471,55 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = append(m.Waiting, SemaphoreHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24772,7 +29545,7 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sequence) Unmarshal(dAtA []byte) error {
+func (m *StopStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24795,17 +29568,17 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
 			}
-			var msglen int
+			m.ConsecutiveFailures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24815,31 +29588,67 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ConsecutiveFailures |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Count == nil {
-				m.Count = &intstr.IntOrString{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Submit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Submit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Submit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24866,16 +29675,13 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &intstr.IntOrString{}
-			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24902,101 +29708,16 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.End == nil {
-				m.End = &intstr.IntOrString{}
+			if m.Arguments == nil {
+				m.Arguments = &Arguments{}
 			}
-			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Format = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Submit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Submit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Submit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25023,13 +29744,45 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DedupeKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DedupeKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DedupeWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25056,16 +29809,16 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Arguments == nil {
-				m.Arguments = &Arguments{}
+			if m.DedupeWindow == nil {
+				m.DedupeWindow = &v11.Duration{}
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DedupeWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25092,7 +29845,10 @@ func (m *Submit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RateLimit == nil {
+				m.RateLimit = &EventRateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27407,8 +32163,230 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.FailFast = &b
+			b := bool(v != 0)
+			m.FailFast = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TerminateAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerminateAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerminateAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27433,7 +32411,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TemplateRef) Unmarshal(dAtA []byte) error {
+func (m *TransformationStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27456,15 +32434,15 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemplateRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransformationStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransformationStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27492,11 +32470,11 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parse", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27524,13 +32502,13 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.Parse = DataFormat(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27540,68 +32518,59 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClusterScope = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransformationStep) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransformationStep: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransformationStep: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Map = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27629,8 +32598,27 @@ func (m *TransformationStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			m.Chunk = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunk |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28959,6 +33947,150 @@ func (m *WorkflowEventBindingSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resume == nil {
+				m.Resume = &ResumeAction{}
+			}
+			if err := m.Resume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stop == nil {
+				m.Stop = &StopAction{}
+			}
+			if err := m.Stop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Terminate == nil {
+				m.Terminate = &TerminateAction{}
+			}
+			if err := m.Terminate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Set == nil {
+				m.Set = &SetAction{}
+			}
+			if err := m.Set.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -0,0 +1,213 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+)
+
+type ParameterType string
+
+const (
+	ParameterTypeString  ParameterType = "string"
+	ParameterTypeInteger ParameterType = "integer"
+	ParameterTypeNumber  ParameterType = "number"
+	ParameterTypeBoolean ParameterType = "boolean"
+	ParameterTypeArray   ParameterType = "array"
+	ParameterTypeObject  ParameterType = "object"
+)
+
+// ParameterSchema is a subset of JSON schema, that the value of a parameter must match.
+// Values of parameters that are not strings must be JSON, e.g. `3`, `true`, `["a","b"]` or `{"a":1}`.
+type ParameterSchema struct {
+	// Type of the value. One of: string (default), integer, number, boolean, array, object
+	Type ParameterType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=ParameterType"`
+
+	// Pattern is a regular expression that string values must match
+	Pattern string `json:"pattern,omitempty" protobuf:"bytes,2,opt,name=pattern"`
+
+	// MinLength is the minimum length of string values
+	MinLength *int64 `json:"minLength,omitempty" protobuf:"varint,3,opt,name=minLength"`
+
+	// MaxLength is the maximum length of string values
+	MaxLength *int64 `json:"maxLength,omitempty" protobuf:"varint,4,opt,name=maxLength"`
+
+	// Minimum is the minimum of integer and number values
+	Minimum *Amount `json:"minimum,omitempty" protobuf:"bytes,5,opt,name=minimum"`
+
+	// Maximum is the maximum of integer and number values
+	Maximum *Amount `json:"maximum,omitempty" protobuf:"bytes,6,opt,name=maximum"`
+
+	// MinItems is the minimum number of items of array values
+	MinItems *int64 `json:"minItems,omitempty" protobuf:"varint,7,opt,name=minItems"`
+
+	// MaxItems is the maximum number of items of array values
+	MaxItems *int64 `json:"maxItems,omitempty" protobuf:"varint,8,opt,name=maxItems"`
+
+	// Items is the schema of the items of array values
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	Items *ParameterSchema `json:"items,omitempty" protobuf:"bytes,9,opt,name=items"`
+
+	// Properties are the schemas of the properties of object values
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	Properties map[string]ParameterSchema `json:"properties,omitempty" protobuf:"bytes,10,rep,name=properties"`
+
+	// Required are the properties that object values must have
+	Required []string `json:"required,omitempty" protobuf:"bytes,11,rep,name=required"`
+}
+
+// ValidateSchema returns an error if the schema itself is invalid, e.g. it has an unknown type
+func (s *ParameterSchema) ValidateSchema() error {
+	return s.validateSchema("schema")
+}
+
+func (s *ParameterSchema) validateSchema(path string) error {
+	switch s.Type {
+	case "", ParameterTypeString, ParameterTypeInteger, ParameterTypeNumber, ParameterTypeBoolean, ParameterTypeArray, ParameterTypeObject:
+	default:
+		return fmt.Errorf("%s.type %q is not one of: string, integer, number, boolean, array, object", path, s.Type)
+	}
+	if _, err := regexp.Compile(s.Pattern); err != nil {
+		return fmt.Errorf("%s.pattern is invalid: %w", path, err)
+	}
+	if s.Minimum != nil {
+		if _, err := s.Minimum.Float64(); err != nil {
+			return fmt.Errorf("%s.minimum %q is not a number", path, s.Minimum.Value)
+		}
+	}
+	if s.Maximum != nil {
+		if _, err := s.Maximum.Float64(); err != nil {
+			return fmt.Errorf("%s.maximum %q is not a number", path, s.Maximum.Value)
+		}
+	}
+	if s.Items != nil {
+		if err := s.Items.validateSchema(path + ".items"); err != nil {
+			return err
+		}
+	}
+	for _, name := range s.propertyNames() {
+		p := s.Properties[name]
+		if err := p.validateSchema(path + ".properties." + name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ParameterSchema) propertyNames() []string {
+	var names []string
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate returns an error if the value of the parameter does not match the schema
+func (s *ParameterSchema) Validate(value string) error {
+	if err := s.ValidateSchema(); err != nil {
+		return err
+	}
+	if s.Type == "" || s.Type == ParameterTypeString {
+		return s.validate("value", value)
+	}
+	var v interface{}
+	d := json.NewDecoder(bytes.NewBufferString(value))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil || d.More() {
+		return fmt.Errorf("value %q must be JSON of type %s", value, s.Type)
+	}
+	return s.validate("value", v)
+}
+
+func (s *ParameterSchema) validate(path string, v interface{}) error {
+	switch s.Type {
+	case "", ParameterTypeString:
+		x, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("%s must be a string", path)
+		}
+		if s.Pattern != "" && !regexp.MustCompile(s.Pattern).MatchString(x) {
+			return fmt.Errorf("%s %q must match %q", path, x, s.Pattern)
+		}
+		n := int64(utf8.RuneCountInString(x))
+		if s.MinLength != nil && n < *s.MinLength {
+			return fmt.Errorf("%s %q must be at least %d characters long", path, x, *s.MinLength)
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			return fmt.Errorf("%s %q must be at most %d characters long", path, x, *s.MaxLength)
+		}
+	case ParameterTypeInteger, ParameterTypeNumber:
+		x, ok := v.(json.Number)
+		if !ok && s.Type == ParameterTypeInteger {
+			return fmt.Errorf("%s must be an integer", path)
+		}
+		if !ok {
+			return fmt.Errorf("%s must be a number", path)
+		}
+		if _, err := x.Int64(); err != nil && s.Type == ParameterTypeInteger {
+			return fmt.Errorf("%s %s must be an integer", path, x)
+		}
+		f, err := x.Float64()
+		if err != nil {
+			return fmt.Errorf("%s %s must be a number", path, x)
+		}
+		if s.Minimum != nil {
+			if min, _ := s.Minimum.Float64(); f < min {
+				return fmt.Errorf("%s %s must be at least %s", path, x, s.Minimum.Value)
+			}
+		}
+		if s.Maximum != nil {
+			if max, _ := s.Maximum.Float64(); f > max {
+				return fmt.Errorf("%s %s must be at most %s", path, x, s.Maximum.Value)
+			}
+		}
+	case ParameterTypeBoolean:
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("%s must be a boolean", path)
+		}
+	case ParameterTypeArray:
+		x, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s must be an array", path)
+		}
+		n := int64(len(x))
+		if s.MinItems != nil && n < *s.MinItems {
+			return fmt.Errorf("%s must have at least %d items", path, *s.MinItems)
+		}
+		if s.MaxItems != nil && n > *s.MaxItems {
+			return fmt.Errorf("%s must have at most %d items", path, *s.MaxItems)
+		}
+		if s.Items != nil {
+			for i, item := range x {
+				if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+	case ParameterTypeObject:
+		x, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s must be an object", path)
+		}
+		for _, name := range s.Required {
+			if _, ok := x[name]; !ok {
+				return fmt.Errorf("%s must have property %q", path, name)
+			}
+		}
+		for _, name := range s.propertyNames() {
+			if y, ok := x[name]; ok {
+				p := s.Properties[name]
+				if err := p.validate(path+"."+name, y); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+)
+
+func TestParameterSchema_ValidateSchema(t *testing.T) {
+	assert.NoError(t, (&ParameterSchema{Type: ParameterTypeArray, Items: &ParameterSchema{Type: ParameterTypeInteger}}).ValidateSchema())
+	assert.EqualError(t, (&ParameterSchema{Type: "int"}).ValidateSchema(), `schema.type "int" is not one of: string, integer, number, boolean, array, object`)
+	assert.EqualError(t, (&ParameterSchema{Pattern: "("}).ValidateSchema(), "schema.pattern is invalid: error parsing regexp: missing closing ): `(`")
+	assert.EqualError(t, (&ParameterSchema{Type: ParameterTypeInteger, Minimum: &Amount{Value: "one"}}).ValidateSchema(), `schema.minimum "one" is not a number`)
+	assert.EqualError(t, (&ParameterSchema{Type: ParameterTypeObject, Properties: map[string]ParameterSchema{"a": {Type: "int"}}}).ValidateSchema(), `schema.properties.a.type "int" is not one of: string, integer, number, boolean, array, object`)
+}
+
+func TestParameterSchema_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema ParameterSchema
+		value  string
+		err    string
+	}{
+		{"String", ParameterSchema{}, "{}", ""},
+		{"Pattern", ParameterSchema{Pattern: "^[a-z]+$"}, "abc", ""},
+		{"PatternMismatch", ParameterSchema{Pattern: "^[a-z]+$"}, "ABC", `value "ABC" must match "^[a-z]+$"`},
+		{"MinLength", ParameterSchema{MinLength: pointer.Int64Ptr(4)}, "abc", `value "abc" must be at least 4 characters long`},
+		{"MaxLength", ParameterSchema{MaxLength: pointer.Int64Ptr(2)}, "abc", `value "abc" must be at most 2 characters long`},
+		{"Integer", ParameterSchema{Type: ParameterTypeInteger}, "3", ""},
+		{"NotInteger", ParameterSchema{Type: ParameterTypeInteger}, "1.5", "value 1.5 must be an integer"},
+		{"NotJSON", ParameterSchema{Type: ParameterTypeInteger}, "three", `value "three" must be JSON of type integer`},
+		{"NotOneValue", ParameterSchema{Type: ParameterTypeInteger}, "1 2", `value "1 2" must be JSON of type integer`},
+		{"Minimum", ParameterSchema{Type: ParameterTypeNumber, Minimum: &Amount{Value: "0.5"}}, "0.25", "value 0.25 must be at least 0.5"},
+		{"Maximum", ParameterSchema{Type: ParameterTypeInteger, Maximum: &Amount{Value: "10"}}, "11", "value 11 must be at most 10"},
+		{"Boolean", ParameterSchema{Type: ParameterTypeBoolean}, "true", ""},
+		{"NotBoolean", ParameterSchema{Type: ParameterTypeBoolean}, `"true"`, "value must be a boolean"},
+		{"Array", ParameterSchema{Type: ParameterTypeArray, Items: &ParameterSchema{Type: ParameterTypeInteger}}, "[1, 2]", ""},
+		{"ArrayItem", ParameterSchema{Type: ParameterTypeArray, Items: &ParameterSchema{Type: ParameterTypeInteger}}, `[1, "2"]`, "value[1] must be an integer"},
+		{"MinItems", ParameterSchema{Type: ParameterTypeArray, MinItems: pointer.Int64Ptr(1)}, "[]", "value must have at least 1 items"},
+		{"MaxItems", ParameterSchema{Type: ParameterTypeArray, MaxItems: pointer.Int64Ptr(1)}, "[1, 2]", "value must have at most 1 items"},
+		{"Object", ParameterSchema{Type: ParameterTypeObject, Required: []string{"a"}, Properties: map[string]ParameterSchema{"a": {Type: ParameterTypeBoolean}}}, `{"a": true, "b": 1}`, ""},
+		{"ObjectRequired", ParameterSchema{Type: ParameterTypeObject, Required: []string{"a"}}, `{"b": 1}`, `value must have property "a"`},
+		{"ObjectProperty", ParameterSchema{Type: ParameterTypeObject, Properties: map[string]ParameterSchema{"a": {Type: ParameterTypeBoolean}}}, `{"a": 1}`, "value.a must be a boolean"},
+		{"NotObject", ParameterSchema{Type: ParameterTypeObject}, "[]", "value must be an object"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schema.Validate(tt.value)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
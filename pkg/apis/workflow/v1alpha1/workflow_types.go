@@ -750,6 +750,13 @@ type Parameter struct {
 
 	// Enum holds a list of string values to choose from, for the actual value of the parameter
 	Enum []AnyString `json:"enum,omitempty" protobuf:"bytes,6,rep,name=enum"`
+
+	// Schema is the schema the value of the parameter must match, which is checked when the workflow is validated and submitted
+	Schema *ParameterSchema `json:"schema,omitempty" protobuf:"bytes,7,opt,name=schema"`
+
+	// Sensitive parameters of the workflow's arguments are delivered to pods by a secret, rather than inline, and their
+	// values are masked in the workflow's status, archived workflows, the API and the executor's logs
+	Sensitive bool `json:"sensitive,omitempty" protobuf:"varint,8,opt,name=sensitive"`
 }
 
 // ValueFrom describes a location in which to obtain the value to a parameter
@@ -819,6 +826,13 @@ type Artifact struct {
 
 	// FromExpression, if defined, is evaluated to specify the value for the artifact
 	FromExpression string `json:"fromExpression,omitempty" protobuf:"bytes,11,opt,name=fromExpression"`
+
+	// Digest is the digest of the artifact as stored in the artifact repository, e.g. "sha256:<hex>". It is recorded
+	// when an output artifact is saved, and verified when the artifact is loaded as an input.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,12,opt,name=digest"`
+
+	// SizeBytes is the size of the artifact as stored in the artifact repository, recorded when it is saved
+	SizeBytes int64 `json:"sizeBytes,omitempty" protobuf:"varint,13,opt,name=sizeBytes"`
 }
 
 // PodGC describes how to delete completed pods as they complete
@@ -1029,6 +1043,21 @@ func (a *ArtifactLocation) IsArchiveLogs() bool {
 	return a != nil && a.ArchiveLogs != nil && *a.ArchiveLogs
 }
 
+// GetStreaming returns the streaming configuration of the artifact's bucket, or nil if it is not streamed
+func (a *ArtifactLocation) GetStreaming() *ArtifactStreaming {
+	switch {
+	case a == nil:
+		return nil
+	case a.S3 != nil:
+		return a.S3.Streaming
+	case a.GCS != nil:
+		return a.GCS.Streaming
+	case a.OSS != nil:
+		return a.OSS.Streaming
+	}
+	return nil
+}
+
 func (a *ArtifactLocation) GetKey() (string, error) {
 	v := a.Get()
 	if v == nil {
@@ -1546,6 +1575,15 @@ func (cs *Conditions) JoinConditions(conditions *Conditions) {
 	}
 }
 
+func (cs *Conditions) HasCondition(conditionType ConditionType) bool {
+	for _, c := range *cs {
+		if c.Type == conditionType {
+			return true
+		}
+	}
+	return false
+}
+
 func (cs *Conditions) RemoveCondition(conditionType ConditionType) {
 	for index, wfCondition := range *cs {
 		if wfCondition.Type == conditionType {
@@ -1857,6 +1895,9 @@ type S3Bucket struct {
 
 	// CreateBucketIfNotPresent tells the driver to attempt to create the S3 bucket for output artifacts, if it doesn't exist
 	CreateBucketIfNotPresent *CreateS3BucketOptions `json:"createBucketIfNotPresent,omitempty" protobuf:"bytes,9,opt,name=createBucketIfNotPresent"`
+
+	// Streaming tells the driver to stream artifacts to and from the bucket, rather than staging them as local files
+	Streaming *ArtifactStreaming `json:"streaming,omitempty" protobuf:"bytes,10,opt,name=streaming"`
 }
 
 // CreateS3BucketOptions options used to determine automatic automatic bucket-creation process
@@ -1865,6 +1906,49 @@ type CreateS3BucketOptions struct {
 	ObjectLocking bool `json:"objectLocking,omitempty" protobuf:"varint,3,opt,name=objectLocking"`
 }
 
+const (
+	DefaultArtifactStreamingPartSize    = 16 * 1024 * 1024
+	DefaultArtifactStreamingConcurrency = 4
+	// MinArtifactStreamingPartSize is the smallest part, other than the last, that S3 and OSS accept
+	MinArtifactStreamingPartSize = 5 * 1024 * 1024
+	// MaxArtifactStreamingParts is the most parts that S3 and OSS accept in a multipart upload
+	MaxArtifactStreamingParts = 10000
+)
+
+// ArtifactStreaming configures the streaming of artifacts. Output artifacts are archived straight into a multipart upload,
+// whose parts are uploaded in parallel, and input artifacts are unarchived as they are downloaded.
+type ArtifactStreaming struct {
+	// PartSize is the size of each part of the upload, e.g. "64Mi". Defaults to 16Mi. For S3 and OSS, it must be at least 5Mi, and an upload can have at most 10,000 parts, so the default limits an artifact to 160Gi.
+	PartSize *resource.Quantity `json:"partSize,omitempty" protobuf:"bytes,1,opt,name=partSize"`
+
+	// Concurrency is the number of parts uploaded in parallel. Defaults to 4. Not used for GCS, which uploads the parts in sequence.
+	Concurrency int32 `json:"concurrency,omitempty" protobuf:"varint,2,opt,name=concurrency"`
+}
+
+// GetPartSize returns the part size in bytes
+func (s *ArtifactStreaming) GetPartSize() int64 {
+	if s == nil || s.PartSize == nil || s.PartSize.Value() <= 0 {
+		return DefaultArtifactStreamingPartSize
+	}
+	return s.PartSize.Value()
+}
+
+// ValidateMultipart returns an error if the part size is smaller than S3 and OSS accept
+func (s *ArtifactStreaming) ValidateMultipart() error {
+	if s.GetPartSize() < MinArtifactStreamingPartSize {
+		return fmt.Errorf("streaming.partSize %s is less than the minimum of 5Mi", s.PartSize)
+	}
+	return nil
+}
+
+// GetConcurrency returns the number of parts uploaded in parallel
+func (s *ArtifactStreaming) GetConcurrency() int {
+	if s == nil || s.Concurrency <= 0 {
+		return DefaultArtifactStreamingConcurrency
+	}
+	return int(s.Concurrency)
+}
+
 // S3Artifact is the location of an S3 artifact
 type S3Artifact struct {
 	S3Bucket `json:",inline" protobuf:"bytes,1,opt,name=s3Bucket"`
@@ -2102,6 +2186,9 @@ type GCSBucket struct {
 
 	// ServiceAccountKeySecret is the secret selector to the bucket's service account key
 	ServiceAccountKeySecret *apiv1.SecretKeySelector `json:"serviceAccountKeySecret,omitempty" protobuf:"bytes,2,opt,name=serviceAccountKeySecret"`
+
+	// Streaming tells the driver to stream artifacts to and from the bucket, rather than staging them as local files
+	Streaming *ArtifactStreaming `json:"streaming,omitempty" protobuf:"bytes,3,opt,name=streaming"`
 }
 
 // GCSArtifact is the location of a GCS artifact
@@ -2144,6 +2231,9 @@ type OSSBucket struct {
 
 	// SecurityToken is the user's temporary security token. For more details, check out: https://www.alibabacloud.com/help/doc-detail/100624.htm
 	SecurityToken string `json:"securityToken,omitempty" protobuf:"bytes,6,opt,name=securityToken"`
+
+	// Streaming tells the driver to stream artifacts to and from the bucket, rather than staging them as local files
+	Streaming *ArtifactStreaming `json:"streaming,omitempty" protobuf:"bytes,7,opt,name=streaming"`
 }
 
 // OSSArtifact is the location of an Alibaba Cloud OSS artifact
@@ -2453,6 +2543,60 @@ func (args *Arguments) GetParameterByName(name string) *Parameter {
 	return nil
 }
 
+// SensitiveParameterMask is shown instead of the values of sensitive parameters
+const SensitiveParameterMask = "******"
+
+// GetSensitiveParameterNames returns the names of the sensitive parameters
+func (args *Arguments) GetSensitiveParameterNames() map[string]bool {
+	names := map[string]bool{}
+	for _, param := range args.Parameters {
+		if param.Sensitive {
+			names[param.Name] = true
+		}
+	}
+	return names
+}
+
+// MaskParameters replaces the values of the named parameters with SensitiveParameterMask
+func (args *Arguments) MaskParameters(names map[string]bool) {
+	for i, param := range args.Parameters {
+		if !names[param.Name] {
+			continue
+		}
+		if param.Value != nil {
+			args.Parameters[i].Value = AnyStringPtr(SensitiveParameterMask)
+		}
+		if param.Default != nil {
+			args.Parameters[i].Default = AnyStringPtr(SensitiveParameterMask)
+		}
+	}
+}
+
+// MaskSensitiveParameters replaces the values of the sensitive parameters with SensitiveParameterMask
+func (args *Arguments) MaskSensitiveParameters() {
+	args.MaskParameters(args.GetSensitiveParameterNames())
+}
+
+// UnmaskSensitiveParameters replaces the masked values of the sensitive parameters with their values in the original
+// arguments, e.g. when an object got from the Argo Server is updated
+func (args *Arguments) UnmaskSensitiveParameters(original Arguments) {
+	for i, param := range args.Parameters {
+		if !param.Sensitive {
+			continue
+		}
+		x := original.GetParameterByName(param.Name)
+		if x == nil {
+			continue
+		}
+		if param.Value != nil && param.Value.String() == SensitiveParameterMask {
+			args.Parameters[i].Value = x.Value
+		}
+		if param.Default != nil && param.Default.String() == SensitiveParameterMask {
+			args.Parameters[i].Default = x.Default
+		}
+	}
+}
+
 func (a *Artifact) GetArchive() *ArchiveStrategy {
 	if a == nil || a.Archive == nil {
 		return &ArchiveStrategy{}
@@ -2460,6 +2604,19 @@ func (a *Artifact) GetArchive() *ArchiveStrategy {
 	return a.Archive
 }
 
+// MaskSensitiveParameters replaces the values of the sensitive parameters of the workflow's arguments with
+// SensitiveParameterMask, in both its spec and its stored spec, which declares the parameters of its workflow template
+func (wf *Workflow) MaskSensitiveParameters() {
+	names := wf.Spec.Arguments.GetSensitiveParameterNames()
+	if wf.Status.StoredWorkflowSpec != nil {
+		for name := range wf.Status.StoredWorkflowSpec.Arguments.GetSensitiveParameterNames() {
+			names[name] = true
+		}
+		wf.Status.StoredWorkflowSpec.Arguments.MaskParameters(names)
+	}
+	wf.Spec.Arguments.MaskParameters(names)
+}
+
 // GetTemplateByName retrieves a defined template by its name
 func (wf *Workflow) GetTemplateByName(name string) *Template {
 	for _, t := range wf.Spec.Templates {
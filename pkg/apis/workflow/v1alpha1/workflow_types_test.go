@@ -850,3 +850,38 @@ func TestNodeStatusGetReason(t *testing.T) {
 		assert.Equal(t, NodeReason(""), nodeStatusWithLock.GetReason())
 	})
 }
+
+func TestWorkflow_MaskSensitiveParameters(t *testing.T) {
+	wf := &Workflow{
+		Spec: WorkflowSpec{Arguments: Arguments{Parameters: []Parameter{
+			{Name: "password", Value: AnyStringPtr("s3cret")},
+			{Name: "user", Value: AnyStringPtr("me")},
+		}}},
+		Status: WorkflowStatus{StoredWorkflowSpec: &WorkflowSpec{Arguments: Arguments{Parameters: []Parameter{
+			{Name: "password", Value: AnyStringPtr("s3cret"), Sensitive: true},
+			{Name: "user", Value: AnyStringPtr("me")},
+			{Name: "token", Default: AnyStringPtr("t0ken"), Sensitive: true},
+		}}}},
+	}
+	wf.MaskSensitiveParameters()
+	assert.Equal(t, SensitiveParameterMask, wf.Spec.Arguments.GetParameterByName("password").Value.String())
+	assert.Equal(t, "me", wf.Spec.Arguments.GetParameterByName("user").Value.String())
+	assert.Equal(t, SensitiveParameterMask, wf.Status.StoredWorkflowSpec.Arguments.GetParameterByName("password").Value.String())
+	assert.Equal(t, "me", wf.Status.StoredWorkflowSpec.Arguments.GetParameterByName("user").Value.String())
+	assert.Equal(t, SensitiveParameterMask, wf.Status.StoredWorkflowSpec.Arguments.GetParameterByName("token").Default.String())
+}
+
+func TestArguments_UnmaskSensitiveParameters(t *testing.T) {
+	original := Arguments{Parameters: []Parameter{
+		{Name: "password", Value: AnyStringPtr("s3cret"), Sensitive: true},
+		{Name: "token", Default: AnyStringPtr("t0ken"), Sensitive: true},
+	}}
+	args := *original.DeepCopy()
+	args.Parameters = append(args.Parameters, Parameter{Name: "new", Value: AnyStringPtr(SensitiveParameterMask), Sensitive: true})
+	args.MaskSensitiveParameters()
+	assert.Equal(t, SensitiveParameterMask, args.GetParameterByName("password").Value.String())
+	args.UnmaskSensitiveParameters(original)
+	assert.Equal(t, "s3cret", args.GetParameterByName("password").Value.String())
+	assert.Equal(t, "t0ken", args.GetParameterByName("token").Default.String())
+	assert.Equal(t, SensitiveParameterMask, args.GetParameterByName("new").Value.String(), "there is no original value")
+}
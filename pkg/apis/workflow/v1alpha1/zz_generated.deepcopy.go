@@ -116,6 +116,23 @@ func (in *Artifact) DeepCopy() *Artifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactFiles) DeepCopyInto(out *ArtifactFiles) {
+	*out = *in
+	in.Artifact.DeepCopyInto(&out.Artifact)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactFiles.
+func (in *ArtifactFiles) DeepCopy() *ArtifactFiles {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactFiles)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 	*out = *in
@@ -227,6 +244,27 @@ func (in *ArtifactRepositoryRefStatus) DeepCopy() *ArtifactRepositoryRefStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactStreaming) DeepCopyInto(out *ArtifactStreaming) {
+	*out = *in
+	if in.PartSize != nil {
+		in, out := &in.PartSize, &out.PartSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactStreaming.
+func (in *ArtifactStreaming) DeepCopy() *ArtifactStreaming {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactStreaming)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactoryArtifact) DeepCopyInto(out *ArtifactoryArtifact) {
 	*out = *in
@@ -552,6 +590,32 @@ func (in *CreateS3BucketOptions) DeepCopy() *CreateS3BucketOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronExclusions) DeepCopyInto(out *CronExclusions) {
+	*out = *in
+	if in.Dates != nil {
+		in, out := &in.Dates, &out.Dates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronExclusions.
+func (in *CronExclusions) DeepCopy() *CronExclusions {
+	if in == nil {
+		return nil
+	}
+	out := new(CronExclusions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronWorkflow) DeepCopyInto(out *CronWorkflow) {
 	*out = *in
@@ -580,6 +644,28 @@ func (in *CronWorkflow) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronWorkflowBackfill) DeepCopyInto(out *CronWorkflowBackfill) {
+	*out = *in
+	in.From.DeepCopyInto(&out.From)
+	in.To.DeepCopyInto(&out.To)
+	if in.Next != nil {
+		in, out := &in.Next, &out.Next
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronWorkflowBackfill.
+func (in *CronWorkflowBackfill) DeepCopy() *CronWorkflowBackfill {
+	if in == nil {
+		return nil
+	}
+	out := new(CronWorkflowBackfill)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronWorkflowList) DeepCopyInto(out *CronWorkflowList) {
 	*out = *in
@@ -613,6 +699,24 @@ func (in *CronWorkflowList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronWorkflowRun) DeepCopyInto(out *CronWorkflowRun) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	in.FinishedAt.DeepCopyInto(&out.FinishedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronWorkflowRun.
+func (in *CronWorkflowRun) DeepCopy() *CronWorkflowRun {
+	if in == nil {
+		return nil
+	}
+	out := new(CronWorkflowRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronWorkflowSpec) DeepCopyInto(out *CronWorkflowSpec) {
 	*out = *in
@@ -637,6 +741,21 @@ func (in *CronWorkflowSpec) DeepCopyInto(out *CronWorkflowSpec) {
 		*out = new(metav1.ObjectMeta)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = new(CronExclusions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StopStrategy != nil {
+		in, out := &in.StopStrategy, &out.StopStrategy
+		*out = new(StopStrategy)
+		**out = **in
+	}
 	return
 }
 
@@ -667,6 +786,22 @@ func (in *CronWorkflowStatus) DeepCopyInto(out *CronWorkflowStatus) {
 		*out = make(Conditions, len(*in))
 		copy(*out, *in)
 	}
+	if in.Backfill != nil {
+		in, out := &in.Backfill, &out.Backfill
+		*out = new(CronWorkflowBackfill)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecentRuns != nil {
+		in, out := &in.RecentRuns, &out.RecentRuns
+		*out = make([]CronWorkflowRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextScheduledTime != nil {
+		in, out := &in.NextScheduledTime, &out.NextScheduledTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -782,6 +917,21 @@ func (in *DataSource) DeepCopyInto(out *DataSource) {
 		*out = new(ArtifactPaths)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.ArtifactFiles != nil {
+		in, out := &in.ArtifactFiles, &out.ArtifactFiles
+		*out = new(ArtifactFiles)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPDataSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -811,6 +961,27 @@ func (in *Event) DeepCopy() *Event {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventRateLimit) DeepCopyInto(out *EventRateLimit) {
+	*out = *in
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventRateLimit.
+func (in *EventRateLimit) DeepCopy() *EventRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(EventRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutorConfig) DeepCopyInto(out *ExecutorConfig) {
 	*out = *in
@@ -852,6 +1023,11 @@ func (in *GCSBucket) DeepCopyInto(out *GCSBucket) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(ArtifactStreaming)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1018,6 +1194,27 @@ func (in *HTTPArtifact) DeepCopy() *HTTPArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPDataSource) DeepCopyInto(out *HTTPDataSource) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]Header, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPDataSource.
+func (in *HTTPDataSource) DeepCopy() *HTTPDataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPDataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Header) DeepCopyInto(out *Header) {
 	*out = *in
@@ -1428,6 +1625,11 @@ func (in *OSSBucket) DeepCopyInto(out *OSSBucket) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(ArtifactStreaming)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1527,6 +1729,11 @@ func (in *Parameter) DeepCopyInto(out *Parameter) {
 		*out = make([]AnyString, len(*in))
 		copy(*out, *in)
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(ParameterSchema)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1540,6 +1747,69 @@ func (in *Parameter) DeepCopy() *Parameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterSchema) DeepCopyInto(out *ParameterSchema) {
+	*out = *in
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxLength != nil {
+		in, out := &in.MaxLength, &out.MaxLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Minimum != nil {
+		in, out := &in.Minimum, &out.Minimum
+		*out = new(Amount)
+		**out = **in
+	}
+	if in.Maximum != nil {
+		in, out := &in.Maximum, &out.Maximum
+		*out = new(Amount)
+		**out = **in
+	}
+	if in.MinItems != nil {
+		in, out := &in.MinItems, &out.MinItems
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = new(ParameterSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]ParameterSchema, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterSchema.
+func (in *ParameterSchema) DeepCopy() *ParameterSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodGC) DeepCopyInto(out *PodGC) {
 	*out = *in
@@ -1662,6 +1932,29 @@ func (in ResourcesDuration) DeepCopy() ResourcesDuration {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResumeAction) DeepCopyInto(out *ResumeAction) {
+	*out = *in
+	if in.OutputParameters != nil {
+		in, out := &in.OutputParameters, &out.OutputParameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResumeAction.
+func (in *ResumeAction) DeepCopy() *ResumeAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ResumeAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryAffinity) DeepCopyInto(out *RetryAffinity) {
 	*out = *in
@@ -1770,6 +2063,11 @@ func (in *S3Bucket) DeepCopyInto(out *S3Bucket) {
 		*out = new(CreateS3BucketOptions)
 		**out = **in
 	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(ArtifactStreaming)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1903,6 +2201,61 @@ func (in *Sequence) DeepCopy() *Sequence {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetAction) DeepCopyInto(out *SetAction) {
+	*out = *in
+	if in.OutputParameters != nil {
+		in, out := &in.OutputParameters, &out.OutputParameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetAction.
+func (in *SetAction) DeepCopy() *SetAction {
+	if in == nil {
+		return nil
+	}
+	out := new(SetAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StopAction) DeepCopyInto(out *StopAction) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StopAction.
+func (in *StopAction) DeepCopy() *StopAction {
+	if in == nil {
+		return nil
+	}
+	out := new(StopAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StopStrategy) DeepCopyInto(out *StopStrategy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StopStrategy.
+func (in *StopStrategy) DeepCopy() *StopStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(StopStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Submit) DeepCopyInto(out *Submit) {
 	*out = *in
@@ -1913,6 +2266,16 @@ func (in *Submit) DeepCopyInto(out *Submit) {
 		*out = new(Arguments)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DedupeWindow != nil {
+		in, out := &in.DedupeWindow, &out.DedupeWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(EventRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2277,6 +2640,22 @@ func (in *TemplateRef) DeepCopy() *TemplateRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerminateAction) DeepCopyInto(out *TerminateAction) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerminateAction.
+func (in *TerminateAction) DeepCopy() *TerminateAction {
+	if in == nil {
+		return nil
+	}
+	out := new(TerminateAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Transformation) DeepCopyInto(out *Transformation) {
 	{
@@ -2490,6 +2869,26 @@ func (in *WorkflowEventBindingSpec) DeepCopyInto(out *WorkflowEventBindingSpec)
 		*out = new(Submit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Resume != nil {
+		in, out := &in.Resume, &out.Resume
+		*out = new(ResumeAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stop != nil {
+		in, out := &in.Stop, &out.Stop
+		*out = new(StopAction)
+		**out = **in
+	}
+	if in.Terminate != nil {
+		in, out := &in.Terminate, &out.Terminate
+		*out = new(TerminateAction)
+		**out = **in
+	}
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = new(SetAction)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -22,6 +22,8 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3"
 	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/artifactoffload"
+	"github.com/argoproj/argo-workflows/v3/persist/coldstorage"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	clusterwftemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
@@ -34,8 +36,11 @@ import (
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/artifacts"
+	"github.com/argoproj/argo-workflows/v3/server/audit"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/policy"
 	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
+	"github.com/argoproj/argo-workflows/v3/server/auth/token"
 	"github.com/argoproj/argo-workflows/v3/server/auth/webhook"
 	"github.com/argoproj/argo-workflows/v3/server/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/server/cronworkflow"
@@ -71,6 +76,7 @@ type argoServer struct {
 	clients                  *types.Clients
 	gatekeeper               auth.Gatekeeper
 	oAuth2Service            sso.Interface
+	tokensIf                 token.Interface
 	configController         config.Controller
 	stopCh                   chan struct{}
 	eventQueueSize           int
@@ -98,13 +104,22 @@ type ArgoServerOpts struct {
 
 func NewArgoServer(ctx context.Context, opts ArgoServerOpts) (*argoServer, error) {
 	configController := config.NewController(opts.Namespace, opts.ConfigName, opts.Clients.Kubernetes, emptyConfigFunc)
-	ssoIf := sso.NullSSO
+	// token auth mode requires SSO, so tokens are configured with it
+	var ssoConfig sso.Config
 	if opts.AuthModes[auth.SSO] {
 		c, err := configController.Get(ctx)
 		if err != nil {
 			return nil, err
 		}
-		ssoIf, err = sso.New(c.(*Config).SSO, opts.Clients.Kubernetes.CoreV1().Secrets(opts.Namespace), opts.BaseHRef, opts.TLSConfig != nil)
+		ssoConfig = c.(*Config).SSO
+	}
+	// personal access tokens are stored in the namespace the server is installed in, like the SSO secret
+	tokensIf := token.New(opts.Clients.Kubernetes.CoreV1().Secrets(opts.Namespace), ssoConfig.GetTokenMaxExpiry())
+	ssoIf := sso.NullSSO
+	if opts.AuthModes[auth.SSO] {
+		var err error
+		// a user's tokens get the groups they have each time they log in
+		ssoIf, err = sso.New(ssoConfig, opts.Clients.Kubernetes.CoreV1().Secrets(opts.Namespace), opts.BaseHRef, opts.TLSConfig != nil, tokensIf.Refresh)
 		if err != nil {
 			return nil, err
 		}
@@ -112,7 +127,7 @@ func NewArgoServer(ctx context.Context, opts ArgoServerOpts) (*argoServer, error
 	} else {
 		log.Info("SSO disabled")
 	}
-	gatekeeper, err := auth.NewGatekeeper(opts.AuthModes, opts.Clients, opts.RestConfig, ssoIf, auth.DefaultClientForAuthorization, opts.Namespace)
+	gatekeeper, err := auth.NewGatekeeper(opts.AuthModes, opts.Clients, opts.RestConfig, ssoIf, tokensIf, auth.DefaultClientForAuthorization, opts.Namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -125,6 +140,7 @@ func NewArgoServer(ctx context.Context, opts ArgoServerOpts) (*argoServer, error
 		clients:                  opts.Clients,
 		gatekeeper:               gatekeeper,
 		oAuth2Service:            ssoIf,
+		tokensIf:                 tokensIf,
 		configController:         configController,
 		stopCh:                   make(chan struct{}),
 		eventQueueSize:           opts.EventOperationQueueSize,
@@ -151,6 +167,9 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	instanceIDService := instanceid.NewService(config.InstanceID)
 	offloadRepo := sqldb.ExplosiveOffloadNodeStatusRepo
 	wfArchive := sqldb.NullWorkflowArchive
+	// without a database, we only remember the most recent deliveries, and they are lost on restart
+	var eventDeliveries sqldb.EventDeliveryRepo = sqldb.NewMemoryEventDeliveryRepo(1000)
+	var coldStorage coldstorage.Interface
 	persistence := config.Persistence
 	if persistence != nil {
 		session, tableName, err := sqldb.CreateDBSession(as.clients.Kubernetes, as.namespace, persistence)
@@ -166,12 +185,38 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 		// we always enable the archive for the Argo Server, as the Argo Server does not write records, so you can
 		// disable the archiving - and still read old records
 		wfArchive = sqldb.NewWorkflowArchive(session, persistence.GetClusterName(), as.managedNamespace, instanceIDService)
+		if persistence.EventDeliveries {
+			eventDeliveries = sqldb.NewEventDeliveryRepo(session, persistence.GetClusterName(), persistence.GetEventDeliveriesTTL())
+		}
+		if persistence.ArchiveExport != nil {
+			coldStorage, err = coldstorage.New(*persistence.ArchiveExport, persistence.GetClusterName(), as.clients.Kubernetes, as.namespace)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	if config.ArtifactNodeStatusOffload != nil {
+		offloadRepo, err = artifactoffload.New(*config.ArtifactNodeStatusOffload, config.ArtifactRepository, as.clients.Kubernetes, as.namespace)
+		if err != nil {
+			log.Fatal(err)
+		}
 	}
 	eventRecorderManager := events.NewEventRecorderManager(as.clients.Kubernetes)
 	artifactRepositories := artifactrepositories.New(as.clients.Kubernetes, as.managedNamespace, &config.ArtifactRepository)
 	artifactServer := artifacts.NewArtifactServer(as.gatekeeper, hydrator.New(offloadRepo), wfArchive, instanceIDService, artifactRepositories)
-	eventServer := event.NewController(instanceIDService, eventRecorderManager, as.eventQueueSize, as.eventWorkerCount)
-	grpcServer := as.newGRPCServer(instanceIDService, offloadRepo, wfArchive, eventServer, config.Links)
+	var auditor *audit.Auditor
+	if config.Audit != nil {
+		auditor, err = audit.New(ctx, *config.Audit, as.clients.Kubernetes, as.clients.Workflow, as.namespace)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	templatePolicy, err := policy.New(config.TemplatePolicy)
+	if err != nil {
+		log.Fatal(err)
+	}
+	eventServer := event.NewController(instanceIDService, hydrator.New(offloadRepo), templatePolicy, eventDeliveries, eventRecorderManager, as.eventQueueSize, as.eventWorkerCount)
+	grpcServer := as.newGRPCServer(instanceIDService, offloadRepo, wfArchive, coldStorage, eventServer, config.Links, auditor, templatePolicy, artifactRepositories)
 	httpServer := as.newHTTPServer(ctx, port, artifactServer)
 
 	// Start listener
@@ -215,9 +260,24 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	<-as.stopCh
 }
 
-func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, eventServer *event.Controller, links []*v1alpha1.Link) *grpc.Server {
+func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, coldStorage coldstorage.Interface, eventServer *event.Controller, links []*v1alpha1.Link, auditor *audit.Auditor, templatePolicy *policy.Policy, artifactRepositories artifactrepositories.Interface) *grpc.Server {
 	serverLog := log.NewEntry(log.StandardLogger())
 
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpc_prometheus.UnaryServerInterceptor,
+		grpc_logrus.UnaryServerInterceptor(serverLog),
+		grpcutil.PanicLoggerUnaryServerInterceptor(serverLog),
+		grpcutil.ErrorTranslationUnaryServerInterceptor,
+	}
+	// HTTP requests are proxied to gRPC, so this audits both; streaming methods are read-only, so are never audited.
+	// The auditor runs before the gatekeeper, so calls it rejects are audited, and gets the claims from after it.
+	if auditor != nil {
+		unaryInterceptors = append(unaryInterceptors, auditor.UnaryServerInterceptor(), as.gatekeeper.UnaryServerInterceptor(), auditor.ClaimsUnaryServerInterceptor())
+	} else {
+		unaryInterceptors = append(unaryInterceptors, as.gatekeeper.UnaryServerInterceptor())
+	}
+	unaryInterceptors = append(unaryInterceptors, workflow.SensitiveParametersUnaryServerInterceptor)
+
 	// "Prometheus histograms are a great way to measure latency distributions of your RPCs. However, since it is bad practice to have metrics of high cardinality the latency monitoring metrics are disabled by default. To enable them please call the following in your server initialization code:"
 	grpc_prometheus.EnableHandlingTimeHistogram()
 
@@ -228,33 +288,28 @@ func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloa
 		grpc.MaxRecvMsgSize(MaxGRPCMessageSize),
 		grpc.MaxSendMsgSize(MaxGRPCMessageSize),
 		grpc.ConnectionTimeout(300 * time.Second),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			grpc_prometheus.UnaryServerInterceptor,
-			grpc_logrus.UnaryServerInterceptor(serverLog),
-			grpcutil.PanicLoggerUnaryServerInterceptor(serverLog),
-			grpcutil.ErrorTranslationUnaryServerInterceptor,
-			as.gatekeeper.UnaryServerInterceptor(),
-		)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			grpc_prometheus.StreamServerInterceptor,
 			grpc_logrus.StreamServerInterceptor(serverLog),
 			grpcutil.PanicLoggerStreamServerInterceptor(serverLog),
 			grpcutil.ErrorTranslationStreamServerInterceptor,
 			as.gatekeeper.StreamServerInterceptor(),
+			workflow.SensitiveParametersStreamServerInterceptor,
 		)),
 	}
 
 	grpcServer := grpc.NewServer(sOpts...)
 
-	infopkg.RegisterInfoServiceServer(grpcServer, info.NewInfoServer(as.managedNamespace, links))
+	infopkg.RegisterInfoServiceServer(grpcServer, info.NewInfoServer(as.managedNamespace, links, as.tokensIf))
 	eventpkg.RegisterEventServiceServer(grpcServer, eventServer)
 	eventsourcepkg.RegisterEventSourceServiceServer(grpcServer, eventsource.NewEventSourceServer())
 	sensorpkg.RegisterSensorServiceServer(grpcServer, sensor.NewSensorServer())
-	workflowpkg.RegisterWorkflowServiceServer(grpcServer, workflow.NewWorkflowServer(instanceIDService, offloadNodeStatusRepo))
-	workflowtemplatepkg.RegisterWorkflowTemplateServiceServer(grpcServer, workflowtemplate.NewWorkflowTemplateServer(instanceIDService))
-	cronworkflowpkg.RegisterCronWorkflowServiceServer(grpcServer, cronworkflow.NewCronWorkflowServer(instanceIDService))
-	workflowarchivepkg.RegisterArchivedWorkflowServiceServer(grpcServer, workflowarchive.NewWorkflowArchiveServer(wfArchive))
-	clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceServer(grpcServer, clusterworkflowtemplate.NewClusterWorkflowTemplateServer(instanceIDService))
+	workflowpkg.RegisterWorkflowServiceServer(grpcServer, workflow.NewWorkflowServer(instanceIDService, offloadNodeStatusRepo, wfArchive, templatePolicy, artifactRepositories))
+	workflowtemplatepkg.RegisterWorkflowTemplateServiceServer(grpcServer, workflowtemplate.NewWorkflowTemplateServer(instanceIDService, templatePolicy))
+	cronworkflowpkg.RegisterCronWorkflowServiceServer(grpcServer, cronworkflow.NewCronWorkflowServer(instanceIDService, templatePolicy))
+	workflowarchivepkg.RegisterArchivedWorkflowServiceServer(grpcServer, workflowarchive.NewWorkflowArchiveServer(wfArchive, hydrator.New(offloadNodeStatusRepo), coldStorage, templatePolicy))
+	clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceServer(grpcServer, clusterworkflowtemplate.NewClusterWorkflowTemplateServer(instanceIDService, templatePolicy))
 	grpc_prometheus.Register(grpcServer)
 	return grpcServer
 }
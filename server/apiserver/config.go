@@ -2,6 +2,8 @@ package apiserver
 
 import (
 	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/server/audit"
+	"github.com/argoproj/argo-workflows/v3/server/auth/policy"
 	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
 )
 
@@ -11,4 +13,8 @@ type Config struct {
 	config.Config
 	// SSO in settings for single-sign on
 	SSO sso.Config `json:"sso,omitempty"`
+	// Audit in settings for the audit log of mutating API calls
+	Audit *audit.Config `json:"audit,omitempty"`
+	// TemplatePolicy restricts which workflow templates users in certain SSO groups may submit or act on
+	TemplatePolicy *policy.Config `json:"templatePolicy,omitempty"`
 }
@@ -2,6 +2,8 @@ package artifacts
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -125,31 +127,11 @@ func (a *ArtifactServer) serverInternalError(err error, w http.ResponseWriter) {
 }
 
 func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWriter, r *http.Request, wf *wfv1.Workflow, nodeId, artifactName string) error {
-	kubeClient := auth.GetKubeClient(ctx)
-
 	art := wf.Status.Nodes[nodeId].Outputs.GetArtifactByName(artifactName)
 	if art == nil {
 		return fmt.Errorf("artifact not found")
 	}
 
-	ref, err := a.artifactRepositories.Resolve(ctx, wf.Spec.ArtifactRepositoryRef, wf.Namespace)
-	if err != nil {
-		return err
-	}
-	ar, err := a.artifactRepositories.Get(ctx, ref)
-	if err != nil {
-		return err
-	}
-	l := ar.ToArtifactLocation()
-	err = art.Relocate(l)
-	if err != nil {
-		return err
-	}
-
-	driver, err := a.artDriverFactory(ctx, art, resources{kubeClient, wf.Namespace})
-	if err != nil {
-		return err
-	}
 	tmp, err := ioutil.TempFile("/tmp", "artifact")
 	if err != nil {
 		return err
@@ -157,7 +139,7 @@ func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWrit
 	tmpPath := tmp.Name()
 	defer func() { _ = os.Remove(tmpPath) }()
 
-	err = driver.Load(art, tmpPath)
+	err = LoadArtifact(ctx, a.artifactRepositories, a.artDriverFactory, wf, art, tmpPath)
 	if err != nil {
 		return err
 	}
@@ -179,6 +161,9 @@ func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWrit
 
 	key, _ := art.GetKey()
 	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s"`, path.Base(key)))
+	if digest, ok := digestHeader(art.Digest); ok {
+		w.Header().Add("Digest", digest)
+	}
 	w.WriteHeader(200)
 
 	http.ServeContent(w, r, "", time.Time{}, file)
@@ -186,6 +171,18 @@ func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWrit
 	return nil
 }
 
+// digestHeader returns the RFC 3230 Digest header for the artifact's digest, e.g. "SHA-256=<base64>"
+func digestHeader(digest string) (string, bool) {
+	if !strings.HasPrefix(digest, "sha256:") {
+		return "", false
+	}
+	sum, err := hex.DecodeString(strings.TrimPrefix(digest, "sha256:"))
+	if err != nil {
+		return "", false
+	}
+	return "SHA-256=" + base64.StdEncoding.EncodeToString(sum), true
+}
+
 func (a *ArtifactServer) getWorkflowAndValidate(ctx context.Context, namespace string, workflowName string) (*wfv1.Workflow, error) {
 	wfClient := auth.GetWfClient(ctx)
 	wf, err := wfClient.ArgoprojV1alpha1().Workflows(namespace).Get(ctx, workflowName, metav1.GetOptions{})
@@ -217,3 +214,26 @@ func (a *ArtifactServer) getWorkflowByUID(ctx context.Context, uid string) (*wfv
 	}
 	return wf, nil
 }
+
+// LoadArtifact loads the workflow's output artifact from its artifact repository to the path
+func LoadArtifact(ctx context.Context, artifactRepositories artifactrepositories.Interface, artDriverFactory artifact.NewDriverFunc, wf *wfv1.Workflow, art *wfv1.Artifact, path string) error {
+	ref, err := artifactRepositories.Resolve(ctx, wf.Spec.ArtifactRepositoryRef, wf.Namespace)
+	if err != nil {
+		return err
+	}
+	ar, err := artifactRepositories.Get(ctx, ref)
+	if err != nil {
+		return err
+	}
+	// copy the artifact, so relocating it does not change the workflow
+	art = art.DeepCopy()
+	err = art.Relocate(ar.ToArtifactLocation())
+	if err != nil {
+		return err
+	}
+	driver, err := artDriverFactory(ctx, art, resources{auth.GetKubeClient(ctx), wf.Namespace})
+	if err != nil {
+		return err
+	}
+	return driver.Load(art, path)
+}
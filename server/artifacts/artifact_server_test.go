@@ -63,7 +63,8 @@ func newServer() *ArtifactServer {
 					Outputs: &wfv1.Outputs{
 						Artifacts: wfv1.Artifacts{
 							{
-								Name: "my-s3-artifact",
+								Name:   "my-s3-artifact",
+								Digest: "sha256:c0b8114a809d94b548e3f098b4b76b1589e8ea6297dc795b1377df2c99055385",
 								ArtifactLocation: wfv1.ArtifactLocation{
 									S3: &wfv1.S3Artifact{
 										// S3 is a configured artifact repo, so does not need key
@@ -131,10 +132,12 @@ func TestArtifactServer_GetArtifact(t *testing.T) {
 	tests := []struct {
 		fileName     string
 		artifactName string
+		digest       string
 	}{
 		{
 			fileName:     "my-s3-artifact.tgz",
 			artifactName: "my-s3-artifact",
+			digest:       "SHA-256=wLgRSoCdlLVI4/CYtLdrFYno6mKX3HlbE3ffLJkFU4U=",
 		},
 		{
 			fileName:     "my-gcs-artifact",
@@ -154,6 +157,7 @@ func TestArtifactServer_GetArtifact(t *testing.T) {
 			s.GetArtifact(w, r)
 			if assert.Equal(t, 200, w.StatusCode) {
 				assert.Equal(t, fmt.Sprintf(`filename="%s"`, tt.fileName), w.Header().Get("Content-Disposition"))
+				assert.Equal(t, tt.digest, w.Header().Get("Digest"))
 				assert.Equal(t, "my-data", w.Output)
 			}
 		})
@@ -0,0 +1,175 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	grpcutil "github.com/argoproj/argo-workflows/v3/util/grpc"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// Auditor records mutating API calls. Its UnaryServerInterceptor must run before the gatekeeper, so that calls the
+// gatekeeper rejects are audited too, and its ClaimsUnaryServerInterceptor after, so that the caller's claims are known.
+type Auditor struct {
+	sinks             []Sink
+	redactor          redactor
+	annotateWorkflows bool
+	// used to annotate workflows, as the caller may not be allowed to
+	wfClient versioned.Interface
+	timeout  time.Duration
+}
+
+func New(ctx context.Context, config Config, kubeClient kubernetes.Interface, wfClient versioned.Interface, namespace string) (*Auditor, error) {
+	a := &Auditor{
+		redactor:          newRedactor(config.RedactKeys),
+		annotateWorkflows: config.AnnotateWorkflows,
+		wfClient:          wfClient,
+		timeout:           10 * time.Second,
+	}
+	if config.File != "" {
+		sink, err := newFileSink(config.File)
+		if err != nil {
+			return nil, err
+		}
+		a.sinks = append(a.sinks, sink)
+	}
+	if w := config.Webhook; w != nil {
+		if w.URL == "" {
+			return nil, fmt.Errorf("audit webhook must have a URL")
+		}
+		sink := &webhookSink{url: w.URL, client: &http.Client{Timeout: w.GetTimeout()}}
+		if s := w.BearerTokenSecret; s != nil {
+			secret, err := kubeClient.CoreV1().Secrets(namespace).Get(ctx, s.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get audit webhook bearer token secret: %w", err)
+			}
+			sink.bearerToken = string(secret.Data[s.Key])
+		}
+		// the webhook is remote, so is written to in the background, rather than slowing down API calls
+		a.sinks = append(a.sinks, newAsyncSink(sink, webhookQueueSize))
+	}
+	if len(a.sinks) == 0 && !a.annotateWorkflows {
+		return nil, fmt.Errorf("audit must have at least one of file, webhook or annotateWorkflows")
+	}
+	log.WithFields(log.Fields{"file": config.File, "webhook": config.Webhook != nil, "annotateWorkflows": config.AnnotateWorkflows}).Info("Audit log enabled")
+	return a, nil
+}
+
+// isMutating returns whether the operation may change something, i.e. it is not a get, list, watch or similar
+func isMutating(operation string) bool {
+	for _, prefix := range []string{"Get", "List", "Watch", "Lint", "Diff"} {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+	return !strings.HasSuffix(operation, "Logs")
+}
+
+type userKey struct{}
+
+// UnaryServerInterceptor audits mutating calls, it must run before the gatekeeper, so that unauthenticated and
+// unauthorized calls are audited
+func (a *Auditor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		operation := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+		if !isMutating(operation) {
+			return handler(ctx, req)
+		}
+		// the gatekeeper adds the claims to the context it passes on, so they are recorded here by
+		// ClaimsUnaryServerInterceptor, and are empty if the gatekeeper rejected the call
+		user := &User{}
+		resp, err := handler(context.WithValue(ctx, userKey{}, user), req)
+		a.record(*user, info.FullMethod, operation, req, resp, err)
+		return resp, err
+	}
+}
+
+// ClaimsUnaryServerInterceptor records the caller's claims for the audit event, it must run after the gatekeeper
+func (a *Auditor) ClaimsUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if user, ok := ctx.Value(userKey{}).(*User); ok {
+			*user = userFor(auth.GetClaims(ctx))
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (a *Auditor) record(user User, method, operation string, req, resp interface{}, err error) {
+	e := &Event{
+		Time:      time.Now().UTC(),
+		User:      user,
+		Method:    method,
+		Operation: operation,
+		Outcome:   Success,
+		Code:      status.Code(grpcutil.TranslateError(err)).String(),
+	}
+	if err != nil {
+		e.Outcome = Failure
+		e.Error = err.Error()
+	}
+	if data, err := json.Marshal(req); err == nil {
+		var v interface{}
+		if err := json.Unmarshal(data, &v); err == nil {
+			e.Request = a.redactor.redact(v)
+			if m, ok := v.(map[string]interface{}); ok {
+				e.Namespace, _ = m["namespace"].(string)
+				e.Name, _ = m["name"].(string)
+			}
+		}
+	}
+	// e.g. for creates, the name is not known until the object is created
+	// on error, the response may be a typed nil, so we do not look at it
+	if obj, ok := resp.(metav1.Object); ok && err == nil {
+		if e.Namespace == "" {
+			e.Namespace = obj.GetNamespace()
+		}
+		if e.Name == "" {
+			e.Name = obj.GetName()
+		}
+	}
+	logCtx := log.WithFields(log.Fields{"operation": operation, "namespace": e.Namespace, "name": e.Name, "subject": e.User.Subject})
+	writeCtx, cancel := context.WithTimeout(context.Background(), a.timeout)
+	defer cancel()
+	for _, sink := range a.sinks {
+		if err := sink.Write(writeCtx, e); err != nil {
+			logCtx.WithError(err).Error("failed to write audit event")
+		}
+	}
+	if wf, ok := resp.(*wfv1.Workflow); ok && err == nil && a.annotateWorkflows {
+		if err := a.annotate(writeCtx, wf, e); err != nil {
+			logCtx.WithError(err).Error("failed to annotate workflow with audit event")
+		}
+	}
+}
+
+// annotate records the last audit event on the workflow
+func (a *Auditor) annotate(ctx context.Context, wf *wfv1.Workflow, e *Event) error {
+	value, err := json.Marshal(map[string]interface{}{"time": e.Time, "user": e.User, "operation": e.Operation})
+	if err != nil {
+		return err
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{common.AnnotationKeyLastAuditEvent: string(value)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = a.wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace).Patch(ctx, wf.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
@@ -0,0 +1,169 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/square/go-jose.v2/jwt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	wffake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func Test_isMutating(t *testing.T) {
+	assert.True(t, isMutating("SubmitWorkflow"))
+	assert.True(t, isMutating("DeleteWorkflow"))
+	assert.True(t, isMutating("ReceiveEvent"))
+	assert.False(t, isMutating("GetWorkflow"))
+	assert.False(t, isMutating("ListWorkflows"))
+	assert.False(t, isMutating("WatchWorkflows"))
+	assert.False(t, isMutating("LintWorkflow"))
+	assert.False(t, isMutating("PodLogs"))
+}
+
+func TestAuditor(t *testing.T) {
+	ctx := context.Background()
+	webhookEvents := make(chan Event, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+		e := Event{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&e))
+		webhookEvents <- e
+	}))
+	defer webhook.Close()
+	// the webhook is written to in the background
+	nextWebhookEvent := func(t *testing.T) (Event, bool) {
+		select {
+		case e := <-webhookEvents:
+			return e, true
+		case <-time.After(5 * time.Second):
+			t.Error("timeout waiting for webhook event")
+			return Event{}, false
+		}
+	}
+	kubeClient := kubefake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "argo"},
+		Data:       map[string][]byte{"token": []byte("my-token")},
+	})
+	wfClient := wffake.NewSimpleClientset(&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns"}})
+	file := filepath.Join(t.TempDir(), "audit.log")
+
+	t.Run("NoSinks", func(t *testing.T) {
+		_, err := New(ctx, Config{}, kubeClient, wfClient, "argo")
+		assert.Error(t, err)
+	})
+
+	a, err := New(ctx, Config{
+		File:              file,
+		Webhook:           &WebhookConfig{URL: webhook.URL, BearerTokenSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"}, Key: "token"}},
+		AnnotateWorkflows: true,
+	}, kubeClient, wfClient, "argo")
+	if !assert.NoError(t, err) {
+		return
+	}
+	interceptor := a.UnaryServerInterceptor()
+	claimsInterceptor := a.ClaimsUnaryServerInterceptor()
+	claims := &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, Email: "my@email"}
+	// call the interceptors as the server does, with the gatekeeper adding the claims between them
+	call := func(req interface{}, method string, handler grpc.UnaryHandler) (interface{}, error) {
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return claimsInterceptor(context.WithValue(ctx, auth.ClaimsKey, claims), req, info, handler)
+		})
+	}
+
+	t.Run("ReadOnly", func(t *testing.T) {
+		_, err := call(&workflowpkg.WorkflowGetRequest{Namespace: "my-ns", Name: "my-wf"}, "/workflow.WorkflowService/GetWorkflow", func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &wfv1.Workflow{}, nil
+		})
+		assert.NoError(t, err)
+	})
+	t.Run("Success", func(t *testing.T) {
+		_, err := call(&workflowpkg.WorkflowStopRequest{Namespace: "my-ns", Name: "my-wf", Message: "my-message"}, "/workflow.WorkflowService/StopWorkflow", func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns"}}, nil
+		})
+		assert.NoError(t, err)
+		if e, ok := nextWebhookEvent(t); ok {
+			// the read-only call was not audited
+			assert.Equal(t, "StopWorkflow", e.Operation)
+			assert.Equal(t, "my-ns", e.Namespace)
+			assert.Equal(t, "my-wf", e.Name)
+			assert.Equal(t, "my-sub", e.User.Subject)
+			assert.Equal(t, "my@email", e.User.Email)
+			assert.Equal(t, Success, e.Outcome)
+			assert.Equal(t, "OK", e.Code)
+			assert.Equal(t, "my-message", e.Request.(map[string]interface{})["message"])
+		}
+		wf, err := wfClient.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, "my-wf", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Contains(t, wf.Annotations[common.AnnotationKeyLastAuditEvent], `"operation":"StopWorkflow"`)
+		}
+	})
+	t.Run("Failure", func(t *testing.T) {
+		_, err := call(&workflowpkg.WorkflowCreateRequest{Namespace: "my-ns", Workflow: &wfv1.Workflow{Spec: wfv1.WorkflowSpec{Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "password", Value: wfv1.AnyStringPtr("my-password")}}}}}}, "/workflow.WorkflowService/CreateWorkflow", func(ctx context.Context, req interface{}) (interface{}, error) {
+			var wf *wfv1.Workflow
+			return wf, status.Error(codes.PermissionDenied, "not allowed")
+		})
+		assert.Error(t, err)
+		if e, ok := nextWebhookEvent(t); ok {
+			assert.Equal(t, Failure, e.Outcome)
+			assert.Equal(t, "PermissionDenied", e.Code)
+			assert.Contains(t, e.Error, "not allowed")
+			assert.Equal(t, "my-sub", e.User.Subject)
+		}
+	})
+	t.Run("Unauthenticated", func(t *testing.T) {
+		// the gatekeeper rejects the call, so neither the claims interceptor nor the handler are called
+		_, err := interceptor(ctx, &workflowpkg.WorkflowDeleteRequest{Namespace: "my-ns", Name: "my-wf"}, &grpc.UnaryServerInfo{FullMethod: "/workflow.WorkflowService/DeleteWorkflow"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.Unauthenticated, "token not valid")
+		})
+		assert.Error(t, err)
+		if e, ok := nextWebhookEvent(t); ok {
+			assert.Equal(t, "DeleteWorkflow", e.Operation)
+			assert.Equal(t, "Unauthenticated", e.Code)
+			assert.Empty(t, e.User.Subject)
+		}
+	})
+	data, err := ioutil.ReadFile(file)
+	if assert.NoError(t, err) {
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		assert.Len(t, lines, 3)
+		assert.NotContains(t, string(data), "my-password")
+	}
+}
+
+type blockingSink struct{ unblock chan struct{} }
+
+func (s blockingSink) Write(context.Context, *Event) error {
+	<-s.unblock
+	return nil
+}
+
+func TestAsyncSink(t *testing.T) {
+	sink := blockingSink{unblock: make(chan struct{})}
+	defer close(sink.unblock)
+	s := newAsyncSink(sink, 1)
+	// the first event is taken off the queue, and blocks, the second fills the queue
+	assert.NoError(t, s.Write(context.Background(), &Event{}))
+	assert.Eventually(t, func() bool { return len(s.(*asyncSink).queue) == 0 }, 5*time.Second, 10*time.Millisecond)
+	assert.NoError(t, s.Write(context.Background(), &Event{}))
+	assert.EqualError(t, s.Write(context.Background(), &Event{}), "audit queue is full, dropping event")
+}
@@ -0,0 +1,35 @@
+package audit
+
+import (
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Config configures the audit log of mutating API calls made to the Argo Server
+type Config struct {
+	// File is the path of a file to append audit events to, one JSON object per line
+	File string `json:"file,omitempty"`
+	// Webhook posts each audit event as JSON to a URL
+	Webhook *WebhookConfig `json:"webhook,omitempty"`
+	// AnnotateWorkflows records the last audit event on the affected workflow as an annotation
+	AnnotateWorkflows bool `json:"annotateWorkflows,omitempty"`
+	// RedactKeys are additional (case-insensitive) request field or parameter names whose values are redacted
+	RedactKeys []string `json:"redactKeys,omitempty"`
+}
+
+type WebhookConfig struct {
+	URL string `json:"url"`
+	// BearerTokenSecret is the secret and key that contain a token sent in the `Authorization` header
+	BearerTokenSecret *apiv1.SecretKeySelector `json:"bearerTokenSecret,omitempty"`
+	// Timeout defaults to 10s
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+func (c WebhookConfig) GetTimeout() time.Duration {
+	if c.Timeout.Duration > 0 {
+		return c.Timeout.Duration
+	}
+	return 10 * time.Second
+}
@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+)
+
+type Outcome string
+
+const (
+	Success Outcome = "Success"
+	Failure Outcome = "Failure"
+)
+
+// Event is a record of a single mutating API call
+type Event struct {
+	Time time.Time `json:"time"`
+	User User      `json:"user"`
+	// Method is the full gRPC method, e.g. "/workflow.WorkflowService/SubmitWorkflow"
+	Method string `json:"method"`
+	// Operation is the short name of the method, e.g. "SubmitWorkflow"
+	Operation string `json:"operation"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	// Request is the request, with secrets redacted
+	Request interface{} `json:"request,omitempty"`
+	Outcome Outcome     `json:"outcome"`
+	Code    string      `json:"code"`
+	Error   string      `json:"error,omitempty"`
+}
+
+type User struct {
+	Subject            string   `json:"subject,omitempty"`
+	Issuer             string   `json:"issuer,omitempty"`
+	Email              string   `json:"email,omitempty"`
+	Groups             []string `json:"groups,omitempty"`
+	ServiceAccountName string   `json:"serviceAccountName,omitempty"`
+}
+
+func userFor(claims *types.Claims) User {
+	if claims == nil {
+		return User{}
+	}
+	return User{
+		Subject:            claims.Subject,
+		Issuer:             claims.Issuer,
+		Email:              claims.Email,
+		Groups:             claims.Groups,
+		ServiceAccountName: claims.ServiceAccountName,
+	}
+}
@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"strings"
+)
+
+const redacted = "******"
+
+// these are matched as case-insensitive substrings of a field or parameter name
+var defaultRedactKeys = []string{"password", "passwd", "secret", "token", "credential", "privatekey", "apikey"}
+
+type redactor []string
+
+func newRedactor(extraKeys []string) redactor {
+	r := redactor(defaultRedactKeys)
+	for _, k := range extraKeys {
+		r = append(r, strings.ToLower(k))
+	}
+	return r
+}
+
+func (r redactor) isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, k := range r {
+		if strings.Contains(key, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// redact returns a copy of the unmarshalled JSON value, with sensitive values replaced:
+//
+// * string values of sensitive fields, e.g. `{"password": "x"}`
+// * the value of a name/value pair with a sensitive name, e.g. a parameter `{"name": "password", "value": "x"}`
+// * the value and default of a sensitive parameter, e.g. `{"name": "slack-hook", "value": "x", "sensitive": true}`
+// * "key=value" strings with a sensitive key, e.g. the `parameters` submit option
+func (r redactor) redact(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(x))
+		name, _ := x["name"].(string)
+		sensitive, _ := x["sensitive"].(bool)
+		for k, v := range x {
+			if _, ok := v.(string); ok && (r.isSensitive(k) || (k == "value" && r.isSensitive(name)) || (sensitive && (k == "value" || k == "default"))) {
+				out[k] = redacted
+			} else {
+				out[k] = r.redact(v)
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(x))
+		for i, v := range x {
+			out[i] = r.redact(v)
+		}
+		return out
+	case string:
+		if parts := strings.SplitN(x, "=", 2); len(parts) == 2 && r.isSensitive(parts[0]) {
+			return parts[0] + "=" + redacted
+		}
+		return x
+	default:
+		return v
+	}
+}
@@ -0,0 +1,40 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_redactor(t *testing.T) {
+	r := newRedactor([]string{"Licence"})
+	assert.Equal(t, map[string]interface{}{
+		"name":         "my-wf",
+		"password":     redacted,
+		"myLicence":    redacted,
+		"automount":    true,
+		"secretKeyRef": map[string]interface{}{"name": "my-secret", "key": "my-key"},
+		"parameters": []interface{}{
+			map[string]interface{}{"name": "db-password", "value": redacted},
+			map[string]interface{}{"name": "message", "value": "hello"},
+			map[string]interface{}{"name": "slack-hook", "value": redacted, "default": redacted, "sensitive": true},
+		},
+		"submitOptions": map[string]interface{}{
+			"parameters": []interface{}{"apiToken=" + redacted, "message=hello"},
+		},
+	}, r.redact(map[string]interface{}{
+		"name":         "my-wf",
+		"password":     "my-password",
+		"myLicence":    "my-licence",
+		"automount":    true,
+		"secretKeyRef": map[string]interface{}{"name": "my-secret", "key": "my-key"},
+		"parameters": []interface{}{
+			map[string]interface{}{"name": "db-password", "value": "my-password"},
+			map[string]interface{}{"name": "message", "value": "hello"},
+			map[string]interface{}{"name": "slack-hook", "value": "https://hooks.slack.com/x", "default": "https://hooks.slack.com/y", "sensitive": true},
+		},
+		"submitOptions": map[string]interface{}{
+			"parameters": []interface{}{"apiToken=my-token", "message=hello"},
+		},
+	}))
+}
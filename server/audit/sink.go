@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// the number of audit events that can be waiting to be written to a webhook
+const webhookQueueSize = 1000
+
+// Sink is somewhere audit events are written to
+type Sink interface {
+	Write(ctx context.Context, e *Event) error
+}
+
+type fileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func newFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	return &fileSink{file: file}, nil
+}
+
+func (s *fileSink) Write(_ context.Context, e *Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(append(data, '\n'))
+	return err
+}
+
+type webhookSink struct {
+	url         string
+	bearerToken string
+	client      *http.Client
+}
+
+func (s *webhookSink) Write(ctx context.Context, e *Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", s.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.bearerToken)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("audit webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// asyncSink writes events to a sink in the background, so a slow sink does not slow down API calls. If more than
+// queueSize events are waiting to be written, then further events are dropped.
+type asyncSink struct {
+	sink  Sink
+	queue chan *Event
+}
+
+func newAsyncSink(sink Sink, queueSize int) Sink {
+	s := &asyncSink{sink: sink, queue: make(chan *Event, queueSize)}
+	go s.run()
+	return s
+}
+
+func (s *asyncSink) Write(_ context.Context, e *Event) error {
+	select {
+	case s.queue <- e:
+		return nil
+	default:
+		return fmt.Errorf("audit queue is full, dropping event")
+	}
+}
+
+func (s *asyncSink) run() {
+	for e := range s.queue {
+		if err := s.sink.Write(context.Background(), e); err != nil {
+			log.WithError(err).WithFields(log.Fields{"operation": e.Operation, "namespace": e.Namespace, "name": e.Name}).Error("failed to write audit event")
+		}
+	}
+}
@@ -24,6 +24,7 @@ import (
 	workflow "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-workflows/v3/server/auth/serviceaccount"
 	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
+	"github.com/argoproj/argo-workflows/v3/server/auth/token"
 	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 	servertypes "github.com/argoproj/argo-workflows/v3/server/types"
 	jsonutil "github.com/argoproj/argo-workflows/v3/util/json"
@@ -39,6 +40,7 @@ const (
 	EventSourceKey ContextKey = "eventsource.Interface"
 	KubeKey        ContextKey = "kubernetes.Interface"
 	ClaimsKey      ContextKey = "types.Claims"
+	ModeKey        ContextKey = "auth.Mode"
 )
 
 //go:generate mockery -name Gatekeeper
@@ -57,16 +59,21 @@ type gatekeeper struct {
 	clients                *servertypes.Clients
 	restConfig             *rest.Config
 	ssoIf                  sso.Interface
+	tokensIf               token.Interface
 	clientForAuthorization ClientForAuthorization
 	// The namespace the server is installed in.
 	namespace string
 }
 
-func NewGatekeeper(modes Modes, clients *servertypes.Clients, restConfig *rest.Config, ssoIf sso.Interface, clientForAuthorization ClientForAuthorization, namespace string) (Gatekeeper, error) {
+func NewGatekeeper(modes Modes, clients *servertypes.Clients, restConfig *rest.Config, ssoIf sso.Interface, tokensIf token.Interface, clientForAuthorization ClientForAuthorization, namespace string) (Gatekeeper, error) {
 	if len(modes) == 0 {
 		return nil, fmt.Errorf("must specify at least one auth mode")
 	}
-	return &gatekeeper{modes, clients, restConfig, ssoIf, clientForAuthorization, namespace}, nil
+	// tokens are created by SSO users, and use the same RBAC
+	if modes[Token] && !modes[SSO] {
+		return nil, fmt.Errorf("token auth mode requires sso auth mode")
+	}
+	return &gatekeeper{modes, clients, restConfig, ssoIf, tokensIf, clientForAuthorization, namespace}, nil
 }
 
 func (s *gatekeeper) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
@@ -92,7 +99,7 @@ func (s *gatekeeper) StreamServerInterceptor() grpc.StreamServerInterceptor {
 }
 
 func (s *gatekeeper) Context(ctx context.Context) (context.Context, error) {
-	clients, claims, err := s.getClients(ctx)
+	clients, claims, mode, err := s.getClients(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +108,7 @@ func (s *gatekeeper) Context(ctx context.Context) (context.Context, error) {
 	ctx = context.WithValue(ctx, SensorKey, clients.Sensor)
 	ctx = context.WithValue(ctx, KubeKey, clients.Kubernetes)
 	ctx = context.WithValue(ctx, ClaimsKey, claims)
+	ctx = context.WithValue(ctx, ModeKey, mode)
 	return ctx, nil
 }
 
@@ -125,6 +133,12 @@ func GetClaims(ctx context.Context) *types.Claims {
 	return config
 }
 
+// GetMode returns the auth mode the request was authenticated with
+func GetMode(ctx context.Context) Mode {
+	mode, _ := ctx.Value(ModeKey).(Mode)
+	return mode
+}
+
 func getAuthHeader(md metadata.MD) string {
 	// looks for the HTTP header `Authorization: Bearer ...`
 	for _, t := range md.Get("authorization") {
@@ -143,46 +157,58 @@ func getAuthHeader(md metadata.MD) string {
 	return ""
 }
 
-func (s gatekeeper) getClients(ctx context.Context) (*servertypes.Clients, *types.Claims, error) {
+func (s gatekeeper) getClients(ctx context.Context) (*servertypes.Clients, *types.Claims, Mode, error) {
 	md, _ := metadata.FromIncomingContext(ctx)
 	authorization := getAuthHeader(md)
 	mode, valid := s.Modes.GetMode(authorization)
 	if !valid {
-		return nil, nil, status.Error(codes.Unauthenticated, "token not valid for running mode")
+		return nil, nil, "", status.Error(codes.Unauthenticated, "token not valid for running mode")
 	}
 	switch mode {
 	case Client:
 		restConfig, clients, err := s.clientForAuthorization(authorization)
 		if err != nil {
-			return nil, nil, status.Error(codes.Unauthenticated, err.Error())
+			return nil, nil, "", status.Error(codes.Unauthenticated, err.Error())
 		}
 		claims, _ := serviceaccount.ClaimSetFor(restConfig)
-		return clients, claims, nil
+		return clients, claims, mode, nil
 	case Server:
 		claims, _ := serviceaccount.ClaimSetFor(s.restConfig)
-		return s.clients, claims, nil
+		return s.clients, claims, mode, nil
 	case SSO:
 		claims, err := s.ssoIf.Authorize(authorization)
 		if err != nil {
-			return nil, nil, status.Error(codes.Unauthenticated, err.Error())
+			return nil, nil, "", status.Error(codes.Unauthenticated, err.Error())
 		}
-		if s.ssoIf.IsRBACEnabled() {
-			clients, err := s.rbacAuthorization(ctx, claims)
-			if err != nil {
-				log.WithError(err).Error("failed to perform RBAC authorization")
-				return nil, nil, status.Error(codes.PermissionDenied, "not allowed")
-			}
-			return clients, claims, nil
-		} else {
-			// important! write an audit entry (i.e. log entry) so we know which user performed an operation
-			log.WithFields(log.Fields{"subject": claims.Subject}).Info("using the default service account for user")
-			return s.clients, claims, nil
+		clients, err := s.ssoClients(ctx, claims)
+		return clients, claims, mode, err
+	case Token:
+		claims, err := s.tokensIf.Authorize(ctx, authorization)
+		if err != nil {
+			return nil, nil, "", status.Error(codes.Unauthenticated, err.Error())
 		}
+		clients, err := s.ssoClients(ctx, claims)
+		return clients, claims, mode, err
 	default:
 		panic("this should never happen")
 	}
 }
 
+// ssoClients returns the clients for an SSO user (or a user's token), using SSO RBAC if it is enabled
+func (s gatekeeper) ssoClients(ctx context.Context, claims *types.Claims) (*servertypes.Clients, error) {
+	if s.ssoIf.IsRBACEnabled() {
+		clients, err := s.rbacAuthorization(ctx, claims)
+		if err != nil {
+			log.WithError(err).Error("failed to perform RBAC authorization")
+			return nil, status.Error(codes.PermissionDenied, "not allowed")
+		}
+		return clients, nil
+	}
+	// important! write an audit entry (i.e. log entry) so we know which user performed an operation
+	log.WithFields(log.Fields{"subject": claims.Subject}).Info("using the default service account for user")
+	return s.clients, nil
+}
+
 func (s *gatekeeper) rbacAuthorization(ctx context.Context, claims *types.Claims) (*servertypes.Clients, error) {
 	list, err := s.clients.Kubernetes.CoreV1().ServiceAccounts(s.namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
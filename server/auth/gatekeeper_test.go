@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
@@ -18,6 +19,7 @@ import (
 
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	ssomocks "github.com/argoproj/argo-workflows/v3/server/auth/sso/mocks"
+	"github.com/argoproj/argo-workflows/v3/server/auth/token"
 	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 	servertypes "github.com/argoproj/argo-workflows/v3/server/types"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
@@ -61,25 +63,25 @@ func TestServer_GetWFClient(t *testing.T) {
 	}
 	clients := &servertypes.Clients{Workflow: wfClient, Kubernetes: kubeClient}
 	t.Run("None", func(t *testing.T) {
-		_, err := NewGatekeeper(Modes{}, clients, nil, nil, clientForAuthorization, "")
+		_, err := NewGatekeeper(Modes{}, clients, nil, nil, nil, clientForAuthorization, "")
 		assert.Error(t, err)
 	})
 	t.Run("Invalid", func(t *testing.T) {
-		g, err := NewGatekeeper(Modes{Client: true}, clients, nil, nil, clientForAuthorization, "")
+		g, err := NewGatekeeper(Modes{Client: true}, clients, nil, nil, nil, clientForAuthorization, "")
 		if assert.NoError(t, err) {
 			_, err := g.Context(x("invalid"))
 			assert.Error(t, err)
 		}
 	})
 	t.Run("NotAllowed", func(t *testing.T) {
-		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, nil, clientForAuthorization, "")
+		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, nil, nil, clientForAuthorization, "")
 		if assert.NoError(t, err) {
 			_, err := g.Context(x("Bearer "))
 			assert.Error(t, err)
 		}
 	})
 	t.Run("Client", func(t *testing.T) {
-		g, err := NewGatekeeper(Modes{Client: true}, clients, &rest.Config{Username: "my-username"}, nil, clientForAuthorization, "")
+		g, err := NewGatekeeper(Modes{Client: true}, clients, &rest.Config{Username: "my-username"}, nil, nil, clientForAuthorization, "")
 		assert.NoError(t, err)
 		ctx, err := g.Context(x("Bearer "))
 		if assert.NoError(t, err) {
@@ -89,7 +91,7 @@ func TestServer_GetWFClient(t *testing.T) {
 		}
 	})
 	t.Run("Server", func(t *testing.T) {
-		g, err := NewGatekeeper(Modes{Server: true}, clients, &rest.Config{Username: "my-username"}, nil, clientForAuthorization, "")
+		g, err := NewGatekeeper(Modes{Server: true}, clients, &rest.Config{Username: "my-username"}, nil, nil, clientForAuthorization, "")
 		assert.NoError(t, err)
 		ctx, err := g.Context(x(""))
 		if assert.NoError(t, err) {
@@ -102,7 +104,7 @@ func TestServer_GetWFClient(t *testing.T) {
 		ssoIf := &ssomocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Claims: jwt.Claims{Subject: "my-sub"}}, nil)
 		ssoIf.On("IsRBACEnabled").Return(false)
-		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns")
+		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, nil, clientForAuthorization, "my-ns")
 		if assert.NoError(t, err) {
 			ctx, err := g.Context(x("Bearer v2:whatever"))
 			if assert.NoError(t, err) {
@@ -121,7 +123,7 @@ func TestServer_GetWFClient(t *testing.T) {
 		ssoIf := &ssomocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Groups: []string{"my-group", "other-group"}}, nil)
 		ssoIf.On("IsRBACEnabled").Return(true)
-		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns")
+		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, nil, clientForAuthorization, "my-ns")
 		if assert.NoError(t, err) {
 			ctx, err := g.Context(x("Bearer v2:whatever"))
 			if assert.NoError(t, err) {
@@ -139,7 +141,7 @@ func TestServer_GetWFClient(t *testing.T) {
 		ssoIf := &ssomocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{Groups: []string{"other-group"}}, nil)
 		ssoIf.On("IsRBACEnabled").Return(true)
-		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns")
+		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, nil, clientForAuthorization, "my-ns")
 		if assert.NoError(t, err) {
 			ctx, err := g.Context(x("Bearer v2:whatever"))
 			if assert.NoError(t, err) {
@@ -152,12 +154,38 @@ func TestServer_GetWFClient(t *testing.T) {
 		ssoIf := &ssomocks.Interface{}
 		ssoIf.On("Authorize", mock.Anything, mock.Anything).Return(&types.Claims{}, nil)
 		ssoIf.On("IsRBACEnabled").Return(true)
-		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, clientForAuthorization, "my-ns")
+		g, err := NewGatekeeper(Modes{SSO: true}, clients, nil, ssoIf, nil, clientForAuthorization, "my-ns")
 		if assert.NoError(t, err) {
 			_, err := g.Context(x("Bearer v2:whatever"))
 			assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = not allowed")
 		}
 	})
+	t.Run("TokenWithoutSSO", func(t *testing.T) {
+		_, err := NewGatekeeper(Modes{Token: true}, clients, nil, nil, nil, clientForAuthorization, "my-ns")
+		assert.EqualError(t, err, "token auth mode requires sso auth mode")
+	})
+	t.Run("Token", func(t *testing.T) {
+		ssoIf := &ssomocks.Interface{}
+		ssoIf.On("IsRBACEnabled").Return(true)
+		tokensIf := token.New(kubefake.NewSimpleClientset().CoreV1().Secrets("my-ns"), time.Hour)
+		_, value, err := tokensIf.Create(context.Background(), &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, Groups: []string{"my-group"}}, "my-token", time.Hour)
+		if !assert.NoError(t, err) {
+			return
+		}
+		g, err := NewGatekeeper(Modes{SSO: true, Token: true}, clients, nil, ssoIf, tokensIf, clientForAuthorization, "my-ns")
+		if assert.NoError(t, err) {
+			ctx, err := g.Context(x("Bearer " + value))
+			if assert.NoError(t, err) {
+				assert.Equal(t, Token, GetMode(ctx))
+				if assert.NotNil(t, GetClaims(ctx)) {
+					assert.Equal(t, "my-sub", GetClaims(ctx).Subject)
+					assert.Equal(t, "my-sa", GetClaims(ctx).ServiceAccountName)
+				}
+			}
+			_, err = g.Context(x("Bearer pat:00.invalid"))
+			assert.Error(t, err)
+		}
+	})
 }
 
 func x(authorization string) context.Context {
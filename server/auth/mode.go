@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/argoproj/argo-workflows/v3/server/auth/sso"
+	"github.com/argoproj/argo-workflows/v3/server/auth/token"
 )
 
 type Modes map[Mode]bool
@@ -15,11 +16,13 @@ const (
 	Client Mode = "client"
 	Server Mode = "server"
 	SSO    Mode = "sso"
+	// Token is personal access tokens created by SSO users
+	Token Mode = "token"
 )
 
 func (m Modes) Add(value string) error {
 	switch value {
-	case "client", "server", "sso":
+	case "client", "server", "sso", "token":
 		m[Mode(value)] = true
 	case "hybrid":
 		m[Client] = true
@@ -34,6 +37,9 @@ func (m Modes) GetMode(authorisation string) (Mode, bool) {
 	if m[SSO] && strings.HasPrefix(authorisation, sso.Prefix) {
 		return SSO, true
 	}
+	if m[Token] && strings.HasPrefix(authorisation, token.Prefix) {
+		return Token, true
+	}
 	if m[Client] && (strings.HasPrefix(authorisation, "Bearer ") || strings.HasPrefix(authorisation, "Basic ")) {
 		return Client, true
 	}
@@ -35,6 +35,12 @@ func TestModes_Add(t *testing.T) {
 			assert.Contains(t, m, SSO)
 		}
 	})
+	t.Run("Token", func(t *testing.T) {
+		m := Modes{}
+		if assert.NoError(t, m.Add("token")) {
+			assert.Contains(t, m, Token)
+		}
+	})
 }
 
 func TestModes_GetMode(t *testing.T) {
@@ -42,6 +48,7 @@ func TestModes_GetMode(t *testing.T) {
 		Client: true,
 		SSO:    true,
 		Server: true,
+		Token:  true,
 	}
 	t.Run("Client", func(t *testing.T) {
 		mode, valid := m.GetMode("Bearer ")
@@ -61,6 +68,12 @@ func TestModes_GetMode(t *testing.T) {
 			assert.Equal(t, SSO, mode)
 		}
 	})
+	t.Run("Token", func(t *testing.T) {
+		mode, valid := m.GetMode("Bearer pat:")
+		if assert.True(t, valid) {
+			assert.Equal(t, Token, mode)
+		}
+	})
 
 	m = Modes{
 		Client: false,
@@ -0,0 +1,39 @@
+package policy
+
+// Action is something a user can do to a workflow
+type Action string
+
+const (
+	// Submit is creating, submitting, resubmitting, or retrying a workflow
+	Submit    Action = "submit"
+	Suspend   Action = "suspend"
+	Resume    Action = "resume"
+	Stop      Action = "stop"
+	Terminate Action = "terminate"
+)
+
+var actions = map[Action]bool{Submit: true, Suspend: true, Resume: true, Stop: true, Terminate: true}
+
+// Config restricts which workflow templates users in certain groups may submit or act on.
+// Users that are not in any rule's groups are not restricted.
+type Config struct {
+	Rules []Rule `json:"rules,omitempty"`
+}
+
+// Rule allows users in any of the groups to perform the actions on workflows from matching templates.
+type Rule struct {
+	// Groups are the SSO groups the rule applies to
+	Groups []string `json:"groups"`
+	// Namespaces the rule applies to, empty means any namespace
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Actions the rule allows, empty means all actions
+	Actions []Action `json:"actions,omitempty"`
+	// WorkflowTemplates are glob patterns of the names of workflow templates the rule allows
+	WorkflowTemplates []string `json:"workflowTemplates,omitempty"`
+	// ClusterWorkflowTemplates are glob patterns of the names of cluster workflow templates the rule allows
+	ClusterWorkflowTemplates []string `json:"clusterWorkflowTemplates,omitempty"`
+	// TemplateSelector is a label selector the (cluster) workflow template must match
+	TemplateSelector string `json:"templateSelector,omitempty"`
+	// Parameters are the names of the parameters and artifacts that may be overridden, "*" allows any
+	Parameters []string `json:"parameters,omitempty"`
+}
@@ -0,0 +1,248 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+)
+
+// Policy enforces the rules. A nil policy allows everything.
+type Policy struct {
+	rules []rule
+}
+
+type rule struct {
+	Rule
+	selector labels.Selector
+}
+
+func New(config *Config) (*Policy, error) {
+	if config == nil {
+		return nil, nil
+	}
+	p := &Policy{}
+	for i, r := range config.Rules {
+		if len(r.Groups) == 0 {
+			return nil, fmt.Errorf("template policy rule %d must have groups", i)
+		}
+		for _, a := range r.Actions {
+			if !actions[a] {
+				return nil, fmt.Errorf("template policy rule %d has unknown action %q", i, a)
+			}
+		}
+		if len(r.WorkflowTemplates) == 0 && len(r.ClusterWorkflowTemplates) == 0 && r.TemplateSelector == "" {
+			return nil, fmt.Errorf("template policy rule %d must have workflowTemplates, clusterWorkflowTemplates, or a templateSelector", i)
+		}
+		for _, pattern := range append(append([]string{}, r.WorkflowTemplates...), r.ClusterWorkflowTemplates...) {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("template policy rule %d has invalid pattern %q: %w", i, pattern, err)
+			}
+		}
+		selector, err := labels.Parse(r.TemplateSelector)
+		if err != nil {
+			return nil, fmt.Errorf("template policy rule %d has invalid templateSelector: %w", i, err)
+		}
+		p.rules = append(p.rules, rule{r, selector})
+	}
+	return p, nil
+}
+
+// AuthorizeSubmit checks that a new workflow may be submitted. Restricted users may only submit a workflow that
+// references a workflow template, and may only override the allowed arguments.
+func (p *Policy) AuthorizeSubmit(ctx context.Context, namespace string, wf *wfv1.Workflow) error {
+	rules := p.rulesFor(ctx)
+	if len(rules) == 0 {
+		return nil
+	}
+	spec := wf.Spec.DeepCopy()
+	spec.WorkflowTemplateRef = nil
+	spec.Arguments = wfv1.Arguments{}
+	if !reflect.DeepEqual(*spec, wfv1.WorkflowSpec{}) {
+		return status.Error(codes.PermissionDenied, "policy only allows submitting a workflow template with arguments")
+	}
+	var overrides []string
+	for _, x := range wf.Spec.Arguments.Parameters {
+		overrides = append(overrides, x.Name)
+	}
+	for _, x := range wf.Spec.Arguments.Artifacts {
+		overrides = append(overrides, x.Name)
+	}
+	return p.authorize(ctx, rules, Submit, namespace, wf.Spec.WorkflowTemplateRef, overrides)
+}
+
+// AuthorizeSubmitOpts checks that a workflow made from a workflow template or cron workflow may be submitted with the
+// options. It must be called before the options are applied, as restricted users may only override parameters.
+func (p *Policy) AuthorizeSubmitOpts(ctx context.Context, namespace string, wf *wfv1.Workflow, opts *wfv1.SubmitOpts) error {
+	rules := p.rulesFor(ctx)
+	if len(rules) == 0 {
+		return nil
+	}
+	wf = wf.DeepCopy()
+	if opts != nil {
+		// a parameter file may contain any parameters, so cannot be checked
+		if opts.Entrypoint != "" || opts.ServiceAccount != "" || opts.ParameterFile != "" {
+			return status.Error(codes.PermissionDenied, "policy only allows submitting a workflow template with parameters")
+		}
+		for _, x := range opts.Parameters {
+			wf.Spec.Arguments.Parameters = append(wf.Spec.Arguments.Parameters, wfv1.Parameter{Name: strings.SplitN(x, "=", 2)[0]})
+		}
+	}
+	return p.AuthorizeSubmit(ctx, namespace, wf)
+}
+
+// AuthorizeCronWorkflow checks that the workflows a cron workflow in the namespace creates may be submitted
+func (p *Policy) AuthorizeCronWorkflow(ctx context.Context, namespace string, cronWf *wfv1.CronWorkflow) error {
+	return p.AuthorizeSubmit(ctx, namespace, &wfv1.Workflow{Spec: cronWf.Spec.WorkflowSpec})
+}
+
+// AuthorizeTemplateChange checks that a workflow template or cluster workflow template may be created or updated.
+// Restricted users may not, as they could otherwise change what an allowed template runs.
+func (p *Policy) AuthorizeTemplateChange(ctx context.Context) error {
+	if len(p.rulesFor(ctx)) > 0 {
+		return status.Error(codes.PermissionDenied, "policy does not allow creating or updating workflow templates")
+	}
+	return nil
+}
+
+// Authorize checks that an action may be performed on an existing workflow in the namespace, overriding the named
+// parameters
+func (p *Policy) Authorize(ctx context.Context, action Action, namespace string, wf *wfv1.Workflow, overrides []string) error {
+	rules := p.rulesFor(ctx)
+	if len(rules) == 0 {
+		return nil
+	}
+	return p.authorize(ctx, rules, action, namespace, wf.Spec.WorkflowTemplateRef, overrides)
+}
+
+// rulesFor returns the rules that apply to the user, if there are none, then the user is not restricted
+func (p *Policy) rulesFor(ctx context.Context) []rule {
+	if p == nil {
+		return nil
+	}
+	claims := auth.GetClaims(ctx)
+	if claims == nil {
+		return nil
+	}
+	var rules []rule
+	for _, r := range p.rules {
+		if intersects(r.Groups, claims.Groups) {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
+func (p *Policy) authorize(ctx context.Context, rules []rule, action Action, namespace string, ref *wfv1.WorkflowTemplateRef, overrides []string) error {
+	if ref == nil || ref.Name == "" {
+		return status.Errorf(codes.PermissionDenied, "policy only allows %s of workflows from a workflow template", action)
+	}
+	var templateLabels labels.Set
+	for _, r := range rules {
+		if !r.allows(action) || !r.appliesTo(namespace) || !r.allowsTemplate(ref) || !r.allowsOverrides(overrides) {
+			continue
+		}
+		if !r.selector.Empty() {
+			if templateLabels == nil {
+				var err error
+				templateLabels, err = getTemplateLabels(ctx, namespace, ref)
+				if err != nil {
+					return err
+				}
+			}
+			if !r.selector.Matches(templateLabels) {
+				continue
+			}
+		}
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "policy does not allow %s of workflow template %q with arguments %v", action, ref.Name, overrides)
+}
+
+func getTemplateLabels(ctx context.Context, namespace string, ref *wfv1.WorkflowTemplateRef) (labels.Set, error) {
+	wfClient := auth.GetWfClient(ctx)
+	if ref.ClusterScope {
+		tmpl, err := wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return labels.Set(tmpl.Labels), nil
+	}
+	tmpl, err := wfClient.ArgoprojV1alpha1().WorkflowTemplates(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return labels.Set(tmpl.Labels), nil
+}
+
+func (r rule) allows(action Action) bool {
+	if len(r.Actions) == 0 {
+		return true
+	}
+	for _, a := range r.Actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+func (r rule) appliesTo(namespace string) bool {
+	return len(r.Namespaces) == 0 || contains(r.Namespaces, namespace)
+}
+
+func (r rule) allowsTemplate(ref *wfv1.WorkflowTemplateRef) bool {
+	// a rule with only a selector allows any name
+	if len(r.WorkflowTemplates) == 0 && len(r.ClusterWorkflowTemplates) == 0 {
+		return true
+	}
+	patterns := r.WorkflowTemplates
+	if ref.ClusterScope {
+		patterns = r.ClusterWorkflowTemplates
+	}
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, ref.Name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (r rule) allowsOverrides(overrides []string) bool {
+	if contains(r.Parameters, "*") {
+		return true
+	}
+	for _, name := range overrides {
+		if !contains(r.Parameters, name) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func intersects(a, b []string) bool {
+	for _, v := range a {
+		if contains(b, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+)
+
+func TestNew(t *testing.T) {
+	p, err := New(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+	_, err = New(&Config{Rules: []Rule{{WorkflowTemplates: []string{"*"}}}})
+	assert.EqualError(t, err, "template policy rule 0 must have groups")
+	_, err = New(&Config{Rules: []Rule{{Groups: []string{"my-group"}, Actions: []Action{"delete"}, WorkflowTemplates: []string{"*"}}}})
+	assert.EqualError(t, err, `template policy rule 0 has unknown action "delete"`)
+	_, err = New(&Config{Rules: []Rule{{Groups: []string{"my-group"}}}})
+	assert.EqualError(t, err, "template policy rule 0 must have workflowTemplates, clusterWorkflowTemplates, or a templateSelector")
+	_, err = New(&Config{Rules: []Rule{{Groups: []string{"my-group"}, WorkflowTemplates: []string{"["}}}})
+	assert.Error(t, err)
+	_, err = New(&Config{Rules: []Rule{{Groups: []string{"my-group"}, TemplateSelector: "!!"}}})
+	assert.Error(t, err)
+}
+
+func fromTemplate(name string, clusterScope bool, parameters ...string) *wfv1.Workflow {
+	wf := &wfv1.Workflow{Spec: wfv1.WorkflowSpec{WorkflowTemplateRef: &wfv1.WorkflowTemplateRef{Name: name, ClusterScope: clusterScope}}}
+	for _, name := range parameters {
+		wf.Spec.Arguments.Parameters = append(wf.Spec.Arguments.Parameters, wfv1.Parameter{Name: name, Value: wfv1.AnyStringPtr("x")})
+	}
+	return wf
+}
+
+func TestPolicy(t *testing.T) {
+	p, err := New(&Config{Rules: []Rule{
+		{
+			Groups:            []string{"analysts"},
+			Namespaces:        []string{"analytics"},
+			WorkflowTemplates: []string{"report-*"},
+			Parameters:        []string{"date"},
+		},
+		{
+			Groups:                   []string{"analysts"},
+			Actions:                  []Action{Submit},
+			ClusterWorkflowTemplates: []string{"*"},
+			TemplateSelector:         "approved=true",
+			Parameters:               []string{"*"},
+		},
+	}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	wfClient := fake.NewSimpleClientset(
+		&wfv1.ClusterWorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Name: "approved", Labels: map[string]string{"approved": "true"}}},
+		&wfv1.ClusterWorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Name: "unapproved"}},
+	)
+	analyst := context.WithValue(context.WithValue(context.Background(), auth.WfKey, wfClient), auth.ClaimsKey, &types.Claims{Groups: []string{"analysts"}})
+	denied := func(t *testing.T, err error) {
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	}
+
+	t.Run("NilPolicy", func(t *testing.T) {
+		var p *Policy
+		assert.NoError(t, p.AuthorizeSubmit(analyst, "analytics", &wfv1.Workflow{}))
+		assert.NoError(t, p.Authorize(analyst, Stop, "analytics", &wfv1.Workflow{}, nil))
+	})
+	t.Run("Unrestricted", func(t *testing.T) {
+		admin := context.WithValue(context.Background(), auth.ClaimsKey, &types.Claims{Groups: []string{"admins"}})
+		assert.NoError(t, p.AuthorizeSubmit(admin, "analytics", &wfv1.Workflow{}))
+		assert.NoError(t, p.AuthorizeSubmit(context.Background(), "analytics", &wfv1.Workflow{}))
+	})
+	t.Run("Submit", func(t *testing.T) {
+		assert.NoError(t, p.AuthorizeSubmit(analyst, "analytics", fromTemplate("report-daily", false, "date")))
+		denied(t, p.AuthorizeSubmit(analyst, "analytics", &wfv1.Workflow{Spec: wfv1.WorkflowSpec{Entrypoint: "main"}}))
+		denied(t, p.AuthorizeSubmit(analyst, "other", fromTemplate("report-daily", false)))
+		denied(t, p.AuthorizeSubmit(analyst, "analytics", fromTemplate("etl", false)))
+		denied(t, p.AuthorizeSubmit(analyst, "analytics", fromTemplate("report-daily", false, "image")))
+		wf := fromTemplate("report-daily", false)
+		wf.Spec.ServiceAccountName = "admin"
+		denied(t, p.AuthorizeSubmit(analyst, "analytics", wf))
+	})
+	t.Run("TemplateSelector", func(t *testing.T) {
+		assert.NoError(t, p.AuthorizeSubmit(analyst, "other", fromTemplate("approved", true, "image")))
+		denied(t, p.AuthorizeSubmit(analyst, "other", fromTemplate("unapproved", true)))
+	})
+	t.Run("Actions", func(t *testing.T) {
+		assert.NoError(t, p.Authorize(analyst, Stop, "analytics", fromTemplate("report-daily", false), nil))
+		denied(t, p.Authorize(analyst, Stop, "other", fromTemplate("approved", true), nil))
+		denied(t, p.Authorize(analyst, Resume, "analytics", &wfv1.Workflow{}, nil))
+	})
+	t.Run("SubmitOpts", func(t *testing.T) {
+		wf := fromTemplate("report-daily", false)
+		assert.NoError(t, p.AuthorizeSubmitOpts(analyst, "analytics", wf, nil))
+		assert.NoError(t, p.AuthorizeSubmitOpts(analyst, "analytics", wf, &wfv1.SubmitOpts{Parameters: []string{"date=today"}}))
+		denied(t, p.AuthorizeSubmitOpts(analyst, "analytics", wf, &wfv1.SubmitOpts{Parameters: []string{"image=evil"}}))
+		denied(t, p.AuthorizeSubmitOpts(analyst, "analytics", wf, &wfv1.SubmitOpts{Entrypoint: "other"}))
+		denied(t, p.AuthorizeSubmitOpts(analyst, "analytics", wf, &wfv1.SubmitOpts{ServiceAccount: "admin"}))
+		denied(t, p.AuthorizeSubmitOpts(analyst, "analytics", wf, &wfv1.SubmitOpts{ParameterFile: "params.yaml"}))
+		assert.Empty(t, wf.Spec.Arguments.Parameters, "the workflow is not changed")
+	})
+	t.Run("CronWorkflow", func(t *testing.T) {
+		cronWf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{WorkflowSpec: fromTemplate("report-daily", false, "date").Spec}}
+		assert.NoError(t, p.AuthorizeCronWorkflow(analyst, "analytics", cronWf))
+		cronWf.Spec.WorkflowSpec.Entrypoint = "main"
+		denied(t, p.AuthorizeCronWorkflow(analyst, "analytics", cronWf))
+	})
+	t.Run("TemplateChange", func(t *testing.T) {
+		assert.NoError(t, p.AuthorizeTemplateChange(context.Background()))
+		denied(t, p.AuthorizeTemplateChange(analyst))
+	})
+}
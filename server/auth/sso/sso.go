@@ -44,6 +44,9 @@ type Interface interface {
 
 var _ Interface = &sso{}
 
+// LoginHook is called with the claims of each user that logs in
+type LoginHook func(ctx context.Context, claims *types.Claims) error
+
 type sso struct {
 	config          *oauth2.Config
 	idTokenVerifier *oidc.IDTokenVerifier
@@ -53,6 +56,7 @@ type sso struct {
 	encrypter       jose.Encrypter
 	rbacConfig      *rbac.Config
 	expiry          time.Duration
+	onLogin         LoginHook
 }
 
 func (s *sso) IsRBACEnabled() bool {
@@ -68,6 +72,8 @@ type Config struct {
 	// additional scopes (on top of "openid")
 	Scopes        []string        `json:"scopes,omitempty"`
 	SessionExpiry metav1.Duration `json:"sessionExpiry,omitempty"`
+	// the longest a personal access token may last
+	TokenMaxExpiry metav1.Duration `json:"tokenMaxExpiry,omitempty"`
 }
 
 func (c Config) GetSessionExpiry() time.Duration {
@@ -77,6 +83,13 @@ func (c Config) GetSessionExpiry() time.Duration {
 	return 10 * time.Hour
 }
 
+func (c Config) GetTokenMaxExpiry() time.Duration {
+	if c.TokenMaxExpiry.Duration > 0 {
+		return c.TokenMaxExpiry.Duration
+	}
+	return 90 * 24 * time.Hour
+}
+
 // Abstract methods of oidc.Provider that our code uses into an interface. That
 // will allow us to implement a stub for unit testing.  If you start using more
 // oidc.Provider methods in this file, add them here and provide a stub
@@ -92,8 +105,8 @@ func providerFactoryOIDC(ctx context.Context, issuer string) (providerInterface,
 	return oidc.NewProvider(ctx, issuer)
 }
 
-func New(c Config, secretsIf corev1.SecretInterface, baseHRef string, secure bool) (Interface, error) {
-	return newSso(providerFactoryOIDC, c, secretsIf, baseHRef, secure)
+func New(c Config, secretsIf corev1.SecretInterface, baseHRef string, secure bool, onLogin LoginHook) (Interface, error) {
+	return newSso(providerFactoryOIDC, c, secretsIf, baseHRef, secure, onLogin)
 }
 
 func newSso(
@@ -102,6 +115,7 @@ func newSso(
 	secretsIf corev1.SecretInterface,
 	baseHRef string,
 	secure bool,
+	onLogin LoginHook,
 ) (Interface, error) {
 	if c.Issuer == "" {
 		return nil, fmt.Errorf("issuer empty")
@@ -187,6 +201,7 @@ func newSso(
 		encrypter:       encrypter,
 		rbacConfig:      c.RBAC,
 		expiry:          c.GetSessionExpiry(),
+		onLogin:         onLogin,
 	}, nil
 }
 
@@ -249,6 +264,11 @@ func (s *sso) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		EmailVerified:      c.EmailVerified,
 		ServiceAccountName: c.ServiceAccountName,
 	}
+	if s.onLogin != nil {
+		if err := s.onLogin(ctx, argoClaims); err != nil {
+			log.WithError(err).WithField("subject", argoClaims.Subject).Error("failed to handle login")
+		}
+	}
 	raw, err := jwt.Encrypted(s.encrypter).Claims(argoClaims).CompactSerialize()
 	if err != nil {
 		panic(err)
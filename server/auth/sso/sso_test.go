@@ -58,7 +58,7 @@ func TestLoadSsoClientIdFromSecret(t *testing.T) {
 		ClientSecret: getSecretKeySelector("argo-sso-secret", "client-secret"),
 		RedirectURL:  "https://dummy",
 	}
-	ssoInterface, err := newSso(fakeOidcFactory, config, fakeClient, "/", false)
+	ssoInterface, err := newSso(fakeOidcFactory, config, fakeClient, "/", false, nil)
 	assert.NoError(t, err)
 	ssoObject := ssoInterface.(*sso)
 	assert.Equal(t, "sso-client-id-value", ssoObject.config.ClientID)
@@ -85,7 +85,7 @@ func TestLoadSsoClientIdFromDifferentSecret(t *testing.T) {
 		ClientSecret: getSecretKeySelector("argo-sso-secret", "client-secret"),
 		RedirectURL:  "https://dummy",
 	}
-	ssoInterface, err := newSso(fakeOidcFactory, config, fakeClient, "/", false)
+	ssoInterface, err := newSso(fakeOidcFactory, config, fakeClient, "/", false, nil)
 	assert.NoError(t, err)
 	ssoObject := ssoInterface.(*sso)
 	assert.Equal(t, "sso-client-id-value", ssoObject.config.ClientID)
@@ -99,7 +99,7 @@ func TestLoadSsoClientIdFromSecretNoKeyFails(t *testing.T) {
 		ClientSecret: getSecretKeySelector("argo-sso-secret", "client-secret"),
 		RedirectURL:  "https://dummy",
 	}
-	_, err := newSso(fakeOidcFactory, config, fakeClient, "/", false)
+	_, err := newSso(fakeOidcFactory, config, fakeClient, "/", false, nil)
 	assert.Error(t, err)
 	assert.Regexp(t, "key nonexistent missing in secret argo-sso-secret", err.Error())
 }
@@ -0,0 +1,280 @@
+package token
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/square/go-jose.v2/jwt"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+)
+
+const (
+	// Prefix is the prefix of the authorization header for a personal access token
+	Prefix = "Bearer pat:"
+	// LabelKeyToken labels the secrets that store personal access tokens
+	LabelKeyToken = workflow.WorkflowFullName + "/personal-access-token"
+	secretPrefix  = "argo-token-"
+	// DefaultExpiry is how long a token lasts if no expiry is given, unless that is longer than the maximum
+	DefaultExpiry = 30 * 24 * time.Hour
+)
+
+type Interface interface {
+	// Create mints a token carrying the claims, returning the token and its (only ever returned once) value. A zero
+	// expiry is the default expiry.
+	Create(ctx context.Context, claims *types.Claims, name string, expiry time.Duration) (*Token, string, error)
+	// List lists the tokens belonging to the claims' subject
+	List(ctx context.Context, claims *types.Claims) ([]Token, error)
+	// Revoke deletes the named token belonging to the claims' subject
+	Revoke(ctx context.Context, claims *types.Claims, name string) error
+	// Refresh updates the identity (email and groups) of the tokens belonging to the claims' subject, e.g. when they
+	// log in using SSO
+	Refresh(ctx context.Context, claims *types.Claims) error
+	// Authorize verifies the authorization header, returning the claims of the user that created the token
+	Authorize(ctx context.Context, authorization string) (*types.Claims, error)
+}
+
+type Token struct {
+	Name      string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+var (
+	ErrNotFound      = fmt.Errorf("token not found")
+	ErrAlreadyExists = fmt.Errorf("token already exists")
+	ErrExpiryTooLong = fmt.Errorf("token expiry too long")
+)
+
+type tokens struct {
+	secretsIf corev1.SecretInterface
+	maxExpiry time.Duration
+}
+
+// New returns tokens stored (hashed) in secrets in the namespace the secrets interface is for, that may not expire
+// after more than the maximum expiry
+func New(secretsIf corev1.SecretInterface, maxExpiry time.Duration) Interface {
+	return &tokens{secretsIf, maxExpiry}
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hash(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
+
+func (t *tokens) Create(ctx context.Context, claims *types.Claims, name string, expiry time.Duration) (*Token, string, error) {
+	if claims == nil || claims.Subject == "" {
+		return nil, "", fmt.Errorf("tokens can only be created by users with a subject")
+	}
+	if name == "" {
+		return nil, "", fmt.Errorf("token name is required")
+	}
+	if expiry == 0 {
+		expiry = DefaultExpiry
+		if expiry > t.maxExpiry {
+			expiry = t.maxExpiry
+		}
+	}
+	if expiry <= 0 {
+		return nil, "", fmt.Errorf("token expiry must be positive")
+	}
+	if expiry > t.maxExpiry {
+		return nil, "", fmt.Errorf("%w: must be at most %v", ErrExpiryTooLong, t.maxExpiry)
+	}
+	existing, err := t.List(ctx, claims)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, x := range existing {
+		if x.Name == name {
+			return nil, "", fmt.Errorf("%w: %q", ErrAlreadyExists, name)
+		}
+	}
+	id, err := randomHex(5)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return nil, "", err
+	}
+	// the token carries the user's identity, but not their SSO session's expiry or service account
+	stored := *claims
+	stored.Expiry = nil
+	stored.ServiceAccountName = ""
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return nil, "", err
+	}
+	token := &Token{Name: name, CreatedAt: time.Now().UTC().Truncate(time.Second)}
+	token.ExpiresAt = token.CreatedAt.Add(expiry)
+	_, err = t.secretsIf.Create(ctx, &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   secretPrefix + id,
+			Labels: map[string]string{LabelKeyToken: "true"},
+		},
+		Data: map[string][]byte{
+			"name":      []byte(name),
+			"subject":   []byte(claims.Subject),
+			"hash":      []byte(hash(secret)),
+			"claims":    data,
+			"createdAt": []byte(token.CreatedAt.Format(time.RFC3339)),
+			"expiresAt": []byte(token.ExpiresAt.Format(time.RFC3339)),
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	log.WithFields(log.Fields{"subject": claims.Subject, "name": name, "expiresAt": token.ExpiresAt}).Info("Created personal access token")
+	return token, strings.TrimPrefix(Prefix, "Bearer ") + id + "." + secret, nil
+}
+
+func tokenFor(secret apiv1.Secret) (Token, error) {
+	createdAt, err := time.Parse(time.RFC3339, string(secret.Data["createdAt"]))
+	if err != nil {
+		return Token{}, err
+	}
+	expiresAt, err := time.Parse(time.RFC3339, string(secret.Data["expiresAt"]))
+	if err != nil {
+		return Token{}, err
+	}
+	return Token{Name: string(secret.Data["name"]), CreatedAt: createdAt, ExpiresAt: expiresAt}, nil
+}
+
+func (t *tokens) secrets(ctx context.Context, claims *types.Claims) ([]apiv1.Secret, error) {
+	if claims == nil || claims.Subject == "" {
+		return nil, nil
+	}
+	list, err := t.secretsIf.List(ctx, metav1.ListOptions{LabelSelector: LabelKeyToken + "=true"})
+	if err != nil {
+		return nil, err
+	}
+	var secrets []apiv1.Secret
+	for _, s := range list.Items {
+		if string(s.Data["subject"]) == claims.Subject {
+			secrets = append(secrets, s)
+		}
+	}
+	return secrets, nil
+}
+
+func (t *tokens) List(ctx context.Context, claims *types.Claims) ([]Token, error) {
+	secrets, err := t.secrets(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]Token, 0, len(secrets))
+	for _, s := range secrets {
+		token, err := tokenFor(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid token secret %s: %w", s.Name, err)
+		}
+		tokens = append(tokens, token)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Name < tokens[j].Name })
+	return tokens, nil
+}
+
+func (t *tokens) Revoke(ctx context.Context, claims *types.Claims, name string) error {
+	secrets, err := t.secrets(ctx, claims)
+	if err != nil {
+		return err
+	}
+	for _, s := range secrets {
+		if string(s.Data["name"]) == name {
+			err := t.secretsIf.Delete(ctx, s.Name, metav1.DeleteOptions{})
+			if err != nil && !apierr.IsNotFound(err) {
+				return err
+			}
+			log.WithFields(log.Fields{"subject": claims.Subject, "name": name}).Info("Revoked personal access token")
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func (t *tokens) Refresh(ctx context.Context, claims *types.Claims) error {
+	secrets, err := t.secrets(ctx, claims)
+	if err != nil {
+		return err
+	}
+	for _, s := range secrets {
+		stored := &types.Claims{}
+		if err := json.Unmarshal(s.Data["claims"], stored); err != nil {
+			return fmt.Errorf("failed to unmarshal token claims: %w", err)
+		}
+		stored.Groups = claims.Groups
+		stored.Email = claims.Email
+		stored.EmailVerified = claims.EmailVerified
+		data, err := json.Marshal(stored)
+		if err != nil {
+			return err
+		}
+		if string(data) == string(s.Data["claims"]) {
+			continue
+		}
+		s.Data["claims"] = data
+		if _, err := t.secretsIf.Update(ctx, &s, metav1.UpdateOptions{}); err != nil && !apierr.IsNotFound(err) {
+			return err
+		}
+		log.WithFields(log.Fields{"subject": claims.Subject, "name": string(s.Data["name"])}).Info("Refreshed personal access token")
+	}
+	return nil
+}
+
+func (t *tokens) Authorize(ctx context.Context, authorization string) (*types.Claims, error) {
+	parts := strings.SplitN(strings.TrimPrefix(authorization, Prefix), ".", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("malformed token")
+	}
+	id, value := parts[0], parts[1]
+	// the ID becomes part of the secret name, so it must be what we generated
+	if _, err := hex.DecodeString(id); err != nil || id == "" {
+		return nil, fmt.Errorf("malformed token")
+	}
+	secret, err := t.secretsIf.Get(ctx, secretPrefix+id, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, fmt.Errorf("token not found or revoked")
+		}
+		return nil, err
+	}
+	if secret.Labels[LabelKeyToken] != "true" || subtle.ConstantTimeCompare([]byte(hash(value)), secret.Data["hash"]) != 1 {
+		return nil, fmt.Errorf("invalid token")
+	}
+	token, err := tokenFor(*secret)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().After(token.ExpiresAt) {
+		return nil, fmt.Errorf("token expired")
+	}
+	claims := &types.Claims{}
+	if err := json.Unmarshal(secret.Data["claims"], claims); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token claims: %w", err)
+	}
+	claims.Expiry = jwt.NewNumericDate(token.ExpiresAt)
+	return claims, nil
+}
@@ -0,0 +1,170 @@
+package token
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/square/go-jose.v2/jwt"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/server/auth/types"
+)
+
+func TestTokens(t *testing.T) {
+	ctx := context.Background()
+	secretsIf := kubefake.NewSimpleClientset().CoreV1().Secrets("argo")
+	tokens := New(secretsIf, 48*time.Hour)
+	me := &types.Claims{Claims: jwt.Claims{Issuer: "argo-server", Subject: "my-sub", Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour))}, Groups: []string{"my-group"}, ServiceAccountName: "my-sa"}
+	other := &types.Claims{Claims: jwt.Claims{Subject: "other-sub"}}
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, _, err := tokens.Create(ctx, &types.Claims{}, "my-token", time.Hour)
+		assert.Error(t, err)
+		_, _, err = tokens.Create(ctx, me, "", time.Hour)
+		assert.Error(t, err)
+		_, _, err = tokens.Create(ctx, me, "my-token", -time.Hour)
+		assert.Error(t, err)
+		_, _, err = tokens.Create(ctx, me, "my-token", 49*time.Hour)
+		assert.ErrorIs(t, err, ErrExpiryTooLong)
+	})
+	t.Run("DefaultExpiry", func(t *testing.T) {
+		token, _, err := tokens.Create(ctx, other, "my-default-token", 0)
+		if assert.NoError(t, err) {
+			// the default is longer than the maximum
+			assert.Equal(t, 48*time.Hour, token.ExpiresAt.Sub(token.CreatedAt))
+		}
+	})
+	token, value, err := tokens.Create(ctx, me, "my-token", 24*time.Hour)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "my-token", token.Name)
+	assert.Equal(t, 24*time.Hour, token.ExpiresAt.Sub(token.CreatedAt))
+	t.Run("AlreadyExists", func(t *testing.T) {
+		_, _, err := tokens.Create(ctx, me, "my-token", time.Hour)
+		assert.ErrorIs(t, err, ErrAlreadyExists)
+		// names are per-user
+		_, _, err = tokens.Create(ctx, other, "my-token", time.Hour)
+		assert.NoError(t, err)
+	})
+	t.Run("Hashed", func(t *testing.T) {
+		list, err := secretsIf.List(ctx, metav1.ListOptions{})
+		if assert.NoError(t, err) {
+			for _, s := range list.Items {
+				for _, v := range s.Data {
+					assert.NotContains(t, string(v), value[len("pat:"):])
+				}
+			}
+		}
+	})
+	t.Run("List", func(t *testing.T) {
+		list, err := tokens.List(ctx, me)
+		if assert.NoError(t, err) && assert.Len(t, list, 1) {
+			assert.Equal(t, *token, list[0])
+		}
+	})
+	t.Run("Authorize", func(t *testing.T) {
+		claims, err := tokens.Authorize(ctx, "Bearer "+value)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "argo-server", claims.Issuer)
+			assert.Equal(t, "my-sub", claims.Subject)
+			assert.Equal(t, []string{"my-group"}, claims.Groups)
+			assert.Empty(t, claims.ServiceAccountName)
+			assert.Equal(t, token.ExpiresAt, claims.Expiry.Time().UTC())
+		}
+		_, err = tokens.Authorize(ctx, "Bearer "+value+"x")
+		assert.EqualError(t, err, "invalid token")
+		_, err = tokens.Authorize(ctx, "Bearer pat:../foo.bar")
+		assert.EqualError(t, err, "malformed token")
+		_, err = tokens.Authorize(ctx, "Bearer pat:")
+		assert.EqualError(t, err, "malformed token")
+	})
+	t.Run("Refresh", func(t *testing.T) {
+		assert.NoError(t, tokens.Refresh(ctx, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, Groups: []string{"my-new-group"}, Email: "me@example.com"}))
+		claims, err := tokens.Authorize(ctx, "Bearer "+value)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "argo-server", claims.Issuer)
+			assert.Equal(t, []string{"my-new-group"}, claims.Groups)
+			assert.Equal(t, "me@example.com", claims.Email)
+		}
+	})
+	t.Run("Expired", func(t *testing.T) {
+		_, value, err := tokens.Create(ctx, me, "my-expiring-token", time.Nanosecond)
+		if assert.NoError(t, err) {
+			_, err = tokens.Authorize(ctx, "Bearer "+value)
+			assert.EqualError(t, err, "token expired")
+		}
+	})
+	t.Run("Revoke", func(t *testing.T) {
+		assert.ErrorIs(t, tokens.Revoke(ctx, other, "my-expiring-token"), ErrNotFound)
+		assert.NoError(t, tokens.Revoke(ctx, me, "my-token"))
+		assert.ErrorIs(t, tokens.Revoke(ctx, me, "my-token"), ErrNotFound)
+		_, err := tokens.Authorize(ctx, "Bearer "+value)
+		assert.EqualError(t, err, "token not found or revoked")
+	})
+}
+
+// the Argo Server's role must allow every verb that the token store uses on secrets
+func TestRBAC(t *testing.T) {
+	ctx := context.Background()
+	kube := kubefake.NewSimpleClientset()
+	verbs := map[string]bool{}
+	kube.PrependReactor("*", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		verbs[action.GetVerb()] = true
+		return false, nil, nil
+	})
+	tokens := New(kube.CoreV1().Secrets("argo"), 48*time.Hour)
+	me := &types.Claims{Claims: jwt.Claims{Issuer: "argo-server", Subject: "my-sub"}, ServiceAccountName: "my-sa"}
+	_, value, err := tokens.Create(ctx, me, "my-token", time.Hour)
+	assert.NoError(t, err)
+	_, err = tokens.Authorize(ctx, "Bearer "+value)
+	assert.NoError(t, err)
+	_, err = tokens.List(ctx, me)
+	assert.NoError(t, err)
+	assert.NoError(t, tokens.Refresh(ctx, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, Groups: []string{"my-new-group"}}))
+	assert.NoError(t, tokens.Revoke(ctx, me, "my-token"))
+	assert.Equal(t, map[string]bool{"create": true, "get": true, "list": true, "update": true, "delete": true}, verbs)
+
+	for filename, name := range map[string]string{
+		"../../../manifests/cluster-install/argo-server-rbac/argo-server-clusterole.yaml": "argo-server-cluster-role",
+		"../../../manifests/namespace-install/argo-server-rbac/argo-server-role.yaml":     "argo-server-role",
+		"../../../manifests/install.yaml":                                                 "argo-server-cluster-role",
+		"../../../manifests/namespace-install.yaml":                                       "argo-server-role",
+	} {
+		t.Run(filename, func(t *testing.T) {
+			data, err := ioutil.ReadFile(filename)
+			if !assert.NoError(t, err) {
+				return
+			}
+			var rules []rbacv1.PolicyRule
+			for _, doc := range strings.Split(string(data), "\n---\n") {
+				role := &rbacv1.ClusterRole{}
+				if err := yaml.Unmarshal([]byte(doc), role); err == nil && role.Name == name && strings.HasSuffix(role.Kind, "Role") {
+					rules = role.Rules
+				}
+			}
+			allowed := map[string]bool{}
+			for _, rule := range rules {
+				for _, resource := range rule.Resources {
+					if resource == "secrets" {
+						for _, verb := range rule.Verbs {
+							allowed[verb] = true
+						}
+					}
+				}
+			}
+			for verb := range verbs {
+				assert.True(t, allowed[verb], "%s must be allowed", verb)
+			}
+		})
+	}
+}
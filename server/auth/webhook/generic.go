@@ -0,0 +1,157 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// genericWebhook is the configuration of a "generic" webhook client, that signs the request body using HMAC
+type genericWebhook struct {
+	// Header containing the signature, defaults to "X-Signature"
+	Header string `json:"header,omitempty"`
+	// Algorithm is one of "sha1", "sha256" (default) or "sha512"
+	Algorithm string `json:"algorithm,omitempty"`
+	// Encoding of the signature, "hex" (default) or "base64"
+	Encoding string `json:"encoding,omitempty"`
+	// SignaturePrefix is removed from the header value before verification, e.g. "sha256="
+	SignaturePrefix string `json:"signaturePrefix,omitempty"`
+	// TimestampHeader contains the time the request was sent as Unix seconds or RFC3339. If set, the signed payload is
+	// "<timestamp>.<body>", requests outside the tolerance are rejected, and each signature may only be used once per
+	// Argo Server.
+	TimestampHeader string `json:"timestampHeader,omitempty"`
+	// TimestampTolerance defaults to 5m
+	TimestampTolerance string `json:"timestampTolerance,omitempty"`
+}
+
+var algorithms = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+func (g genericWebhook) validate() error {
+	if _, ok := algorithms[g.algorithm()]; !ok {
+		return fmt.Errorf("unknown algorithm %q", g.Algorithm)
+	}
+	switch g.Encoding {
+	case "", "hex", "base64":
+	default:
+		return fmt.Errorf("unknown encoding %q", g.Encoding)
+	}
+	_, err := g.tolerance()
+	return err
+}
+
+func (g genericWebhook) header() string {
+	if g.Header != "" {
+		return g.Header
+	}
+	return "X-Signature"
+}
+
+func (g genericWebhook) algorithm() string {
+	if g.Algorithm != "" {
+		return g.Algorithm
+	}
+	return "sha256"
+}
+
+func (g genericWebhook) tolerance() (time.Duration, error) {
+	if g.TimestampTolerance == "" {
+		return 5 * time.Minute, nil
+	}
+	return time.ParseDuration(g.TimestampTolerance)
+}
+
+func (g genericWebhook) decode(signature string) ([]byte, error) {
+	if g.Encoding == "base64" {
+		return base64.StdEncoding.DecodeString(signature)
+	}
+	return hex.DecodeString(signature)
+}
+
+func parseTimestamp(value string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func (g genericWebhook) match(secret string, r *http.Request) bool {
+	value := r.Header.Get(g.header())
+	if value == "" || !strings.HasPrefix(value, g.SignaturePrefix) {
+		return false
+	}
+	signature, err := g.decode(strings.TrimPrefix(value, g.SignaturePrefix))
+	if err != nil {
+		return false
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return false
+	}
+	r.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+	payload := body
+	if g.TimestampHeader != "" {
+		timestamp := r.Header.Get(g.TimestampHeader)
+		t, err := parseTimestamp(timestamp)
+		if err != nil {
+			return false
+		}
+		tolerance, _ := g.tolerance()
+		if age := time.Since(t); age > tolerance || age < -tolerance {
+			return false
+		}
+		payload = append([]byte(timestamp+"."), body...)
+	}
+	mac := hmac.New(algorithms[g.algorithm()], []byte(secret))
+	_, _ = mac.Write(payload)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return false
+	}
+	// a signed timestamp is only proof of freshness if the signature cannot be replayed within the tolerance
+	if g.TimestampHeader != "" {
+		tolerance, _ := g.tolerance()
+		return signatures.add(hex.EncodeToString(signature), 2*tolerance)
+	}
+	return true
+}
+
+// seenSignatures remembers signatures, so that they cannot be replayed. This is per process: it does not stop a
+// signature being replayed to another replica of the Argo Server, or after a restart.
+type seenSignatures struct {
+	mu      sync.Mutex
+	expires map[string]time.Time
+}
+
+var signatures = &seenSignatures{expires: map[string]time.Time{}}
+
+// add returns false if the signature has already been seen
+func (s *seenSignatures) add(signature string, ttl time.Duration) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for k, expires := range s.expires {
+		if now.After(expires) {
+			delete(s.expires, k)
+		}
+	}
+	if _, ok := s.expires[signature]; ok {
+		return false
+	}
+	s.expires[signature] = now.Add(ttl)
+	return true
+}
@@ -0,0 +1,23 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookClient_matcher(t *testing.T) {
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := (&webhookClient{Type: "unknown"}).matcher()
+		assert.EqualError(t, err, `unknown type "unknown"`)
+	})
+	t.Run("InvalidAlgorithm", func(t *testing.T) {
+		_, err := (&webhookClient{Type: "generic", genericWebhook: genericWebhook{Algorithm: "md5"}}).matcher()
+		assert.EqualError(t, err, `unknown algorithm "md5"`)
+	})
+	t.Run("Generic", func(t *testing.T) {
+		m, err := (&webhookClient{Type: "generic"}).matcher()
+		assert.NoError(t, err)
+		assert.NotNil(t, m)
+	})
+}
@@ -2,14 +2,17 @@ package webhook
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"sigs.k8s.io/yaml"
 )
 
@@ -18,6 +21,45 @@ type webhookClient struct {
 	Type string `json:"type"`
 	// e.g. "shh!"
 	Secret string `json:"secret"`
+	// SecretRef is the name of a secret, and the key within it, in the same namespace, to use instead of "secret"
+	SecretRef *corev1.SecretKeySelector `json:"secretRef,omitempty"`
+	// configuration for the "generic" type
+	genericWebhook `json:",inline"`
+}
+
+func (c *webhookClient) matcher() (matcher, error) {
+	if c.Type == "generic" {
+		if err := c.genericWebhook.validate(); err != nil {
+			return nil, err
+		}
+		return c.genericWebhook.match, nil
+	}
+	m, ok := webhookParsers[c.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %q", c.Type)
+	}
+	return m, nil
+}
+
+// resolve returns the matcher and the secret of the client. An empty secret is an error, because anyone could sign a
+// request with it.
+func (c *webhookClient) resolve(ctx context.Context, secretsInterface v1.SecretInterface) (matcher, string, error) {
+	match, err := c.matcher()
+	if err != nil {
+		return nil, "", err
+	}
+	secret := c.Secret
+	if ref := c.SecretRef; ref != nil {
+		s, err := secretsInterface.Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get secret: %w", err)
+		}
+		secret = string(s.Data[ref.Key])
+	}
+	if secret == "" {
+		return nil, "", fmt.Errorf("empty secret")
+	}
+	return match, secret, nil
 }
 
 type matcher = func(secret string, r *http.Request) bool
@@ -74,10 +116,17 @@ func addWebhookAuthorization(r *http.Request, kube kubernetes.Interface) error {
 		client := &webhookClient{}
 		err := yaml.Unmarshal(data, client)
 		if err != nil {
-			return fmt.Errorf("failed to unmarshal webhook client \"%s\": %w", serviceAccountName, err)
+			log.WithError(err).WithField("serviceAccountName", serviceAccountName).Error("Skipping webhook client that cannot be unmarshalled")
+			continue
+		}
+		// a client that is misconfigured must not stop the other clients from matching
+		match, secret, err := client.resolve(ctx, secretsInterface)
+		if err != nil {
+			log.WithError(err).WithField("serviceAccountName", serviceAccountName).Error("Skipping invalid webhook client")
+			continue
 		}
 		log.WithFields(log.Fields{"serviceAccountName": serviceAccountName, "webhookType": client.Type}).Debug("Attempting to match webhook request")
-		ok := webhookParsers[client.Type](client.Secret, r)
+		ok := match(secret, r)
 		if ok {
 			log.WithField("serviceAccountName", serviceAccountName).Debug("Matched webhook request")
 			serviceAccount, err := serviceAccountInterface.Get(ctx, serviceAccountName, metav1.GetOptions{})
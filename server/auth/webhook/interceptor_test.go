@@ -2,9 +2,14 @@ package webhook
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -71,6 +76,46 @@ func TestInterceptor(t *testing.T) {
 		})
 		assert.Equal(t, []string{"Bearer my-gitlab-token"}, r.Header["Authorization"])
 	})
+	t.Run("Generic", func(t *testing.T) {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		headers := map[string]string{
+			"X-My-Signature": "sha256=" + sign("sh!", timestamp+".{}"),
+			"X-My-Timestamp": timestamp,
+		}
+		r, _ := intercept("POST", "/api/v1/events/my-ns/my-d", headers)
+		assert.Equal(t, []string{"Bearer my-generic-token"}, r.Header["Authorization"])
+		t.Run("Replay", func(t *testing.T) {
+			r, _ := intercept("POST", "/api/v1/events/my-ns/my-d", headers)
+			assert.Empty(t, r.Header["Authorization"])
+		})
+	})
+	t.Run("GenericBadSignature", func(t *testing.T) {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		r, _ := intercept("POST", "/api/v1/events/my-ns/my-d", map[string]string{
+			"X-My-Signature": "sha256=" + sign("wrong", timestamp+".{}"),
+			"X-My-Timestamp": timestamp,
+		})
+		assert.Empty(t, r.Header["Authorization"])
+	})
+	t.Run("GenericEmptySecret", func(t *testing.T) {
+		r, w := intercept("POST", "/api/v1/events/my-ns/my-d", map[string]string{"X-Empty-Signature": sign("", "{}")})
+		assert.Empty(t, r.Header["Authorization"])
+		assert.Equal(t, 200, w.Code)
+	})
+	t.Run("GenericStaleTimestamp", func(t *testing.T) {
+		timestamp := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+		r, _ := intercept("POST", "/api/v1/events/my-ns/my-d", map[string]string{
+			"X-My-Signature": "sha256=" + sign("sh!", timestamp+".{}"),
+			"X-My-Timestamp": timestamp,
+		})
+		assert.Empty(t, r.Header["Authorization"])
+	})
+}
+
+func sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
 }
 
 func intercept(method string, target string, headers map[string]string) (*http.Request, *httptest.ResponseRecorder) {
@@ -83,8 +128,31 @@ func intercept(method string, target string, headers map[string]string) (*http.R
 				"bitbucketserver": []byte("type: bitbucketserver\nsecret: sh!"),
 				"github":          []byte("type: github\nsecret: sh!"),
 				"gitlab":          []byte("type: gitlab\nsecret: sh!"),
+				"generic":         []byte("type: generic\nsecretRef: {name: generic-secret, key: secret}\nheader: X-My-Signature\nsignaturePrefix: sha256=\ntimestampHeader: X-My-Timestamp"),
+				// these are skipped, rather than failing every request
+				"empty":       []byte("type: generic\nsecretRef: {name: generic-secret, key: missing}\nheader: X-Empty-Signature"),
+				"missing":     []byte("type: generic\nsecretRef: {name: missing-secret, key: secret}"),
+				"invalid":     []byte("type: unknown\nsecret: sh!"),
+				"unparseable": []byte("type: ["),
 			},
 		},
+		// generic
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "generic-secret", Namespace: "my-ns"},
+			Data:       map[string][]byte{"secret": []byte("sh!")},
+		},
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "generic", Namespace: "my-ns"},
+			Secrets:    []corev1.ObjectReference{{Name: "generic-token"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "generic-token", Namespace: "my-ns"},
+			Data:       map[string][]byte{"token": []byte("my-generic-token")},
+		},
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "empty", Namespace: "my-ns"},
+			Secrets:    []corev1.ObjectReference{{Name: "generic-token"}},
+		},
 		// bitbucket
 		&corev1.ServiceAccount{
 			ObjectMeta: metav1.ObjectMeta{Name: "bitbucket", Namespace: "my-ns"},
@@ -10,6 +10,7 @@ import (
 	clusterwftmplpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/policy"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
@@ -18,10 +19,12 @@ import (
 
 type ClusterWorkflowTemplateServer struct {
 	instanceIDService instanceid.Service
+	// nil if no template policy is configured
+	templatePolicy *policy.Policy
 }
 
-func NewClusterWorkflowTemplateServer(instanceID instanceid.Service) clusterwftmplpkg.ClusterWorkflowTemplateServiceServer {
-	return &ClusterWorkflowTemplateServer{instanceID}
+func NewClusterWorkflowTemplateServer(instanceID instanceid.Service, templatePolicy *policy.Policy) clusterwftmplpkg.ClusterWorkflowTemplateServiceServer {
+	return &ClusterWorkflowTemplateServer{instanceID, templatePolicy}
 }
 
 func (cwts *ClusterWorkflowTemplateServer) CreateClusterWorkflowTemplate(ctx context.Context, req *clusterwftmplpkg.ClusterWorkflowTemplateCreateRequest) (*v1alpha1.ClusterWorkflowTemplate, error) {
@@ -29,10 +32,14 @@ func (cwts *ClusterWorkflowTemplateServer) CreateClusterWorkflowTemplate(ctx con
 	if req.Template == nil {
 		return nil, fmt.Errorf("cluster workflow template was not found in the request body")
 	}
+	err := cwts.templatePolicy.AuthorizeTemplateChange(ctx)
+	if err != nil {
+		return nil, err
+	}
 	cwts.instanceIDService.Label(req.Template)
 	creator.Label(ctx, req.Template)
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates())
-	_, err := validate.ValidateClusterWorkflowTemplate(nil, cwftmplGetter, req.Template)
+	_, err = validate.ValidateClusterWorkflowTemplate(nil, cwftmplGetter, req.Template)
 	if err != nil {
 		return nil, err
 	}
@@ -109,11 +116,20 @@ func (cwts *ClusterWorkflowTemplateServer) UpdateClusterWorkflowTemplate(ctx con
 	if req.Template == nil {
 		return nil, fmt.Errorf("ClusterWorkflowTemplate is not found in Request body")
 	}
-	err := cwts.instanceIDService.Validate(req.Template)
+	err := cwts.templatePolicy.AuthorizeTemplateChange(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = cwts.instanceIDService.Validate(req.Template)
 	if err != nil {
 		return nil, err
 	}
 	wfClient := auth.GetWfClient(ctx)
+	// the template may have been got from us, so have masked sensitive parameters
+	existing, err := wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, req.Template.Name, v1.GetOptions{})
+	if err == nil {
+		req.Template.Spec.Arguments.UnmaskSensitiveParameters(existing.Spec.Arguments)
+	}
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates())
 
 	_, err = validate.ValidateClusterWorkflowTemplate(nil, cwftmplGetter, req.Template)
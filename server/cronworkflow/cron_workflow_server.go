@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/policy"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
@@ -19,11 +22,13 @@ import (
 
 type cronWorkflowServiceServer struct {
 	instanceIDService instanceid.Service
+	// nil if no template policy is configured
+	templatePolicy *policy.Policy
 }
 
 // NewCronWorkflowServer returns a new cronWorkflowServiceServer
-func NewCronWorkflowServer(instanceIDService instanceid.Service) cronworkflowpkg.CronWorkflowServiceServer {
-	return &cronWorkflowServiceServer{instanceIDService}
+func NewCronWorkflowServer(instanceIDService instanceid.Service, templatePolicy *policy.Policy) cronworkflowpkg.CronWorkflowServiceServer {
+	return &cronWorkflowServiceServer{instanceIDService, templatePolicy}
 }
 
 func (c *cronWorkflowServiceServer) LintCronWorkflow(ctx context.Context, req *cronworkflowpkg.LintCronWorkflowRequest) (*v1alpha1.CronWorkflow, error) {
@@ -53,11 +58,15 @@ func (c *cronWorkflowServiceServer) CreateCronWorkflow(ctx context.Context, req
 	if req.CronWorkflow == nil {
 		return nil, fmt.Errorf("cron workflow was not found in the request body")
 	}
+	err := c.templatePolicy.AuthorizeCronWorkflow(ctx, req.Namespace, req.CronWorkflow)
+	if err != nil {
+		return nil, err
+	}
 	c.instanceIDService.Label(req.CronWorkflow)
 	creator.Label(ctx, req.CronWorkflow)
 	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace))
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates())
-	err := validate.ValidateCronWorkflow(wftmplGetter, cwftmplGetter, req.CronWorkflow)
+	err = validate.ValidateCronWorkflow(wftmplGetter, cwftmplGetter, req.CronWorkflow)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +82,13 @@ func (c *cronWorkflowServiceServer) GetCronWorkflow(ctx context.Context, req *cr
 }
 
 func (c *cronWorkflowServiceServer) UpdateCronWorkflow(ctx context.Context, req *cronworkflowpkg.UpdateCronWorkflowRequest) (*v1alpha1.CronWorkflow, error) {
-	_, err := c.getCronWorkflowAndValidate(ctx, req.Namespace, req.CronWorkflow.Name, metav1.GetOptions{})
+	existing, err := c.getCronWorkflowAndValidate(ctx, req.Namespace, req.CronWorkflow.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// the cron workflow may have been got from us, so have masked sensitive parameters
+	req.CronWorkflow.Spec.WorkflowSpec.Arguments.UnmaskSensitiveParameters(existing.Spec.WorkflowSpec.Arguments)
+	err = c.templatePolicy.AuthorizeCronWorkflow(ctx, req.Namespace, req.CronWorkflow)
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +129,38 @@ func setCronWorkflowSuspend(ctx context.Context, setTo bool, namespace, name str
 	return auth.GetWfClient(ctx).ArgoprojV1alpha1().CronWorkflows(namespace).Patch(ctx, name, types.MergePatchType, data, metav1.PatchOptions{})
 }
 
+func (c *cronWorkflowServiceServer) BackfillCronWorkflow(ctx context.Context, req *cronworkflowpkg.CronWorkflowBackfillRequest) (*v1alpha1.CronWorkflow, error) {
+	cronWf, err := c.getCronWorkflowAndValidate(ctx, req.Namespace, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// a backfill submits workflows, just like the schedule does
+	err = c.templatePolicy.AuthorizeCronWorkflow(ctx, req.Namespace, cronWf)
+	if err != nil {
+		return nil, err
+	}
+	backfill := req.Backfill
+	if backfill == nil || backfill.From.IsZero() || backfill.To.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "backfill from and to must be specified")
+	}
+	if backfill.To.Before(&backfill.From) {
+		return nil, status.Error(codes.InvalidArgument, "backfill to must not be before from")
+	}
+	if backfill.MaxParallel < 0 {
+		return nil, status.Error(codes.InvalidArgument, "backfill max parallel must not be negative")
+	}
+	if cronWf.Status.Backfill != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "a backfill from %v to %v is already in progress", cronWf.Status.Backfill.From, cronWf.Status.Backfill.To)
+	}
+	// the controller records its progress in `next`, so the request must not set it
+	backfill.Next = nil
+	data, err := json.Marshal(map[string]interface{}{"status": map[string]interface{}{"backfill": backfill}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshall cron workflow patch data: %w", err)
+	}
+	return auth.GetWfClient(ctx).ArgoprojV1alpha1().CronWorkflows(req.Namespace).Patch(ctx, req.Name, types.MergePatchType, data, metav1.PatchOptions{})
+}
+
 func (c *cronWorkflowServiceServer) getCronWorkflowAndValidate(ctx context.Context, namespace string, name string, options metav1.GetOptions) (*v1alpha1.CronWorkflow, error) {
 	wfClient := auth.GetWfClient(ctx)
 	cronWf, err := wfClient.ArgoprojV1alpha1().CronWorkflows(namespace).Get(ctx, name, options)
@@ -3,9 +3,11 @@ package cronworkflow
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/square/go-jose.v2/jwt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -52,7 +54,7 @@ metadata:
 `, &unlabelled)
 
 	wfClientset := wftFake.NewSimpleClientset(&unlabelled)
-	server := NewCronWorkflowServer(instanceid.NewService("my-instanceid"))
+	server := NewCronWorkflowServer(instanceid.NewService("my-instanceid"), nil)
 	ctx := context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClientset), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
 
 	t.Run("CreateCronWorkflow", func(t *testing.T) {
@@ -107,6 +109,29 @@ metadata:
 			assert.Error(t, err)
 		})
 	})
+	t.Run("BackfillCronWorkflow", func(t *testing.T) {
+		from := metav1.NewTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+		to := metav1.NewTime(from.Add(time.Hour))
+		t.Run("Invalid", func(t *testing.T) {
+			_, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.CronWorkflowBackfillRequest{Name: "my-name", Namespace: "my-ns", Backfill: &wfv1.CronWorkflowBackfill{From: to, To: from}})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = backfill to must not be before from")
+		})
+		t.Run("Labelled", func(t *testing.T) {
+			cronWf, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.CronWorkflowBackfillRequest{Name: "my-name", Namespace: "my-ns", Backfill: &wfv1.CronWorkflowBackfill{From: from, To: to, Next: &to}})
+			if assert.NoError(t, err) && assert.NotNil(t, cronWf.Status.Backfill) {
+				assert.True(t, from.Equal(&cronWf.Status.Backfill.From))
+				assert.Nil(t, cronWf.Status.Backfill.Next)
+			}
+		})
+		t.Run("InProgress", func(t *testing.T) {
+			_, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.CronWorkflowBackfillRequest{Name: "my-name", Namespace: "my-ns", Backfill: &wfv1.CronWorkflowBackfill{From: from, To: to}})
+			assert.Error(t, err)
+		})
+		t.Run("Unlabelled", func(t *testing.T) {
+			_, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.CronWorkflowBackfillRequest{Name: "unlabelled", Namespace: "my-ns", Backfill: &wfv1.CronWorkflowBackfill{From: from, To: to}})
+			assert.Error(t, err)
+		})
+	})
 	t.Run("DeleteCronWorkflow", func(t *testing.T) {
 		t.Run("Labelled", func(t *testing.T) {
 			_, err := server.DeleteCronWorkflow(ctx, &cronworkflowpkg.DeleteCronWorkflowRequest{Name: "my-name", Namespace: "my-ns"})
@@ -2,6 +2,7 @@ package dispatch
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,12 +13,16 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/metadata"
 	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 
+	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/policy"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	exprenv "github.com/argoproj/argo-workflows/v3/util/expr/env"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
@@ -26,17 +31,22 @@ import (
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
+	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
 type Operation struct {
 	ctx               context.Context
 	eventRecorder     record.EventRecorder
 	instanceIDService instanceid.Service
+	hydrator          hydrator.Interface
+	rateLimiters      *RateLimiters
+	templatePolicy    *policy.Policy
 	events            []wfv1.WorkflowEventBinding
 	env               map[string]interface{}
 }
 
-func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eventRecorder record.EventRecorder, events []wfv1.WorkflowEventBinding, namespace, discriminator string, payload *wfv1.Item) (*Operation, error) {
+func NewOperation(ctx context.Context, instanceIDService instanceid.Service, hydrator hydrator.Interface, rateLimiters *RateLimiters, templatePolicy *policy.Policy, eventRecorder record.EventRecorder, events []wfv1.WorkflowEventBinding, namespace, discriminator string, payload *wfv1.Item) (*Operation, error) {
 	env, err := expressionEnvironment(ctx, namespace, discriminator, payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create workflow template expression environment: %w", err)
@@ -45,99 +55,337 @@ func NewOperation(ctx context.Context, instanceIDService instanceid.Service, eve
 		ctx:               ctx,
 		eventRecorder:     eventRecorder,
 		instanceIDService: instanceIDService,
+		hydrator:          hydrator,
+		rateLimiters:      rateLimiters,
+		templatePolicy:    templatePolicy,
 		events:            events,
 		env:               env,
 	}, nil
 }
 
-func (o *Operation) Dispatch(ctx context.Context) {
+// Dispatch dispatches the event to each binding, and returns what happened for each one
+func (o *Operation) Dispatch(ctx context.Context) []*eventpkg.EventBindingResult {
 	log.Debug("Executing event dispatch")
 
 	data, _ := json.MarshalIndent(o.env, "", "  ")
 	log.Debugln(string(data))
 
-	for _, event := range o.events {
+	results := make([]*eventpkg.EventBindingResult, len(o.events))
+	for i, event := range o.events {
+		result := &eventpkg.EventBindingResult{Name: event.Name, Namespace: event.Namespace}
 		// we use a predicable suffix for the name so that lost connections cannot result in the same workflow being created twice
 		// being created twice
 		nameSuffix := fmt.Sprintf("%v", time.Now().Unix())
-		err := waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
-			_, err := o.dispatch(ctx, event, nameSuffix)
-			return !errorsutil.IsTransientErr(err), err
-		})
+		matched, wf, err := o.dispatch(ctx, event, nameSuffix)
+		result.Matched = matched
+		if wf != nil {
+			result.Workflow = wf.Name
+		}
 		if err != nil {
 			log.WithError(err).WithFields(log.Fields{"namespace": event.Namespace, "event": event.Name}).Error("failed to dispatch from event")
 			o.eventRecorder.Event(&event, corev1.EventTypeWarning, "WorkflowEventBindingError", "failed to dispatch event: "+err.Error())
+			result.Error = err.Error()
 		}
+		results[i] = result
 	}
+	return results
 }
 
-func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding, nameSuffix string) (*wfv1.Workflow, error) {
+// dispatch returns whether the binding matched the event, and the workflow submitted, if any
+func (o *Operation) dispatch(ctx context.Context, wfeb wfv1.WorkflowEventBinding, nameSuffix string) (bool, *wfv1.Workflow, error) {
 	selector := wfeb.Spec.Event.Selector
 	result, err := expr.Eval(selector, o.env)
 	if err != nil {
-		return nil, fmt.Errorf("failed to evaluate workflow template expression: %w", err)
+		return false, nil, fmt.Errorf("failed to evaluate workflow template expression: %w", err)
 	}
 	matched, boolExpr := result.(bool)
 	log.WithFields(log.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name, "selector": selector, "matched": matched, "boolExpr": boolExpr}).Debug("Selector evaluation")
-	submit := wfeb.Spec.Submit
 	if !boolExpr {
-		return nil, errors.New("malformed workflow template expression: did not evaluate to boolean")
-	} else if matched && submit != nil {
-		client := auth.GetWfClient(o.ctx)
-		ref := wfeb.Spec.Submit.WorkflowTemplateRef
-		var tmpl wfv1.WorkflowSpecHolder
+		return false, nil, errors.New("malformed workflow template expression: did not evaluate to boolean")
+	} else if !matched {
+		return false, nil, nil
+	}
+	// acting on each workflow is retried by itself, and separately from submitting, so that a transient error
+	// never results in acting on a workflow twice
+	err = o.act(ctx, wfeb)
+	if err != nil {
+		return true, nil, err
+	}
+	if wfeb.Spec.Submit == nil {
+		return true, nil, nil
+	}
+	var wf *wfv1.Workflow
+	err = retryTransient(func() error {
 		var err error
-		if ref.ClusterScope {
-			tmpl, err = client.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, ref.Name, metav1.GetOptions{})
-		} else {
-			tmpl, err = client.ArgoprojV1alpha1().WorkflowTemplates(wfeb.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		wf, err = o.submit(ctx, wfeb, nameSuffix)
+		return err
+	})
+	return true, wf, err
+}
+
+// submit submits a workflow from the binding's workflow template, returning nil if the event is a duplicate
+func (o *Operation) submit(ctx context.Context, wfeb wfv1.WorkflowEventBinding, nameSuffix string) (*wfv1.Workflow, error) {
+	submit := wfeb.Spec.Submit
+	client := auth.GetWfClient(o.ctx)
+	ref := wfeb.Spec.Submit.WorkflowTemplateRef
+	var tmpl wfv1.WorkflowSpecHolder
+	var err error
+	if ref.ClusterScope {
+		tmpl, err = client.ArgoprojV1alpha1().ClusterWorkflowTemplates().Get(ctx, ref.Name, metav1.GetOptions{})
+	} else {
+		tmpl, err = client.ArgoprojV1alpha1().WorkflowTemplates(wfeb.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow template: %w", err)
+	}
+	err = o.instanceIDService.Validate(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate workflow template instanceid: %w", err)
+	}
+	wf := common.NewWorkflowFromWorkflowTemplate(tmpl.GetName(), tmpl.GetWorkflowMetadata(), ref.ClusterScope)
+	o.instanceIDService.Label(wf)
+	err = o.populateWorkflowMetadata(wf, &submit.ObjectMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	dedupeKey := ""
+	if submit.DedupeKey != "" {
+		key, err := o.evaluateDedupeKey(submit.DedupeKey)
+		if err != nil {
+			return nil, err
 		}
+		dedupeKey = hash(key)
+		duplicate, err := o.isDuplicate(ctx, wfeb, dedupeKey, submit.GetDedupeWindow())
 		if err != nil {
-			return nil, fmt.Errorf("failed to get workflow template: %w", err)
+			return nil, err
 		}
-		err = o.instanceIDService.Validate(tmpl)
+		if duplicate {
+			log.WithFields(log.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name, "dedupeKey": key}).Info("Skipping duplicate event")
+			o.eventRecorder.Event(&wfeb, corev1.EventTypeNormal, "WorkflowEventBindingDuplicate", fmt.Sprintf("skipped duplicate event with dedupe key \"%s\"", key))
+			return nil, nil
+		}
+		labels.Label(wf, common.LabelKeyEventDedupeKey, dedupeKey)
+		// duplicates received concurrently within the same window get the same name, so only one can be created
+		nameSuffix = hash(fmt.Sprintf("%s/%v", dedupeKey, time.Now().Truncate(submit.GetDedupeWindow()).Unix()))[:10]
+	}
+
+	if wf.Name == "" {
+		// make sure we have a predicable name, so re-creation doesn't create two workflows
+		wf.SetName(wf.GetGenerateName() + nameSuffix)
+	}
+
+	// users will always want to know why a workflow was submitted,
+	// so we label with creator (which is a standard) and the name of the triggering event
+	creator.Label(o.ctx, wf)
+	labels.Label(wf, common.LabelKeyWorkflowEventBinding, wfeb.Name)
+	if submit.Arguments != nil {
+		for _, p := range submit.Arguments.Parameters {
+			if p.ValueFrom == nil {
+				return nil, fmt.Errorf("malformed workflow template parameter \"%s\": validFrom is nil", p.Name)
+			}
+			result, err := expr.Eval(p.ValueFrom.Event, o.env)
+			if err != nil {
+				return nil, fmt.Errorf("failed to evaluate workflow template parameter \"%s\" expression: %w", p.Name, err)
+			}
+			data, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert result to JSON \"%s\" expression: %w", p.Name, err)
+			}
+			wf.Spec.Arguments.Parameters = append(wf.Spec.Arguments.Parameters, wfv1.Parameter{Name: p.Name, Value: wfv1.AnyStringPtr(wfv1.Item{Value: data})})
+		}
+	}
+	// the binding submits as the sender of the event
+	err = o.templatePolicy.AuthorizeSubmit(o.ctx, wfeb.Namespace, wf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authorize workflow: %w", err)
+	}
+	// only workflows that are created count towards the rate limit
+	if limit := submit.RateLimit; limit != nil && !o.rateLimiters.Allow(wfeb, *limit) {
+		return nil, fmt.Errorf("rate limit of %d workflows per %v exceeded", limit.Limit, limit.GetPeriod())
+	}
+	wf, err = client.ArgoprojV1alpha1().Workflows(wfeb.Namespace).Create(ctx, wf, metav1.CreateOptions{})
+	if err != nil && submit.RateLimit != nil {
+		o.rateLimiters.Release(wfeb)
+	}
+	if apierr.IsAlreadyExists(err) && dedupeKey != "" {
+		log.WithFields(log.Fields{"namespace": wfeb.Namespace, "event": wfeb.Name}).Info("Skipping duplicate event, workflow already exists")
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workflow: %w", err)
+	}
+	return wf, nil
+}
+
+func (o *Operation) evaluateDedupeKey(statement string) (string, error) {
+	result, err := expr.Eval(statement, o.env)
+	if err != nil {
+		return "", fmt.Errorf("failed to evaluate dedupe key expression: %w", err)
+	}
+	if v, ok := result.(string); ok {
+		return v, nil
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert dedupe key to JSON: %w", err)
+	}
+	return string(data), nil
+}
+
+// isDuplicate returns true if a workflow with the dedupe key was submitted from the binding within the window
+func (o *Operation) isDuplicate(ctx context.Context, wfeb wfv1.WorkflowEventBinding, dedupeKey string, window time.Duration) (bool, error) {
+	options := metav1.ListOptions{LabelSelector: common.LabelKeyWorkflowEventBinding + "=" + wfeb.Name + "," + common.LabelKeyEventDedupeKey + "=" + dedupeKey}
+	o.instanceIDService.With(&options)
+	list, err := auth.GetWfClient(o.ctx).ArgoprojV1alpha1().Workflows(wfeb.Namespace).List(ctx, options)
+	if err != nil {
+		return false, fmt.Errorf("failed to list workflows: %w", err)
+	}
+	for _, wf := range list.Items {
+		if time.Since(wf.CreationTimestamp.Time) < window {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// retryTransient calls f until it succeeds or fails with an error that is not transient
+func retryTransient(f func() error) error {
+	return waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
+		err := f()
+		return !errorsutil.IsTransientErr(err), err
+	})
+}
+
+// hash returns a value that can be used as a label value or name suffix
+func hash(value string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(value)))[:40]
+}
+
+// act resumes, stops, terminates, or sets the workflows selected by the binding's actions
+func (o *Operation) act(ctx context.Context, wfeb wfv1.WorkflowEventBinding) error {
+	wfIf := auth.GetWfClient(o.ctx).ArgoprojV1alpha1().Workflows(wfeb.Namespace)
+	if a := wfeb.Spec.Resume; a != nil {
+		outputParameters, err := o.evaluateOutputParameters(a.OutputParameters)
 		if err != nil {
-			return nil, fmt.Errorf("failed to validate workflow template instanceid: %w", err)
+			return err
 		}
-		wf := common.NewWorkflowFromWorkflowTemplate(tmpl.GetName(), tmpl.GetWorkflowMetadata(), ref.ClusterScope)
-		o.instanceIDService.Label(wf)
-		err = o.populateWorkflowMetadata(wf, &submit.ObjectMeta)
+		err = o.forEachWorkflow(ctx, wfeb, a.LabelSelector, policy.Resume, func(name string) error {
+			if len(outputParameters) > 0 {
+				return util.SetWorkflow(ctx, wfIf, o.hydrator, name, a.NodeFieldSelector, util.SetOperationValues{Phase: wfv1.NodeSucceeded, OutputParameters: outputParameters})
+			}
+			return util.ResumeWorkflow(ctx, wfIf, o.hydrator, name, a.NodeFieldSelector)
+		})
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("failed to resume workflow: %w", err)
+		}
+	}
+	if a := wfeb.Spec.Stop; a != nil {
+		message, err := o.evaluateMessage(a.Message)
+		if err != nil {
+			return err
+		}
+		err = o.forEachWorkflow(ctx, wfeb, a.LabelSelector, policy.Stop, func(name string) error {
+			return util.StopWorkflow(ctx, wfIf, o.hydrator, name, a.NodeFieldSelector, message)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to stop workflow: %w", err)
+		}
+	}
+	if a := wfeb.Spec.Terminate; a != nil {
+		err := o.forEachWorkflow(ctx, wfeb, a.LabelSelector, policy.Terminate, func(name string) error {
+			return util.TerminateWorkflow(ctx, wfIf, name)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to terminate workflow: %w", err)
+		}
+	}
+	if a := wfeb.Spec.Set; a != nil {
+		message, err := o.evaluateMessage(a.Message)
+		if err != nil {
+			return err
+		}
+		outputParameters, err := o.evaluateOutputParameters(a.OutputParameters)
+		if err != nil {
+			return err
 		}
+		// setting a node's phase is how a suspended workflow is approved, so this is authorized like a resume
+		err = o.forEachWorkflow(ctx, wfeb, a.LabelSelector, policy.Resume, func(name string) error {
+			return util.SetWorkflow(ctx, wfIf, o.hydrator, name, a.NodeFieldSelector, util.SetOperationValues{Phase: a.Phase, Message: message, OutputParameters: outputParameters})
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set workflow: %w", err)
+		}
+	}
+	return nil
+}
 
-		if wf.Name == "" {
-			// make sure we have a predicable name, so re-creation doesn't create two workflows
-			wf.SetName(wf.GetGenerateName() + nameSuffix)
-		}
-
-		// users will always want to know why a workflow was submitted,
-		// so we label with creator (which is a standard) and the name of the triggering event
-		creator.Label(o.ctx, wf)
-		labels.Label(wf, common.LabelKeyWorkflowEventBinding, wfeb.Name)
-		if submit.Arguments != nil {
-			for _, p := range submit.Arguments.Parameters {
-				if p.ValueFrom == nil {
-					return nil, fmt.Errorf("malformed workflow template parameter \"%s\": validFrom is nil", p.Name)
-				}
-				result, err := expr.Eval(p.ValueFrom.Event, o.env)
-				if err != nil {
-					return nil, fmt.Errorf("failed to evaluate workflow template parameter \"%s\" expression: %w", p.Name, err)
-				}
-				data, err := json.Marshal(result)
-				if err != nil {
-					return nil, fmt.Errorf("failed to convert result to JSON \"%s\" expression: %w", p.Name, err)
-				}
-				wf.Spec.Arguments.Parameters = append(wf.Spec.Arguments.Parameters, wfv1.Parameter{Name: p.Name, Value: wfv1.AnyStringPtr(wfv1.Item{Value: data})})
-			}
+// forEachWorkflow calls f for each incomplete workflow in the binding's namespace matching the label selector
+// expression, if the template policy allows the action on it
+func (o *Operation) forEachWorkflow(ctx context.Context, wfeb wfv1.WorkflowEventBinding, labelSelector string, action policy.Action, f func(name string) error) error {
+	selector, err := o.evaluateStringExpression(labelSelector, "label selector")
+	if err != nil {
+		return err
+	}
+	// we never want to act on every workflow in the namespace by mistake
+	if selector == "" {
+		return errors.New("workflow label selector expression must not evaluate to an empty string")
+	}
+	if _, err := k8slabels.Parse(selector); err != nil {
+		return fmt.Errorf("malformed workflow label selector \"%s\": %w", selector, err)
+	}
+	options := metav1.ListOptions{LabelSelector: selector + "," + common.LabelKeyCompleted + "!=true"}
+	o.instanceIDService.With(&options)
+	var list *wfv1.WorkflowList
+	err = retryTransient(func() error {
+		var err error
+		list, err = auth.GetWfClient(o.ctx).ArgoprojV1alpha1().Workflows(wfeb.Namespace).List(ctx, options)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list workflows: %w", err)
+	}
+	for _, wf := range list.Items {
+		if err := o.templatePolicy.Authorize(o.ctx, action, wf.Namespace, &wf, nil); err != nil {
+			return fmt.Errorf("\"%s\": %w", wf.Name, err)
+		}
+		log.WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name, "event": wfeb.Name}).Info("Acting on workflow from event")
+		// only the workflow that failed is retried, so that we never act on any other workflow twice
+		if err := retryTransient(func() error { return f(wf.Name) }); err != nil {
+			return fmt.Errorf("\"%s\": %w", wf.Name, err)
+		}
+	}
+	return nil
+}
+
+func (o *Operation) evaluateMessage(statement string) (string, error) {
+	if statement == "" {
+		return "", nil
+	}
+	return o.evaluateStringExpression(statement, "message")
+}
+
+func (o *Operation) evaluateOutputParameters(parameters []wfv1.Parameter) (map[string]string, error) {
+	values := make(map[string]string, len(parameters))
+	for _, p := range parameters {
+		if p.ValueFrom == nil {
+			return nil, fmt.Errorf("malformed output parameter \"%s\": validFrom is nil", p.Name)
+		}
+		result, err := expr.Eval(p.ValueFrom.Event, o.env)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate output parameter \"%s\" expression: %w", p.Name, err)
+		}
+		if v, ok := result.(string); ok {
+			values[p.Name] = v
+			continue
 		}
-		wf, err = client.ArgoprojV1alpha1().Workflows(wfeb.Namespace).Create(ctx, wf, metav1.CreateOptions{})
+		data, err := json.Marshal(result)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create workflow: %w", err)
+			return nil, fmt.Errorf("failed to convert result to JSON \"%s\" expression: %w", p.Name, err)
 		}
-		return wf, nil
+		values[p.Name] = string(data)
 	}
-	return nil, nil
+	return values, nil
 }
 
 func (o *Operation) populateWorkflowMetadata(wf *wfv1.Workflow, metadata *metav1.ObjectMeta) error {
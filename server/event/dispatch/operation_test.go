@@ -3,20 +3,26 @@ package dispatch
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/metadata"
 	"gopkg.in/square/go-jose.v2/jwt"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/record"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/policy"
 	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	hydratorfake "github.com/argoproj/argo-workflows/v3/workflow/hydrator/fake"
 )
 
 func Test_metaData(t *testing.T) {
@@ -56,7 +62,7 @@ func TestNewOperation(t *testing.T) {
 	recorder := record.NewFakeRecorder(6)
 
 	// act
-	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, []wfv1.WorkflowEventBinding{
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), hydratorfake.Noop, NewRateLimiters(), nil, recorder, []wfv1.WorkflowEventBinding{
 		// test a malformed binding
 		{
 			ObjectMeta: metav1.ObjectMeta{Name: "malformed", Namespace: "my-ns"},
@@ -169,6 +175,302 @@ func TestNewOperation(t *testing.T) {
 	assert.Equal(t, "Warning WorkflowEventBindingError failed to dispatch event: failed to evaluate workflow template parameter \"my-param\" expression: unexpected token Operator(\"!\") (1:8)\n | rubbish!!!\n | .......^", <-recorder.Events)
 }
 
+func TestOperation_act(t *testing.T) {
+	// set-up
+	instanceIDLabel := map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}
+	client := fake.NewSimpleClientset(
+		&wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf-suspended", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid", "ticket": "123"}},
+			Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+				"my-node": {
+					Name: "my-node", DisplayName: "approve", Type: wfv1.NodeTypeSuspend, Phase: wfv1.NodeRunning,
+					Outputs: &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "approver", ValueFrom: &wfv1.ValueFrom{Supplied: &wfv1.SuppliedValueFrom{}}}}},
+				},
+			}},
+		},
+		&wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf-running", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid", "ticket": "456"}},
+		},
+		&wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf-other-instanceid", Namespace: "my-ns", Labels: map[string]string{"ticket": "456"}},
+		},
+	)
+	ctx := context.WithValue(context.WithValue(context.Background(), auth.WfKey, client), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+	recorder := record.NewFakeRecorder(2)
+
+	// act
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), hydratorfake.Noop, NewRateLimiters(), nil, recorder, []wfv1.WorkflowEventBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-approve", Namespace: "my-ns", Labels: instanceIDLabel},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event: wfv1.Event{Selector: `payload.action == "approve"`},
+				Resume: &wfv1.ResumeAction{
+					LabelSelector:     `"ticket=" + payload.ticket`,
+					NodeFieldSelector: "displayName=approve",
+					OutputParameters:  []wfv1.Parameter{{Name: "approver", ValueFrom: &wfv1.ValueFrom{Event: "payload.user"}}},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-cancel", Namespace: "my-ns", Labels: instanceIDLabel},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:     wfv1.Event{Selector: "true"},
+				Terminate: &wfv1.TerminateAction{LabelSelector: `"ticket=456"`},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-empty-selector", Namespace: "my-ns", Labels: instanceIDLabel},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event: wfv1.Event{Selector: "true"},
+				Stop:  &wfv1.StopAction{LabelSelector: `""`},
+			},
+		},
+	}, "my-ns", "my-discriminator", &wfv1.Item{Value: json.RawMessage(`{"action": "approve", "ticket": "123", "user": "alice"}`)})
+	if assert.NoError(t, err) {
+		operation.Dispatch(ctx)
+	}
+
+	// assert
+	wf, err := client.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, "my-wf-suspended", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		node := wf.Status.Nodes["my-node"]
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		assert.Equal(t, "alice", node.Outputs.Parameters[0].Value.String())
+		assert.Empty(t, wf.Spec.Shutdown)
+	}
+	wf, err = client.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, "my-wf-running", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, wfv1.ShutdownStrategyTerminate, wf.Spec.Shutdown)
+	}
+	wf, err = client.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, "my-wf-other-instanceid", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, wf.Spec.Shutdown)
+	}
+	assert.Equal(t, "Warning WorkflowEventBindingError failed to dispatch event: failed to stop workflow: workflow label selector expression must not evaluate to an empty string", <-recorder.Events)
+}
+
+func TestOperation_templatePolicy(t *testing.T) {
+	// set-up
+	instanceIDLabels := map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}
+	client := fake.NewSimpleClientset(
+		&wfv1.WorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Name: "allowed", Namespace: "my-ns", Labels: instanceIDLabels}},
+		&wfv1.WorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Name: "denied", Namespace: "my-ns", Labels: instanceIDLabels}},
+		&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid", "ticket": "123"}}},
+	)
+	templatePolicy, err := policy.New(&policy.Config{Rules: []policy.Rule{{Groups: []string{"my-group"}, WorkflowTemplates: []string{"allowed"}}}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	ctx := context.WithValue(context.WithValue(context.Background(), auth.WfKey, client), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, Groups: []string{"my-group"}})
+	recorder := record.NewFakeRecorder(2)
+
+	// act
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), hydratorfake.Noop, NewRateLimiters(), templatePolicy, recorder, []wfv1.WorkflowEventBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-allowed", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:  wfv1.Event{Selector: "true"},
+				Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "allowed"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-denied", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:  wfv1.Event{Selector: "true"},
+				Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "denied"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-terminate", Namespace: "my-ns", Labels: instanceIDLabels},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:     wfv1.Event{Selector: "true"},
+				Terminate: &wfv1.TerminateAction{LabelSelector: `"ticket=123"`},
+			},
+		},
+	}, "my-ns", "my-discriminator", &wfv1.Item{Value: json.RawMessage(`{}`)})
+	if assert.NoError(t, err) {
+		operation.Dispatch(ctx)
+	}
+
+	// assert
+	list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeyWorkflowEventBinding})
+	if assert.NoError(t, err) && assert.Len(t, list.Items, 1) {
+		assert.Equal(t, "my-wfeb-allowed", list.Items[0].Labels[common.LabelKeyWorkflowEventBinding])
+	}
+	wf, err := client.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, "my-wf", metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Empty(t, wf.Spec.Shutdown)
+	}
+	assert.Contains(t, <-recorder.Events, "failed to authorize workflow: rpc error: code = PermissionDenied")
+	assert.Contains(t, <-recorder.Events, "failed to terminate workflow: \"my-wf\": rpc error: code = PermissionDenied")
+}
+
+func TestOperation_dedupe(t *testing.T) {
+	// set-up
+	client := fake.NewSimpleClientset(
+		&wfv1.WorkflowTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}},
+		},
+	)
+	// the fake client does not set the creation timestamp
+	client.PrependReactor("create", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		action.(k8stesting.CreateAction).GetObject().(*wfv1.Workflow).CreationTimestamp = metav1.Now()
+		return false, nil, nil
+	})
+	ctx := context.WithValue(context.WithValue(context.Background(), auth.WfKey, client), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+	recorder := record.NewFakeRecorder(4)
+	rateLimiters := NewRateLimiters()
+	bindings := []wfv1.WorkflowEventBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-dedupe", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event: wfv1.Event{Selector: "true"},
+				Submit: &wfv1.Submit{
+					WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"},
+					DedupeKey:           "payload.commit",
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-rate-limit", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event: wfv1.Event{Selector: "true"},
+				Submit: &wfv1.Submit{
+					WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"},
+					ObjectMeta:          metav1.ObjectMeta{GenerateName: "my-wft-"},
+					RateLimit:           &wfv1.EventRateLimit{Limit: 1},
+				},
+			},
+		},
+	}
+	dispatch := func(commit string) {
+		operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), hydratorfake.Noop, rateLimiters, nil, recorder, bindings, "my-ns", "my-discriminator", &wfv1.Item{Value: json.RawMessage(`{"commit": "` + commit + `"}`)})
+		if assert.NoError(t, err) {
+			operation.Dispatch(ctx)
+		}
+	}
+
+	// act
+	dispatch("abc")
+	dispatch("abc")
+
+	// assert
+	list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeyWorkflowEventBinding + "=my-wfeb-dedupe"})
+	if assert.NoError(t, err) && assert.Len(t, list.Items, 1) {
+		assert.Equal(t, hash("abc"), list.Items[0].Labels[common.LabelKeyEventDedupeKey])
+	}
+	list, err = client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeyWorkflowEventBinding + "=my-wfeb-rate-limit"})
+	if assert.NoError(t, err) {
+		assert.Len(t, list.Items, 1)
+	}
+	assert.Equal(t, `Normal WorkflowEventBindingDuplicate skipped duplicate event with dedupe key "abc"`, <-recorder.Events)
+	assert.Equal(t, "Warning WorkflowEventBindingError failed to dispatch event: rate limit of 1 workflows per 1m0s exceeded", <-recorder.Events)
+}
+
+func TestOperation_rateLimit(t *testing.T) {
+	// set-up
+	client := fake.NewSimpleClientset(
+		&wfv1.WorkflowTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}},
+		},
+	)
+	failed := false
+	client.PrependReactor("create", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if !failed {
+			failed = true
+			return true, nil, errors.New("boom")
+		}
+		return false, nil, nil
+	})
+	ctx := context.WithValue(context.WithValue(context.Background(), auth.WfKey, client), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+	recorder := record.NewFakeRecorder(4)
+	rateLimiters := NewRateLimiters()
+	bindings := []wfv1.WorkflowEventBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event: wfv1.Event{Selector: "true"},
+				Submit: &wfv1.Submit{
+					WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"},
+					ObjectMeta:          metav1.ObjectMeta{GenerateName: "my-wft-"},
+					RateLimit:           &wfv1.EventRateLimit{Limit: 1},
+				},
+			},
+		},
+	}
+
+	// act
+	for i := 0; i < 3; i++ {
+		operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), hydratorfake.Noop, rateLimiters, nil, recorder, bindings, "my-ns", "my-discriminator", &wfv1.Item{Value: json.RawMessage(`{}`)})
+		if assert.NoError(t, err) {
+			operation.Dispatch(ctx)
+		}
+	}
+
+	// assert
+	list, err := client.ArgoprojV1alpha1().Workflows("my-ns").List(ctx, metav1.ListOptions{})
+	if assert.NoError(t, err) {
+		assert.Len(t, list.Items, 1, "the failed create is not counted")
+	}
+	assert.Equal(t, "Warning WorkflowEventBindingError failed to dispatch event: failed to create workflow: boom", <-recorder.Events)
+	assert.Equal(t, "Warning WorkflowEventBindingError failed to dispatch event: rate limit of 1 workflows per 1m0s exceeded", <-recorder.Events)
+}
+
+func TestOperation_retry(t *testing.T) {
+	// set-up
+	labels := map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid", "ticket": "123"}
+	suspended := wfv1.WorkflowStatus{Nodes: wfv1.Nodes{"my-node": {Name: "my-node", DisplayName: "approve", Type: wfv1.NodeTypeSuspend, Phase: wfv1.NodeRunning}}}
+	client := fake.NewSimpleClientset(
+		&wfv1.WorkflowTemplate{ObjectMeta: metav1.ObjectMeta{Name: "my-wft", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyControllerInstanceID: "my-instanceid"}}},
+		&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf-0", Namespace: "my-ns", Labels: labels}, Status: suspended},
+		&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf-1", Namespace: "my-ns", Labels: labels}, Status: suspended},
+	)
+	unavailable := apierr.NewServiceUnavailable("unavailable")
+	updates := map[string]int{}
+	client.PrependReactor("update", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		name := action.(k8stesting.UpdateAction).GetObject().(*wfv1.Workflow).Name
+		updates[name]++
+		if name == "my-wf-1" && updates[name] == 1 {
+			return true, nil, unavailable
+		}
+		return false, nil, nil
+	})
+	creates := 0
+	client.PrependReactor("create", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		creates++
+		if creates == 1 {
+			return true, nil, unavailable
+		}
+		return false, nil, nil
+	})
+	ctx := context.WithValue(context.WithValue(context.Background(), auth.WfKey, client), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+	recorder := record.NewFakeRecorder(1)
+
+	// act
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), hydratorfake.Noop, NewRateLimiters(), nil, recorder, []wfv1.WorkflowEventBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb", Namespace: "my-ns"},
+			Spec: wfv1.WorkflowEventBindingSpec{
+				Event:  wfv1.Event{Selector: "true"},
+				Resume: &wfv1.ResumeAction{LabelSelector: `"ticket=123"`, NodeFieldSelector: "displayName=approve"},
+				Submit: &wfv1.Submit{WorkflowTemplateRef: wfv1.WorkflowTemplateRef{Name: "my-wft"}},
+			},
+		},
+	}, "my-ns", "my-discriminator", &wfv1.Item{Value: json.RawMessage(`{}`)})
+	if assert.NoError(t, err) {
+		results := operation.Dispatch(ctx)
+		if assert.Len(t, results, 1) {
+			assert.Empty(t, results[0].Error)
+			assert.NotEmpty(t, results[0].Workflow)
+		}
+	}
+
+	// assert
+	assert.Equal(t, map[string]int{"my-wf-0": 1, "my-wf-1": 2}, updates, "only the workflow that failed is resumed again")
+	assert.Equal(t, 2, creates)
+	assert.Empty(t, recorder.Events)
+}
+
 func Test_populateWorkflowMetadata(t *testing.T) {
 	// set-up
 	client := fake.NewSimpleClientset(
@@ -180,7 +482,7 @@ func Test_populateWorkflowMetadata(t *testing.T) {
 	recorder := record.NewFakeRecorder(10)
 
 	// act
-	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), recorder, []wfv1.WorkflowEventBinding{
+	operation, err := NewOperation(ctx, instanceid.NewService("my-instanceid"), hydratorfake.Noop, NewRateLimiters(), nil, recorder, []wfv1.WorkflowEventBinding{
 		{
 			// No name specified
 			ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb-1", Namespace: "my-ns"},
@@ -0,0 +1,57 @@
+package dispatch
+
+import (
+	"sync"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// RateLimiters limits the number of workflows submitted from each binding, counting submissions in fixed windows.
+// Submissions are counted in memory, so each replica of the Argo Server has its own limit.
+type RateLimiters struct {
+	mu      sync.Mutex
+	windows map[string]*window
+}
+
+type window struct {
+	end   time.Time
+	count int32
+}
+
+func NewRateLimiters() *RateLimiters {
+	return &RateLimiters{windows: map[string]*window{}}
+}
+
+// Allow returns true, and counts the submission, if the binding has not reached its limit. If the workflow is then not
+// created, the submission must be released.
+func (r *RateLimiters) Allow(wfeb wfv1.WorkflowEventBinding, limit wfv1.EventRateLimit) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for key, w := range r.windows {
+		if !now.Before(w.end) {
+			delete(r.windows, key)
+		}
+	}
+	key := wfeb.Namespace + "/" + wfeb.Name
+	w, ok := r.windows[key]
+	if !ok {
+		w = &window{end: now.Add(limit.GetPeriod())}
+		r.windows[key] = w
+	}
+	if w.count >= limit.Limit {
+		return false
+	}
+	w.count++
+	return true
+}
+
+// Release stops counting a submission that was allowed, but did not create a workflow
+func (r *RateLimiters) Release(wfeb wfv1.WorkflowEventBinding) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if w, ok := r.windows[wfeb.Namespace+"/"+wfeb.Name]; ok && w.count > 0 {
+		w.count--
+	}
+}
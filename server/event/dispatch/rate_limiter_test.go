@@ -0,0 +1,27 @@
+package dispatch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestRateLimiters_Allow(t *testing.T) {
+	r := NewRateLimiters()
+	wfeb := wfv1.WorkflowEventBinding{ObjectMeta: metav1.ObjectMeta{Name: "my-wfeb", Namespace: "my-ns"}}
+	other := wfv1.WorkflowEventBinding{ObjectMeta: metav1.ObjectMeta{Name: "my-other-wfeb", Namespace: "my-ns"}}
+	limit := wfv1.EventRateLimit{Limit: 2, Period: &metav1.Duration{Duration: 100 * time.Millisecond}}
+	assert.True(t, r.Allow(wfeb, limit))
+	assert.True(t, r.Allow(wfeb, limit))
+	assert.False(t, r.Allow(wfeb, limit))
+	assert.True(t, r.Allow(other, limit), "limits are per binding")
+	r.Release(wfeb)
+	assert.True(t, r.Allow(wfeb, limit), "released submissions are not counted")
+	assert.False(t, r.Allow(wfeb, limit))
+	time.Sleep(100 * time.Millisecond)
+	assert.True(t, r.Allow(wfeb, limit), "limit resets after the period")
+}
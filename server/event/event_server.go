@@ -2,38 +2,69 @@ package event
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/policy"
 	"github.com/argoproj/argo-workflows/v3/server/event/dispatch"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
+	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
+)
+
+const (
+	deliveryPending   = "Pending"
+	deliverySucceeded = "Succeeded"
+	deliveryNoMatch   = "NoMatch"
+	deliveryFailed    = "Failed"
 )
 
 type Controller struct {
 	instanceIDService    instanceid.Service
+	hydrator             hydrator.Interface
+	rateLimiters         *dispatch.RateLimiters
+	templatePolicy       *policy.Policy
 	eventRecorderManager events.EventRecorderManager
+	deliveries           sqldb.EventDeliveryRepo
 	// a channel for operations to be executed async on
-	operationQueue chan dispatch.Operation
+	operationQueue chan operation
 	workerCount    int
 }
 
+// operation is an operation waiting to be dispatched, together with the record of the delivery
+type operation struct {
+	dispatch.Operation
+	delivery *eventpkg.EventDelivery
+}
+
 var _ eventpkg.EventServiceServer = &Controller{}
 
-func NewController(instanceIDService instanceid.Service, eventRecorderManager events.EventRecorderManager, operationQueueSize, workerCount int) *Controller {
+func NewController(instanceIDService instanceid.Service, hydrator hydrator.Interface, templatePolicy *policy.Policy, deliveries sqldb.EventDeliveryRepo, eventRecorderManager events.EventRecorderManager, operationQueueSize, workerCount int) *Controller {
 	log.WithFields(log.Fields{"workerCount": workerCount, "operationQueueSize": operationQueueSize}).Info("Creating event controller")
 
 	return &Controller{
 		instanceIDService:    instanceIDService,
+		hydrator:             hydrator,
+		rateLimiters:         dispatch.NewRateLimiters(),
+		templatePolicy:       templatePolicy,
 		eventRecorderManager: eventRecorderManager,
+		deliveries:           deliveries,
 		//  so we can have `operationQueueSize` operations outstanding before we start putting back pressure on the senders
-		operationQueue: make(chan dispatch.Operation, operationQueueSize),
+		operationQueue: make(chan operation, operationQueueSize),
 		workerCount:    workerCount,
 	}
 }
@@ -47,7 +78,7 @@ func (s *Controller) Run(stopCh <-chan struct{}) {
 			defer wg.Done()
 			for operation := range s.operationQueue {
 				ctx := context.Background()
-				operation.Dispatch(ctx)
+				s.complete(operation.delivery, operation.Dispatch(ctx))
 			}
 		}()
 		wg.Add(1)
@@ -65,24 +96,63 @@ func (s *Controller) Run(stopCh <-chan struct{}) {
 }
 
 func (s *Controller) ReceiveEvent(ctx context.Context, req *eventpkg.EventRequest) (*eventpkg.EventResponse, error) {
+	delivery := newDelivery(req.Namespace, req.Discriminator, req.Payload, headers(ctx))
+	if err := s.receive(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return &eventpkg.EventResponse{}, nil
+}
+
+// receive records the delivery, and queues it to be dispatched
+func (s *Controller) receive(ctx context.Context, delivery *eventpkg.EventDelivery) error {
 	options := metav1.ListOptions{}
 	s.instanceIDService.With(&options)
 
-	list, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(req.Namespace).List(ctx, options)
+	list, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(delivery.Namespace).List(ctx, options)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	operation, err := dispatch.NewOperation(ctx, s.instanceIDService, s.eventRecorderManager.Get(req.Namespace), list.Items, req.Namespace, req.Discriminator, req.Payload)
+	op, err := dispatch.NewOperation(ctx, s.instanceIDService, s.hydrator, s.rateLimiters, s.templatePolicy, s.eventRecorderManager.Get(delivery.Namespace), list.Items, delivery.Namespace, delivery.Discriminator, delivery.Payload)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
+	s.save(delivery)
+
+	// the worker records the outcome on its own copy, as the caller may still be using the delivery
+	queued := *delivery
 	select {
-	case s.operationQueue <- *operation:
-		return &eventpkg.EventResponse{}, nil
+	case s.operationQueue <- operation{Operation: *op, delivery: &queued}:
+		return nil
 	default:
-		return nil, apierrors.NewServiceUnavailable("operation queue full")
+		delivery.Phase = deliveryFailed
+		delivery.Message = "operation queue full"
+		s.save(delivery)
+		return apierrors.NewServiceUnavailable("operation queue full")
+	}
+}
+
+// complete records the outcome of dispatching the delivery
+func (s *Controller) complete(delivery *eventpkg.EventDelivery, results []*eventpkg.EventBindingResult) {
+	delivery.Bindings = results
+	delivery.Phase = deliveryNoMatch
+	for _, result := range results {
+		if result.Error != "" {
+			delivery.Phase = deliveryFailed
+			break
+		}
+		if result.Matched {
+			delivery.Phase = deliverySucceeded
+		}
+	}
+	s.save(delivery)
+}
+
+// save records the delivery, failing to do so must not stop the event being dispatched
+func (s *Controller) save(delivery *eventpkg.EventDelivery) {
+	if err := s.deliveries.SaveEventDelivery(delivery); err != nil {
+		log.WithError(err).WithField("id", delivery.Id).Error("failed to save event delivery")
 	}
 }
 
@@ -93,3 +163,84 @@ func (s *Controller) ListWorkflowEventBindings(ctx context.Context, in *eventpkg
 	}
 	return auth.GetWfClient(ctx).ArgoprojV1alpha1().WorkflowEventBindings(in.Namespace).List(ctx, listOptions)
 }
+
+func (s *Controller) ListEventDeliveries(ctx context.Context, req *eventpkg.ListEventDeliveriesRequest) (*eventpkg.EventDeliveryList, error) {
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowEventBindingPlural, req.Namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "Permission denied")
+	}
+	limit := 0
+	if req.ListOptions != nil {
+		limit = int(req.ListOptions.Limit)
+	}
+	items, err := s.deliveries.ListEventDeliveries(req.Namespace, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &eventpkg.EventDeliveryList{Items: items}, nil
+}
+
+func (s *Controller) ReplayEvent(ctx context.Context, req *eventpkg.ReplayEventRequest) (*eventpkg.EventDelivery, error) {
+	original, err := s.deliveries.GetEventDelivery(req.Id)
+	if err == sqldb.ErrEventDeliveryNotFound || (err == nil && original.Namespace != req.Namespace) {
+		return nil, status.Error(codes.NotFound, "event delivery not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the bindings may use the headers, so we restore them as they were when the event was received
+	md := metadata.MD{}
+	for _, h := range original.Headers {
+		md[h.Name] = h.Values
+	}
+	delivery := newDelivery(original.Namespace, original.Discriminator, original.Payload, original.Headers)
+	delivery.ReplayOf = original.Id
+	// replaying is sending the event again, so the caller needs the same permissions as they would to send it
+	if err := s.receive(metadata.NewIncomingContext(ctx, md), delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func newDelivery(namespace, discriminator string, payload *wfv1.Item, headers []*eventpkg.EventHeader) *eventpkg.EventDelivery {
+	now := metav1.Now()
+	return &eventpkg.EventDelivery{
+		Id:            string(uuid.NewUUID()),
+		Namespace:     namespace,
+		Discriminator: discriminator,
+		Payload:       payload,
+		Headers:       headers,
+		ReceivedAt:    &now,
+		Phase:         deliveryPending,
+	}
+}
+
+// sensitiveHeaders are parts of the names of headers that may contain webhook secrets or signatures, e.g.
+// `X-Gitlab-Token`, `X-Hub-Signature-256`, or Bitbucket's `X-Hook-UUID`
+var sensitiveHeaders = []string{"token", "signature", "secret", "password", "auth", "key", "hook-uuid"}
+
+func isSensitiveHeader(name string) bool {
+	for _, s := range sensitiveHeaders {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// headers returns the `X-` headers to record with the delivery, which are the only ones available to bindings, other
+// than those that may contain webhook secrets or signatures, as deliveries can be listed by anyone who can list bindings
+func headers(ctx context.Context) []*eventpkg.EventHeader {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var headers []*eventpkg.EventHeader
+	for k, v := range md {
+		if strings.HasPrefix(k, "x-") && !isSensitiveHeader(k) {
+			headers = append(headers, &eventpkg.EventHeader{Name: k, Values: v})
+		}
+	}
+	sort.Slice(headers, func(i, j int) bool { return headers[i].Name < headers[j].Name })
+	return headers
+}
@@ -5,21 +5,33 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
 	fakekube "k8s.io/client-go/kubernetes/fake"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	eventpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/event"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/events"
+	hydratorfake "github.com/argoproj/argo-workflows/v3/workflow/hydrator/fake"
 )
 
 func TestController(t *testing.T) {
 	clientset := fake.NewSimpleClientset()
-	s := NewController(instanceid.NewService("my-instanceid"), events.NewEventRecorderManager(fakekube.NewSimpleClientset()), 1, 1)
+	deliveries := sqldb.NewMemoryEventDeliveryRepo(10)
+	s := NewController(instanceid.NewService("my-instanceid"), hydratorfake.Noop, nil, deliveries, events.NewEventRecorderManager(fakekube.NewSimpleClientset()), 1, 1)
 
 	ctx := context.WithValue(context.TODO(), auth.WfKey, clientset)
+	ctx = metadata.NewIncomingContext(ctx, metadata.MD{
+		"x-my-header":         {"my-value"},
+		"authorization":       {"my-token"},
+		"x-gitlab-token":      {"my-webhook-secret"},
+		"x-hub-signature":     {"sha1=my-signature"},
+		"x-hub-signature-256": {"sha256=my-signature"},
+		"x-hook-uuid":         {"my-webhook-uuid"},
+	})
 	_, err := s.ReceiveEvent(ctx, &eventpkg.EventRequest{Namespace: "my-ns", Payload: &wfv1.Item{}})
 	assert.NoError(t, err)
 
@@ -33,4 +45,45 @@ func TestController(t *testing.T) {
 	s.Run(stopCh)
 
 	assert.Len(t, s.operationQueue, 0, "all events were processed")
+
+	list, err := deliveries.ListEventDeliveries("my-ns", 0)
+	if assert.NoError(t, err) && assert.Len(t, list, 1) {
+		d := list[0]
+		assert.Equal(t, "NoMatch", d.Phase, "no bindings, so no match")
+		assert.Equal(t, []*eventpkg.EventHeader{{Name: "x-my-header", Values: []string{"my-value"}}}, d.Headers, "only X- headers that are not secrets or signatures are recorded")
+	}
+	list, err = deliveries.ListEventDeliveries("", 0)
+	if assert.NoError(t, err) && assert.Len(t, list, 2, "all namespaces") {
+		assert.Equal(t, "Failed", list[0].Phase)
+		assert.Equal(t, "operation queue full", list[0].Message)
+	}
+}
+
+func TestController_ReplayEvent(t *testing.T) {
+	deliveries := sqldb.NewMemoryEventDeliveryRepo(10)
+	s := NewController(instanceid.NewService("my-instanceid"), hydratorfake.Noop, nil, deliveries, events.NewEventRecorderManager(fakekube.NewSimpleClientset()), 1, 1)
+	ctx := context.WithValue(context.TODO(), auth.WfKey, fake.NewSimpleClientset())
+	original := &eventpkg.EventDelivery{Id: "my-id", Namespace: "my-ns", Discriminator: "my-d", Payload: &wfv1.Item{}, Phase: "Failed"}
+	assert.NoError(t, deliveries.SaveEventDelivery(original))
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := s.ReplayEvent(ctx, &eventpkg.ReplayEventRequest{Namespace: "my-ns", Id: "not-found"})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = event delivery not found")
+	})
+	t.Run("OtherNamespace", func(t *testing.T) {
+		_, err := s.ReplayEvent(ctx, &eventpkg.ReplayEventRequest{Namespace: "other-ns", Id: "my-id"})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = event delivery not found")
+	})
+	t.Run("Replay", func(t *testing.T) {
+		d, err := s.ReplayEvent(ctx, &eventpkg.ReplayEventRequest{Namespace: "my-ns", Id: "my-id"})
+		if assert.NoError(t, err) {
+			assert.NotEqual(t, "my-id", d.Id)
+			assert.Equal(t, "my-id", d.ReplayOf)
+			assert.Equal(t, "my-d", d.Discriminator)
+			assert.Equal(t, "Pending", d.Phase)
+		}
+		if assert.Len(t, s.operationQueue, 1) {
+			assert.NotSame(t, d, (<-s.operationQueue).delivery, "the worker must not update the returned delivery")
+		}
+	})
 }
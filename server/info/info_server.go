@@ -2,16 +2,24 @@ package info
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-workflows/v3"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/token"
 )
 
 type infoServer struct {
 	managedNamespace string
 	links            []*wfv1.Link
+	tokensIf         token.Interface
 }
 
 func (i *infoServer) GetUserInfo(ctx context.Context, _ *infopkg.GetUserInfoRequest) (*infopkg.GetUserInfoResponse, error) {
@@ -38,6 +46,56 @@ func (i *infoServer) GetVersion(context.Context, *infopkg.GetVersionRequest) (*w
 	return &version, nil
 }
 
-func NewInfoServer(managedNamespace string, links []*wfv1.Link) infopkg.InfoServiceServer {
-	return &infoServer{managedNamespace, links}
+func tokenFor(t token.Token) *infopkg.Token {
+	createdAt, expiresAt := metav1.NewTime(t.CreatedAt), metav1.NewTime(t.ExpiresAt)
+	return &infopkg.Token{Name: t.Name, CreatedAt: &createdAt, ExpiresAt: &expiresAt}
+}
+
+func (i *infoServer) CreateToken(ctx context.Context, req *infopkg.CreateTokenRequest) (*infopkg.CreateTokenResponse, error) {
+	// otherwise, a token could be used to create a longer lived token
+	if auth.GetMode(ctx) != auth.SSO {
+		return nil, status.Error(codes.PermissionDenied, "tokens can only be created by users logged in using SSO")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	var expiry time.Duration
+	if req.Expiry != nil {
+		expiry = req.Expiry.Duration
+	}
+	t, value, err := i.tokensIf.Create(ctx, auth.GetClaims(ctx), req.Name, expiry)
+	if errors.Is(err, token.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	} else if errors.Is(err, token.ErrExpiryTooLong) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+	return &infopkg.CreateTokenResponse{Token: tokenFor(*t), Value: value}, nil
+}
+
+func (i *infoServer) ListTokens(ctx context.Context, _ *infopkg.ListTokensRequest) (*infopkg.TokenList, error) {
+	tokens, err := i.tokensIf.List(ctx, auth.GetClaims(ctx))
+	if err != nil {
+		return nil, err
+	}
+	list := &infopkg.TokenList{Items: make([]*infopkg.Token, 0, len(tokens))}
+	for _, t := range tokens {
+		list.Items = append(list.Items, tokenFor(t))
+	}
+	return list, nil
+}
+
+func (i *infoServer) RevokeToken(ctx context.Context, req *infopkg.RevokeTokenRequest) (*infopkg.RevokeTokenResponse, error) {
+	err := i.tokensIf.Revoke(ctx, auth.GetClaims(ctx), req.Name)
+	if errors.Is(err, token.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+	return &infopkg.RevokeTokenResponse{}, nil
+}
+
+func NewInfoServer(managedNamespace string, links []*wfv1.Link, tokensIf token.Interface) infopkg.InfoServiceServer {
+	return &infoServer{managedNamespace, links, tokensIf}
 }
@@ -3,11 +3,18 @@ package info
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/square/go-jose.v2/jwt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 
+	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/token"
 	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 )
 
@@ -24,3 +31,37 @@ func Test_infoServer_GetUserInfo(t *testing.T) {
 		assert.Equal(t, "my-sa", info.ServiceAccountName)
 	}
 }
+
+func Test_infoServer_Tokens(t *testing.T) {
+	i := &infoServer{tokensIf: token.New(kubefake.NewSimpleClientset().CoreV1().Secrets("argo"), 90*24*time.Hour)}
+	ctx := context.WithValue(context.TODO(), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+	t.Run("NotSSO", func(t *testing.T) {
+		_, err := i.CreateToken(context.WithValue(ctx, auth.ModeKey, auth.Token), &infopkg.CreateTokenRequest{Name: "my-token"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	ctx = context.WithValue(ctx, auth.ModeKey, auth.SSO)
+	t.Run("Create", func(t *testing.T) {
+		resp, err := i.CreateToken(ctx, &infopkg.CreateTokenRequest{Name: "my-token"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-token", resp.Token.Name)
+			assert.Equal(t, token.DefaultExpiry, resp.Token.ExpiresAt.Sub(resp.Token.CreatedAt.Time))
+			assert.NotEmpty(t, resp.Value)
+		}
+		_, err = i.CreateToken(ctx, &infopkg.CreateTokenRequest{Name: "my-token"})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		_, err = i.CreateToken(ctx, &infopkg.CreateTokenRequest{Name: "my-long-lived-token", Expiry: &metav1.Duration{Duration: 91 * 24 * time.Hour}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("List", func(t *testing.T) {
+		list, err := i.ListTokens(ctx, &infopkg.ListTokensRequest{})
+		if assert.NoError(t, err) {
+			assert.Len(t, list.Items, 1)
+		}
+	})
+	t.Run("Revoke", func(t *testing.T) {
+		_, err := i.RevokeToken(ctx, &infopkg.RevokeTokenRequest{Name: "my-token"})
+		assert.NoError(t, err)
+		_, err = i.RevokeToken(ctx, &infopkg.RevokeTokenRequest{Name: "my-token"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,162 @@
+package workflow
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/diff"
+)
+
+// nodeSummary is the part of a node that is compared
+type nodeSummary struct {
+	Phase      wfv1.NodePhase    `json:"phase,omitempty"`
+	Duration   string            `json:"duration,omitempty"`
+	ExitCode   string            `json:"exitCode,omitempty"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+	Artifacts  map[string]string `json:"artifacts,omitempty"`
+}
+
+// diffWorkflows compares the resolved arguments, templates, and nodes of two workflows
+func diffWorkflows(wf, other *wfv1.Workflow) (*workflowpkg.WorkflowDiffResponse, error) {
+	arguments, err := fieldDiffs(resolvedArguments(wf), resolvedArguments(other))
+	if err != nil {
+		return nil, err
+	}
+	res := &workflowpkg.WorkflowDiffResponse{Arguments: arguments}
+	templates, otherTemplates := templatesByName(wf), templatesByName(other)
+	names := make(map[string]bool)
+	for name := range templates {
+		names[name] = true
+	}
+	for name := range otherTemplates {
+		names[name] = true
+	}
+	for _, name := range sortedKeys(names) {
+		fields, err := fieldDiffs(templates[name], otherTemplates[name])
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) > 0 {
+			res.Templates = append(res.Templates, &workflowpkg.TemplateDiff{Name: name, Fields: fields})
+		}
+	}
+	nodes, otherNodes := nodesByName(wf), nodesByName(other)
+	names = make(map[string]bool)
+	for name := range nodes {
+		names[name] = true
+	}
+	for name := range otherNodes {
+		names[name] = true
+	}
+	for _, name := range sortedKeys(names) {
+		fields, err := fieldDiffs(nodes[name], otherNodes[name])
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) > 0 {
+			res.Nodes = append(res.Nodes, &workflowpkg.NodeDiff{Name: name, Fields: fields})
+		}
+	}
+	return res, nil
+}
+
+func fieldDiffs(a, b interface{}) ([]*workflowpkg.FieldDiff, error) {
+	changes, err := diff.Changes(a, b)
+	if err != nil {
+		return nil, err
+	}
+	var fields []*workflowpkg.FieldDiff
+	for _, c := range changes {
+		fields = append(fields, &workflowpkg.FieldDiff{Path: c.Path, Value: c.Old, OtherValue: c.New})
+	}
+	return fields, nil
+}
+
+// resolvedArguments returns the arguments, including those from any workflowTemplateRef, e.g. "parameters.message"
+func resolvedArguments(wf *wfv1.Workflow) map[string]string {
+	spec := wf.Spec
+	if wf.Status.StoredWorkflowSpec != nil {
+		spec = *wf.Status.StoredWorkflowSpec
+	}
+	arguments := make(map[string]string)
+	for _, p := range spec.Arguments.Parameters {
+		switch {
+		case p.Value != nil:
+			arguments["parameters."+p.Name] = p.Value.String()
+		case p.Default != nil:
+			arguments["parameters."+p.Name] = p.Default.String()
+		default:
+			arguments["parameters."+p.Name] = ""
+		}
+	}
+	for _, a := range spec.Arguments.Artifacts {
+		key, _ := a.GetKey()
+		arguments["artifacts."+a.Name] = key
+	}
+	return arguments
+}
+
+// templatesByName returns the workflow's templates, and those stored from referenced WorkflowTemplates by their key
+func templatesByName(wf *wfv1.Workflow) map[string]*wfv1.Template {
+	templates := make(map[string]*wfv1.Template)
+	if wf.Status.StoredWorkflowSpec != nil {
+		for i, t := range wf.Status.StoredWorkflowSpec.Templates {
+			templates[t.Name] = &wf.Status.StoredWorkflowSpec.Templates[i]
+		}
+	}
+	for i, t := range wf.Spec.Templates {
+		templates[t.Name] = &wf.Spec.Templates[i]
+	}
+	for key := range wf.Status.StoredTemplates {
+		t := wf.Status.StoredTemplates[key]
+		templates[key] = &t
+	}
+	return templates
+}
+
+// nodesByName returns a summary of the nodes, by their name without the workflow name prefix
+func nodesByName(wf *wfv1.Workflow) map[string]*nodeSummary {
+	nodes := make(map[string]*nodeSummary)
+	for _, n := range wf.Status.Nodes {
+		s := &nodeSummary{Phase: n.Phase}
+		if !n.StartedAt.IsZero() && !n.FinishedAt.IsZero() {
+			s.Duration = n.FinishedAt.Sub(n.StartedAt.Time).Round(time.Second).String()
+		}
+		if n.Outputs != nil {
+			if n.Outputs.ExitCode != nil {
+				s.ExitCode = *n.Outputs.ExitCode
+			}
+			for _, p := range n.Outputs.Parameters {
+				if s.Parameters == nil {
+					s.Parameters = make(map[string]string)
+				}
+				if p.Value != nil {
+					s.Parameters[p.Name] = p.Value.String()
+				} else {
+					s.Parameters[p.Name] = ""
+				}
+			}
+			for _, a := range n.Outputs.Artifacts {
+				if s.Artifacts == nil {
+					s.Artifacts = make(map[string]string)
+				}
+				key, _ := a.GetKey()
+				s.Artifacts[a.Name] = key
+			}
+		}
+		nodes[strings.TrimPrefix(strings.TrimPrefix(n.Name, wf.Name), ".")] = s
+	}
+	return nodes
+}
+
+func sortedKeys(keys map[string]bool) []string {
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,61 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_diffWorkflows(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
+		Spec: wfv1.WorkflowSpec{
+			Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}}},
+		},
+		Status: wfv1.WorkflowStatus{
+			StoredTemplates: map[string]wfv1.Template{"namespaced/my-wftmpl/main": {Name: "main", Container: &corev1.Container{Image: "my-image:v1"}}},
+			Nodes: wfv1.Nodes{
+				"my-wf-1": {Name: "my-wf.step", Phase: wfv1.NodeSucceeded, Outputs: &wfv1.Outputs{
+					ExitCode:   pointerTo("0"),
+					Parameters: []wfv1.Parameter{{Name: "result", Value: wfv1.AnyStringPtr("1")}},
+					Artifacts:  []wfv1.Artifact{{Name: "out", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "my-wf/out.tgz"}}}},
+				}},
+			},
+		},
+	}
+	other := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-wf"},
+		Status: wfv1.WorkflowStatus{
+			StoredWorkflowSpec: &wfv1.WorkflowSpec{
+				Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("goodbye")}}},
+			},
+			StoredTemplates: map[string]wfv1.Template{"namespaced/my-wftmpl/main": {Name: "main", Container: &corev1.Container{Image: "my-image:v2"}}},
+			Nodes: wfv1.Nodes{
+				"other-wf-1": {Name: "other-wf.step", Phase: wfv1.NodeFailed, Outputs: &wfv1.Outputs{
+					ExitCode:  pointerTo("1"),
+					Artifacts: []wfv1.Artifact{{Name: "out", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "other-wf/out.tgz"}}}},
+				}},
+			},
+		},
+	}
+	diff, err := diffWorkflows(wf, other)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []*workflowpkg.FieldDiff{{Path: "parameters.message", Value: "hello", OtherValue: "goodbye"}}, diff.Arguments)
+		assert.Equal(t, []*workflowpkg.TemplateDiff{{Name: "namespaced/my-wftmpl/main", Fields: []*workflowpkg.FieldDiff{{Path: "container.image", Value: "my-image:v1", OtherValue: "my-image:v2"}}}}, diff.Templates)
+		assert.Equal(t, []*workflowpkg.NodeDiff{{Name: "step", Fields: []*workflowpkg.FieldDiff{
+			{Path: "artifacts.out", Value: "my-wf/out.tgz", OtherValue: "other-wf/out.tgz"},
+			{Path: "exitCode", Value: "0", OtherValue: "1"},
+			{Path: "parameters", Value: `{"result":"1"}`},
+			{Path: "phase", Value: "Succeeded", OtherValue: "Failed"},
+		}}}, diff.Nodes)
+	}
+}
+
+func pointerTo(s string) *string {
+	return &s
+}
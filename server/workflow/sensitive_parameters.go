@@ -0,0 +1,131 @@
+package workflow
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// SensitiveParametersUnaryServerInterceptor masks the values of the sensitive parameters of the workflows, workflow
+// templates, cluster workflow templates and cron workflows in responses
+func SensitiveParametersUnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return maskSensitiveParameters(resp), nil
+}
+
+// SensitiveParametersStreamServerInterceptor masks the values of the sensitive parameters of the workflows in streamed messages
+func SensitiveParametersStreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, sensitiveParametersServerStream{ss})
+}
+
+type sensitiveParametersServerStream struct {
+	grpc.ServerStream
+}
+
+func (s sensitiveParametersServerStream) SendMsg(m interface{}) error {
+	return s.ServerStream.SendMsg(maskSensitiveParameters(m))
+}
+
+func maskSensitiveParameters(m interface{}) interface{} {
+	switch x := m.(type) {
+	case *wfv1.Workflow:
+		return maskWorkflow(x)
+	case *wfv1.WorkflowList:
+		if x == nil {
+			return x
+		}
+		y := *x
+		y.Items = make(wfv1.Workflows, len(x.Items))
+		for i := range x.Items {
+			y.Items[i] = *maskWorkflow(&x.Items[i])
+		}
+		return &y
+	case *workflowpkg.WorkflowWatchEvent:
+		if x == nil || x.Object == nil {
+			return x
+		}
+		y := *x
+		y.Object = maskWorkflow(x.Object)
+		return &y
+	case *wfv1.WorkflowTemplate:
+		if x == nil || !hasSensitiveArguments(x.Spec.Arguments) {
+			return x
+		}
+		y := x.DeepCopy()
+		y.Spec.Arguments.MaskSensitiveParameters()
+		return y
+	case *wfv1.WorkflowTemplateList:
+		if x == nil {
+			return x
+		}
+		y := *x
+		y.Items = make(wfv1.WorkflowTemplates, len(x.Items))
+		for i := range x.Items {
+			y.Items[i] = *maskSensitiveParameters(&x.Items[i]).(*wfv1.WorkflowTemplate)
+		}
+		return &y
+	case *wfv1.ClusterWorkflowTemplate:
+		if x == nil || !hasSensitiveArguments(x.Spec.Arguments) {
+			return x
+		}
+		y := x.DeepCopy()
+		y.Spec.Arguments.MaskSensitiveParameters()
+		return y
+	case *wfv1.ClusterWorkflowTemplateList:
+		if x == nil {
+			return x
+		}
+		y := *x
+		y.Items = make(wfv1.ClusterWorkflowTemplates, len(x.Items))
+		for i := range x.Items {
+			y.Items[i] = *maskSensitiveParameters(&x.Items[i]).(*wfv1.ClusterWorkflowTemplate)
+		}
+		return &y
+	case *wfv1.CronWorkflow:
+		if x == nil || !hasSensitiveArguments(x.Spec.WorkflowSpec.Arguments) {
+			return x
+		}
+		y := x.DeepCopy()
+		y.Spec.WorkflowSpec.Arguments.MaskSensitiveParameters()
+		return y
+	case *wfv1.CronWorkflowList:
+		if x == nil {
+			return x
+		}
+		y := *x
+		y.Items = make([]wfv1.CronWorkflow, len(x.Items))
+		for i := range x.Items {
+			y.Items[i] = *maskSensitiveParameters(&x.Items[i]).(*wfv1.CronWorkflow)
+		}
+		return &y
+	}
+	return m
+}
+
+// maskWorkflow returns a copy of the workflow with the values of its sensitive parameters masked, so the original, which
+// may be cached, is not changed
+func maskWorkflow(wf *wfv1.Workflow) *wfv1.Workflow {
+	if wf == nil || !hasSensitiveParameters(wf) {
+		return wf
+	}
+	wf = wf.DeepCopy()
+	wf.MaskSensitiveParameters()
+	return wf
+}
+
+func hasSensitiveParameters(wf *wfv1.Workflow) bool {
+	if hasSensitiveArguments(wf.Spec.Arguments) {
+		return true
+	}
+	return wf.Status.StoredWorkflowSpec != nil && hasSensitiveArguments(wf.Status.StoredWorkflowSpec.Arguments)
+}
+
+func hasSensitiveArguments(args wfv1.Arguments) bool {
+	return len(args.GetSensitiveParameterNames()) > 0
+}
@@ -0,0 +1,96 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type sentServerStream struct {
+	testServerStream
+	sent []interface{}
+}
+
+func (s *sentServerStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestSensitiveParametersServerInterceptors(t *testing.T) {
+	wf := &wfv1.Workflow{Spec: wfv1.WorkflowSpec{Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{
+		{Name: "password", Value: wfv1.AnyStringPtr("s3cret"), Sensitive: true},
+	}}}}
+	masked := func(wf *wfv1.Workflow) string {
+		return wf.Spec.Arguments.GetParameterByName("password").Value.String()
+	}
+	t.Run("Unary", func(t *testing.T) {
+		for _, resp := range []interface{}{wf, &wfv1.WorkflowList{Items: wfv1.Workflows{*wf}}} {
+			x, err := SensitiveParametersUnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(context.Context, interface{}) (interface{}, error) {
+				return resp, nil
+			})
+			if assert.NoError(t, err) {
+				switch y := x.(type) {
+				case *wfv1.Workflow:
+					assert.Equal(t, wfv1.SensitiveParameterMask, masked(y))
+				case *wfv1.WorkflowList:
+					assert.Equal(t, wfv1.SensitiveParameterMask, masked(&y.Items[0]))
+				}
+			}
+		}
+		assert.Equal(t, "s3cret", masked(wf), "the original is not changed")
+	})
+	t.Run("Stream", func(t *testing.T) {
+		ss := &sentServerStream{}
+		err := SensitiveParametersStreamServerInterceptor(nil, ss, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+			return stream.SendMsg(&workflowpkg.WorkflowWatchEvent{Object: wf})
+		})
+		if assert.NoError(t, err) && assert.Len(t, ss.sent, 1) {
+			assert.Equal(t, wfv1.SensitiveParameterMask, masked(ss.sent[0].(*workflowpkg.WorkflowWatchEvent).Object))
+		}
+		assert.Equal(t, "s3cret", masked(wf), "the original is not changed")
+	})
+}
+
+func TestSensitiveParametersServerInterceptors_Templates(t *testing.T) {
+	args := wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "slack-hook", Value: wfv1.AnyStringPtr("my-value"), Default: wfv1.AnyStringPtr("my-default"), Sensitive: true}}}
+	wftmpl := &wfv1.WorkflowTemplate{Spec: wfv1.WorkflowTemplateSpec{WorkflowSpec: wfv1.WorkflowSpec{Arguments: args}}}
+	cwftmpl := &wfv1.ClusterWorkflowTemplate{Spec: wfv1.WorkflowTemplateSpec{WorkflowSpec: wfv1.WorkflowSpec{Arguments: args}}}
+	cronWf := &wfv1.CronWorkflow{Spec: wfv1.CronWorkflowSpec{WorkflowSpec: wfv1.WorkflowSpec{Arguments: args}}}
+	masked := func(args wfv1.Arguments) {
+		param := args.GetParameterByName("slack-hook")
+		assert.Equal(t, wfv1.SensitiveParameterMask, param.Value.String())
+		assert.Equal(t, wfv1.SensitiveParameterMask, param.Default.String())
+	}
+	for _, resp := range []interface{}{
+		wftmpl, &wfv1.WorkflowTemplateList{Items: wfv1.WorkflowTemplates{*wftmpl}},
+		cwftmpl, &wfv1.ClusterWorkflowTemplateList{Items: wfv1.ClusterWorkflowTemplates{*cwftmpl}},
+		cronWf, &wfv1.CronWorkflowList{Items: []wfv1.CronWorkflow{*cronWf}},
+	} {
+		x, err := SensitiveParametersUnaryServerInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(context.Context, interface{}) (interface{}, error) {
+			return resp, nil
+		})
+		if assert.NoError(t, err) {
+			switch y := x.(type) {
+			case *wfv1.WorkflowTemplate:
+				masked(y.Spec.Arguments)
+			case *wfv1.WorkflowTemplateList:
+				masked(y.Items[0].Spec.Arguments)
+			case *wfv1.ClusterWorkflowTemplate:
+				masked(y.Spec.Arguments)
+			case *wfv1.ClusterWorkflowTemplateList:
+				masked(y.Items[0].Spec.Arguments)
+			case *wfv1.CronWorkflow:
+				masked(y.Spec.WorkflowSpec.Arguments)
+			case *wfv1.CronWorkflowList:
+				masked(y.Items[0].Spec.WorkflowSpec.Arguments)
+			}
+		}
+	}
+	assert.Equal(t, "my-value", wftmpl.Spec.Arguments.GetParameterByName("slack-hook").Value.String(), "the original is not changed")
+	assert.Equal(t, "my-value", cronWf.Spec.WorkflowSpec.Arguments.GetParameterByName("slack-hook").Value.String(), "the original is not changed")
+}
@@ -4,11 +4,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
 	"sort"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,11 +24,15 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-workflows/v3/server/artifacts"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/policy"
 	argoutil "github.com/argoproj/argo-workflows/v3/util"
 	"github.com/argoproj/argo-workflows/v3/util/fields"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/util/logs"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
@@ -36,13 +45,18 @@ type workflowServer struct {
 	instanceIDService     instanceid.Service
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
+	wfArchive             sqldb.WorkflowArchive
+	templatePolicy        *policy.Policy
+	artifactRepositories  artifactrepositories.Interface
+	artDriverFactory      artifact.NewDriverFunc
 }
 
 const latestAlias = "@latest"
 
-// NewWorkflowServer returns a new workflowServer
-func NewWorkflowServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo) workflowpkg.WorkflowServiceServer {
-	return &workflowServer{instanceIDService, offloadNodeStatusRepo, hydrator.New(offloadNodeStatusRepo)}
+// NewWorkflowServer returns a new workflowServer. The artifact repositories are used to serve the logs saved as
+// artifacts once the pods have been deleted, if they are nil only the logs of existing pods are served.
+func NewWorkflowServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, templatePolicy *policy.Policy, artifactRepositories artifactrepositories.Interface) workflowpkg.WorkflowServiceServer {
+	return &workflowServer{instanceIDService, offloadNodeStatusRepo, hydrator.New(offloadNodeStatusRepo), wfArchive, templatePolicy, artifactRepositories, artifact.NewDriver}
 }
 
 func (s *workflowServer) CreateWorkflow(ctx context.Context, req *workflowpkg.WorkflowCreateRequest) (*wfv1.Workflow, error) {
@@ -59,10 +73,15 @@ func (s *workflowServer) CreateWorkflow(ctx context.Context, req *workflowpkg.Wo
 	s.instanceIDService.Label(req.Workflow)
 	creator.Label(ctx, req.Workflow)
 
+	err := s.templatePolicy.AuthorizeSubmit(ctx, req.Namespace, req.Workflow)
+	if err != nil {
+		return nil, err
+	}
+
 	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace))
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates())
 
-	_, err := validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, req.Workflow, validate.ValidateOpts{})
+	_, err = validate.ValidateWorkflow(wftmplGetter, cwftmplGetter, req.Workflow, validate.ValidateOpts{})
 	if err != nil {
 		return nil, err
 	}
@@ -320,6 +339,11 @@ func (s *workflowServer) RetryWorkflow(ctx context.Context, req *workflowpkg.Wor
 		return nil, err
 	}
 
+	err = s.templatePolicy.Authorize(ctx, policy.Submit, wf.Namespace, wf, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	wf, err = util.RetryWorkflow(ctx, kubeClient, s.hydrator, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), wf.Name, req.RestartSuccessful, req.NodeFieldSelector)
 	if err != nil {
 		return nil, err
@@ -339,6 +363,11 @@ func (s *workflowServer) ResubmitWorkflow(ctx context.Context, req *workflowpkg.
 		return nil, err
 	}
 
+	err = s.templatePolicy.Authorize(ctx, policy.Submit, wf.Namespace, wf, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	newWF, err := util.FormulateResubmitWorkflow(wf, req.Memoized)
 	if err != nil {
 		return nil, err
@@ -363,6 +392,11 @@ func (s *workflowServer) ResumeWorkflow(ctx context.Context, req *workflowpkg.Wo
 		return nil, err
 	}
 
+	err = s.templatePolicy.Authorize(ctx, policy.Resume, wf.Namespace, wf, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	err = util.ResumeWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), s.hydrator, wf.Name, req.NodeFieldSelector)
 	if err != nil {
 		log.Warnf("Failed to resume %s: %+v", wf.Name, err)
@@ -390,6 +424,11 @@ func (s *workflowServer) SuspendWorkflow(ctx context.Context, req *workflowpkg.W
 		return nil, err
 	}
 
+	err = s.templatePolicy.Authorize(ctx, policy.Suspend, wf.Namespace, wf, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	err = util.SuspendWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace), wf.Name)
 	if err != nil {
 		return nil, err
@@ -416,6 +455,11 @@ func (s *workflowServer) TerminateWorkflow(ctx context.Context, req *workflowpkg
 		return nil, err
 	}
 
+	err = s.templatePolicy.Authorize(ctx, policy.Terminate, wf.Namespace, wf, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	err = util.TerminateWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), wf.Name)
 	if err != nil {
 		return nil, err
@@ -438,6 +482,11 @@ func (s *workflowServer) StopWorkflow(ctx context.Context, req *workflowpkg.Work
 	if err != nil {
 		return nil, err
 	}
+
+	err = s.templatePolicy.Authorize(ctx, policy.Stop, wf.Namespace, wf, nil)
+	if err != nil {
+		return nil, err
+	}
 	err = util.StopWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), s.hydrator, wf.Name, req.NodeFieldSelector, req.Message)
 	if err != nil {
 		return nil, err
@@ -483,6 +532,11 @@ func (s *workflowServer) SetWorkflow(ctx context.Context, req *workflowpkg.Workf
 		OutputParameters: outputParams,
 	}
 
+	// setting a node, e.g. approving a suspend node, resumes the workflow
+	err = s.templatePolicy.Authorize(ctx, policy.Resume, wf.Namespace, wf, nil)
+	if err != nil {
+		return nil, err
+	}
 	err = util.SetWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(req.Namespace), s.hydrator, wf.Name, req.NodeFieldSelector, operation)
 	if err != nil {
 		return nil, err
@@ -514,11 +568,7 @@ func (s *workflowServer) PodLogs(req *workflowpkg.WorkflowLogRequest, ws workflo
 	ctx := ws.Context()
 	wfClient := auth.GetWfClient(ctx)
 	kubeClient := auth.GetKubeClient(ctx)
-	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
-	if err != nil {
-		return err
-	}
-	err = s.validateWorkflow(wf)
+	wf, archived, err := s.getLiveOrArchivedWorkflow(ctx, req.Namespace, req.Name)
 	if err != nil {
 		return err
 	}
@@ -530,9 +580,58 @@ func (s *workflowServer) PodLogs(req *workflowpkg.WorkflowLogRequest, ws workflo
 		return err
 	}
 
+	if s.artifactRepositories != nil {
+		// the logs of pods that no longer exist are served from the artifacts they were saved as
+		livePods := map[string]bool{}
+		if !archived {
+			list, err := kubeClient.CoreV1().Pods(req.Namespace).List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeyWorkflow + "=" + wf.Name})
+			if err != nil {
+				return err
+			}
+			for _, pod := range list.Items {
+				livePods[pod.Name] = true
+			}
+		}
+		err = logs.ArtifactLogs(ctx, wf, req, ws, s.openArtifact, func(podName string) bool { return livePods[podName] })
+		if err != nil {
+			return err
+		}
+	}
+	if archived {
+		return nil
+	}
 	return logs.WorkflowLogs(ctx, wfClient, kubeClient, req, ws)
 }
 
+// openArtifact opens the output artifact of the workflow's node, it is loaded to a temporary file that is deleted once
+// it is closed
+func (s *workflowServer) openArtifact(ctx context.Context, wf *wfv1.Workflow, _ string, art *wfv1.Artifact) (io.ReadCloser, error) {
+	tmp, err := ioutil.TempFile("", "artifact")
+	if err != nil {
+		return nil, err
+	}
+	_ = tmp.Close()
+	err = artifacts.LoadArtifact(ctx, s.artifactRepositories, s.artDriverFactory, wf, art, tmp.Name())
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return nil, err
+	}
+	file, err := os.Open(tmp.Name())
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return nil, err
+	}
+	return &tempFile{file}, nil
+}
+
+// tempFile is a file that is deleted when it is closed
+type tempFile struct{ *os.File }
+
+func (f *tempFile) Close() error {
+	defer func() { _ = os.Remove(f.Name()) }()
+	return f.File.Close()
+}
+
 func (s *workflowServer) WorkflowLogs(req *workflowpkg.WorkflowLogRequest, ws workflowpkg.WorkflowService_WorkflowLogsServer) error {
 	return s.PodLogs(req, ws)
 }
@@ -553,6 +652,37 @@ func (s *workflowServer) getWorkflow(ctx context.Context, wfClient versioned.Int
 	return wf, nil
 }
 
+// getLiveOrArchivedWorkflow returns the hydrated workflow with the name, or if there is none, the archived workflow with
+// the name as its UID, and whether it is archived
+func (s *workflowServer) getLiveOrArchivedWorkflow(ctx context.Context, namespace string, name string) (*wfv1.Workflow, bool, error) {
+	wf, err := s.getWorkflow(ctx, auth.GetWfClient(ctx), namespace, name, metav1.GetOptions{})
+	if err == nil {
+		err = s.validateWorkflow(wf)
+		if err != nil {
+			return nil, false, err
+		}
+		return wf, false, s.hydrator.Hydrate(wf)
+	}
+	if !apierr.IsNotFound(err) || !s.wfArchive.IsEnabled() {
+		return nil, false, err
+	}
+	archived, archiveErr := s.wfArchive.GetWorkflow(name)
+	if archiveErr != nil {
+		return nil, false, archiveErr
+	}
+	if archived == nil || archived.Namespace != namespace {
+		return nil, false, err
+	}
+	allowed, err := auth.CanI(ctx, "get", workflow.WorkflowPlural, archived.Namespace, archived.Name)
+	if err != nil {
+		return nil, false, err
+	}
+	if !allowed {
+		return nil, false, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return archived, true, s.hydrator.Hydrate(archived)
+}
+
 func (s *workflowServer) validateWorkflow(wf *wfv1.Workflow) error {
 	return s.instanceIDService.Validate(wf)
 }
@@ -600,9 +730,15 @@ func (s *workflowServer) SubmitWorkflow(ctx context.Context, req *workflowpkg.Wo
 		return nil, errors.Errorf(errors.CodeBadRequest, "Resource kind '%s' is not supported for submitting", req.ResourceKind)
 	}
 
+	// authorize before the options are applied, so the policy checks the options rather than the workflow they produce
+	err := s.templatePolicy.AuthorizeSubmitOpts(ctx, req.Namespace, wf, req.SubmitOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	s.instanceIDService.Label(wf)
 	creator.Label(ctx, wf)
-	err := util.ApplySubmitOpts(wf, req.SubmitOptions)
+	err = util.ApplySubmitOpts(wf, req.SubmitOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -616,3 +752,95 @@ func (s *workflowServer) SubmitWorkflow(ctx context.Context, req *workflowpkg.Wo
 	}
 	return wfClient.ArgoprojV1alpha1().Workflows(req.Namespace).Create(ctx, wf, metav1.CreateOptions{})
 }
+
+func (s *workflowServer) DiffWorkflows(ctx context.Context, req *workflowpkg.WorkflowDiffRequest) (*workflowpkg.WorkflowDiffResponse, error) {
+	if req.Name == "" || req.OtherName == "" {
+		return nil, status.Error(codes.InvalidArgument, "name and otherName are required")
+	}
+	wf, _, err := s.getLiveOrArchivedWorkflow(ctx, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	other, _, err := s.getLiveOrArchivedWorkflow(ctx, req.Namespace, req.OtherName)
+	if err != nil {
+		return nil, err
+	}
+	return diffWorkflows(wf, other)
+}
+
+// bulkActions are the actions BulkWorkflowAction supports
+var bulkActions = map[string]bool{"delete": true, "stop": true, "terminate": true, "suspend": true, "resume": true, "retry": true, "resubmit": true}
+
+func (s *workflowServer) BulkWorkflowAction(ctx context.Context, req *workflowpkg.WorkflowBulkActionRequest) (*workflowpkg.WorkflowBulkActionResponse, error) {
+	if !bulkActions[req.Action] {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported action %q", req.Action)
+	}
+	listOptions := &metav1.ListOptions{}
+	if req.ListOptions != nil {
+		listOptions = req.ListOptions.DeepCopy()
+	}
+	if listOptions.LabelSelector == "" && listOptions.FieldSelector == "" {
+		return nil, status.Error(codes.InvalidArgument, "a label or field selector is required")
+	}
+	s.instanceIDService.With(listOptions)
+	wfList, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows(req.Namespace).List(ctx, *listOptions)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(wfList.Items, func(i, j int) bool {
+		a, b := wfList.Items[i], wfList.Items[j]
+		return a.Namespace < b.Namespace || a.Namespace == b.Namespace && a.Name < b.Name
+	})
+	results := make([]*workflowpkg.WorkflowBulkActionResult, len(wfList.Items))
+	concurrency := int(req.Concurrency)
+	if concurrency <= 0 {
+		concurrency = 10
+	}
+	sem := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+	for i, wf := range wfList.Items {
+		results[i] = &workflowpkg.WorkflowBulkActionResult{Namespace: wf.Namespace, Name: wf.Name}
+		if req.DryRun {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *workflowpkg.WorkflowBulkActionResult) {
+			defer func() { <-sem; wg.Done() }()
+			createdName, err := s.bulkAction(ctx, req, result.Namespace, result.Name)
+			result.CreatedName = createdName
+			if err != nil {
+				result.Error = err.Error()
+			}
+		}(results[i])
+	}
+	wg.Wait()
+	log.WithFields(log.Fields{"action": req.Action, "dryRun": req.DryRun, "workflows": len(results)}).Info("Bulk workflow action")
+	return &workflowpkg.WorkflowBulkActionResponse{Results: results}, nil
+}
+
+// bulkAction applies the action to a single workflow, returning the name of any workflow created
+func (s *workflowServer) bulkAction(ctx context.Context, req *workflowpkg.WorkflowBulkActionRequest, namespace, name string) (string, error) {
+	var err error
+	switch req.Action {
+	case "delete":
+		_, err = s.DeleteWorkflow(ctx, &workflowpkg.WorkflowDeleteRequest{Namespace: namespace, Name: name})
+	case "stop":
+		_, err = s.StopWorkflow(ctx, &workflowpkg.WorkflowStopRequest{Namespace: namespace, Name: name, NodeFieldSelector: req.NodeFieldSelector, Message: req.Message})
+	case "terminate":
+		_, err = s.TerminateWorkflow(ctx, &workflowpkg.WorkflowTerminateRequest{Namespace: namespace, Name: name})
+	case "suspend":
+		_, err = s.SuspendWorkflow(ctx, &workflowpkg.WorkflowSuspendRequest{Namespace: namespace, Name: name})
+	case "resume":
+		_, err = s.ResumeWorkflow(ctx, &workflowpkg.WorkflowResumeRequest{Namespace: namespace, Name: name, NodeFieldSelector: req.NodeFieldSelector})
+	case "retry":
+		_, err = s.RetryWorkflow(ctx, &workflowpkg.WorkflowRetryRequest{Namespace: namespace, Name: name, RestartSuccessful: req.RestartSuccessful, NodeFieldSelector: req.NodeFieldSelector})
+	case "resubmit":
+		created, err := s.ResubmitWorkflow(ctx, &workflowpkg.WorkflowResubmitRequest{Namespace: namespace, Name: name, Memoized: req.Memoized})
+		if err != nil {
+			return "", err
+		}
+		return created.Name, nil
+	}
+	return "", err
+}
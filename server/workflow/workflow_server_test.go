@@ -8,7 +8,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/square/go-jose.v2/jwt"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -23,6 +26,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	v1alpha "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/policy"
 	"github.com/argoproj/argo-workflows/v3/server/auth/types"
 	testutil "github.com/argoproj/argo-workflows/v3/test/util"
 	"github.com/argoproj/argo-workflows/v3/util"
@@ -589,8 +593,18 @@ func getWorkflowServer() (workflowpkg.WorkflowServiceServer, context.Context) {
 	offloadNodeStatusRepo := &mocks.OffloadNodeStatusRepo{}
 	offloadNodeStatusRepo.On("IsEnabled", mock.Anything).Return(true)
 	offloadNodeStatusRepo.On("List", mock.Anything).Return(map[sqldb.UUIDVersion]v1alpha1.Nodes{}, nil)
-	server := NewWorkflowServer(instanceid.NewService("my-instanceid"), offloadNodeStatusRepo)
+	archivedWfObj := wfObj1.DeepCopy()
+	archivedWfObj.Spec.Templates[0].Container.Image = "docker/whalesay:v2"
+	archivedWfObj.Status.Nodes["hello-world-9tql2"] = v1alpha1.NodeStatus{Name: "hello-world-9tql2", Phase: v1alpha1.NodeFailed}
+	wfArchive := &mocks.WorkflowArchive{}
+	wfArchive.On("IsEnabled").Return(true)
+	wfArchive.On("GetWorkflow", "my-archived-uid").Return(archivedWfObj, nil)
+	wfArchive.On("GetWorkflow", mock.Anything).Return(nil, nil)
+	server := NewWorkflowServer(instanceid.NewService("my-instanceid"), offloadNodeStatusRepo, wfArchive, nil, nil)
 	kubeClientSet := fake.NewSimpleClientset()
+	kubeClientSet.PrependReactor("create", "selfsubjectaccessreviews", func(action ktesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+	})
 	wfClientset := v1alpha.NewSimpleClientset(&unlabelledObj, &wfObj1, &wfObj2, &wfObj3, &wfObj4, &wfObj5, &failedWfObj, &wftmpl, &cronwfObj, &cwfTmpl)
 	wfClientset.PrependReactor("create", "workflows", generateNameReactor)
 	ctx := context.WithValue(context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClientset), auth.KubeKey, kubeClientSet), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
@@ -774,6 +788,20 @@ func TestSuspendResumeWorkflow(t *testing.T) {
 	}
 }
 
+func TestResumeAndSetWorkflowWithTemplatePolicy(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	p, err := policy.New(&policy.Config{Rules: []policy.Rule{{Groups: []string{"analysts"}, Actions: []policy.Action{policy.Submit}, WorkflowTemplates: []string{"*"}}}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	server.(*workflowServer).templatePolicy = p
+	ctx = context.WithValue(ctx, auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}, Groups: []string{"analysts"}})
+	_, err = server.ResumeWorkflow(ctx, &workflowpkg.WorkflowResumeRequest{Name: "hello-world-9tql2-run", Namespace: "workflows"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = server.SetWorkflow(ctx, &workflowpkg.WorkflowSetRequest{Name: "hello-world-9tql2-run", Namespace: "workflows", Phase: "Succeeded"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestSuspendResumeWorkflowWithNotFound(t *testing.T) {
 	server, ctx := getWorkflowServer()
 
@@ -925,3 +953,84 @@ func TestSubmitWorkflowFromResource(t *testing.T) {
 		}
 	})
 }
+
+func TestDiffWorkflows(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := server.DiffWorkflows(ctx, &workflowpkg.WorkflowDiffRequest{Namespace: "workflows", Name: "hello-world-9tql2"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := server.DiffWorkflows(ctx, &workflowpkg.WorkflowDiffRequest{Namespace: "workflows", Name: "hello-world-9tql2", OtherName: "not-found"})
+		assert.Error(t, err)
+	})
+	t.Run("Live", func(t *testing.T) {
+		diff, err := server.DiffWorkflows(ctx, &workflowpkg.WorkflowDiffRequest{Namespace: "workflows", Name: "hello-world-9tql2", OtherName: "hello-world-b6h5m"})
+		if assert.NoError(t, err) {
+			assert.Empty(t, diff.Arguments)
+			assert.Empty(t, diff.Templates)
+			assert.Equal(t, []*workflowpkg.NodeDiff{{Fields: []*workflowpkg.FieldDiff{{Path: "duration", Value: "7s", OtherValue: "3s"}}}}, diff.Nodes)
+		}
+	})
+	t.Run("Archived", func(t *testing.T) {
+		diff, err := server.DiffWorkflows(ctx, &workflowpkg.WorkflowDiffRequest{Namespace: "workflows", Name: "hello-world-9tql2", OtherName: "my-archived-uid"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []*workflowpkg.TemplateDiff{{Name: "whalesay", Fields: []*workflowpkg.FieldDiff{{Path: "container.image", Value: "docker/whalesay:latest", OtherValue: "docker/whalesay:v2"}}}}, diff.Templates)
+			assert.Equal(t, []*workflowpkg.NodeDiff{{Fields: []*workflowpkg.FieldDiff{
+				{Path: "duration", Value: "7s"},
+				{Path: "phase", Value: "Succeeded", OtherValue: "Failed"},
+			}}}, diff.Nodes)
+		}
+	})
+}
+
+func TestBulkWorkflowAction(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	succeeded := &metav1.ListOptions{LabelSelector: common.LabelKeyPhase + "=Succeeded"}
+	t.Run("UnsupportedAction", func(t *testing.T) {
+		_, err := server.BulkWorkflowAction(ctx, &workflowpkg.WorkflowBulkActionRequest{Namespace: "workflows", ListOptions: succeeded, Action: "explode"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("NoSelector", func(t *testing.T) {
+		_, err := server.BulkWorkflowAction(ctx, &workflowpkg.WorkflowBulkActionRequest{Namespace: "workflows", Action: "delete"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("DryRun", func(t *testing.T) {
+		res, err := server.BulkWorkflowAction(ctx, &workflowpkg.WorkflowBulkActionRequest{Namespace: "workflows", ListOptions: succeeded, Action: "delete", DryRun: true})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []*workflowpkg.WorkflowBulkActionResult{
+				{Namespace: "workflows", Name: "hello-world-9tql2"},
+				{Namespace: "workflows", Name: "hello-world-b6h5m"},
+			}, res.Results)
+		}
+		_, err = getWorkflow(ctx, server, "workflows", "hello-world-9tql2")
+		assert.NoError(t, err)
+	})
+	t.Run("Retry", func(t *testing.T) {
+		res, err := server.BulkWorkflowAction(ctx, &workflowpkg.WorkflowBulkActionRequest{Namespace: "workflows", ListOptions: succeeded, Action: "retry"})
+		if assert.NoError(t, err) && assert.Len(t, res.Results, 2) {
+			for _, r := range res.Results {
+				assert.NotEmpty(t, r.Error, "succeeded workflows cannot be retried")
+			}
+		}
+	})
+	t.Run("Resubmit", func(t *testing.T) {
+		res, err := server.BulkWorkflowAction(ctx, &workflowpkg.WorkflowBulkActionRequest{Namespace: "workflows", ListOptions: succeeded, Action: "resubmit", Concurrency: 1})
+		if assert.NoError(t, err) && assert.Len(t, res.Results, 2) {
+			for _, r := range res.Results {
+				assert.Empty(t, r.Error)
+				assert.NotEmpty(t, r.CreatedName)
+			}
+		}
+	})
+	t.Run("Delete", func(t *testing.T) {
+		res, err := server.BulkWorkflowAction(ctx, &workflowpkg.WorkflowBulkActionRequest{Namespace: "workflows", ListOptions: succeeded, Action: "delete"})
+		if assert.NoError(t, err) && assert.Len(t, res.Results, 2) {
+			for _, r := range res.Results {
+				assert.Empty(t, r.Error)
+				_, err := getWorkflow(ctx, server, r.Namespace, r.Name)
+				assert.Error(t, err)
+			}
+		}
+	})
+}
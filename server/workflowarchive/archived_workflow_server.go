@@ -8,25 +8,37 @@ import (
 	"strings"
 	"time"
 
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/argoproj/argo-workflows/v3/persist/coldstorage"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/server/auth/policy"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
+	"github.com/argoproj/argo-workflows/v3/workflow/packer"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
 type archivedWorkflowServer struct {
-	wfArchive sqldb.WorkflowArchive
+	wfArchive   sqldb.WorkflowArchive
+	hydrator    hydrator.Interface
+	coldStorage coldstorage.Interface
+	// nil if no template policy is configured
+	templatePolicy *policy.Policy
 }
 
-// NewWorkflowArchiveServer returns a new archivedWorkflowServer
-func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive) workflowarchivepkg.ArchivedWorkflowServiceServer {
-	return &archivedWorkflowServer{wfArchive: wfArchive}
+// NewWorkflowArchiveServer returns a new archivedWorkflowServer, coldStorage is nil if archive export is not configured
+func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive, hydrator hydrator.Interface, coldStorage coldstorage.Interface, templatePolicy *policy.Policy) workflowarchivepkg.ArchivedWorkflowServiceServer {
+	return &archivedWorkflowServer{wfArchive: wfArchive, hydrator: hydrator, coldStorage: coldStorage, templatePolicy: templatePolicy}
 }
 
 func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req *workflowarchivepkg.ListArchivedWorkflowsRequest) (*wfv1.WorkflowList, error) {
@@ -146,3 +158,159 @@ func (w *archivedWorkflowServer) DeleteArchivedWorkflow(ctx context.Context, req
 	}
 	return &workflowarchivepkg.ArchivedWorkflowDeletedResponse{}, nil
 }
+
+func (w *archivedWorkflowServer) ResubmitArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.ResubmitArchivedWorkflowRequest) (*wfv1.Workflow, error) {
+	wfClient := auth.GetWfClient(ctx)
+	wf, err := w.getHydratedArchivedWorkflow(ctx, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = wf.Namespace
+	}
+	err = w.templatePolicy.Authorize(ctx, policy.Submit, namespace, wf, nil)
+	if err != nil {
+		return nil, err
+	}
+	newWF, err := util.FormulateResubmitWorkflow(wf, req.Memoized)
+	if err != nil {
+		return nil, err
+	}
+	return util.SubmitWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(namespace), wfClient, namespace, newWF, &wfv1.SubmitOpts{})
+}
+
+func (w *archivedWorkflowServer) RetryArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.RetryArchivedWorkflowRequest) (*wfv1.Workflow, error) {
+	wfClient := auth.GetWfClient(ctx)
+	kubeClient := auth.GetKubeClient(ctx)
+	wf, err := w.getHydratedArchivedWorkflow(ctx, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = wf.Namespace
+	}
+	err = w.templatePolicy.Authorize(ctx, policy.Submit, namespace, wf, nil)
+	if err != nil {
+		return nil, err
+	}
+	wfIf := wfClient.ArgoprojV1alpha1().Workflows(namespace)
+	_, err = wfIf.Get(ctx, wf.Name, metav1.GetOptions{})
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "workflow %s still exists, use `argo retry %s` instead", wf.Name, wf.Name)
+	}
+	if !apierr.IsNotFound(err) {
+		return nil, err
+	}
+	// the values of sensitive parameters are masked when the workflow is archived, and the secret that delivered them
+	// to pods was deleted with the workflow, so they cannot be delivered to the retried pods
+	if name := maskedSensitiveParameter(wf); name != "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "the value of sensitive parameter %q is not archived, resubmit the workflow with it instead", name)
+	}
+	newWF, podsToDelete, err := util.FormulateRetryWorkflow(wf, req.RestartSuccessful, req.NodeFieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	podIf := kubeClient.CoreV1().Pods(namespace)
+	for _, podName := range podsToDelete {
+		log.WithFields(log.Fields{"namespace": namespace, "podName": podName}).Info("Deleting pod")
+		err := podIf.Delete(ctx, podName, metav1.DeleteOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	// the workflow is re-created with its nodes hydrated, it only gets its UID when it is created, so the controller
+	// offloads its nodes under that UID when it next updates it, until then they are compressed if they are too large
+	newWF.Namespace = namespace
+	newWF.ResourceVersion = ""
+	newWF.UID = ""
+	err = packer.CompressWorkflowIfNeeded(newWF)
+	if err != nil {
+		return nil, err
+	}
+	return wfIf.Create(ctx, newWF, metav1.CreateOptions{})
+}
+
+// maskedSensitiveParameter returns the name of a sensitive parameter of the workflow, or its stored spec, whose value is
+// masked, or "" if there is none
+func maskedSensitiveParameter(wf *wfv1.Workflow) string {
+	params := append([]wfv1.Parameter{}, wf.Spec.Arguments.Parameters...)
+	if wf.Status.StoredWorkflowSpec != nil {
+		params = append(params, wf.Status.StoredWorkflowSpec.Arguments.Parameters...)
+	}
+	for _, param := range params {
+		if param.Sensitive && param.Value != nil && param.Value.String() == wfv1.SensitiveParameterMask {
+			return param.Name
+		}
+	}
+	return ""
+}
+
+func (w *archivedWorkflowServer) ImportArchivedWorkflows(ctx context.Context, req *workflowarchivepkg.ImportArchivedWorkflowsRequest) (*workflowarchivepkg.ImportArchivedWorkflowsResponse, error) {
+	if w.coldStorage == nil {
+		return nil, status.Error(codes.FailedPrecondition, "archive export is not configured")
+	}
+	keys := []string{req.Key}
+	if req.Key == "" {
+		if req.Date == "" {
+			return nil, status.Error(codes.InvalidArgument, "one of date or key must be specified")
+		}
+		var err error
+		keys, err = w.coldStorage.Keys(ctx, req.Date)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var wfs wfv1.Workflows
+	for _, key := range keys {
+		imported, err := w.coldStorage.Import(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		wfs = append(wfs, imported...)
+	}
+	// check every namespace before archiving anything, so a denied import does not leave a partial import behind
+	namespaces := make(map[string]bool)
+	for _, wf := range wfs {
+		if namespaces[wf.Namespace] {
+			continue
+		}
+		allowed, err := auth.CanI(ctx, "create", workflow.WorkflowPlural, wf.Namespace, "")
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+		namespaces[wf.Namespace] = true
+	}
+	resp := &workflowarchivepkg.ImportArchivedWorkflowsResponse{Keys: keys}
+	for _, wf := range wfs {
+		if wf.Labels == nil {
+			wf.Labels = map[string]string{}
+		}
+		wf.Labels[common.LabelKeyWorkflowImported] = "true"
+		// imported workflows have already expired, so they are excluded from archived workflow GC, and are kept until
+		// they are deleted
+		err := w.wfArchive.ImportWorkflow(&wf)
+		if err != nil {
+			return nil, err
+		}
+		resp.Uids = append(resp.Uids, string(wf.UID))
+	}
+	return resp, nil
+}
+
+// getHydratedArchivedWorkflow gets an archived workflow, restoring any compressed or offloaded node status
+func (w *archivedWorkflowServer) getHydratedArchivedWorkflow(ctx context.Context, uid string) (*wfv1.Workflow, error) {
+	wf, err := w.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: uid})
+	if err != nil {
+		return nil, err
+	}
+	err = w.hydrator.Hydrate(wf)
+	if err != nil {
+		return nil, err
+	}
+	return wf, nil
+}
@@ -2,36 +2,61 @@ package workflowarchive
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 
+	"github.com/argoproj/argo-workflows/v3/persist/coldstorage"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	hydratorfake "github.com/argoproj/argo-workflows/v3/workflow/hydrator/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/packer"
 )
 
+type fakeColdStorage struct {
+	coldstorage.Interface
+}
+
+func (fakeColdStorage) Keys(context.Context, string) ([]string, error) {
+	return []string{"my-key"}, nil
+}
+
+func (fakeColdStorage) Import(context.Context, string) (wfv1.Workflows, error) {
+	return wfv1.Workflows{
+		{ObjectMeta: metav1.ObjectMeta{Name: "my-name", Namespace: "my-ns", UID: "imported-uid"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "other-name", Namespace: "other-ns", UID: "other-imported-uid"}},
+	}, nil
+}
+
 func Test_archivedWorkflowServer(t *testing.T) {
 	repo := &mocks.WorkflowArchive{}
 	kubeClient := &kubefake.Clientset{}
 	wfClient := &argofake.Clientset{}
-	w := NewWorkflowArchiveServer(repo)
+	w := NewWorkflowArchiveServer(repo, hydratorfake.Noop, nil, nil)
 	allowed := true
+	// namespace that is denied even when allowed
+	deniedNamespace := "denied-ns"
 	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
 		return true, &authorizationv1.SelfSubjectAccessReview{
-			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed && review.Spec.ResourceAttributes.Namespace != deniedNamespace},
 		}, nil
 	})
 	kubeClient.AddReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
@@ -57,16 +82,51 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		Spec: wfv1.WorkflowSpec{
 			Entrypoint: "my-entrypoint",
 			Templates: []wfv1.Template{
-				{Name: "my-entrypoint", Container: &apiv1.Container{}},
+				{Name: "my-entrypoint", Container: &apiv1.Container{Image: "my-image"}},
+			},
+		},
+	}, nil)
+	repo.On("GetWorkflow", "failed-uid").Return(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "failed-name", Namespace: "my-ns"},
+		Spec: wfv1.WorkflowSpec{
+			Entrypoint: "my-entrypoint",
+			Templates: []wfv1.Template{
+				{Name: "my-entrypoint", Container: &apiv1.Container{Image: "my-image"}},
 			},
 		},
+		Status: wfv1.WorkflowStatus{
+			Phase: wfv1.WorkflowFailed,
+			Nodes: wfv1.Nodes{
+				"failed-name":    {ID: "failed-name", Type: wfv1.NodeTypePod, Phase: wfv1.NodeFailed},
+				"succeeded-name": {ID: "succeeded-name", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, Message: strings.Repeat("x", 1024)},
+			},
+		},
+	}, nil)
+	repo.On("GetWorkflow", "sensitive-uid").Return(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "failed-name", Namespace: "my-ns"},
+		Spec: wfv1.WorkflowSpec{
+			Arguments: wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "password", Value: wfv1.AnyStringPtr(wfv1.SensitiveParameterMask), Sensitive: true}}},
+		},
+		Status: wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed},
 	}, nil)
+	wfClient.AddReactor("get", "workflows", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		name := action.(k8stesting.GetAction).GetName()
+		if name == "failed-name" {
+			return true, nil, apierr.NewNotFound(wfv1.Resource("workflows"), name)
+		}
+		return true, &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+	})
+	var created *wfv1.Workflow
 	wfClient.AddReactor("create", "workflows", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		created = action.(k8stesting.CreateAction).GetObject().(*wfv1.Workflow)
 		return true, &wfv1.Workflow{
 			ObjectMeta: metav1.ObjectMeta{Name: "my-name-resubmitted"},
 		}, nil
 	})
 	repo.On("DeleteWorkflow", "my-uid").Return(nil)
+	repo.On("ImportWorkflow", mock.MatchedBy(func(wf *wfv1.Workflow) bool {
+		return wf.Labels[common.LabelKeyWorkflowImported] == "true"
+	})).Return(nil)
 
 	ctx := context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClient), auth.KubeKey, kubeClient)
 	t.Run("ListArchivedWorkflows", func(t *testing.T) {
@@ -109,4 +169,55 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		_, err = w.DeleteArchivedWorkflow(ctx, &workflowarchivepkg.DeleteArchivedWorkflowRequest{Uid: "my-uid"})
 		assert.NoError(t, err)
 	})
+	t.Run("ResubmitArchivedWorkflow", func(t *testing.T) {
+		allowed = false
+		_, err := w.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: "my-uid"})
+		assert.Equal(t, err, status.Error(codes.PermissionDenied, "permission denied"))
+		allowed = true
+		wf, err := w.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: "my-uid", Namespace: "my-ns"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-name-resubmitted", wf.Name)
+		}
+	})
+	t.Run("RetryArchivedWorkflow", func(t *testing.T) {
+		_, err := w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "my-uid", Namespace: "my-ns"})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		// the nodes must not be offloaded before the workflow is created, as it does not have a UID yet
+		w := NewWorkflowArchiveServer(repo, hydratorfake.Always, nil, nil)
+		_, err = w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "failed-uid"})
+		if assert.NoError(t, err) {
+			assert.Empty(t, created.UID)
+			assert.Empty(t, created.Status.OffloadNodeStatusVersion)
+			assert.NotEmpty(t, created.Status.Nodes)
+		}
+		t.Run("Large", func(t *testing.T) {
+			defer packer.SetMaxWorkflowSize(1024)()
+			_, err = w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "failed-uid"})
+			if assert.NoError(t, err) {
+				assert.Empty(t, created.Status.Nodes)
+				assert.NotEmpty(t, created.Status.CompressedNodes)
+			}
+		})
+		t.Run("SensitiveParameters", func(t *testing.T) {
+			_, err = w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "sensitive-uid"})
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+	})
+	t.Run("ImportArchivedWorkflows", func(t *testing.T) {
+		_, err := w.ImportArchivedWorkflows(ctx, &workflowarchivepkg.ImportArchivedWorkflowsRequest{Date: "2021-01-01"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		w := NewWorkflowArchiveServer(repo, hydratorfake.Noop, fakeColdStorage{}, nil)
+		_, err = w.ImportArchivedWorkflows(ctx, &workflowarchivepkg.ImportArchivedWorkflowsRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		deniedNamespace = "other-ns"
+		_, err = w.ImportArchivedWorkflows(ctx, &workflowarchivepkg.ImportArchivedWorkflowsRequest{Date: "2021-01-01"})
+		assert.Equal(t, err, status.Error(codes.PermissionDenied, "permission denied"))
+		repo.AssertNotCalled(t, "ImportWorkflow", mock.Anything)
+		deniedNamespace = "denied-ns"
+		resp, err := w.ImportArchivedWorkflows(ctx, &workflowarchivepkg.ImportArchivedWorkflowsRequest{Date: "2021-01-01"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"my-key"}, resp.Keys)
+			assert.Equal(t, []string{"imported-uid", "other-imported-uid"}, resp.Uids)
+		}
+	})
 }
@@ -168,7 +168,7 @@ func getWorkflowTemplateServer() (workflowtemplatepkg.WorkflowTemplateServiceSer
 	kubeClientSet := fake.NewSimpleClientset()
 	wfClientset := wftFake.NewSimpleClientset(&unlabelledObj, &wftObj1, &wftObj2)
 	ctx := context.WithValue(context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClientset), auth.KubeKey, kubeClientSet), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
-	return NewWorkflowTemplateServer(instanceid.NewService("my-instanceid")), ctx
+	return NewWorkflowTemplateServer(instanceid.NewService("my-instanceid"), nil), ctx
 }
 
 func TestWorkflowTemplateServer_CreateWorkflowTemplate(t *testing.T) {
@@ -258,6 +258,21 @@ func TestWorkflowTemplateServer_UpdateWorkflowTemplate(t *testing.T) {
 			assert.Equal(t, "alpine:latest", wftRsp.Spec.Templates[0].Container.Image)
 		}
 	})
+	t.Run("SensitiveParameters", func(t *testing.T) {
+		var wftObj1 v1alpha1.WorkflowTemplate
+		testutil.MustUnmarshallJSON(wftStr2, &wftObj1)
+		wftObj1.Spec.Arguments.Parameters = []v1alpha1.Parameter{{Name: "password", Value: v1alpha1.AnyStringPtr("s3cret"), Sensitive: true}}
+		_, err := server.UpdateWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateUpdateRequest{Namespace: "default", Template: &wftObj1})
+		if !assert.NoError(t, err) {
+			return
+		}
+		// as got from the API
+		wftObj1.Spec.Arguments.MaskSensitiveParameters()
+		wftRsp, err := server.UpdateWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateUpdateRequest{Namespace: "default", Template: &wftObj1})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "s3cret", wftRsp.Spec.Arguments.GetParameterByName("password").Value.String())
+		}
+	})
 	t.Run("Unlabelled", func(t *testing.T) {
 		_, err := server.UpdateWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateUpdateRequest{
 			Template: &v1alpha1.WorkflowTemplate{
@@ -12,7 +12,7 @@ import {NamespaceFilter} from '../../../shared/components/namespace-filter';
 import {Timestamp} from '../../../shared/components/timestamp';
 import {ZeroState} from '../../../shared/components/zero-state';
 import {Consumer} from '../../../shared/context';
-import {getNextScheduledTime} from '../../../shared/cron';
+import {getNextScheduledTime, getSchedules} from '../../../shared/cron';
 import {Footnote} from '../../../shared/footnote';
 import {services} from '../../../shared/services';
 import {Utils} from '../../../shared/utils';
@@ -138,15 +138,19 @@ export class CronWorkflowList extends BasePage<RouteComponentProps<any>, State>
                             <div className='columns small-1'>{w.spec.suspend ? <i className='fa fa-pause' /> : <i className='fa fa-clock' />}</div>
                             <div className='columns small-3'>{w.metadata.name}</div>
                             <div className='columns small-2'>{w.metadata.namespace}</div>
-                            <div className='columns small-1'>{w.spec.schedule}</div>
+                            <div className='columns small-1'>{getSchedules(w.spec).join(', ')}</div>
                             <div className='columns small-3'>
-                                <PrettySchedule schedule={w.spec.schedule} />
+                                {getSchedules(w.spec).map(schedule => (
+                                    <div key={schedule}>
+                                        <PrettySchedule schedule={schedule} />
+                                    </div>
+                                ))}
                             </div>
                             <div className='columns small-1'>
                                 <Timestamp date={w.metadata.creationTimestamp} />
                             </div>
                             <div className='columns small-1'>
-                                {w.spec.suspend ? '' : <Ticker intervalMs={1000}>{() => <Timestamp date={getNextScheduledTime(w.spec.schedule, w.spec.timezone)} />}</Ticker>}
+                                {w.spec.suspend ? '' : <Ticker intervalMs={1000}>{() => <Timestamp date={getNextScheduledTime(getSchedules(w.spec), w.spec.timezone)} />}</Ticker>}
                             </div>
                         </Link>
                     ))}
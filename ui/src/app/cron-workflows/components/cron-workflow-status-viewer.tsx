@@ -1,13 +1,15 @@
 import * as kubernetes from 'argo-ui/src/models/kubernetes';
 import * as React from 'react';
 import {CronWorkflowSpec, CronWorkflowStatus} from '../../../models';
+import {PhaseIcon} from '../../shared/components/phase-icon';
 import {Timestamp} from '../../shared/components/timestamp';
 import {ConditionsPanel} from '../../shared/conditions-panel';
+import {getSchedules} from '../../shared/cron';
 import {WorkflowLink} from '../../workflows/components/workflow-link';
 import {PrettySchedule} from './pretty-schedule';
 
 const parser = require('cron-parser');
-export const CronWorkflowStatusViewer = ({spec, status}: {spec: CronWorkflowSpec; status: CronWorkflowStatus}) => {
+export const CronWorkflowStatusViewer = ({namespace, spec, status}: {namespace: string; spec: CronWorkflowSpec; status: CronWorkflowStatus}) => {
     if (status === null) {
         return null;
     }
@@ -20,7 +22,11 @@ export const CronWorkflowStatusViewer = ({spec, status}: {spec: CronWorkflowSpec
                         title: 'Schedule',
                         value: (
                             <>
-                                <code>{spec.schedule}</code> <PrettySchedule schedule={spec.schedule} />
+                                {getSchedules(spec).map(schedule => (
+                                    <div key={schedule}>
+                                        <code>{schedule}</code> <PrettySchedule schedule={schedule} />
+                                    </div>
+                                ))}
                             </>
                         )
                     },
@@ -29,10 +35,38 @@ export const CronWorkflowStatusViewer = ({spec, status}: {spec: CronWorkflowSpec
                         title: 'Next Scheduled Time',
                         value: (
                             <>
-                                <Timestamp date={getNextScheduledTime(spec.schedule, spec.timezone)} /> (assumes workflow-controller is in UTC)
+                                {status.nextScheduledTime ? (
+                                    <Timestamp date={status.nextScheduledTime} />
+                                ) : (
+                                    <>
+                                        <Timestamp date={getNextScheduledTime(getSchedules(spec), spec.timezone)} /> (assumes workflow-controller is in UTC)
+                                    </>
+                                )}
                             </>
                         )
                     },
+                    {
+                        title: 'Runs',
+                        value: (
+                            <>
+                                {status.succeeded || 0} succeeded, {status.failed || 0} failed
+                                {status.consecutiveFailures ? `, ${status.consecutiveFailures} consecutive failures` : ''}
+                                {spec.stopStrategy ? ` (suspends after ${spec.stopStrategy.consecutiveFailures})` : ''}
+                            </>
+                        )
+                    },
+                    {
+                        title: 'Recent Runs',
+                        value: status.recentRuns ? (
+                            status.recentRuns.map(run => (
+                                <div key={run.name}>
+                                    <PhaseIcon value={run.phase} /> <WorkflowLink namespace={namespace} name={run.name} /> <Timestamp date={run.finishedAt} />
+                                </div>
+                            ))
+                        ) : (
+                            <i>No Workflows Completed</i>
+                        )
+                    },
                     {title: 'Conditions', value: <ConditionsPanel conditions={status.conditions} />}
                 ].map(attr => (
                     <div className='row white-box__details-row' key={attr.title}>
@@ -49,15 +83,19 @@ function getCronWorkflowActiveWorkflowList(active: kubernetes.ObjectReference[])
     return active.reverse().map(activeWf => <WorkflowLink key={activeWf.uid} namespace={activeWf.namespace} name={activeWf.name} />);
 }
 
-function getNextScheduledTime(schedule: string, tz: string): string {
+function getNextScheduledTime(schedules: string[], tz: string): string {
     let out = '';
     try {
-        out = parser
-            .parseExpression(schedule, {utc: !tz, tz})
-            .next()
-            .toISOString();
+        out = schedules
+            .map(schedule =>
+                parser
+                    .parseExpression(schedule, {utc: !tz, tz})
+                    .next()
+                    .toISOString()
+            )
+            .sort()[0];
     } catch (e) {
         // Do nothing
     }
-    return out;
+    return out || '';
 }
@@ -1,12 +1,22 @@
 import parser = require('cron-parser');
+import {CronWorkflowSpec} from '../../models';
 
-export function getNextScheduledTime(schedule: string, tz: string): Date {
+export function getSchedules(spec: CronWorkflowSpec): string[] {
+    return (spec.schedule ? [spec.schedule] : []).concat(spec.schedules || []);
+}
+
+export function getNextScheduledTime(schedules: string[], tz: string): Date {
     let out: Date;
     try {
-        out = parser
-            .parseExpression(schedule, {utc: !tz, tz})
-            .next()
-            .toDate();
+        schedules.forEach(schedule => {
+            const next = parser
+                .parseExpression(schedule, {utc: !tz, tz})
+                .next()
+                .toDate();
+            if (!out || next < out) {
+                out = next;
+            }
+        });
     } catch (e) {
         // Do nothing
     }
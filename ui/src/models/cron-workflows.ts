@@ -1,5 +1,5 @@
 import * as kubernetes from 'argo-ui/src/models/kubernetes';
-import {Condition, WorkflowSpec} from './workflows';
+import {Condition, WorkflowPhase, WorkflowSpec} from './workflows';
 
 export interface CronWorkflow {
     apiVersion?: string;
@@ -14,7 +14,11 @@ export type ConcurrencyPolicy = 'Allow' | 'Forbid' | 'Replace';
 export interface CronWorkflowSpec {
     workflowSpec: WorkflowSpec;
     workflowMetadata?: kubernetes.ObjectMeta;
-    schedule: string;
+    schedule?: string;
+    schedules?: string[];
+    when?: string;
+    exclusions?: CronExclusions;
+    stopStrategy?: StopStrategy;
     concurrencyPolicy?: ConcurrencyPolicy;
     suspend?: boolean;
     startingDeadlineSeconds?: number;
@@ -23,10 +27,32 @@ export interface CronWorkflowSpec {
     timezone?: string;
 }
 
+export interface CronExclusions {
+    dates?: string[];
+    configMapKeyRef?: {name: string; key: string; optional?: boolean};
+}
+
+export interface StopStrategy {
+    consecutiveFailures: number;
+}
+
 export interface CronWorkflowStatus {
     active: kubernetes.ObjectReference[];
     lastScheduledTime: kubernetes.Time;
     conditions?: Condition[];
+    succeeded?: number;
+    failed?: number;
+    consecutiveFailures?: number;
+    recentRuns?: CronWorkflowRun[];
+    nextScheduledTime?: kubernetes.Time;
+}
+
+export interface CronWorkflowRun {
+    name: string;
+    phase: WorkflowPhase;
+    startedAt?: kubernetes.Time;
+    finishedAt?: kubernetes.Time;
+    message?: string;
 }
 
 export interface CronWorkflowList {
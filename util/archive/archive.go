@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 
@@ -134,3 +135,102 @@ func tarFile(sourcePath string, tw *tar.Writer) error {
 	_, err = io.Copy(tw, f)
 	return err
 }
+
+// UntarGz extracts the tar.gz read from r into the destination directory, creating it if needed.
+// Entries that would be extracted outside of the destination directory, or through a symlink, and symlinks that point
+// outside of it, are an error.
+func UntarGz(r io.Reader, destPath string) error {
+	destPath, err := filepath.Abs(destPath)
+	if err != nil {
+		return errors.InternalErrorf("getting absolute path: %v", err)
+	}
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	defer util.Close(gzr)
+	tr := tar.NewReader(gzr)
+	within := func(path string) bool {
+		return path == destPath || strings.HasPrefix(path, destPath+string(os.PathSeparator))
+	}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.InternalWrapError(err)
+		}
+		target := filepath.Join(destPath, header.Name)
+		if !within(target) {
+			return errors.InternalErrorf("illegal file path in archive: %s", header.Name)
+		}
+		// a symlink extracted earlier could otherwise be used to write outside of the destination
+		if link, err := hasSymlink(destPath, target); err != nil {
+			return errors.InternalWrapError(err)
+		} else if link {
+			return errors.InternalErrorf("illegal file path in archive, it is within a symlink: %s", header.Name)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return errors.InternalWrapError(err)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return errors.InternalWrapError(err)
+			}
+		case tar.TypeReg:
+			if err := untarFile(tr, target, header.FileInfo().Mode().Perm()); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			// relative to the directory the link is in
+			if filepath.IsAbs(header.Linkname) || !within(filepath.Join(filepath.Dir(target), header.Linkname)) {
+				return errors.InternalErrorf("illegal link in archive: %s -> %s", header.Name, header.Linkname)
+			}
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return errors.InternalWrapError(err)
+			}
+		default:
+			log.Debugf("skipping %s of type %v", header.Name, header.Typeflag)
+		}
+	}
+}
+
+// hasSymlink returns true if the path, or any directory between the destination and it, is a symlink
+func hasSymlink(destPath, path string) (bool, error) {
+	rel, err := filepath.Rel(destPath, path)
+	if err != nil || rel == "." {
+		return false, err
+	}
+	for _, name := range strings.Split(rel, string(os.PathSeparator)) {
+		destPath = filepath.Join(destPath, name)
+		info, err := os.Lstat(destPath)
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func untarFile(r io.Reader, target string, mode os.FileMode) error {
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	_, err = io.Copy(f, r)
+	closeErr := f.Close()
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	if closeErr != nil {
+		return errors.InternalWrapError(closeErr)
+	}
+	return nil
+}
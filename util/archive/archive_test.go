@@ -1,10 +1,13 @@
 package archive
 
 import (
+	"archive/tar"
 	"bufio"
+	"bytes"
 	"compress/gzip"
 	"crypto/rand"
 	"encoding/hex"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
@@ -139,3 +142,78 @@ func TestTarFile(t *testing.T) {
 		})
 	}
 }
+
+func TestUntarGz(t *testing.T) {
+	src, err := ioutil.TempDir("", "argo-test")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(src) }()
+	assert.NoError(t, os.MkdirAll(filepath.Join(src, "out", "sub"), 0o755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(src, "out", "sub", "result.txt"), []byte("hello"), 0o644))
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, TarGzToWriter(filepath.Join(src, "out"), gzip.DefaultCompression, buf))
+
+	dest := filepath.Join(src, "dest")
+	if assert.NoError(t, UntarGz(buf, dest)) {
+		data, err := ioutil.ReadFile(filepath.Join(dest, "out", "sub", "result.txt"))
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	}
+
+	t.Run("IllegalPath", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		gzw := gzip.NewWriter(buf)
+		tw := tar.NewWriter(gzw)
+		assert.NoError(t, tw.WriteHeader(&tar.Header{Name: "../evil.txt", Mode: 0o644, Size: 0, Typeflag: tar.TypeReg}))
+		assert.NoError(t, tw.Close())
+		assert.NoError(t, gzw.Close())
+		assert.EqualError(t, UntarGz(buf, dest), "illegal file path in archive: ../evil.txt")
+	})
+	tarGz := func(headers ...*tar.Header) *bytes.Buffer {
+		buf := &bytes.Buffer{}
+		gzw := gzip.NewWriter(buf)
+		tw := tar.NewWriter(gzw)
+		for _, h := range headers {
+			assert.NoError(t, tw.WriteHeader(h))
+		}
+		assert.NoError(t, tw.Close())
+		assert.NoError(t, gzw.Close())
+		return buf
+	}
+	outside := filepath.Join(src, "outside")
+	assert.NoError(t, os.MkdirAll(outside, 0o755))
+	t.Run("AbsoluteSymlink", func(t *testing.T) {
+		dest := filepath.Join(src, "absolute")
+		buf := tarGz(
+			&tar.Header{Name: "link", Linkname: outside, Typeflag: tar.TypeSymlink},
+			&tar.Header{Name: "link/evil.txt", Mode: 0o644, Typeflag: tar.TypeReg},
+		)
+		assert.EqualError(t, UntarGz(buf, dest), "illegal link in archive: link -> "+outside)
+		_, err := os.Lstat(filepath.Join(dest, "link"))
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("RelativeSymlink", func(t *testing.T) {
+		dest := filepath.Join(src, "relative")
+		buf := tarGz(&tar.Header{Name: "sub/link", Linkname: "../../outside", Typeflag: tar.TypeSymlink})
+		assert.EqualError(t, UntarGz(buf, dest), "illegal link in archive: sub/link -> ../../outside")
+	})
+	t.Run("WithinSymlink", func(t *testing.T) {
+		dest := filepath.Join(src, "within")
+		assert.NoError(t, os.MkdirAll(dest, 0o755))
+		// e.g. a symlink left by a previous extraction
+		assert.NoError(t, os.Symlink(outside, filepath.Join(dest, "link")))
+		for _, name := range []string{"link", "link/evil.txt", "link/sub/evil.txt"} {
+			buf := tarGz(&tar.Header{Name: name, Mode: 0o644, Typeflag: tar.TypeReg})
+			assert.EqualError(t, UntarGz(buf, dest), "illegal file path in archive, it is within a symlink: "+name)
+		}
+		buf := tarGz(
+			&tar.Header{Name: "dir", Linkname: ".", Typeflag: tar.TypeSymlink},
+			&tar.Header{Name: "dir/file.txt", Mode: 0o644, Typeflag: tar.TypeReg},
+		)
+		assert.EqualError(t, UntarGz(buf, dest), "illegal file path in archive, it is within a symlink: dir/file.txt")
+		files, err := ioutil.ReadDir(outside)
+		if assert.NoError(t, err) {
+			assert.Empty(t, files)
+		}
+	})
+}
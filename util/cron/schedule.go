@@ -0,0 +1,43 @@
+package cron
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// schedules is the union of schedules, i.e. it is next due when the earliest of them is
+type schedules []cron.Schedule
+
+func (s schedules) Next(t time.Time) time.Time {
+	var next time.Time
+	for _, schedule := range s {
+		if n := schedule.Next(t); !n.IsZero() && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+	}
+	return next
+}
+
+// ParseSchedules parses one or more schedules in standard cron format, in the timezone, if any, into a single schedule
+func ParseSchedules(specs []string, timezone string) (cron.Schedule, error) {
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("at least one schedule is required")
+	}
+	var s schedules
+	for _, spec := range specs {
+		if timezone != "" {
+			spec = "CRON_TZ=" + timezone + " " + spec
+		}
+		schedule, err := cron.ParseStandard(spec)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse schedule %q: %w", spec, err)
+		}
+		s = append(s, schedule)
+	}
+	if len(s) == 1 {
+		return s[0], nil
+	}
+	return s, nil
+}
@@ -0,0 +1,34 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSchedules(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		_, err := ParseSchedules(nil, "")
+		assert.EqualError(t, err, "at least one schedule is required")
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseSchedules([]string{"0 * * * *", "foo"}, "")
+		assert.Error(t, err)
+	})
+	t.Run("Multiple", func(t *testing.T) {
+		schedule, err := ParseSchedules([]string{"0 18 * * *", "30 9 * * *"}, "")
+		if assert.NoError(t, err) {
+			now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+			assert.Equal(t, time.Date(2021, 1, 1, 18, 0, 0, 0, time.UTC), schedule.Next(now))
+			assert.Equal(t, time.Date(2021, 1, 2, 9, 30, 0, 0, time.UTC), schedule.Next(schedule.Next(now)))
+		}
+	})
+	t.Run("Timezone", func(t *testing.T) {
+		schedule, err := ParseSchedules([]string{"0 9 * * *"}, "Asia/Tokyo")
+		if assert.NoError(t, err) {
+			now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+			assert.Equal(t, time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC), schedule.Next(now).UTC())
+		}
+	})
+}
@@ -2,6 +2,9 @@ package diff
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
@@ -16,3 +19,112 @@ func LogChanges(old, new interface{}) {
 	patch, _ := jsonpatch.CreateMergePatch(a, b)
 	log.Debugf("Log changes patch: %s", string(patch))
 }
+
+// Change is a difference in a single field between two values.
+type Change struct {
+	// Path of the field, e.g. "container.args[0]", empty if the values themselves differ
+	Path string
+	// Old is the old value, strings are as-is, other values are JSON, empty if absent
+	Old string
+	// New is the new value
+	New string
+}
+
+// Changes returns the field-by-field changes between the JSON representations of two values, sorted by path.
+// A field that is absent in one value is a single change, rather than a change for every field within it.
+func Changes(old, new interface{}) ([]Change, error) {
+	a, err := toJSONValue(old)
+	if err != nil {
+		return nil, err
+	}
+	b, err := toJSONValue(new)
+	if err != nil {
+		return nil, err
+	}
+	var changes []Change
+	err = changesInto(&changes, "", a, b)
+	return changes, err
+}
+
+func toJSONValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	return out, json.Unmarshal(data, &out)
+}
+
+func changesInto(changes *[]Change, path string, a, b interface{}) error {
+	switch x := a.(type) {
+	case map[string]interface{}:
+		if y, ok := b.(map[string]interface{}); ok {
+			keys := make(map[string]bool)
+			for k := range x {
+				keys[k] = true
+			}
+			for k := range y {
+				keys[k] = true
+			}
+			sorted := make([]string, 0, len(keys))
+			for k := range keys {
+				sorted = append(sorted, k)
+			}
+			sort.Strings(sorted)
+			for _, k := range sorted {
+				p := k
+				if path != "" {
+					p = path + "." + k
+				}
+				if err := changesInto(changes, p, x[k], y[k]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case []interface{}:
+		if y, ok := b.([]interface{}); ok {
+			n := len(x)
+			if len(y) > n {
+				n = len(y)
+			}
+			for i := 0; i < n; i++ {
+				var u, v interface{}
+				if i < len(x) {
+					u = x[i]
+				}
+				if i < len(y) {
+					v = y[i]
+				}
+				if err := changesInto(changes, fmt.Sprintf("%s[%d]", path, i), u, v); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	if reflect.DeepEqual(a, b) {
+		return nil
+	}
+	old, err := format(a)
+	if err != nil {
+		return err
+	}
+	new, err := format(b)
+	if err != nil {
+		return err
+	}
+	*changes = append(*changes, Change{Path: path, Old: old, New: new})
+	return nil
+}
+
+func format(v interface{}) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return x, nil
+	}
+	data, err := json.Marshal(v)
+	return string(data), err
+}
@@ -0,0 +1,40 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChanges(t *testing.T) {
+	type thing struct {
+		Name   string            `json:"name,omitempty"`
+		Args   []string          `json:"args,omitempty"`
+		Labels map[string]string `json:"labels,omitempty"`
+	}
+	t.Run("Equal", func(t *testing.T) {
+		changes, err := Changes(thing{Name: "a"}, thing{Name: "a"})
+		if assert.NoError(t, err) {
+			assert.Empty(t, changes)
+		}
+	})
+	t.Run("Fields", func(t *testing.T) {
+		changes, err := Changes(
+			thing{Name: "a", Args: []string{"x", "y"}},
+			thing{Name: "b", Args: []string{"x"}, Labels: map[string]string{"l": "1"}},
+		)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []Change{
+				{Path: "args[1]", Old: "y"},
+				{Path: "labels", New: `{"l":"1"}`},
+				{Path: "name", Old: "a", New: "b"},
+			}, changes)
+		}
+	})
+	t.Run("Absent", func(t *testing.T) {
+		changes, err := Changes(nil, thing{Name: "a"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []Change{{New: `{"name":"a"}`}}, changes)
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package logs
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"regexp"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// OpenArtifactFunc opens the output artifact of the workflow's node for reading.
+type OpenArtifactFunc func(ctx context.Context, wf *wfv1.Workflow, nodeID string, art *wfv1.Artifact) (io.ReadCloser, error)
+
+// ArtifactLogs sends the logs that the workflow's pods saved as artifacts (i.e. `archiveLogs: true`). This is how the
+// logs of pods that have been deleted, and of archived workflows, are served.
+//   - Only the main container's logs are saved, so nothing is sent if another container is requested.
+//   - Saved logs do not have timestamps: the lines of each pod are sent in order, pods are sorted by when they started,
+//     and each line is given the time its pod finished, which is used for `sinceSeconds`, `sinceTime` and `timestamps`.
+//   - `tailLines` and `limitBytes` apply to each pod, like they do for `kubectl logs`.
+//
+// Pods for which skip returns true are not sent, e.g. because they still exist and their live logs are streamed.
+func ArtifactLogs(ctx context.Context, wf *wfv1.Workflow, req request, sender sender, openArtifact OpenArtifactFunc, skip func(podName string) bool) error {
+	grep, err := regexp.Compile(req.GetGrep())
+	if err != nil {
+		return err
+	}
+	logOptions := req.GetLogOptions()
+	if logOptions == nil {
+		logOptions = &corev1.PodLogOptions{}
+	}
+	if logOptions.Container != "" && logOptions.Container != common.MainContainerName {
+		return nil
+	}
+	var since time.Time
+	if logOptions.SinceSeconds != nil {
+		since = time.Now().Add(-time.Duration(*logOptions.SinceSeconds) * time.Second)
+	} else if logOptions.SinceTime != nil {
+		since = logOptions.SinceTime.Time
+	}
+
+	logCtx := log.WithFields(log.Fields{"workflow": wf.Name, "namespace": wf.Namespace})
+
+	var nodes []wfv1.NodeStatus
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod || node.Outputs.GetArtifactByName(common.MainLogsArtifactName) == nil {
+			continue
+		}
+		if req.GetPodName() != "" && req.GetPodName() != node.ID {
+			continue
+		}
+		if skip != nil && skip(node.ID) {
+			continue
+		}
+		if node.FinishedAt.Time.Before(since) {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].StartedAt.Before(&nodes[j].StartedAt)
+	})
+
+	for _, node := range nodes {
+		logCtx := logCtx.WithField("podName", node.ID)
+		logCtx.Debug("Sending pod logs from artifact")
+		lines, err := readLogsArtifact(ctx, wf, node, openArtifact, logOptions)
+		if err != nil {
+			// the artifact may have been deleted, we still want to send the logs of the other pods
+			logCtx.WithError(err).Warn("failed to read logs artifact")
+			continue
+		}
+		timestamp := node.FinishedAt.Time.UTC().Format(time.RFC3339)
+		for _, content := range lines {
+			if !grep.MatchString(content) {
+				continue
+			}
+			if logOptions.Timestamps {
+				content = timestamp + " " + content
+			}
+			if err := sender.Send(&workflowpkg.LogEntry{Content: content, PodName: node.ID}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readLogsArtifact returns the lines of the node's logs artifact, limited by the log options
+func readLogsArtifact(ctx context.Context, wf *wfv1.Workflow, node wfv1.NodeStatus, openArtifact OpenArtifactFunc, logOptions *corev1.PodLogOptions) ([]string, error) {
+	stream, err := openArtifact(ctx, wf, node.ID, node.Outputs.GetArtifactByName(common.MainLogsArtifactName))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = stream.Close() }()
+	var reader io.Reader = stream
+	if logOptions.LimitBytes != nil {
+		reader = io.LimitReader(reader, *logOptions.LimitBytes)
+	}
+	var lines []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+		if logOptions.TailLines != nil && int64(len(lines)) > *logOptions.TailLines {
+			lines = lines[1:]
+		}
+	}
+	return lines, scanner.Err()
+}
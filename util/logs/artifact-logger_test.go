@@ -0,0 +1,91 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type testSender struct{ entries []string }
+
+func (s *testSender) Send(entry *workflowpkg.LogEntry) error {
+	s.entries = append(s.entries, entry.PodName+": "+entry.Content)
+	return nil
+}
+
+func TestArtifactLogs(t *testing.T) {
+	finishedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	node := func(id string, startedAt time.Time) wfv1.NodeStatus {
+		return wfv1.NodeStatus{
+			ID:         id,
+			Type:       wfv1.NodeTypePod,
+			StartedAt:  metav1.NewTime(startedAt),
+			FinishedAt: metav1.NewTime(finishedAt),
+			Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+				{Name: "main-logs", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: id + "/main.log"}}},
+			}},
+		}
+	}
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns"},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"my-wf":   {ID: "my-wf", Type: wfv1.NodeTypeSteps},
+			"my-wf-2": node("my-wf-2", finishedAt.Add(-time.Minute)),
+			"my-wf-1": node("my-wf-1", finishedAt.Add(-2*time.Minute)),
+			"my-wf-3": {ID: "my-wf-3", Type: wfv1.NodeTypePod},
+		}},
+	}
+	openArtifact := func(_ context.Context, _ *wfv1.Workflow, nodeID string, art *wfv1.Artifact) (io.ReadCloser, error) {
+		key, _ := art.GetKey()
+		if key != nodeID+"/main.log" {
+			return nil, fmt.Errorf("unexpected key %q", key)
+		}
+		return ioutil.NopCloser(strings.NewReader("info\nerror\n")), nil
+	}
+	logs := func(req *workflowpkg.WorkflowLogRequest, skip func(string) bool) []string {
+		s := &testSender{}
+		assert.NoError(t, ArtifactLogs(context.Background(), wf, req, s, openArtifact, skip))
+		return s.entries
+	}
+
+	t.Run("All", func(t *testing.T) {
+		assert.Equal(t, []string{"my-wf-1: info", "my-wf-1: error", "my-wf-2: info", "my-wf-2: error"}, logs(&workflowpkg.WorkflowLogRequest{}, nil))
+	})
+	t.Run("PodName", func(t *testing.T) {
+		assert.Equal(t, []string{"my-wf-2: info", "my-wf-2: error"}, logs(&workflowpkg.WorkflowLogRequest{PodName: "my-wf-2"}, nil))
+	})
+	t.Run("Skip", func(t *testing.T) {
+		assert.Equal(t, []string{"my-wf-2: info", "my-wf-2: error"}, logs(&workflowpkg.WorkflowLogRequest{}, func(podName string) bool { return podName == "my-wf-1" }))
+	})
+	t.Run("Grep", func(t *testing.T) {
+		assert.Equal(t, []string{"my-wf-1: error", "my-wf-2: error"}, logs(&workflowpkg.WorkflowLogRequest{Grep: "err"}, nil))
+	})
+	t.Run("InvalidGrep", func(t *testing.T) {
+		assert.Error(t, ArtifactLogs(context.Background(), wf, &workflowpkg.WorkflowLogRequest{Grep: "("}, &testSender{}, openArtifact, nil))
+	})
+	t.Run("Container", func(t *testing.T) {
+		assert.Empty(t, logs(&workflowpkg.WorkflowLogRequest{LogOptions: &corev1.PodLogOptions{Container: "wait"}}, nil))
+	})
+	t.Run("SinceTime", func(t *testing.T) {
+		sinceTime := metav1.NewTime(finishedAt.Add(time.Second))
+		assert.Empty(t, logs(&workflowpkg.WorkflowLogRequest{LogOptions: &corev1.PodLogOptions{SinceTime: &sinceTime}}, nil))
+	})
+	t.Run("TailLines", func(t *testing.T) {
+		assert.Equal(t, []string{"my-wf-1: error", "my-wf-2: error"}, logs(&workflowpkg.WorkflowLogRequest{LogOptions: &corev1.PodLogOptions{TailLines: pointer.Int64Ptr(1)}}, nil))
+	})
+	t.Run("Timestamps", func(t *testing.T) {
+		assert.Equal(t, "my-wf-2: 2021-01-01T00:00:00Z error", logs(&workflowpkg.WorkflowLogRequest{PodName: "my-wf-2", LogOptions: &corev1.PodLogOptions{Timestamps: true}}, nil)[1])
+	})
+}
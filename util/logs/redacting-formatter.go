@@ -0,0 +1,35 @@
+package logs
+
+import (
+	"bytes"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type redactingFormatter struct {
+	formatter log.Formatter
+	values    [][]byte
+	mask      []byte
+}
+
+func (f redactingFormatter) Format(entry *log.Entry) ([]byte, error) {
+	data, err := f.formatter.Format(entry)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range f.values {
+		data = bytes.ReplaceAll(data, value, f.mask)
+	}
+	return data, nil
+}
+
+// NewRedactingFormatter returns a formatter that replaces the values in the log entries formatted by the formatter with the mask
+func NewRedactingFormatter(formatter log.Formatter, values []string, mask string) log.Formatter {
+	f := redactingFormatter{formatter: formatter, mask: []byte(mask)}
+	for _, value := range values {
+		if value != "" {
+			f.values = append(f.values, []byte(value))
+		}
+	}
+	return f
+}
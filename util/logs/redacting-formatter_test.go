@@ -0,0 +1,18 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRedactingFormatter(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := log.New()
+	logger.Out = out
+	logger.Formatter = NewRedactingFormatter(&log.TextFormatter{DisableTimestamp: true}, []string{"s3cret", ""}, "******")
+	logger.WithField("password", "s3cret").Info("the password is s3cret")
+	assert.Equal(t, "level=info msg=\"the password is ******\" password=******\n", out.String())
+}
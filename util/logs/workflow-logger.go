@@ -3,6 +3,7 @@ package logs
 import (
 	"bufio"
 	"context"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -31,6 +32,7 @@ type request interface {
 	GetName() string
 	GetPodName() string
 	GetLogOptions() *corev1.PodLogOptions
+	GetGrep() string
 }
 
 type sender interface {
@@ -44,6 +46,11 @@ func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient
 		return err
 	}
 
+	grep, err := regexp.Compile(req.GetGrep())
+	if err != nil {
+		return err
+	}
+
 	podInterface := kubeClient.CoreV1().Pods(req.GetNamespace())
 
 	logCtx := log.WithFields(log.Fields{"workflow": req.GetName(), "namespace": req.GetNamespace()})
@@ -111,6 +118,9 @@ func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient
 						}
 						// You might ask - why don't we let the client do this? Well, it is because
 						// this is the same as how this works for `kubectl logs`
+						if !grep.MatchString(content) {
+							continue
+						}
 						if req.GetLogOptions().Timestamps {
 							content = line
 						}
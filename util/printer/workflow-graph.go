@@ -0,0 +1,326 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/argoproj/pkg/humanize"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// phaseColors are the colors nodes are filled with, like in the UI
+var phaseColors = map[wfv1.NodePhase]string{
+	wfv1.NodePending:   "#f4c030",
+	wfv1.NodeRunning:   "#0dadea",
+	wfv1.NodeSucceeded: "#18be94",
+	wfv1.NodeSkipped:   "#ccd6dd",
+	wfv1.NodeFailed:    "#e96d76",
+	wfv1.NodeError:     "#e96d76",
+	wfv1.NodeOmitted:   "#ccd6dd",
+}
+
+type graphNode struct {
+	id    string
+	label string
+	phase wfv1.NodePhase
+	// the ID of the steps or DAG node this node is grouped with
+	boundaryID string
+	// whether this is a steps or DAG node, which the nodes within its boundary are grouped with
+	boundary bool
+}
+
+type graphEdge struct{ from, to string }
+
+type graph struct {
+	nodes []graphNode
+	edges []graphEdge
+}
+
+// PrintWorkflowGraph prints the graph of the workflow's nodes, grouped by the steps or DAG they are part of, in the
+// output format, either "dot" (Graphviz) or "mermaid". If the workflow has not run yet, the graph of its templates,
+// starting with the entrypoint, is printed instead.
+func PrintWorkflowGraph(wf *wfv1.Workflow, out io.Writer, output string) error {
+	var g *graph
+	if len(wf.Status.Nodes) > 0 {
+		g = newNodeGraph(wf)
+	} else {
+		g = newTemplateGraph(wf)
+	}
+	switch output {
+	case "dot":
+		printDot(g, out)
+	case "mermaid":
+		printMermaid(g, out)
+	default:
+		return fmt.Errorf("unknown output mode: %s", output)
+	}
+	return nil
+}
+
+func newNodeGraph(wf *wfv1.Workflow) *graph {
+	var nodes []wfv1.NodeStatus
+	for _, node := range wf.Status.Nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if !nodes[i].StartedAt.Equal(&nodes[j].StartedAt) {
+			return nodes[i].StartedAt.Before(&nodes[j].StartedAt)
+		}
+		return nodes[i].ID < nodes[j].ID
+	})
+	g := &graph{}
+	for _, node := range nodes {
+		label := node.DisplayName
+		if node.Phase != "" {
+			label += "\n" + string(node.Phase)
+			if !node.StartedAt.IsZero() {
+				// running nodes have not finished yet
+				finishedAt := node.FinishedAt.Time
+				if finishedAt.IsZero() {
+					finishedAt = time.Now()
+				}
+				label += " " + humanize.RelativeDurationShort(node.StartedAt.Time, finishedAt)
+			}
+		}
+		boundaryID := node.BoundaryID
+		if _, ok := wf.Status.Nodes[boundaryID]; !ok || boundaryID == node.ID {
+			boundaryID = ""
+		}
+		g.nodes = append(g.nodes, graphNode{
+			id:         node.ID,
+			label:      label,
+			phase:      node.Phase,
+			boundaryID: boundaryID,
+			boundary:   node.Type == wfv1.NodeTypeSteps || node.Type == wfv1.NodeTypeDAG,
+		})
+		for _, child := range node.Children {
+			if _, ok := wf.Status.Nodes[child]; ok {
+				g.edges = append(g.edges, graphEdge{node.ID, child})
+			}
+		}
+	}
+	return g
+}
+
+// newTemplateGraph returns the graph of the workflow's templates, starting with the entrypoint. Steps and DAG
+// templates are expanded, except for templates that reference other workflow templates or themselves recursively.
+func newTemplateGraph(wf *wfv1.Workflow) *graph {
+	g := &graph{}
+	entrypoint := wf.Spec.Entrypoint
+	if entrypoint == "" && wf.Spec.WorkflowTemplateRef != nil {
+		g.nodes = append(g.nodes, graphNode{id: wf.Name, label: wf.Name + "\n" + wf.Spec.WorkflowTemplateRef.Name})
+		return g
+	}
+	id := wf.Name
+	if id == "" {
+		id = wf.GenerateName
+	}
+	g.addTemplate(wf, id, id, entrypoint, "", nil)
+	return g
+}
+
+// addTemplate adds the node for the template, and returns the IDs of its first and last nodes, which steps and
+// tasks that run the template are connected to
+func (g *graph) addTemplate(wf *wfv1.Workflow, id, label, templateName, boundaryID string, ancestors []string) (string, []string) {
+	tmpl := wf.GetTemplateByName(templateName)
+	for _, ancestor := range ancestors {
+		if ancestor == templateName {
+			// a recursive template
+			tmpl = nil
+		}
+	}
+	if tmpl == nil || (tmpl.Steps == nil && tmpl.DAG == nil) {
+		g.nodes = append(g.nodes, graphNode{id: id, label: label, boundaryID: boundaryID})
+		return id, []string{id}
+	}
+	g.nodes = append(g.nodes, graphNode{id: id, label: label, boundaryID: boundaryID, boundary: true})
+	ancestors = append(ancestors, templateName)
+	if tmpl.DAG != nil {
+		return id, g.addDAG(wf, id, tmpl, ancestors)
+	}
+	return id, g.addSteps(wf, id, tmpl, ancestors)
+}
+
+func (g *graph) addSteps(wf *wfv1.Workflow, id string, tmpl *wfv1.Template, ancestors []string) []string {
+	previous := []string{id}
+	for i, parallelSteps := range tmpl.Steps {
+		groupID := fmt.Sprintf("%s[%d]", id, i)
+		g.nodes = append(g.nodes, graphNode{id: groupID, label: fmt.Sprintf("[%d]", i), boundaryID: id})
+		for _, from := range previous {
+			g.edges = append(g.edges, graphEdge{from, groupID})
+		}
+		previous = nil
+		for _, step := range parallelSteps.Steps {
+			first, last := g.addTemplate(wf, groupID+"."+step.Name, stepLabel(step.Name, step.Template, step.TemplateRef), step.Template, id, ancestors)
+			g.edges = append(g.edges, graphEdge{groupID, first})
+			previous = append(previous, last...)
+		}
+	}
+	return previous
+}
+
+func (g *graph) addDAG(wf *wfv1.Workflow, id string, tmpl *wfv1.Template, ancestors []string) []string {
+	ctx := &dagTemplateContext{tmpl.DAG}
+	first := map[string]string{}
+	last := map[string][]string{}
+	dependents := map[string]bool{}
+	for _, task := range tmpl.DAG.Tasks {
+		first[task.Name], last[task.Name] = g.addTemplate(wf, id+"."+task.Name, stepLabel(task.Name, task.Template, task.TemplateRef), task.Template, id, ancestors)
+	}
+	for _, task := range tmpl.DAG.Tasks {
+		dependencies := ctx.GetTaskDependencies(task.Name)
+		if len(dependencies) == 0 {
+			g.edges = append(g.edges, graphEdge{id, first[task.Name]})
+		}
+		for _, dependency := range dependencies {
+			dependents[dependency] = true
+			for _, from := range last[dependency] {
+				g.edges = append(g.edges, graphEdge{from, first[task.Name]})
+			}
+		}
+	}
+	var leaves []string
+	for _, task := range tmpl.DAG.Tasks {
+		if !dependents[task.Name] {
+			leaves = append(leaves, last[task.Name]...)
+		}
+	}
+	return leaves
+}
+
+func stepLabel(name, template string, templateRef *wfv1.TemplateRef) string {
+	if templateRef != nil {
+		return name + "\n" + templateRef.Name + "/" + templateRef.Template
+	}
+	return name + "\n" + template
+}
+
+// dagTemplateContext finds the dependencies of a DAG template's tasks
+type dagTemplateContext struct{ dag *wfv1.DAGTemplate }
+
+func (d *dagTemplateContext) GetTask(taskName string) *wfv1.DAGTask {
+	for _, task := range d.dag.Tasks {
+		if task.Name == taskName {
+			return &task
+		}
+	}
+	return nil
+}
+
+func (d *dagTemplateContext) GetTaskDependencies(taskName string) []string {
+	task := d.GetTask(taskName)
+	if task == nil {
+		return nil
+	}
+	if task.Depends == "" {
+		return task.Dependencies
+	}
+	dependencies, _ := common.GetTaskDependencies(task, d)
+	var names []string
+	for name := range dependencies {
+		if d.GetTask(name) != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (d *dagTemplateContext) GetTaskFinishedAtTime(string) time.Time {
+	return time.Time{}
+}
+
+// children returns the nodes grouped with each boundary, "" being the top level
+func (g *graph) children() map[string][]graphNode {
+	children := map[string][]graphNode{}
+	for _, node := range g.nodes {
+		children[node.boundaryID] = append(children[node.boundaryID], node)
+	}
+	return children
+}
+
+func printDot(g *graph, out io.Writer) {
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+	}
+	children := g.children()
+	_, _ = fmt.Fprintln(out, "digraph {")
+	_, _ = fmt.Fprintln(out, "  node [shape=box style=\"rounded,filled\" fillcolor=white]")
+	var printGroup func(boundaryID, indent string)
+	printGroup = func(boundaryID, indent string) {
+		for _, node := range children[boundaryID] {
+			if node.boundary {
+				_, _ = fmt.Fprintf(out, "%ssubgraph %s {\n", indent, quote("cluster_"+node.id))
+				_, _ = fmt.Fprintf(out, "%s  style=dashed\n", indent)
+				printDotNode(out, indent+"  ", quote(node.id), quote(node.label), node.phase)
+				printGroup(node.id, indent+"  ")
+				_, _ = fmt.Fprintf(out, "%s}\n", indent)
+				continue
+			}
+			printDotNode(out, indent, quote(node.id), quote(node.label), node.phase)
+		}
+	}
+	printGroup("", "  ")
+	for _, edge := range g.edges {
+		_, _ = fmt.Fprintf(out, "  %s -> %s\n", quote(edge.from), quote(edge.to))
+	}
+	_, _ = fmt.Fprintln(out, "}")
+}
+
+func printDotNode(out io.Writer, indent, id, label string, phase wfv1.NodePhase) {
+	if color, ok := phaseColors[phase]; ok {
+		_, _ = fmt.Fprintf(out, "%s%s [label=%s fillcolor=%q]\n", indent, id, label, color)
+	} else {
+		_, _ = fmt.Fprintf(out, "%s%s [label=%s]\n", indent, id, label)
+	}
+}
+
+func printMermaid(g *graph, out io.Writer) {
+	// Mermaid IDs cannot contain all the characters node IDs can, so we number the nodes instead
+	ids := map[string]string{}
+	for i, node := range g.nodes {
+		ids[node.id] = fmt.Sprintf("n%d", i)
+	}
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`"`, "#quot;", "\n", "<br/>").Replace(s) + `"`
+	}
+	children := g.children()
+	_, _ = fmt.Fprintln(out, "flowchart TD")
+	var printGroup func(boundaryID, indent string)
+	printGroup = func(boundaryID, indent string) {
+		for _, node := range children[boundaryID] {
+			if node.boundary {
+				_, _ = fmt.Fprintf(out, "%ssubgraph %s_group [%s]\n", indent, ids[node.id], quote(node.label))
+				_, _ = fmt.Fprintf(out, "%s  %s[%s]\n", indent, ids[node.id], quote(node.label))
+				printGroup(node.id, indent+"  ")
+				_, _ = fmt.Fprintf(out, "%send\n", indent)
+				continue
+			}
+			_, _ = fmt.Fprintf(out, "%s%s[%s]\n", indent, ids[node.id], quote(node.label))
+		}
+	}
+	printGroup("", "  ")
+	for _, edge := range g.edges {
+		_, _ = fmt.Fprintf(out, "  %s --> %s\n", ids[edge.from], ids[edge.to])
+	}
+	var phases []string
+	nodesByPhase := map[string][]string{}
+	for _, node := range g.nodes {
+		if _, ok := phaseColors[node.phase]; ok {
+			if nodesByPhase[string(node.phase)] == nil {
+				phases = append(phases, string(node.phase))
+			}
+			nodesByPhase[string(node.phase)] = append(nodesByPhase[string(node.phase)], ids[node.id])
+		}
+	}
+	sort.Strings(phases)
+	for _, phase := range phases {
+		_, _ = fmt.Fprintf(out, "  classDef %s fill:%s\n", phase, phaseColors[wfv1.NodePhase(phase)])
+		_, _ = fmt.Fprintf(out, "  class %s %s\n", strings.Join(nodesByPhase[phase], ","), phase)
+	}
+}
@@ -0,0 +1,119 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestPrintWorkflowGraph(t *testing.T) {
+	startedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"my-wf":   {ID: "my-wf", DisplayName: "my-wf", Type: wfv1.NodeTypeDAG, Phase: wfv1.NodeSucceeded, StartedAt: metav1.NewTime(startedAt), FinishedAt: metav1.NewTime(startedAt.Add(time.Minute)), Children: []string{"my-wf-1"}},
+			"my-wf-1": {ID: "my-wf-1", DisplayName: "a", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, BoundaryID: "my-wf", StartedAt: metav1.NewTime(startedAt.Add(time.Second)), FinishedAt: metav1.NewTime(startedAt.Add(11 * time.Second)), Children: []string{"my-wf-2"}},
+			"my-wf-2": {ID: "my-wf-2", DisplayName: "b", Type: wfv1.NodeTypePod, Phase: wfv1.NodeFailed, BoundaryID: "my-wf", StartedAt: metav1.NewTime(startedAt.Add(12 * time.Second)), FinishedAt: metav1.NewTime(startedAt.Add(13 * time.Second))},
+		}},
+	}
+	t.Run("Dot", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		if assert.NoError(t, PrintWorkflowGraph(wf, out, "dot")) {
+			assert.Equal(t, `digraph {
+  node [shape=box style="rounded,filled" fillcolor=white]
+  subgraph "cluster_my-wf" {
+    style=dashed
+    "my-wf" [label="my-wf\nSucceeded 1m" fillcolor="#18be94"]
+    "my-wf-1" [label="a\nSucceeded 10s" fillcolor="#18be94"]
+    "my-wf-2" [label="b\nFailed 1s" fillcolor="#e96d76"]
+  }
+  "my-wf" -> "my-wf-1"
+  "my-wf-1" -> "my-wf-2"
+}
+`, out.String())
+		}
+	})
+	t.Run("Mermaid", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		if assert.NoError(t, PrintWorkflowGraph(wf, out, "mermaid")) {
+			assert.Equal(t, `flowchart TD
+  subgraph n0_group ["my-wf<br/>Succeeded 1m"]
+    n0["my-wf<br/>Succeeded 1m"]
+    n1["a<br/>Succeeded 10s"]
+    n2["b<br/>Failed 1s"]
+  end
+  n0 --> n1
+  n1 --> n2
+  classDef Failed fill:#e96d76
+  class n2 Failed
+  classDef Succeeded fill:#18be94
+  class n0,n1 Succeeded
+`, out.String())
+		}
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		assert.EqualError(t, PrintWorkflowGraph(wf, &bytes.Buffer{}, "png"), "unknown output mode: png")
+	})
+	t.Run("Running", func(t *testing.T) {
+		wf := &wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
+			Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+				"my-wf": {ID: "my-wf", DisplayName: "my-wf", Type: wfv1.NodeTypePod, Phase: wfv1.NodeRunning, StartedAt: metav1.NewTime(time.Now().Add(-time.Hour))},
+			}},
+		}
+		out := &bytes.Buffer{}
+		if assert.NoError(t, PrintWorkflowGraph(wf, out, "dot")) {
+			assert.Contains(t, out.String(), `label="my-wf\nRunning 1h"`)
+		}
+	})
+}
+
+func TestPrintWorkflowGraphOfTemplates(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
+		Spec: wfv1.WorkflowSpec{
+			Entrypoint: "main",
+			Templates: []wfv1.Template{
+				{Name: "main", Steps: []wfv1.ParallelSteps{
+					{Steps: []wfv1.WorkflowStep{{Name: "a", Template: "dag"}}},
+					{Steps: []wfv1.WorkflowStep{{Name: "b", Template: "main"}, {Name: "c", TemplateRef: &wfv1.TemplateRef{Name: "my-wftmpl", Template: "echo"}}}},
+				}},
+				{Name: "dag", DAG: &wfv1.DAGTemplate{Tasks: []wfv1.DAGTask{
+					{Name: "x", Template: "echo"},
+					{Name: "y", Template: "echo", Depends: "x.Succeeded || x.Failed"},
+				}}},
+				{Name: "echo", Container: &corev1.Container{}},
+			},
+		},
+	}
+	out := &bytes.Buffer{}
+	if assert.NoError(t, PrintWorkflowGraph(wf, out, "mermaid")) {
+		assert.Equal(t, `flowchart TD
+  subgraph n0_group ["my-wf"]
+    n0["my-wf"]
+    n1["[0]"]
+    subgraph n2_group ["a<br/>dag"]
+      n2["a<br/>dag"]
+      n3["x<br/>echo"]
+      n4["y<br/>echo"]
+    end
+    n5["[1]"]
+    n6["b<br/>main"]
+    n7["c<br/>my-wftmpl/echo"]
+  end
+  n0 --> n1
+  n2 --> n3
+  n3 --> n4
+  n1 --> n2
+  n4 --> n5
+  n5 --> n6
+  n5 --> n7
+`, out.String())
+	}
+}
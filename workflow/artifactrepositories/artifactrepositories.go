@@ -90,8 +90,14 @@ func (s *artifactRepositories) get(ctx context.Context, ref *wfv1.ArtifactReposi
 		return nil, nil, fmt.Errorf(`config map missing key "%s" for artifact repository ref "%v"`, key, ref)
 	}
 	repo := &config.ArtifactRepository{}
+	if err := yaml.Unmarshal([]byte(value), repo); err != nil {
+		return nil, nil, err
+	}
+	if err := repo.Validate(); err != nil {
+		return nil, nil, fmt.Errorf(`invalid artifact repository for ref "%v": %w`, ref, err)
+	}
 	// we need the fully filled out ref so we can store it in the workflow status and it will never change
 	// (even if the config map default annotation is changed)
 	// this means users can change the default
-	return &wfv1.ArtifactRepositoryRefStatus{Namespace: namespace, ArtifactRepositoryRef: wfv1.ArtifactRepositoryRef{ConfigMap: configMap, Key: key}}, repo, yaml.Unmarshal([]byte(value), repo)
+	return &wfv1.ArtifactRepositoryRefStatus{Namespace: namespace, ArtifactRepositoryRef: wfv1.ArtifactRepositoryRef{ConfigMap: configMap, Key: key}}, repo, nil
 }
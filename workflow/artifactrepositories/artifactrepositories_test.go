@@ -73,6 +73,20 @@ func TestArtifactRepositories(t *testing.T) {
 		err = k.CoreV1().ConfigMaps("my-ns").Delete(ctx, "artifact-repositories", metav1.DeleteOptions{})
 		assert.NoError(t, err)
 	})
+	t.Run("Explicit.InvalidRepository", func(t *testing.T) {
+		ctx := context.Background()
+		_, err := k.CoreV1().ConfigMaps("my-wf-ns").Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "artifact-repositories"},
+			Data:       map[string]string{"my-key": "s3:\n  streaming:\n    partSize: 1Mi"},
+		}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+
+		_, err = i.Get(ctx, &wfv1.ArtifactRepositoryRefStatus{Namespace: "my-wf-ns", ArtifactRepositoryRef: wfv1.ArtifactRepositoryRef{Key: "my-key"}})
+		assert.EqualError(t, err, `invalid artifact repository for ref "my-wf-ns/#my-key": s3.streaming.partSize 1Mi is less than the minimum of 5Mi`)
+
+		err = k.CoreV1().ConfigMaps("my-wf-ns").Delete(ctx, "artifact-repositories", metav1.DeleteOptions{})
+		assert.NoError(t, err)
+	})
 	t.Run("WorkflowNamespaceDefault", func(t *testing.T) {
 		ctx := context.Background()
 		_, err := k.CoreV1().ConfigMaps("my-wf-ns").Create(ctx, &corev1.ConfigMap{
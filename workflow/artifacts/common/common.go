@@ -1,6 +1,11 @@
 package common
 
-import "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+import (
+	"io"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
 
 // ArtifactDriver is the interface for loading and saving of artifacts
 type ArtifactDriver interface {
@@ -12,3 +17,30 @@ type ArtifactDriver interface {
 
 	ListObjects(artifact *v1alpha1.Artifact) ([]string, error)
 }
+
+// ArtifactDeleter is implemented by drivers that can delete artifacts
+type ArtifactDeleter interface {
+	// Delete deletes the artifact, deleting an artifact that does not exist is not an error
+	Delete(artifact *v1alpha1.Artifact) error
+}
+
+// ArtifactStreamer is implemented by drivers that can stream artifacts, rather than staging them as local files
+type ArtifactStreamer interface {
+	// OpenStream opens the artifact for reading, returning a not found error if it does not exist
+	OpenStream(inputArtifact *v1alpha1.Artifact) (io.ReadCloser, error)
+
+	// SaveStream uploads everything read from the reader to the artifact destination
+	SaveStream(reader io.Reader, outputArtifact *v1alpha1.Artifact) error
+}
+
+// ObjectInfo is an object listed in an artifact repository
+type ObjectInfo struct {
+	Key          string
+	LastModified time.Time
+}
+
+// ArtifactObjectLister is implemented by drivers that can list objects together with when they were last modified
+type ArtifactObjectLister interface {
+	// ListObjectsInfo lists every object under the artifact's key
+	ListObjectsInfo(artifact *v1alpha1.Artifact) ([]ObjectInfo, error)
+}
@@ -0,0 +1,66 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+// UploadParts reads the reader in parts of partSize bytes, and uploads them with uploadPart, up to concurrency at a
+// time. Part numbers start at 1, and an empty reader is uploaded as a single empty part. Only concurrency parts are
+// held in memory at once, so reading blocks until a part has been uploaded. If the reader has more than maxParts parts,
+// it fails before uploading any more. It returns the number of parts uploaded.
+func UploadParts(reader io.Reader, partSize int64, concurrency int, maxParts int, uploadPart func(partNumber int, data []byte) error) (int, error) {
+	buffers := make(chan []byte, concurrency)
+	for i := 0; i < concurrency; i++ {
+		buffers <- nil // allocated on first use, so small artifacts do not allocate every buffer
+	}
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var uploadErr error
+	failed := func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		return uploadErr
+	}
+	partNumber := 0
+	for {
+		buf := <-buffers
+		if failed() != nil {
+			break
+		}
+		if buf == nil {
+			buf = make([]byte, partSize)
+		}
+		n, err := io.ReadFull(reader, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			wg.Wait()
+			return partNumber, err
+		}
+		if n == 0 && partNumber > 0 {
+			break
+		}
+		if partNumber >= maxParts {
+			wg.Wait()
+			return partNumber, fmt.Errorf("the artifact is larger than the maximum of %d parts of %d bytes, use a larger streaming.partSize", maxParts, partSize)
+		}
+		partNumber++
+		wg.Add(1)
+		go func(partNumber int, data []byte) {
+			defer wg.Done()
+			if err := uploadPart(partNumber, data); err != nil {
+				mu.Lock()
+				if uploadErr == nil {
+					uploadErr = fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+				}
+				mu.Unlock()
+			}
+			buffers <- data[:cap(data)]
+		}(partNumber, buf[:n])
+		if err != nil {
+			break
+		}
+	}
+	wg.Wait()
+	return partNumber, failed()
+}
@@ -0,0 +1,82 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadParts(t *testing.T) {
+	upload := func(t *testing.T, content string, partSize int64) map[int]string {
+		var mu sync.Mutex
+		parts := map[int]string{}
+		n, err := UploadParts(strings.NewReader(content), partSize, 2, 100, func(partNumber int, data []byte) error {
+			mu.Lock()
+			defer mu.Unlock()
+			parts[partNumber] = string(data)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Len(t, parts, n)
+		return parts
+	}
+	t.Run("Empty", func(t *testing.T) {
+		assert.Equal(t, map[int]string{1: ""}, upload(t, "", 4))
+	})
+	t.Run("ExactParts", func(t *testing.T) {
+		assert.Equal(t, map[int]string{1: "abcd", 2: "efgh"}, upload(t, "abcdefgh", 4))
+	})
+	t.Run("PartialLastPart", func(t *testing.T) {
+		assert.Equal(t, map[int]string{1: "abcd", 2: "efgh", 3: "ij"}, upload(t, "abcdefghij", 4))
+	})
+	t.Run("Concurrency", func(t *testing.T) {
+		var inFlight, maxInFlight int32
+		_, err := UploadParts(bytes.NewReader(make([]byte, 100)), 1, 3, 100, func(int, []byte) error {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+					break
+				}
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, maxInFlight, int32(3))
+	})
+	t.Run("UploadError", func(t *testing.T) {
+		_, err := UploadParts(strings.NewReader("abcdefghij"), 4, 1, 100, func(partNumber int, data []byte) error {
+			if partNumber == 2 {
+				return errors.New("boom")
+			}
+			return nil
+		})
+		assert.EqualError(t, err, "failed to upload part 2: boom")
+	})
+	t.Run("TooManyParts", func(t *testing.T) {
+		var uploaded int32
+		_, err := UploadParts(strings.NewReader("abcdefghij"), 4, 1, 2, func(int, []byte) error {
+			atomic.AddInt32(&uploaded, 1)
+			return nil
+		})
+		assert.EqualError(t, err, "the artifact is larger than the maximum of 2 parts of 4 bytes, use a larger streaming.partSize")
+		assert.Equal(t, int32(2), uploaded)
+	})
+	t.Run("MaxParts", func(t *testing.T) {
+		assert.Len(t, upload(t, "abcdefgh", 4), 2)
+	})
+	t.Run("ReadError", func(t *testing.T) {
+		_, err := UploadParts(&failingReader{}, 4, 1, 100, func(int, []byte) error { return nil })
+		assert.EqualError(t, err, "read failed")
+	})
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("read failed") }
@@ -0,0 +1,90 @@
+package fake
+
+import (
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// ArtifactDriver is an in-memory S3 artifact driver, for testing code that stores objects in an artifact repository
+type ArtifactDriver struct {
+	// Objects is the content of each object, keyed by S3 key
+	Objects map[string][]byte
+	// LastModified is when each object was last saved, keyed by S3 key
+	LastModified map[string]time.Time
+	now          func() time.Time
+	mutex        sync.Mutex
+}
+
+var (
+	_ common.ArtifactDriver       = &ArtifactDriver{}
+	_ common.ArtifactDeleter      = &ArtifactDriver{}
+	_ common.ArtifactObjectLister = &ArtifactDriver{}
+)
+
+// NewArtifactDriver returns an empty driver, that uses now to record when objects are saved
+func NewArtifactDriver(now func() time.Time) *ArtifactDriver {
+	return &ArtifactDriver{Objects: map[string][]byte{}, LastModified: map[string]time.Time{}, now: now}
+}
+
+func (d *ArtifactDriver) Load(art *wfv1.Artifact, path string) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return ioutil.WriteFile(path, d.Objects[art.S3.Key], 0o600)
+}
+
+func (d *ArtifactDriver) Save(path string, art *wfv1.Artifact) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.Objects[art.S3.Key] = data
+	d.LastModified[art.S3.Key] = d.now()
+	return nil
+}
+
+// ListObjects lists every object under the key, as S3 does
+func (d *ArtifactDriver) ListObjects(art *wfv1.Artifact) ([]string, error) {
+	objects, err := d.ListObjectsInfo(art)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(objects))
+	for i, o := range objects {
+		keys[i] = o.Key
+	}
+	return keys, nil
+}
+
+func (d *ArtifactDriver) ListObjectsInfo(art *wfv1.Artifact) ([]common.ObjectInfo, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	prefix := art.S3.Key
+	if prefix != "" {
+		prefix = path.Clean(prefix) + "/"
+	}
+	var objects []common.ObjectInfo
+	for key := range d.Objects {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, common.ObjectInfo{Key: key, LastModified: d.LastModified[key]})
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+func (d *ArtifactDriver) Delete(art *wfv1.Artifact) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	delete(d.Objects, art.S3.Key)
+	delete(d.LastModified, art.S3.Key)
+	return nil
+}
@@ -28,7 +28,10 @@ type ArtifactDriver struct {
 	ServiceAccountKey string
 }
 
-var _ common.ArtifactDriver = &ArtifactDriver{}
+var (
+	_ common.ArtifactDriver   = &ArtifactDriver{}
+	_ common.ArtifactStreamer = &ArtifactDriver{}
+)
 
 func (g *ArtifactDriver) newGCSClient() (*storage.Client, error) {
 	if g.ServiceAccountKey != "" {
@@ -252,6 +255,59 @@ func uploadObject(client *storage.Client, bucket, key, localPath string) error {
 	return nil
 }
 
+// objectReader closes the client once the object has been read
+type objectReader struct {
+	*storage.Reader
+	client *storage.Client
+}
+
+func (r *objectReader) Close() error {
+	defer r.client.Close()
+	return r.Reader.Close()
+}
+
+// OpenStream opens an object in GCS for reading
+func (g *ArtifactDriver) OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	log.Infof("GCS OpenStream key: %s", inputArtifact.GCS.Key)
+	client, err := g.newGCSClient()
+	if err != nil {
+		return nil, err
+	}
+	rc, err := client.Bucket(inputArtifact.GCS.Bucket).Object(inputArtifact.GCS.Key).NewReader(context.Background())
+	if err != nil {
+		_ = client.Close()
+		if err == storage.ErrObjectNotExist {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, fmt.Errorf("new bucket reader: %v", err)
+	}
+	return &objectReader{Reader: rc, client: client}, nil
+}
+
+// SaveStream saves an artifact to GCS as a resumable upload, in chunks of the part size. The GCS client retries failed
+// chunks, but uploads them in sequence, so the concurrency is not used.
+func (g *ArtifactDriver) SaveStream(reader io.Reader, outputArtifact *wfv1.Artifact) error {
+	partSize := outputArtifact.GCS.Streaming.GetPartSize()
+	log.Infof("GCS SaveStream key: %s, part size: %d", outputArtifact.GCS.Key, partSize)
+	client, err := g.newGCSClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	// cancelling the context before the writer is closed aborts the upload
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	wc := client.Bucket(outputArtifact.GCS.Bucket).Object(outputArtifact.GCS.Key).NewWriter(ctx)
+	wc.ChunkSize = int(partSize)
+	if _, err = io.Copy(wc, reader); err != nil {
+		return fmt.Errorf("io copy: %v", err)
+	}
+	if err := wc.Close(); err != nil {
+		return fmt.Errorf("writer close: %v", err)
+	}
+	return nil
+}
+
 func (g *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
 	return nil, fmt.Errorf("ListObjects is currently not supported for this artifact type, but it will be in a future version")
 }
@@ -1,8 +1,11 @@
 package oss
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
@@ -10,6 +13,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/wait"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
@@ -24,8 +28,9 @@ type ArtifactDriver struct {
 }
 
 var (
-	_            common.ArtifactDriver = &ArtifactDriver{}
-	defaultRetry                       = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1}
+	_            common.ArtifactDriver   = &ArtifactDriver{}
+	_            common.ArtifactStreamer = &ArtifactDriver{}
+	defaultRetry                         = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1}
 
 	// OSS error code reference: https://error-center.alibabacloud.com/status/product/Oss
 	ossTransientErrorCodes = []string{"RequestTimeout", "QuotaExceeded.Refresh", "Default", "ServiceUnavailable", "Throttling", "RequestTimeTooSkewed", "SocketException", "SocketTimeout", "ServiceBusy", "DomainNetWorkVisitedException", "ConnectionTimeout", "CachedTimeTooLarge"}
@@ -124,6 +129,80 @@ func (ossDriver *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact
 	return err
 }
 
+// OpenStream opens an artifact in OSS compliant storage for reading
+func (ossDriver *ArtifactDriver) OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	log.Infof("OSS OpenStream key: %s", inputArtifact.OSS.Key)
+	osscli, err := ossDriver.newOSSClient()
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := osscli.Bucket(inputArtifact.OSS.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := bucket.GetObject(inputArtifact.OSS.Key)
+	if ossErr, ok := err.(oss.ServiceError); ok && ossErr.Code == "NoSuchKey" {
+		return nil, errors.New(errors.CodeNotFound, err.Error())
+	}
+	return reader, err
+}
+
+// SaveStream saves an artifact to OSS compliant storage as a multipart upload, uploading the parts in parallel
+func (ossDriver *ArtifactDriver) SaveStream(reader io.Reader, outputArtifact *wfv1.Artifact) error {
+	bucketName, objectName := outputArtifact.OSS.Bucket, outputArtifact.OSS.Key
+	streaming := outputArtifact.OSS.Streaming
+	log.Infof("OSS SaveStream key: %s, part size: %d, concurrency: %d", objectName, streaming.GetPartSize(), streaming.GetConcurrency())
+	if err := streaming.ValidateMultipart(); err != nil {
+		return err
+	}
+	osscli, err := ossDriver.newOSSClient()
+	if err != nil {
+		return err
+	}
+	if outputArtifact.OSS.CreateBucketIfNotPresent {
+		exists, err := osscli.IsBucketExist(bucketName)
+		if err != nil {
+			return fmt.Errorf("failed to check if bucket %s exists: %w", bucketName, err)
+		}
+		if !exists {
+			if err := osscli.CreateBucket(bucketName); err != nil {
+				return fmt.Errorf("failed to automatically create bucket %s when it's not present: %w", bucketName, err)
+			}
+		}
+	}
+	bucket, err := osscli.Bucket(bucketName)
+	if err != nil {
+		return err
+	}
+	imur, err := bucket.InitiateMultipartUpload(objectName)
+	if err != nil {
+		return err
+	}
+	var mu sync.Mutex
+	var parts []oss.UploadPart
+	_, err = common.UploadParts(reader, streaming.GetPartSize(), streaming.GetConcurrency(), wfv1.MaxArtifactStreamingParts, func(partNumber int, data []byte) error {
+		return waitutil.Backoff(defaultRetry,
+			func() (bool, error) {
+				part, err := bucket.UploadPart(imur, bytes.NewReader(data), int64(len(data)), partNumber)
+				if err != nil {
+					return !isTransientOSSErr(err), err
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				parts = append(parts, part)
+				return true, nil
+			})
+	})
+	if err != nil {
+		if abortErr := bucket.AbortMultipartUpload(imur); abortErr != nil {
+			log.Warnf("Failed to abort multipart upload: %v", abortErr)
+		}
+		return err
+	}
+	_, err = bucket.CompleteMultipartUpload(imur, parts)
+	return err
+}
+
 func (ossDriver *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
 	var files []string
 	err := waitutil.Backoff(defaultRetry,
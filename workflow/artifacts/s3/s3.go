@@ -1,8 +1,14 @@
 package s3
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/argoproj/pkg/file"
@@ -13,6 +19,7 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
@@ -29,11 +36,15 @@ type ArtifactDriver struct {
 	Context     context.Context
 }
 
-var _ artifactscommon.ArtifactDriver = &ArtifactDriver{}
+var (
+	_ artifactscommon.ArtifactDriver       = &ArtifactDriver{}
+	_ artifactscommon.ArtifactDeleter      = &ArtifactDriver{}
+	_ artifactscommon.ArtifactStreamer     = &ArtifactDriver{}
+	_ artifactscommon.ArtifactObjectLister = &ArtifactDriver{}
+)
 
-// newMinioClient instantiates a new minio client object.
-func (s3Driver *ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Client, error) {
-	opts := argos3.S3ClientOpts{
+func (s3Driver *ArtifactDriver) clientOpts() argos3.S3ClientOpts {
+	return argos3.S3ClientOpts{
 		Endpoint:    s3Driver.Endpoint,
 		Region:      s3Driver.Region,
 		Secure:      s3Driver.Secure,
@@ -43,7 +54,20 @@ func (s3Driver *ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Clien
 		Trace:       os.Getenv(common.EnvVarArgoTrace) == "1",
 		UseSDKCreds: s3Driver.UseSDKCreds,
 	}
-	return argos3.NewS3Client(ctx, opts)
+}
+
+// newS3Client instantiates a new S3 client object.
+func (s3Driver *ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Client, error) {
+	return argos3.NewS3Client(ctx, s3Driver.clientOpts())
+}
+
+// newMinioClient instantiates a raw minio client, for operations the S3 client does not support
+func (s3Driver *ArtifactDriver) newMinioClient() (*minio.Client, error) {
+	creds, err := argos3.GetCredentials(s3Driver.clientOpts())
+	if err != nil {
+		return nil, err
+	}
+	return minio.New(s3Driver.Endpoint, &minio.Options{Creds: creds, Secure: s3Driver.Secure, Region: s3Driver.Region})
 }
 
 // Load downloads artifacts from S3 compliant storage
@@ -135,6 +159,80 @@ func (s3Driver *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact)
 	return err
 }
 
+// OpenStream opens an artifact in S3 compliant storage for reading
+func (s3Driver *ArtifactDriver) OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	log.Infof("S3 OpenStream key: %s", inputArtifact.S3.Key)
+	minioClient, err := s3Driver.newMinioClient()
+	if err != nil {
+		return nil, err
+	}
+	core := minio.Core{Client: minioClient}
+	reader, _, _, err := core.GetObject(context.Background(), inputArtifact.S3.Bucket, inputArtifact.S3.Key, minio.GetObjectOptions{})
+	if argos3.IsS3ErrCode(err, "NoSuchKey") {
+		return nil, errors.New(errors.CodeNotFound, err.Error())
+	}
+	return reader, err
+}
+
+// SaveStream saves an artifact to S3 compliant storage as a multipart upload, uploading the parts in parallel
+func (s3Driver *ArtifactDriver) SaveStream(reader io.Reader, outputArtifact *wfv1.Artifact) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bucket, key := outputArtifact.S3.Bucket, outputArtifact.S3.Key
+	streaming := outputArtifact.S3.Streaming
+	log.Infof("S3 SaveStream key: %s, part size: %d, concurrency: %d", key, streaming.GetPartSize(), streaming.GetConcurrency())
+	if err := streaming.ValidateMultipart(); err != nil {
+		return err
+	}
+	minioClient, err := s3Driver.newMinioClient()
+	if err != nil {
+		return err
+	}
+	if outputArtifact.S3.CreateBucketIfNotPresent != nil {
+		if exists, err := minioClient.BucketExists(ctx, bucket); err != nil || !exists {
+			log.Infof("Trying to create bucket: %s", bucket)
+			err := minioClient.MakeBucket(ctx, bucket, minio.MakeBucketOptions{
+				Region:        outputArtifact.S3.Region,
+				ObjectLocking: outputArtifact.S3.CreateBucketIfNotPresent.ObjectLocking,
+			})
+			if err != nil {
+				log.Warnf("Failed to create bucket: %v. Error: %v", bucket, err)
+			}
+		}
+	}
+	core := minio.Core{Client: minioClient}
+	uploadID, err := core.NewMultipartUpload(ctx, bucket, key, minio.PutObjectOptions{})
+	if err != nil {
+		return err
+	}
+	var mu sync.Mutex
+	var parts []minio.CompletePart
+	_, err = artifactscommon.UploadParts(reader, streaming.GetPartSize(), streaming.GetConcurrency(), wfv1.MaxArtifactStreamingParts, func(partNumber int, data []byte) error {
+		return waitutil.Backoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+			func() (bool, error) {
+				part, err := core.PutObjectPart(ctx, bucket, key, uploadID, partNumber, bytes.NewReader(data), int64(len(data)), "", "", nil)
+				if err != nil {
+					log.Warnf("Failed to put part %d: %v", partNumber, err)
+					return false, err
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				parts = append(parts, minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag})
+				return true, nil
+			})
+	})
+	if err != nil {
+		if abortErr := core.AbortMultipartUpload(ctx, bucket, key, uploadID); abortErr != nil {
+			log.Warnf("Failed to abort multipart upload: %v", abortErr)
+		}
+		return err
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+	_, err = core.CompleteMultipartUpload(ctx, bucket, key, uploadID, parts)
+	return err
+}
+
 func (s3Driver *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -155,3 +253,57 @@ func (s3Driver *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string,
 
 	return files, err
 }
+
+// ListObjectsInfo lists every object under the artifact's key, together with when it was last modified
+func (s3Driver *ArtifactDriver) ListObjectsInfo(artifact *wfv1.Artifact) ([]artifactscommon.ObjectInfo, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	prefix := artifact.S3.Key
+	if prefix != "" {
+		prefix = path.Clean(prefix) + "/"
+	}
+	var objects []artifactscommon.ObjectInfo
+	err := waitutil.Backoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			minioClient, err := s3Driver.newMinioClient()
+			if err != nil {
+				return false, err
+			}
+			objects = nil
+			for obj := range minioClient.ListObjects(ctx, artifact.S3.Bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+				if obj.Err != nil {
+					return false, obj.Err
+				}
+				// skip the nameless objects that represent directories
+				if strings.HasSuffix(obj.Key, "/") {
+					continue
+				}
+				objects = append(objects, artifactscommon.ObjectInfo{Key: obj.Key, LastModified: obj.LastModified})
+			}
+			return true, nil
+		})
+	return objects, err
+}
+
+// Delete deletes an artifact from S3 compliant storage
+func (s3Driver *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	return wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("S3 Delete key: %s", artifact.S3.Key)
+			minioClient, err := s3Driver.newMinioClient()
+			if err != nil {
+				log.Warnf("Failed to create new minio client: %v", err)
+				return false, nil
+			}
+			err = minioClient.RemoveObject(ctx, artifact.S3.Bucket, artifact.S3.Key, minio.RemoveObjectOptions{})
+			if err != nil {
+				log.Warnf("Failed to remove object: %v", err)
+				return false, nil
+			}
+			return true, nil
+		})
+}
@@ -17,6 +17,9 @@ const (
 	InitContainerName = "init"
 	WaitContainerName = "wait"
 
+	// MainLogsArtifactName is the name of the output artifact the main container's logs are saved as
+	MainLogsArtifactName = "main-logs"
+
 	// PodMetadataVolumeName is the volume name defined in a workflow pod spec to expose pod metadata via downward API
 	PodMetadataVolumeName = "podmetadata"
 
@@ -50,6 +53,9 @@ const (
 	// AnnotationKeyCronWfScheduledTime is the workflow metadata annotation key containing the time when the workflow
 	// was scheduled to run by CronWorkflow.
 	AnnotationKeyCronWfScheduledTime = workflow.WorkflowFullName + "/scheduled-time"
+	// AnnotationKeyLastAuditEvent is the workflow metadata annotation key containing the last audit event recorded by
+	// the Argo Server for the workflow, as JSON.
+	AnnotationKeyLastAuditEvent = workflow.WorkflowFullName + "/last-audit-event"
 
 	// LabelKeyControllerInstanceID is the label the controller will carry forward to workflows/pod labels
 	// for the purposes of workflow segregation
@@ -67,6 +73,9 @@ const (
 	// * `Archived` - has been archived
 	// See also `LabelKeyCompleted`.
 	LabelKeyWorkflowArchivingStatus = workflow.WorkflowFullName + "/workflow-archiving-status"
+	// LabelKeyWorkflowImported is the label applied to archived workflows imported back from cold storage, it is
+	// informational only, the archive records which workflows were imported itself
+	LabelKeyWorkflowImported = workflow.WorkflowFullName + "/imported"
 	// LabelKeyWorkflow is the pod metadata label to indicate the associated workflow name
 	LabelKeyWorkflow = workflow.WorkflowFullName + "/workflow"
 	// LabelKeyPhase is a label applied to workflows to indicate the current phase of the workflow (for filtering purposes)
@@ -75,10 +84,14 @@ const (
 	LabelKeyPreviousWorkflowName = workflow.WorkflowFullName + "/resubmitted-from-workflow"
 	// LabelKeyCronWorkflow is a label applied to Workflows that are started by a CronWorkflow
 	LabelKeyCronWorkflow = workflow.WorkflowFullName + "/cron-workflow"
+	// LabelKeyCronWorkflowBackfill is a label applied to Workflows that are started by a CronWorkflow backfill
+	LabelKeyCronWorkflowBackfill = workflow.WorkflowFullName + "/cron-workflow-backfill"
 	// LabelKeyWorkflowTemplate is a label applied to Workflows that are submitted from Workflowtemplate
 	LabelKeyWorkflowTemplate = workflow.WorkflowFullName + "/workflow-template"
 	// LabelKeyWorkflowEventBinding is a label applied to Workflows that are submitted from a WorkflowEventBinding
 	LabelKeyWorkflowEventBinding = workflow.WorkflowFullName + "/workflow-event-binding"
+	// LabelKeyEventDedupeKey is a label applied to Workflows submitted from a WorkflowEventBinding with a dedupe key, it is a hash of the key
+	LabelKeyEventDedupeKey = workflow.WorkflowFullName + "/event-dedupe-key"
 	// LabelKeyWorkflowTemplate is a label applied to Workflows that are submitted from ClusterWorkflowtemplate
 	LabelKeyClusterWorkflowTemplate = workflow.WorkflowFullName + "/cluster-workflow-template"
 	// LabelKeyOnExit is a label applied to Pods that are run from onExit nodes, so that they are not shut down when stopping a Workflow
@@ -119,6 +132,8 @@ const (
 	EnvVarKubeletInsecure = "ARGO_KUBELET_INSECURE"
 	// EnvVarArgoTrace is used enable tracing statements in Argo components
 	EnvVarArgoTrace = "ARGO_TRACE"
+	// EnvVarSensitiveParameterPrefix is the prefix of the envvars that contain the values of sensitive parameters
+	EnvVarSensitiveParameterPrefix = "ARGO_PARAMETER_"
 
 	// ContainerRuntimeExecutorDocker to use docker as container runtime executor
 	ContainerRuntimeExecutorDocker = "docker"
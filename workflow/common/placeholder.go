@@ -2,9 +2,13 @@ package common
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
+var placeholderRegexp = regexp.MustCompile(`placeholder-([0-9]+)`)
+
 // placeholderGenerator is to generate dynamically-generated placeholder strings.
 type placeholderGenerator struct {
 	index int
@@ -25,3 +29,14 @@ func (p *placeholderGenerator) NextPlaceholder() string {
 func (p *placeholderGenerator) IsPlaceholder(s string) bool {
 	return strings.HasPrefix(s, "placeholder-")
 }
+
+// ContainsPlaceholder returns whether the string contains a placeholder returned by the generator, e.g. because a
+// variable within it was substituted
+func (p *placeholderGenerator) ContainsPlaceholder(s string) bool {
+	for _, match := range placeholderRegexp.FindAllStringSubmatch(s, -1) {
+		if index, err := strconv.Atoi(match[1]); err == nil && index < p.index {
+			return true
+		}
+	}
+	return false
+}
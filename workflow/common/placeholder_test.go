@@ -13,3 +13,13 @@ func TestNextPlaceholder(t *testing.T) {
 	assert.Equal(t, pg.NextPlaceholder(), "placeholder-1")
 	assert.Equal(t, pg.NextPlaceholder(), "placeholder-2")
 }
+
+func TestContainsPlaceholder(t *testing.T) {
+	pg := NewPlaceholderGenerator()
+	assert.False(t, pg.ContainsPlaceholder("placeholder-0"), "not generated yet")
+	pg.NextPlaceholder()
+	assert.True(t, pg.ContainsPlaceholder("placeholder-0"))
+	assert.True(t, pg.ContainsPlaceholder("[1, placeholder-0]"))
+	assert.False(t, pg.ContainsPlaceholder("my-placeholder-x"))
+	assert.False(t, pg.ContainsPlaceholder("placeholder-1"))
+}
@@ -0,0 +1,65 @@
+package common
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	nonEnvVarChars               = regexp.MustCompile(`[^A-Z0-9_]`)
+	sensitiveParameterReferences = regexp.MustCompile(`\$\((` + EnvVarSensitiveParameterPrefix + `[A-Z0-9_]+)\)`)
+)
+
+// SensitiveParametersSecretName returns the name of the secret that contains the values of the workflow's sensitive parameters
+func SensitiveParametersSecretName(workflowName string) string {
+	return workflowName + "-sensitive-parameters"
+}
+
+// SensitiveParameterEnvVarName returns the name of the envvar that contains the value of the sensitive parameter
+func SensitiveParameterEnvVarName(name string) string {
+	return EnvVarSensitiveParameterPrefix + nonEnvVarChars.ReplaceAllString(strings.ToUpper(name), "_")
+}
+
+// SensitiveParameterReference returns the reference to the envvar that contains the value of the sensitive parameter,
+// which is used instead of its value. Kubernetes expands it in the command, args and env of containers.
+func SensitiveParameterReference(name string) string {
+	return "$(" + SensitiveParameterEnvVarName(name) + ")"
+}
+
+// GetSensitiveParameterEnvVarNames returns the names of the envvars referenced by the text, in the order they are first referenced
+func GetSensitiveParameterEnvVarNames(text string) []string {
+	var names []string
+	found := map[string]bool{}
+	for _, match := range sensitiveParameterReferences.FindAllStringSubmatch(text, -1) {
+		if !found[match[1]] {
+			names = append(names, match[1])
+			found[match[1]] = true
+		}
+	}
+	return names
+}
+
+// ExpandSensitiveParameters replaces the references to sensitive parameters in the text with the values of their envvars,
+// for text that Kubernetes does not expand, e.g. the source of scripts
+func ExpandSensitiveParameters(text string) string {
+	return sensitiveParameterReferences.ReplaceAllStringFunc(text, func(reference string) string {
+		name := sensitiveParameterReferences.FindStringSubmatch(reference)[1]
+		if value, ok := os.LookupEnv(name); ok {
+			return value
+		}
+		return reference
+	})
+}
+
+// GetSensitiveParameterValues returns the values of the sensitive parameters in the environment
+func GetSensitiveParameterValues() []string {
+	var values []string
+	for _, env := range os.Environ() {
+		parts := strings.SplitN(env, "=", 2)
+		if strings.HasPrefix(parts[0], EnvVarSensitiveParameterPrefix) && len(parts) == 2 && parts[1] != "" {
+			values = append(values, parts[1])
+		}
+	}
+	return values
+}
@@ -0,0 +1,26 @@
+package common
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSensitiveParameterEnvVarName(t *testing.T) {
+	assert.Equal(t, "ARGO_PARAMETER_PASSWORD", SensitiveParameterEnvVarName("password"))
+	assert.Equal(t, "ARGO_PARAMETER_MY_API_KEY", SensitiveParameterEnvVarName("my-api.key"))
+	assert.Equal(t, "$(ARGO_PARAMETER_PASSWORD)", SensitiveParameterReference("password"))
+}
+
+func TestGetSensitiveParameterEnvVarNames(t *testing.T) {
+	assert.Empty(t, GetSensitiveParameterEnvVarNames("$(FOO) $(ARGO_PARAMETER_)"))
+	assert.Equal(t, []string{"ARGO_PARAMETER_B", "ARGO_PARAMETER_A"}, GetSensitiveParameterEnvVarNames("$(ARGO_PARAMETER_B) $(ARGO_PARAMETER_A) $(ARGO_PARAMETER_B)"))
+}
+
+func TestExpandSensitiveParameters(t *testing.T) {
+	_ = os.Setenv("ARGO_PARAMETER_PASSWORD", "s3cret")
+	defer func() { _ = os.Unsetenv("ARGO_PARAMETER_PASSWORD") }()
+	assert.Equal(t, "login s3cret $(ARGO_PARAMETER_OTHER)", ExpandSensitiveParameters("login $(ARGO_PARAMETER_PASSWORD) $(ARGO_PARAMETER_OTHER)"))
+	assert.Contains(t, GetSensitiveParameterValues(), "s3cret")
+}
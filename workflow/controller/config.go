@@ -10,6 +10,7 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3/config"
 	"github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/persist/artifactoffload"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
@@ -26,6 +27,9 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 	if wfc.cliExecutorImage == "" && config.ExecutorImage == "" {
 		return errors.Errorf(errors.CodeBadRequest, "ConfigMap does not have executorImage")
 	}
+	if err := config.ArtifactRepository.Validate(); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "ConfigMap has an invalid artifactRepository: %v", err)
+	}
 	wfc.Config = *config
 	if wfc.session != nil {
 		err := wfc.session.Close()
@@ -80,6 +84,16 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 	} else {
 		log.Info("Persistence configuration disabled")
 	}
+	if offload := wfc.Config.ArtifactNodeStatusOffload; offload != nil {
+		if persistence != nil && persistence.NodeStatusOffload {
+			return errors.Errorf(errors.CodeBadRequest, "persistence.nodeStatusOffLoad and artifactNodeStatusOffload cannot both be enabled")
+		}
+		wfc.offloadNodeStatusRepo, err = artifactoffload.New(*offload, wfc.Config.ArtifactRepository, wfc.kubeclientset, wfc.namespace)
+		if err != nil {
+			return err
+		}
+		log.Info("Node status offloading to the artifact repository is enabled")
+	}
 	wfc.hydrator = hydrator.New(wfc.offloadNodeStatusRepo)
 	wfc.updateEstimatorFactory()
 	return nil
@@ -4,8 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func TestUpdateConfig(t *testing.T) {
@@ -18,3 +20,31 @@ func TestUpdateConfig(t *testing.T) {
 	assert.NotNil(t, controller.wfArchive)
 	assert.NotNil(t, controller.offloadNodeStatusRepo)
 }
+
+func TestUpdateConfigInvalidArtifactRepository(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	partSize := resource.MustParse("1Mi")
+	err := controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest", ArtifactRepository: config.ArtifactRepository{
+		S3: &config.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Streaming: &wfv1.ArtifactStreaming{PartSize: &partSize}}},
+	}})
+	assert.EqualError(t, err, "ConfigMap has an invalid artifactRepository: s3.streaming.partSize 1Mi is less than the minimum of 5Mi")
+}
+
+func TestUpdateConfigArtifactNodeStatusOffload(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	err := controller.updateConfig(&config.Config{
+		ExecutorImage:             "argoexec:latest",
+		ArtifactRepository:        config.ArtifactRepository{S3: &config.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}}},
+		ArtifactNodeStatusOffload: &config.ArtifactNodeStatusOffloadConfig{},
+	})
+	if assert.NoError(t, err) {
+		assert.True(t, controller.offloadNodeStatusRepo.IsEnabled())
+	}
+	err = controller.updateConfig(&config.Config{
+		ExecutorImage:             "argoexec:latest",
+		ArtifactNodeStatusOffload: &config.ArtifactNodeStatusOffloadConfig{},
+	})
+	assert.EqualError(t, err, "node status offload artifact repository must be S3")
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3"
 	"github.com/argoproj/argo-workflows/v3/config"
 	argoErr "github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/persist/coldstorage"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
@@ -170,7 +171,7 @@ func (wfc *WorkflowController) runTTLController(ctx context.Context, workflowTTL
 func (wfc *WorkflowController) runCronController(ctx context.Context) {
 	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
 
-	cronController := cron.NewCronController(wfc.wfclientset, wfc.dynamicInterface, wfc.wfInformer, wfc.namespace, wfc.GetManagedNamespace(), wfc.Config.InstanceID, wfc.metrics, wfc.eventRecorderManager)
+	cronController := cron.NewCronController(wfc.wfclientset, wfc.kubeclientset, wfc.dynamicInterface, wfc.wfInformer, wfc.namespace, wfc.GetManagedNamespace(), wfc.Config.InstanceID, wfc.metrics, wfc.eventRecorderManager)
 	cronController.Run(ctx)
 }
 
@@ -582,7 +583,16 @@ func (wfc *WorkflowController) archivedWorkflowGarbageCollector(stopCh <-chan st
 		log.Info("Archived workflows TTL zero - so archived workflow GC disabled - you must restart the controller if you enable this")
 		return
 	}
-	log.WithFields(log.Fields{"ttl": ttl, "periodicity": periodicity}).Info("Performing archived workflow GC")
+	var coldStorage coldstorage.Interface
+	if exportConfig := wfc.Config.Persistence.ArchiveExport; exportConfig != nil {
+		var err error
+		coldStorage, err = coldstorage.New(*exportConfig, wfc.Config.Persistence.GetClusterName(), wfc.kubeclientset, wfc.namespace)
+		if err != nil {
+			log.WithError(err).Error("Invalid archive export config - so archived workflow GC disabled - you must restart the controller if you fix this")
+			return
+		}
+	}
+	log.WithFields(log.Fields{"ttl": ttl, "periodicity": periodicity, "export": coldStorage != nil}).Info("Performing archived workflow GC")
 	ticker := time.NewTicker(periodicity)
 	defer ticker.Stop()
 	for {
@@ -591,6 +601,13 @@ func (wfc *WorkflowController) archivedWorkflowGarbageCollector(stopCh <-chan st
 			return
 		case <-ticker.C:
 			log.Info("Performing archived workflow GC")
+			if coldStorage != nil {
+				err := wfc.exportExpiredArchivedWorkflows(coldStorage, time.Duration(ttl))
+				if err != nil {
+					log.WithField("err", err).Error("Failed to export archived workflows")
+				}
+				continue
+			}
 			err := wfc.wfArchive.DeleteExpiredWorkflows(time.Duration(ttl))
 			if err != nil {
 				log.WithField("err", err).Error("Failed to delete archived workflows")
@@ -599,6 +616,66 @@ func (wfc *WorkflowController) archivedWorkflowGarbageCollector(stopCh <-chan st
 	}
 }
 
+// exportExpiredArchivedWorkflows exports expired archived workflows to cold storage in batches, deleting each
+// workflow only once it has been exported. A workflow that cannot be exported is logged and left in the archive, so
+// that it does not prevent the others being exported and deleted.
+func (wfc *WorkflowController) exportExpiredArchivedWorkflows(coldStorage coldstorage.Interface, ttl time.Duration) error {
+	ctx := context.Background()
+	batchSize := env.LookupEnvIntOr("ARCHIVED_WORKFLOW_EXPORT_BATCH_SIZE", 100)
+	// the number of workflows that could not be exported, and so are still at the beginning of the list
+	skipped := 0
+	for {
+		wfs, err := wfc.wfArchive.ListExpiredWorkflows(ttl, batchSize, skipped)
+		if err != nil {
+			return err
+		}
+		if len(wfs) == 0 {
+			return nil
+		}
+		var toExport wfv1.Workflows
+		for _, wf := range wfs {
+			err := wfc.hydrator.Hydrate(&wf)
+			if err != nil {
+				log.WithError(err).WithField("uid", wf.UID).Error("Failed to hydrate archived workflow, skipping export")
+				skipped++
+				continue
+			}
+			toExport = append(toExport, wf)
+		}
+		exported := wfc.exportArchivedWorkflows(ctx, coldStorage, toExport)
+		skipped += len(toExport) - len(exported)
+		for _, wf := range exported {
+			err := wfc.wfArchive.DeleteWorkflow(string(wf.UID))
+			if err != nil {
+				return err
+			}
+		}
+		log.WithFields(log.Fields{"exported": len(exported), "skipped": skipped}).Info("Exported and deleted archived workflows")
+	}
+}
+
+// exportArchivedWorkflows exports the workflows, if the batch cannot be exported then each workflow is exported on its
+// own, so a single bad workflow does not prevent the others being exported. It returns the exported workflows.
+func (wfc *WorkflowController) exportArchivedWorkflows(ctx context.Context, coldStorage coldstorage.Interface, wfs wfv1.Workflows) wfv1.Workflows {
+	if len(wfs) == 0 {
+		return nil
+	}
+	_, err := coldStorage.Export(ctx, wfs)
+	if err == nil {
+		return wfs
+	}
+	if len(wfs) == 1 {
+		log.WithError(err).WithField("uid", wfs[0].UID).Error("Failed to export archived workflow, skipping")
+		return nil
+	}
+	log.WithError(err).Warn("Failed to export batch of archived workflows, exporting them one at a time")
+	var exported wfv1.Workflows
+	for _, wf := range wfs {
+		exported = append(exported, wfc.exportArchivedWorkflows(ctx, coldStorage, wfv1.Workflows{wf})...)
+	}
+	return exported
+}
+
 func (wfc *WorkflowController) runWorker() {
 	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
 
@@ -877,6 +954,7 @@ func (wfc *WorkflowController) archiveWorkflowAux(ctx context.Context, obj inter
 	if err != nil {
 		return fmt.Errorf("failed to hydrate workflow: %w", err)
 	}
+	wf.MaskSensitiveParameters()
 	log.WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name, "uid": wf.UID}).Info("archiving workflow")
 	err = wfc.wfArchive.ArchiveWorkflow(wf)
 	if err != nil {
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -23,7 +24,9 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/coldstorage"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	sqldbmocks "github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/scheme"
@@ -509,6 +512,44 @@ func TestWorkflowController_archivedWorkflowGarbageCollector(t *testing.T) {
 	controller.archivedWorkflowGarbageCollector(make(chan struct{}))
 }
 
+type fakeColdStorage struct {
+	coldstorage.Interface
+	exported wfv1.Workflows
+}
+
+func (s *fakeColdStorage) Export(_ context.Context, wfs wfv1.Workflows) ([]string, error) {
+	for _, wf := range wfs {
+		if wf.UID == "bad-uid" {
+			return nil, fmt.Errorf("failed to export %s", wf.UID)
+		}
+	}
+	s.exported = append(s.exported, wfs...)
+	return []string{"my-key"}, nil
+}
+
+func TestWorkflowController_exportExpiredArchivedWorkflows(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wfArchive := &sqldbmocks.WorkflowArchive{}
+	controller.wfArchive = wfArchive
+	wfArchive.On("ListExpiredWorkflows", time.Hour, 100, 0).Return(wfv1.Workflows{
+		{ObjectMeta: metav1.ObjectMeta{UID: "bad-uid"}},
+		{ObjectMeta: metav1.ObjectMeta{UID: "my-uid"}},
+	}, nil).Once()
+	// the workflow that could not be exported is still in the archive, so is skipped
+	wfArchive.On("ListExpiredWorkflows", time.Hour, 100, 1).Return(wfv1.Workflows{}, nil)
+	wfArchive.On("DeleteWorkflow", "my-uid").Return(nil)
+	coldStorage := &fakeColdStorage{}
+
+	err := controller.exportExpiredArchivedWorkflows(coldStorage, time.Hour)
+	if assert.NoError(t, err) {
+		if assert.Len(t, coldStorage.exported, 1) {
+			assert.Equal(t, "my-uid", string(coldStorage.exported[0].UID))
+		}
+		wfArchive.AssertNumberOfCalls(t, "DeleteWorkflow", 1)
+	}
+}
+
 const wfWithTmplRef = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -319,7 +319,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		woc.markWorkflowRunning(ctx)
 	}
 
-	node, err := woc.executeTemplate(ctx, woc.wf.ObjectMeta.Name, &wfv1.WorkflowStep{Template: woc.execWf.Spec.Entrypoint}, tmplCtx, woc.execWf.Spec.Arguments, &executeTemplateOpts{})
+	node, err := woc.executeTemplate(ctx, woc.wf.ObjectMeta.Name, &wfv1.WorkflowStep{Template: woc.execWf.Spec.Entrypoint}, tmplCtx, woc.getExecutionArguments(), &executeTemplateOpts{})
 	if err != nil {
 		woc.log.WithError(err).Error("error in entry template execution")
 		// we wrap this error up to report a clear message
@@ -379,7 +379,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 
 		woc.log.Infof("Running OnExit handler: %s", woc.execWf.Spec.OnExit)
 		onExitNodeName := common.GenerateOnExitNodeName(woc.wf.ObjectMeta.Name)
-		onExitNode, err = woc.executeTemplate(ctx, onExitNodeName, &wfv1.WorkflowStep{Template: woc.execWf.Spec.OnExit}, tmplCtx, woc.execWf.Spec.Arguments, &executeTemplateOpts{onExitTemplate: true})
+		onExitNode, err = woc.executeTemplate(ctx, onExitNodeName, &wfv1.WorkflowStep{Template: woc.execWf.Spec.OnExit}, tmplCtx, woc.getExecutionArguments(), &executeTemplateOpts{onExitTemplate: true})
 		if err != nil {
 			// the error are handled in the callee so just log it.
 			woc.log.WithError(err).Error("error in exit template execution")
@@ -478,7 +478,7 @@ func (woc *wfOperationCtx) setGlobalParameters(executionParameters wfv1.Argument
 	}
 	woc.globalParams[common.GlobalVarWorkflowCreationTimestamp+".s"] = strconv.FormatInt(woc.wf.ObjectMeta.CreationTimestamp.Time.Unix(), 10)
 
-	if workflowParameters, err := json.Marshal(woc.execWf.Spec.Arguments.Parameters); err == nil {
+	if workflowParameters, err := json.Marshal(executionParameters.Parameters); err == nil {
 		woc.globalParams[common.GlobalVarWorkflowParameters] = string(workflowParameters)
 	}
 	for _, param := range executionParameters.Parameters {
@@ -2967,7 +2967,7 @@ func (woc *wfOperationCtx) runOnExitNode(ctx context.Context, templateRef, paren
 			onExitNodeName = legacyOnExitNodeName
 		}
 
-		onExitNode, err := woc.executeTemplate(ctx, onExitNodeName, &wfv1.WorkflowStep{Template: templateRef}, tmplCtx, woc.execWf.Spec.Arguments, &executeTemplateOpts{
+		onExitNode, err := woc.executeTemplate(ctx, onExitNodeName, &wfv1.WorkflowStep{Template: templateRef}, tmplCtx, woc.getExecutionArguments(), &executeTemplateOpts{
 			boundaryID:     boundaryID,
 			onExitTemplate: true,
 		})
@@ -3201,7 +3201,7 @@ func (woc *wfOperationCtx) retryStrategy(tmpl *wfv1.Template) *wfv1.RetryStrateg
 
 func (woc *wfOperationCtx) setExecWorkflow(ctx context.Context) error {
 	if woc.wf.Spec.WorkflowTemplateRef != nil {
-		err := woc.setStoredWfSpec()
+		err := woc.setStoredWfSpec(ctx)
 		if err != nil {
 			woc.markWorkflowError(ctx, err)
 			return err
@@ -3221,6 +3221,14 @@ func (woc *wfOperationCtx) setExecWorkflow(ctx context.Context) error {
 		woc.volumes = woc.wf.Spec.DeepCopy().Volumes
 	}
 
+	if !woc.wf.Status.Fulfilled() {
+		err := woc.ensureSensitiveParametersSecret(ctx)
+		if err != nil {
+			woc.markWorkflowError(ctx, err)
+			return err
+		}
+	}
+
 	// Perform one-time workflow validation
 	if woc.wf.Status.Phase == wfv1.WorkflowUnknown {
 		validateOpts := validate.ValidateOpts{ContainerRuntimeExecutor: woc.getContainerRuntimeExecutor()}
@@ -3241,7 +3249,7 @@ func (woc *wfOperationCtx) setExecWorkflow(ctx context.Context) error {
 			woc.updated = true
 		}
 	}
-	woc.setGlobalParameters(woc.execWf.Spec.Arguments)
+	woc.setGlobalParameters(woc.getExecutionArguments())
 	err := woc.substituteGlobalVariables()
 	if err != nil {
 		return err
@@ -3267,7 +3275,7 @@ func (woc *wfOperationCtx) needsStoredWfSpecUpdate() bool {
 		(woc.wf.Spec.Shutdown != woc.wf.Status.StoredWorkflowSpec.Shutdown)
 }
 
-func (woc *wfOperationCtx) setStoredWfSpec() error {
+func (woc *wfOperationCtx) setStoredWfSpec(ctx context.Context) error {
 	wfDefault := woc.controller.Config.WorkflowDefaults
 	if wfDefault == nil {
 		wfDefault = &wfv1.Workflow{}
@@ -3288,6 +3296,9 @@ func (woc *wfOperationCtx) setStoredWfSpec() error {
 			return err
 		}
 
+		// The stored spec is part of the status, so the values of sensitive parameters are delivered by a secret and masked.
+		mergedWf.Spec.Arguments.MaskParameters(mergedWf.Spec.Arguments.GetSensitiveParameterNames())
+
 		woc.wf.Status.StoredWorkflowSpec = &mergedWf.Spec
 		woc.updated = true
 	} else if woc.controller.Config.WorkflowRestrictions.MustNotChangeSpec() {
@@ -3299,6 +3310,7 @@ func (woc *wfOperationCtx) setStoredWfSpec() error {
 		if err != nil {
 			return err
 		}
+		mergedWf.Spec.Arguments.MaskParameters(mergedWf.Spec.Arguments.GetSensitiveParameterNames())
 		if mergedWf.Spec.String() != woc.wf.Status.StoredWorkflowSpec.String() {
 			return fmt.Errorf("workflowTemplateRef reference may not change during execution when the controller is in reference mode")
 		}
@@ -3306,6 +3318,133 @@ func (woc *wfOperationCtx) setStoredWfSpec() error {
 	return nil
 }
 
+// ensureSensitiveParametersSecret ensures that the secret that delivers the values of the sensitive parameters to pods
+// exists. It is ensured whenever the workflow is not completed, rather than only when it starts, because a workflow can
+// be created already running, e.g. when an archived workflow is retried.
+func (woc *wfOperationCtx) ensureSensitiveParametersSecret(ctx context.Context) error {
+	names := woc.wf.Spec.Arguments.GetSensitiveParameterNames()
+	if woc.wf.Status.StoredWorkflowSpec != nil {
+		for name := range woc.wf.Status.StoredWorkflowSpec.Arguments.GetSensitiveParameterNames() {
+			names[name] = true
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	secret, err := woc.controller.kubeclientset.CoreV1().Secrets(woc.wf.Namespace).Get(ctx, common.SensitiveParametersSecretName(woc.wf.Name), metav1.GetOptions{})
+	if err == nil {
+		owned, err := woc.ownsSensitiveParametersSecret(ctx, secret)
+		if err != nil || owned {
+			return err
+		}
+	} else if !apierr.IsNotFound(err) {
+		return fmt.Errorf("failed to get the secret of sensitive parameters: %w", err)
+	}
+	args, err := woc.getUnmaskedArguments()
+	if err != nil {
+		return err
+	}
+	return woc.createSensitiveParametersSecret(ctx, args)
+}
+
+// getUnmaskedArguments returns the arguments of the workflow, joined with those of its workflow template, unlike the
+// stored spec, whose sensitive parameters are masked
+func (woc *wfOperationCtx) getUnmaskedArguments() (wfv1.Arguments, error) {
+	if woc.wf.Spec.WorkflowTemplateRef == nil {
+		return woc.wf.Spec.Arguments, nil
+	}
+	wftHolder, err := woc.fetchWorkflowSpec()
+	if err != nil {
+		return wfv1.Arguments{}, err
+	}
+	wfDefault := woc.controller.Config.WorkflowDefaults
+	if wfDefault == nil {
+		wfDefault = &wfv1.Workflow{}
+	}
+	mergedWf, err := wfutil.JoinWorkflowSpec(&woc.wf.Spec, wftHolder.GetWorkflowSpec(), &wfDefault.Spec)
+	if err != nil {
+		return wfv1.Arguments{}, err
+	}
+	return mergedWf.Spec.Arguments, nil
+}
+
+// createSensitiveParametersSecret creates the secret that delivers the values of the sensitive parameters of the
+// arguments to pods
+func (woc *wfOperationCtx) createSensitiveParametersSecret(ctx context.Context, args wfv1.Arguments) error {
+	data := map[string]string{}
+	for _, param := range args.Parameters {
+		if !param.Sensitive || param.Value == nil {
+			continue
+		}
+		if param.Value.String() == wfv1.SensitiveParameterMask {
+			return fmt.Errorf("the value of sensitive parameter %q is masked, it must be supplied again", param.Name)
+		}
+		data[param.Name] = param.Value.String()
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   common.SensitiveParametersSecretName(woc.wf.Name),
+			Labels: map[string]string{common.LabelKeyWorkflow: woc.wf.Name},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+			},
+		},
+		StringData: data,
+	}
+	_, err := woc.controller.kubeclientset.CoreV1().Secrets(woc.wf.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+	if apierr.IsAlreadyExists(err) {
+		// e.g. created by another operation of this workflow, whose values are the same
+		existing, err := woc.controller.kubeclientset.CoreV1().Secrets(woc.wf.Namespace).Get(ctx, secret.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get the secret of sensitive parameters: %w", err)
+		}
+		if owner := metav1.GetControllerOf(existing); owner == nil || owner.UID != woc.wf.UID {
+			return fmt.Errorf("secret %s already exists, but is not owned by the workflow", secret.Name)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create the secret of sensitive parameters: %w", err)
+	}
+	woc.log.WithField("secret", secret.Name).Info("Created secret of sensitive parameters")
+	return nil
+}
+
+// ownsSensitiveParametersSecret returns whether the workflow owns the secret of sensitive parameters, whose values are
+// delivered to its pods. A secret left by an earlier workflow of the same name, that has not been garbage collected
+// yet, is deleted so that it can be created again. Any other secret, e.g. one created by a user, is an error.
+func (woc *wfOperationCtx) ownsSensitiveParametersSecret(ctx context.Context, secret *apiv1.Secret) (bool, error) {
+	owner := metav1.GetControllerOf(secret)
+	if owner != nil && owner.UID == woc.wf.UID {
+		return true, nil
+	}
+	if owner == nil || owner.Kind != workflow.WorkflowKind || owner.Name != woc.wf.Name {
+		return false, fmt.Errorf("secret %s already exists, but is not owned by the workflow", secret.Name)
+	}
+	woc.log.WithFields(log.Fields{"secret": secret.Name, "ownerUID": owner.UID}).Info("Deleting secret of sensitive parameters of an earlier workflow")
+	err := woc.controller.kubeclientset.CoreV1().Secrets(woc.wf.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &secret.UID}})
+	if err != nil && !apierr.IsNotFound(err) {
+		return false, fmt.Errorf("failed to delete the secret of sensitive parameters of an earlier workflow: %w", err)
+	}
+	return false, nil
+}
+
+// getExecutionArguments returns the arguments of the workflow, with the values of sensitive parameters replaced by
+// references to the envvars that deliver them to pods
+func (woc *wfOperationCtx) getExecutionArguments() wfv1.Arguments {
+	args := *woc.execWf.Spec.Arguments.DeepCopy()
+	for i, param := range args.Parameters {
+		if param.Sensitive {
+			args.Parameters[i].Value = wfv1.AnyStringPtr(common.SensitiveParameterReference(param.Name))
+			args.Parameters[i].Default = nil
+		}
+	}
+	return args
+}
+
 func (woc *wfOperationCtx) mergedTemplateDefaultsInto(originalTmpl *wfv1.Template) error {
 	if woc.execWf.Spec.TemplateDefaults != nil {
 		originalTmplType := originalTmpl.GetType()
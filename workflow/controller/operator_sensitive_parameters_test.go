@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const sensitiveParametersWf = `
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+      - name: password
+        value: s3cret
+        sensitive: true
+      - name: user
+        value: me
+  templates:
+    - name: main
+      inputs:
+        parameters:
+          - name: password
+      container:
+        image: my-image
+        args: ["login", "{{workflow.parameters.user}}", "{{inputs.parameters.password}}"]
+`
+
+func TestSensitiveParameters(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Workflow", func(t *testing.T) {
+		wf := unmarshalWF(sensitiveParametersWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		assert.Equal(t, "$(ARGO_PARAMETER_PASSWORD)", woc.globalParams["workflow.parameters.password"])
+		assert.Equal(t, "me", woc.globalParams["workflow.parameters.user"])
+		assert.NotContains(t, woc.globalParams["workflow.parameters"], "s3cret")
+
+		secret, err := controller.kubeclientset.CoreV1().Secrets("my-ns").Get(ctx, "my-wf-sensitive-parameters", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]string{"password": "s3cret"}, secret.StringData)
+			assert.Equal(t, "my-wf", secret.Labels[common.LabelKeyWorkflow])
+			assert.Len(t, secret.OwnerReferences, 1)
+		}
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("my-wf")
+		if assert.NotNil(t, node) && assert.NotNil(t, node.Inputs) {
+			assert.Equal(t, "$(ARGO_PARAMETER_PASSWORD)", node.Inputs.GetParameterByName("password").Value.String())
+		}
+
+		pods, err := listPods(woc)
+		if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+			pod := pods.Items[0]
+			for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+				if assert.NotEmpty(t, c.Env, c.Name) {
+					env := c.Env[0]
+					assert.Equal(t, "ARGO_PARAMETER_PASSWORD", env.Name)
+					if assert.NotNil(t, env.ValueFrom) && assert.NotNil(t, env.ValueFrom.SecretKeyRef) {
+						assert.Equal(t, "my-wf-sensitive-parameters", env.ValueFrom.SecretKeyRef.Name)
+						assert.Equal(t, "password", env.ValueFrom.SecretKeyRef.Key)
+					}
+				}
+				if c.Name == common.MainContainerName {
+					assert.Equal(t, []string{"login", "me", "$(ARGO_PARAMETER_PASSWORD)"}, c.Args)
+				}
+			}
+		}
+	})
+	t.Run("WorkflowTemplateRef", func(t *testing.T) {
+		wftmpl := unmarshalWFTmpl(`
+metadata:
+  name: my-wftmpl
+  namespace: my-ns
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+      - name: password
+        sensitive: true
+  templates:
+    - name: main
+      container:
+        image: my-image
+        args: ["{{workflow.parameters.password}}"]
+`)
+		wf := unmarshalWF(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  workflowTemplateRef:
+    name: my-wftmpl
+  arguments:
+    parameters:
+      - name: password
+        value: s3cret
+`)
+		cancel, controller := newController(wf, wftmpl)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		assert.Equal(t, wfv1.SensitiveParameterMask, woc.wf.Status.StoredWorkflowSpec.Arguments.GetParameterByName("password").Value.String())
+		assert.Equal(t, "$(ARGO_PARAMETER_PASSWORD)", woc.globalParams["workflow.parameters.password"])
+
+		secret, err := controller.kubeclientset.CoreV1().Secrets("my-ns").Get(ctx, "my-wf-sensitive-parameters", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]string{"password": "s3cret"}, secret.StringData)
+		}
+	})
+	t.Run("Running", func(t *testing.T) {
+		// e.g. a retried archived workflow, which is created running
+		wf := unmarshalWF(sensitiveParametersWf)
+		wf.Status.Phase = wfv1.WorkflowRunning
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		secret, err := controller.kubeclientset.CoreV1().Secrets("my-ns").Get(ctx, "my-wf-sensitive-parameters", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]string{"password": "s3cret"}, secret.StringData)
+		}
+	})
+	t.Run("EarlierWorkflow", func(t *testing.T) {
+		wf := unmarshalWF(sensitiveParametersWf)
+		wf.UID = "my-uid"
+		cancel, controller := newController(wf)
+		defer cancel()
+		_, err := controller.kubeclientset.CoreV1().Secrets("my-ns").Create(ctx, &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "my-wf-sensitive-parameters",
+				OwnerReferences: []metav1.OwnerReference{{Kind: workflow.WorkflowKind, Name: "my-wf", UID: "earlier-uid", Controller: pointer.BoolPtr(true)}},
+			},
+			StringData: map[string]string{"password": "earlier"},
+		}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		secret, err := controller.kubeclientset.CoreV1().Secrets("my-ns").Get(ctx, "my-wf-sensitive-parameters", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]string{"password": "s3cret"}, secret.StringData)
+			assert.Equal(t, types.UID("my-uid"), metav1.GetControllerOf(secret).UID)
+		}
+	})
+	t.Run("NotOwned", func(t *testing.T) {
+		wf := unmarshalWF(sensitiveParametersWf)
+		wf.UID = "my-uid"
+		cancel, controller := newController(wf)
+		defer cancel()
+		_, err := controller.kubeclientset.CoreV1().Secrets("my-ns").Create(ctx, &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf-sensitive-parameters"},
+			StringData: map[string]string{"password": "other"},
+		}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+		assert.Equal(t, "secret my-wf-sensitive-parameters already exists, but is not owned by the workflow", woc.wf.Status.Message)
+	})
+	t.Run("Masked", func(t *testing.T) {
+		wf := unmarshalWF(sensitiveParametersWf)
+		wf.MaskSensitiveParameters()
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+		assert.Equal(t, `the value of sensitive parameter "password" is masked, it must be supplied again`, woc.wf.Status.Message)
+	})
+}
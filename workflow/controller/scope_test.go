@@ -62,6 +62,8 @@ func TestSubPathResolution(t *testing.T) {
 	s3Artifact := `
   name: s3-artifact
   path: some/local/path
+  digest: sha256:ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb
+  sizeBytes: 1024
   s3:
     endpoint: minio:9000
     bucket: test-bucket
@@ -396,6 +396,11 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		}
 	}
 
+	err = woc.addSensitiveParameterEnvVars(pod)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the template has exceeded its timeout duration. If it hasn't set the applicable activeDeadlineSeconds
 	node := woc.wf.GetNodeByName(nodeName)
 	templateDeadline, err := woc.checkTemplateTimeout(tmpl, node)
@@ -463,6 +468,47 @@ func substitutePodParams(pod *apiv1.Pod, globalParams common.Parameters, tmpl *w
 	return &newSpec, nil
 }
 
+// addSensitiveParameterEnvVars adds the envvars that deliver the values of the sensitive parameters the pod references
+// to its containers, from the secret of sensitive parameters. The executor needs them too, to expand the references in
+// scripts and to redact the values from its logs.
+func (woc *wfOperationCtx) addSensitiveParameterEnvVars(pod *apiv1.Pod) error {
+	data, err := json.Marshal(pod)
+	if err != nil {
+		return err
+	}
+	keys := map[string]string{}
+	for name := range woc.execWf.Spec.Arguments.GetSensitiveParameterNames() {
+		keys[common.SensitiveParameterEnvVarName(name)] = name
+	}
+	var env []apiv1.EnvVar
+	for _, name := range common.GetSensitiveParameterEnvVarNames(string(data)) {
+		key, ok := keys[name]
+		if !ok {
+			continue
+		}
+		env = append(env, apiv1.EnvVar{
+			Name: name,
+			ValueFrom: &apiv1.EnvVarSource{
+				SecretKeyRef: &apiv1.SecretKeySelector{
+					LocalObjectReference: apiv1.LocalObjectReference{Name: common.SensitiveParametersSecretName(woc.wf.Name)},
+					Key:                  key,
+				},
+			},
+		})
+	}
+	if len(env) == 0 {
+		return nil
+	}
+	// prepended, so that other envvars can reference them
+	for i, c := range pod.Spec.InitContainers {
+		pod.Spec.InitContainers[i].Env = append(append([]apiv1.EnvVar{}, env...), c.Env...)
+	}
+	for i, c := range pod.Spec.Containers {
+		pod.Spec.Containers[i].Env = append(append([]apiv1.EnvVar{}, env...), c.Env...)
+	}
+	return nil
+}
+
 func (woc *wfOperationCtx) newInitContainer(tmpl *wfv1.Template) apiv1.Container {
 	ctr := woc.newExecContainer(common.InitContainerName, tmpl)
 	ctr.Command = []string{"argoexec", "init", "--loglevel", getExecutorLogLevel()}
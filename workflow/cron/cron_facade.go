@@ -45,19 +45,16 @@ func (f *cronFacade) Delete(key string) {
 	delete(f.entryIDs, key)
 }
 
-func (f *cronFacade) AddJob(key, schedule string, cwoc *cronWfOperationCtx) (ScheduledTimeFunc, error) {
+func (f *cronFacade) AddJob(key string, schedule cron.Schedule, cwoc *cronWfOperationCtx) ScheduledTimeFunc {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	entryID, err := f.cron.AddJob(schedule, cwoc)
-	if err != nil {
-		return nil, err
-	}
+	entryID := f.cron.Schedule(schedule, cwoc)
 	f.entryIDs[key] = entryID
 
 	// Return a function to return the last scheduled time
 	return func() time.Time {
 		return f.cron.Entry(entryID).Prev
-	}, nil
+	}
 }
 
 func (f *cronFacade) Load(key string) (*cronWfOperationCtx, error) {
@@ -5,19 +5,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/antonmedv/expr"
 	"github.com/robfig/cron/v3"
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	typed "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	cronutil "github.com/argoproj/argo-workflows/v3/util/cron"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
@@ -32,6 +36,7 @@ type cronWfOperationCtx struct {
 	name        string
 	cronWf      *v1alpha1.CronWorkflow
 	wfClientset versioned.Interface
+	kubeClient  kubernetes.Interface
 	wfClient    typed.WorkflowInterface
 	cronWfIf    typed.CronWorkflowInterface
 	log         *log.Entry
@@ -40,11 +45,12 @@ type cronWfOperationCtx struct {
 	scheduledTimeFunc ScheduledTimeFunc
 }
 
-func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset versioned.Interface, metrics *metrics.Metrics) *cronWfOperationCtx {
+func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset versioned.Interface, kubeClient kubernetes.Interface, metrics *metrics.Metrics) *cronWfOperationCtx {
 	return &cronWfOperationCtx{
 		name:        cronWorkflow.ObjectMeta.Name,
 		cronWf:      cronWorkflow,
 		wfClientset: wfClientset,
+		kubeClient:  kubeClient,
 		wfClient:    wfClientset.ArgoprojV1alpha1().Workflows(cronWorkflow.Namespace),
 		cronWfIf:    wfClientset.ArgoprojV1alpha1().CronWorkflows(cronWorkflow.Namespace),
 		log: log.WithFields(log.Fields{
@@ -78,6 +84,15 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 		return
 	}
 
+	skipReason, err := woc.skipReason(ctx, scheduledRuntime)
+	if err != nil {
+		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Failed to evaluate whether to run: %s", err))
+		return
+	} else if skipReason != "" {
+		woc.log.WithField("scheduledTime", scheduledRuntime).Infof("Skipping run because %s", skipReason)
+		return
+	}
+
 	proceed, err := woc.enforceRuntimePolicy(ctx)
 	if err != nil {
 		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Concurrency policy error: %s", err))
@@ -132,8 +147,27 @@ func (woc *cronWfOperationCtx) persistUpdate(ctx context.Context) {
 	woc.patch(ctx, map[string]interface{}{"status": woc.cronWf.Status})
 }
 
-func (woc *cronWfOperationCtx) persistUpdateActiveWorkflows(ctx context.Context) {
-	woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"active": woc.cronWf.Status.Active}})
+func (woc *cronWfOperationCtx) persistUpdateRuns(ctx context.Context) {
+	woc.patch(ctx, map[string]interface{}{"status": woc.runsStatus()})
+}
+
+func (woc *cronWfOperationCtx) persistUpdateSuspended(ctx context.Context) {
+	woc.patch(ctx, map[string]interface{}{"spec": map[string]interface{}{"suspend": true}, "status": woc.runsStatus()})
+}
+
+// runsStatus returns the status fields updated by reconcileActiveWfs, including unset ones, which must be explicitly
+// set to null to be removed
+func (woc *cronWfOperationCtx) runsStatus() map[string]interface{} {
+	status := woc.cronWf.Status
+	return map[string]interface{}{
+		"active":              status.Active,
+		"succeeded":           status.Succeeded,
+		"failed":              status.Failed,
+		"consecutiveFailures": status.ConsecutiveFailures,
+		"recentRuns":          status.RecentRuns,
+		"nextScheduledTime":   status.NextScheduledTime,
+		"conditions":          status.Conditions,
+	}
 }
 
 func (woc *cronWfOperationCtx) patch(ctx context.Context, patch map[string]interface{}) {
@@ -216,27 +250,10 @@ func (woc *cronWfOperationCtx) runOutstandingWorkflows(ctx context.Context) (boo
 func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun() (time.Time, error) {
 	// If this CronWorkflow has been run before, check if we have missed any scheduled executions
 	if woc.cronWf.Status.LastScheduledTime != nil {
-		var now time.Time
-		var cronSchedule cron.Schedule
-		if woc.cronWf.Spec.Timezone != "" {
-			loc, err := time.LoadLocation(woc.cronWf.Spec.Timezone)
-			if err != nil {
-				return time.Time{}, fmt.Errorf("invalid timezone '%s': %s", woc.cronWf.Spec.Timezone, err)
-			}
-			now = time.Now().In(loc)
-
-			cronScheduleString := "CRON_TZ=" + woc.cronWf.Spec.Timezone + " " + woc.cronWf.Spec.Schedule
-			cronSchedule, err = cron.ParseStandard(cronScheduleString)
-			if err != nil {
-				return time.Time{}, fmt.Errorf("unable to form timezone schedule '%s': %s", cronScheduleString, err)
-			}
-		} else {
-			var err error
-			now = time.Now()
-			cronSchedule, err = cron.ParseStandard(woc.cronWf.Spec.Schedule)
-			if err != nil {
-				return time.Time{}, err
-			}
+		now := time.Now()
+		cronSchedule, err := woc.parseSchedule()
+		if err != nil {
+			return time.Time{}, err
 		}
 
 		var missedExecutionTime time.Time
@@ -259,11 +276,204 @@ func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun() (time.Time, err
 	return time.Time{}, nil
 }
 
+func (woc *cronWfOperationCtx) parseSchedule() (cron.Schedule, error) {
+	return cronutil.ParseSchedules(woc.cronWf.Spec.GetSchedules(), woc.cronWf.Spec.Timezone)
+}
+
+// skipReason returns why the run at the scheduled time should be skipped, or empty if it should not be
+func (woc *cronWfOperationCtx) skipReason(ctx context.Context, scheduledTime time.Time) (string, error) {
+	if woc.cronWf.Spec.Timezone != "" {
+		loc, err := time.LoadLocation(woc.cronWf.Spec.Timezone)
+		if err != nil {
+			return "", fmt.Errorf("invalid timezone '%s': %s", woc.cronWf.Spec.Timezone, err)
+		}
+		scheduledTime = scheduledTime.In(loc)
+	}
+	excluded, err := woc.isExcluded(ctx, scheduledTime)
+	if err != nil {
+		return "", err
+	}
+	if excluded {
+		return fmt.Sprintf("%s is excluded", scheduledTime.Format("2006-01-02")), nil
+	}
+	if woc.cronWf.Spec.When == "" {
+		return "", nil
+	}
+	env, err := woc.whenEnv(ctx, scheduledTime)
+	if err != nil {
+		return "", err
+	}
+	result, err := expr.Eval(woc.cronWf.Spec.When, env)
+	if err != nil {
+		return "", fmt.Errorf("failed to evaluate when expression: %w", err)
+	}
+	run, ok := result.(bool)
+	if !ok {
+		return "", fmt.Errorf("when expression must evaluate to a boolean, got %v", result)
+	}
+	if !run {
+		return "the when expression is false", nil
+	}
+	return "", nil
+}
+
+func (woc *cronWfOperationCtx) isExcluded(ctx context.Context, scheduledTime time.Time) (bool, error) {
+	exclusions := woc.cronWf.Spec.Exclusions
+	if exclusions == nil {
+		return false, nil
+	}
+	date := scheduledTime.Format("2006-01-02")
+	for _, d := range exclusions.Dates {
+		if d == date {
+			return true, nil
+		}
+	}
+	ref := exclusions.ConfigMapKeyRef
+	if ref == nil {
+		return false, nil
+	}
+	cm, err := woc.kubeClient.CoreV1().ConfigMaps(woc.cronWf.Namespace).Get(ctx, ref.Name, v1.GetOptions{})
+	if errors.IsNotFound(err) && ref.Optional != nil && *ref.Optional {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get exclusions config map: %w", err)
+	}
+	value, ok := cm.Data[ref.Key]
+	if !ok && (ref.Optional == nil || !*ref.Optional) {
+		return false, fmt.Errorf("exclusions config map %q does not have key %q", ref.Name, ref.Key)
+	}
+	for _, line := range strings.Split(value, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && fields[0] == date {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// whenEnv returns the variables available to the when expression
+func (woc *cronWfOperationCtx) whenEnv(ctx context.Context, scheduledTime time.Time) (map[string]interface{}, error) {
+	status := map[string]interface{}{}
+	data, err := json.Marshal(woc.cronWf.Status)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &status); err != nil {
+		return nil, err
+	}
+	lastRun := map[string]interface{}{"name": "", "phase": "", "startedAt": "", "finishedAt": ""}
+	list, err := woc.wfClient.List(ctx, v1.ListOptions{LabelSelector: common.LabelKeyCronWorkflow + "=" + woc.cronWf.Name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	}
+	var last *v1alpha1.Workflow
+	for i, wf := range list.Items {
+		if wf.Status.Fulfilled() && (last == nil || wf.Status.FinishedAt.After(last.Status.FinishedAt.Time)) {
+			last = &list.Items[i]
+		}
+	}
+	if last != nil {
+		lastRun = map[string]interface{}{
+			"name":       last.Name,
+			"phase":      string(last.Status.Phase),
+			"startedAt":  last.Status.StartedAt.Format(time.RFC3339),
+			"finishedAt": last.Status.FinishedAt.Format(time.RFC3339),
+		}
+	}
+	return map[string]interface{}{"scheduledTime": scheduledTime, "lastRun": lastRun, "status": status}, nil
+}
+
+// runBackfill submits workflows for the scheduled times of the backfill in progress, if any, up to the number allowed
+// to run in parallel
+func (woc *cronWfOperationCtx) runBackfill(ctx context.Context, workflows []v1alpha1.Workflow) error {
+	backfill := woc.cronWf.Status.Backfill
+	if backfill == nil || woc.cronWf.Spec.Suspend {
+		return nil
+	}
+	cronSchedule, err := woc.parseSchedule()
+	if err != nil {
+		return err
+	}
+
+	maxParallel := backfill.GetMaxParallel()
+	switch woc.cronWf.Spec.ConcurrencyPolicy {
+	case v1alpha1.ForbidConcurrent, v1alpha1.ReplaceConcurrent:
+		// we never terminate workflows to make way for a backfill, we wait instead
+		if len(woc.cronWf.Status.Active) > 0 {
+			return nil
+		}
+		maxParallel = 1
+	}
+	running := 0
+	for _, wf := range workflows {
+		if wf.Labels[common.LabelKeyCronWorkflowBackfill] == "true" && !wf.Status.Fulfilled() {
+			running++
+		}
+	}
+
+	next := cronSchedule.Next(backfill.From.Add(-time.Second))
+	if backfill.Next != nil {
+		next = backfill.Next.Time
+	}
+	for ; running < maxParallel && !next.After(backfill.To.Time); next = cronSchedule.Next(next) {
+		skipReason, err := woc.skipReason(ctx, next)
+		if err != nil {
+			woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Failed to evaluate whether to run: %s", err))
+			break
+		} else if skipReason != "" {
+			woc.log.WithField("scheduledTime", next).Infof("Skipping backfill because %s", skipReason)
+			continue
+		}
+		wf := common.ConvertCronWorkflowToWorkflowWithProperties(woc.cronWf, getBackfillWorkflowName(woc.cronWf.Name, next), next)
+		wf.Labels[common.LabelKeyCronWorkflowBackfill] = "true"
+		if backfill.Parameter != "" {
+			wf.Spec.Arguments.Parameters = setParameter(wf.Spec.Arguments.Parameters, backfill.Parameter, next.Format(time.RFC3339))
+		}
+		runWf, err := util.SubmitWorkflow(ctx, woc.wfClient, woc.wfClientset, woc.cronWf.Namespace, wf, &v1alpha1.SubmitOpts{})
+		if errors.IsAlreadyExists(err) {
+			continue
+		}
+		if err != nil {
+			woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Failed to submit backfill Workflow: %s", err))
+			break
+		}
+		woc.log.WithField("scheduledTime", next).Info("Submitted backfill workflow")
+		woc.cronWf.Status.Active = append(woc.cronWf.Status.Active, getWorkflowObjectReference(wf, runWf))
+		woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeSubmissionError)
+		running++
+	}
+
+	status := map[string]interface{}{"active": woc.cronWf.Status.Active, "conditions": woc.cronWf.Status.Conditions}
+	if next.After(backfill.To.Time) {
+		woc.log.Info("Backfill complete")
+		woc.cronWf.Status.Backfill = nil
+		// the backfill must be explicitly set to null to remove it
+		status["backfill"] = nil
+	} else {
+		backfill.Next = &v1.Time{Time: next}
+		status["backfill"] = backfill
+	}
+	woc.patch(ctx, map[string]interface{}{"status": status})
+	return nil
+}
+
+// setParameter returns the parameters with the named parameter set to the value, adding it if needed
+func setParameter(parameters []v1alpha1.Parameter, name, value string) []v1alpha1.Parameter {
+	for i, p := range parameters {
+		if p.Name == name {
+			parameters[i].Value = v1alpha1.AnyStringPtr(value)
+			return parameters
+		}
+	}
+	return append(parameters, v1alpha1.Parameter{Name: name, Value: v1alpha1.AnyStringPtr(value)})
+}
+
 func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows []v1alpha1.Workflow) error {
 	updated := false
-	currentWfsFulfilled := make(map[types.UID]bool)
+	currentWfs := make(map[types.UID]v1alpha1.Workflow)
 	for _, wf := range workflows {
-		currentWfsFulfilled[wf.UID] = wf.Status.Fulfilled()
+		currentWfs[wf.UID] = wf
 
 		if !woc.cronWf.Status.HasActiveUID(wf.UID) && !wf.Status.Fulfilled() {
 			updated = true
@@ -271,20 +481,107 @@ func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows
 		}
 	}
 
+	var completed []v1alpha1.Workflow
 	for _, objectRef := range woc.cronWf.Status.Active {
-		if fulfilled, found := currentWfsFulfilled[objectRef.UID]; !found || fulfilled {
+		wf, found := currentWfs[objectRef.UID]
+		if !found || wf.Status.Fulfilled() {
 			updated = true
 			woc.removeFromActiveList(objectRef.UID)
 		}
+		// workflows deleted before they completed are not counted
+		if found && wf.Status.Fulfilled() {
+			completed = append(completed, wf)
+		}
+	}
+	sort.Slice(completed, func(i, j int) bool {
+		return completed[i].Status.FinishedAt.Before(&completed[j].Status.FinishedAt)
+	})
+	for _, wf := range completed {
+		woc.recordRun(wf)
+	}
+
+	if woc.reconcileStopStrategy() {
+		woc.persistUpdateSuspended(ctx)
+		return nil
+	}
+
+	if woc.reconcileNextScheduledTime() {
+		updated = true
 	}
 
 	if updated {
-		woc.persistUpdateActiveWorkflows(ctx)
+		woc.persistUpdateRuns(ctx)
 	}
 
 	return nil
 }
 
+// recordRun updates the run statistics with the outcome of a completed workflow
+func (woc *cronWfOperationCtx) recordRun(wf v1alpha1.Workflow) {
+	status := &woc.cronWf.Status
+	if wf.Status.Successful() {
+		status.Succeeded++
+		status.ConsecutiveFailures = 0
+	} else {
+		status.Failed++
+		status.ConsecutiveFailures++
+	}
+	status.RecentRuns = append([]v1alpha1.CronWorkflowRun{{
+		Name:       wf.Name,
+		Phase:      wf.Status.Phase,
+		StartedAt:  wf.Status.StartedAt,
+		FinishedAt: wf.Status.FinishedAt,
+		Message:    wf.Status.Message,
+	}}, status.RecentRuns...)
+	if len(status.RecentRuns) > v1alpha1.MaxCronWorkflowRecentRuns {
+		status.RecentRuns = status.RecentRuns[:v1alpha1.MaxCronWorkflowRecentRuns]
+	}
+	woc.log.WithFields(log.Fields{"workflowName": wf.Name, "phase": wf.Status.Phase}).Info("Workflow completed")
+}
+
+// reconcileStopStrategy suspends the CronWorkflow if its stop strategy says it should be, returning true if it did so
+func (woc *cronWfOperationCtx) reconcileStopStrategy() bool {
+	status := &woc.cronWf.Status
+	if !woc.cronWf.Spec.Suspend && status.Conditions.HasCondition(v1alpha1.ConditionTypeAutoSuspended) {
+		// the CronWorkflow has been resumed since it was suspended, so we start counting failures again
+		status.Conditions.RemoveCondition(v1alpha1.ConditionTypeAutoSuspended)
+		status.ConsecutiveFailures = 0
+	}
+	stopStrategy := woc.cronWf.Spec.StopStrategy
+	if woc.cronWf.Spec.Suspend || stopStrategy == nil || stopStrategy.ConsecutiveFailures <= 0 || status.ConsecutiveFailures < int64(stopStrategy.ConsecutiveFailures) {
+		return false
+	}
+	message := fmt.Sprintf("Suspended after %d consecutive failed workflows", status.ConsecutiveFailures)
+	woc.log.Info(message)
+	woc.cronWf.Spec.Suspend = true
+	status.NextScheduledTime = nil
+	status.Conditions.UpsertCondition(v1alpha1.Condition{
+		Type:    v1alpha1.ConditionTypeAutoSuspended,
+		Message: message,
+		Status:  v1.ConditionTrue,
+	})
+	return true
+}
+
+// reconcileNextScheduledTime updates the next scheduled time, returning true if it changed
+func (woc *cronWfOperationCtx) reconcileNextScheduledTime() bool {
+	var next *v1.Time
+	if !woc.cronWf.Spec.Suspend {
+		cronSchedule, err := woc.parseSchedule()
+		if err != nil {
+			woc.log.WithError(err).Error("failed to parse schedule")
+			return false
+		}
+		next = &v1.Time{Time: cronSchedule.Next(time.Now())}
+	}
+	current := woc.cronWf.Status.NextScheduledTime
+	if (current == nil && next == nil) || (current != nil && next != nil && current.Equal(next)) {
+		return false
+	}
+	woc.cronWf.Status.NextScheduledTime = next
+	return true
+}
+
 func (woc *cronWfOperationCtx) removeFromActiveList(uid types.UID) {
 	var newActive []corev1.ObjectReference
 	for _, ref := range woc.cronWf.Status.Active {
@@ -380,3 +677,9 @@ func inferScheduledTime() time.Time {
 func getChildWorkflowName(cronWorkflowName string, scheduledRuntime time.Time) string {
 	return fmt.Sprintf("%s-%d", cronWorkflowName, scheduledRuntime.Unix())
 }
+
+// getBackfillWorkflowName returns a name distinct from the scheduled workflow's, so that times that have already been
+// run can be backfilled
+func getBackfillWorkflowName(cronWorkflowName string, scheduledRuntime time.Time) string {
+	return fmt.Sprintf("%s-backfill-%d", cronWorkflowName, scheduledRuntime.Unix())
+}
@@ -8,7 +8,11 @@ import (
 	"github.com/argoproj/pkg/humanize"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -271,3 +275,243 @@ func TestScheduleTimeParam(t *testing.T) {
 	assert.Len(t, wf.GetAnnotations(), 1)
 	assert.NotEmpty(t, wf.GetAnnotations()[common.AnnotationKeyCronWfScheduledTime])
 }
+
+func TestRunBackfill(t *testing.T) {
+	ctx := context.Background()
+	var cronWf v1alpha1.CronWorkflow
+	err := yaml.Unmarshal([]byte(scheduledWf), &cronWf)
+	assert.NoError(t, err)
+	cronWf.Spec.Schedule = "0 * * * *"
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	cronWf.Status.Backfill = &v1alpha1.CronWorkflowBackfill{
+		From:        v1.Time{Time: from},
+		To:          v1.Time{Time: from.Add(3 * time.Hour)},
+		MaxParallel: 2,
+		Parameter:   "date",
+	}
+
+	cs := fake.NewSimpleClientset(&cronWf)
+	newWoc := func(cronWf *v1alpha1.CronWorkflow) *cronWfOperationCtx {
+		return &cronWfOperationCtx{
+			wfClientset: cs,
+			wfClient:    cs.ArgoprojV1alpha1().Workflows("argo"),
+			cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+			cronWf:      cronWf,
+			log:         logrus.WithFields(logrus.Fields{}),
+			metrics:     metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}),
+		}
+	}
+	listWorkflows := func() []v1alpha1.Workflow {
+		list, err := cs.ArgoprojV1alpha1().Workflows("argo").List(ctx, v1.ListOptions{})
+		assert.NoError(t, err)
+		return list.Items
+	}
+
+	woc := newWoc(&cronWf)
+	if assert.NoError(t, woc.runBackfill(ctx, nil)) {
+		wfs := listWorkflows()
+		if assert.Len(t, wfs, 2, "up to max parallel workflows are run") {
+			wf := wfs[0]
+			assert.Equal(t, "hello-world-backfill-1609459200", wf.Name)
+			assert.Equal(t, "true", wf.Labels[common.LabelKeyCronWorkflowBackfill])
+			assert.Equal(t, "2021-01-01T00:00:00Z", wf.Annotations[common.AnnotationKeyCronWfScheduledTime])
+			if assert.Len(t, wf.Spec.Arguments.Parameters, 1) {
+				assert.Equal(t, "date", wf.Spec.Arguments.Parameters[0].Name)
+				assert.Equal(t, "2021-01-01T00:00:00Z", wf.Spec.Arguments.Parameters[0].Value.String())
+			}
+		}
+		if assert.NotNil(t, woc.cronWf.Status.Backfill) && assert.NotNil(t, woc.cronWf.Status.Backfill.Next) {
+			assert.Equal(t, from.Add(2*time.Hour), woc.cronWf.Status.Backfill.Next.Time.UTC())
+		}
+		assert.Len(t, woc.cronWf.Status.Active, 2)
+	}
+
+	woc = newWoc(woc.cronWf)
+	if assert.NoError(t, woc.runBackfill(ctx, listWorkflows())) {
+		assert.Len(t, listWorkflows(), 2, "no more workflows are run while max parallel are running")
+	}
+
+	wfs := listWorkflows()
+	for i := range wfs {
+		wfs[i].Status.Phase = v1alpha1.WorkflowSucceeded
+	}
+	woc = newWoc(woc.cronWf)
+	if assert.NoError(t, woc.runBackfill(ctx, wfs)) {
+		assert.Len(t, listWorkflows(), 4, "the rest of the workflows are run")
+		assert.Nil(t, woc.cronWf.Status.Backfill, "the backfill is complete")
+	}
+}
+
+func TestRunBackfillForbidConcurrent(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	err := yaml.Unmarshal([]byte(scheduledWf), &cronWf)
+	assert.NoError(t, err)
+	cronWf.Spec.ConcurrencyPolicy = v1alpha1.ForbidConcurrent
+	cronWf.Status.Active = []corev1.ObjectReference{{Name: "my-wf"}}
+	cronWf.Status.Backfill = &v1alpha1.CronWorkflowBackfill{From: v1.Now(), To: v1.Now()}
+
+	cs := fake.NewSimpleClientset(&cronWf)
+	woc := &cronWfOperationCtx{
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows("argo"),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+	}
+	if assert.NoError(t, woc.runBackfill(context.Background(), nil)) {
+		list, err := cs.ArgoprojV1alpha1().Workflows("argo").List(context.Background(), v1.ListOptions{})
+		assert.NoError(t, err)
+		assert.Empty(t, list.Items, "backfill waits for the active workflow")
+		assert.NotNil(t, woc.cronWf.Status.Backfill)
+	}
+}
+
+func TestSkipReason(t *testing.T) {
+	ctx := context.Background()
+	newWoc := func(spec v1alpha1.CronWorkflowSpec, objects ...runtime.Object) *cronWfOperationCtx {
+		cs := fake.NewSimpleClientset(&v1alpha1.Workflow{
+			ObjectMeta: v1.ObjectMeta{Name: "my-cwf-1", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyCronWorkflow: "my-cwf"}},
+			Status:     v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowFailed, FinishedAt: v1.Now()},
+		})
+		return &cronWfOperationCtx{
+			cronWf:     &v1alpha1.CronWorkflow{ObjectMeta: v1.ObjectMeta{Name: "my-cwf", Namespace: "my-ns"}, Spec: spec},
+			kubeClient: kubefake.NewSimpleClientset(objects...),
+			wfClient:   cs.ArgoprojV1alpha1().Workflows("my-ns"),
+		}
+	}
+	christmas := time.Date(2021, 12, 25, 9, 0, 0, 0, time.UTC)
+	t.Run("None", func(t *testing.T) {
+		reason, err := newWoc(v1alpha1.CronWorkflowSpec{}).skipReason(ctx, christmas)
+		if assert.NoError(t, err) {
+			assert.Empty(t, reason)
+		}
+	})
+	t.Run("ExcludedDate", func(t *testing.T) {
+		reason, err := newWoc(v1alpha1.CronWorkflowSpec{Exclusions: &v1alpha1.CronExclusions{Dates: []string{"2021-12-25"}}}).skipReason(ctx, christmas)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "2021-12-25 is excluded", reason)
+		}
+	})
+	t.Run("ExcludedDateInTimezone", func(t *testing.T) {
+		reason, err := newWoc(v1alpha1.CronWorkflowSpec{Timezone: "Asia/Tokyo", Exclusions: &v1alpha1.CronExclusions{Dates: []string{"2021-12-26"}}}).skipReason(ctx, christmas.Add(15*time.Hour))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "2021-12-26 is excluded", reason)
+		}
+	})
+	t.Run("ExcludedConfigMap", func(t *testing.T) {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: v1.ObjectMeta{Name: "holidays", Namespace: "my-ns"},
+			Data:       map[string]string{"dates": "# public holidays\n2021-12-25 Christmas Day\n2021-12-26 Boxing Day\n"},
+		}
+		ref := &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "holidays"}, Key: "dates"}
+		woc := newWoc(v1alpha1.CronWorkflowSpec{Exclusions: &v1alpha1.CronExclusions{ConfigMapKeyRef: ref}}, cm)
+		reason, err := woc.skipReason(ctx, christmas)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "2021-12-25 is excluded", reason)
+		}
+		reason, err = woc.skipReason(ctx, christmas.Add(48*time.Hour))
+		if assert.NoError(t, err) {
+			assert.Empty(t, reason)
+		}
+	})
+	t.Run("MissingConfigMap", func(t *testing.T) {
+		ref := &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "holidays"}, Key: "dates"}
+		_, err := newWoc(v1alpha1.CronWorkflowSpec{Exclusions: &v1alpha1.CronExclusions{ConfigMapKeyRef: ref}}).skipReason(ctx, christmas)
+		assert.Error(t, err)
+	})
+	t.Run("When", func(t *testing.T) {
+		reason, err := newWoc(v1alpha1.CronWorkflowSpec{When: `scheduledTime.Weekday().String() != "Saturday"`}).skipReason(ctx, christmas)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "the when expression is false", reason)
+		}
+	})
+	t.Run("WhenLastRun", func(t *testing.T) {
+		reason, err := newWoc(v1alpha1.CronWorkflowSpec{When: `lastRun.phase == "Failed" && lastRun.name == "my-cwf-1"`}).skipReason(ctx, christmas)
+		if assert.NoError(t, err) {
+			assert.Empty(t, reason)
+		}
+	})
+	t.Run("WhenNotBoolean", func(t *testing.T) {
+		_, err := newWoc(v1alpha1.CronWorkflowSpec{When: `"foo"`}).skipReason(ctx, christmas)
+		assert.EqualError(t, err, "when expression must evaluate to a boolean, got foo")
+	})
+}
+
+func TestReconcileActiveWfsStopStrategy(t *testing.T) {
+	ctx := context.Background()
+	var cronWf v1alpha1.CronWorkflow
+	err := yaml.Unmarshal([]byte(scheduledWf), &cronWf)
+	assert.NoError(t, err)
+	cronWf.Spec.StopStrategy = &v1alpha1.StopStrategy{ConsecutiveFailures: 2}
+
+	cs := fake.NewSimpleClientset(&cronWf)
+	newWoc := func(cronWf *v1alpha1.CronWorkflow) *cronWfOperationCtx {
+		return &cronWfOperationCtx{
+			wfClientset: cs,
+			wfClient:    cs.ArgoprojV1alpha1().Workflows("argo"),
+			cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+			cronWf:      cronWf,
+			log:         logrus.WithFields(logrus.Fields{}),
+		}
+	}
+	now := time.Now()
+	newWf := func(name string, phase v1alpha1.WorkflowPhase, finishedAt time.Time) v1alpha1.Workflow {
+		return v1alpha1.Workflow{
+			ObjectMeta: v1.ObjectMeta{Name: name, UID: types.UID(name)},
+			Status:     v1alpha1.WorkflowStatus{Phase: phase, FinishedAt: v1.Time{Time: finishedAt}},
+		}
+	}
+
+	woc := newWoc(&cronWf)
+	t.Run("Running", func(t *testing.T) {
+		wfs := []v1alpha1.Workflow{newWf("a", v1alpha1.WorkflowSucceeded, now), newWf("b", v1alpha1.WorkflowRunning, time.Time{})}
+		// "a" was never seen running, so it is not counted
+		if assert.NoError(t, woc.reconcileActiveWfs(ctx, wfs)) {
+			assert.Len(t, woc.cronWf.Status.Active, 1)
+			assert.Zero(t, woc.cronWf.Status.Succeeded)
+			assert.NotNil(t, woc.cronWf.Status.NextScheduledTime)
+		}
+	})
+	t.Run("Failed", func(t *testing.T) {
+		woc = newWoc(woc.cronWf)
+		woc.cronWf.Status.Active = []corev1.ObjectReference{{Name: "b", UID: "b"}, {Name: "c", UID: "c"}}
+		woc.cronWf.Status.Succeeded = 1
+		wfs := []v1alpha1.Workflow{newWf("b", v1alpha1.WorkflowFailed, now), newWf("c", v1alpha1.WorkflowSucceeded, now.Add(-time.Minute))}
+		if assert.NoError(t, woc.reconcileActiveWfs(ctx, wfs)) {
+			status := woc.cronWf.Status
+			assert.Empty(t, status.Active)
+			assert.Equal(t, int64(2), status.Succeeded)
+			assert.Equal(t, int64(1), status.Failed)
+			assert.Equal(t, int64(1), status.ConsecutiveFailures)
+			if assert.Len(t, status.RecentRuns, 2) {
+				assert.Equal(t, "b", status.RecentRuns[0].Name, "latest first")
+				assert.Equal(t, v1alpha1.WorkflowFailed, status.RecentRuns[0].Phase)
+			}
+			assert.False(t, woc.cronWf.Spec.Suspend)
+		}
+	})
+	t.Run("Suspended", func(t *testing.T) {
+		woc = newWoc(woc.cronWf)
+		woc.cronWf.Status.Active = []corev1.ObjectReference{{Name: "d", UID: "d"}}
+		if assert.NoError(t, woc.reconcileActiveWfs(ctx, []v1alpha1.Workflow{newWf("d", v1alpha1.WorkflowError, now)})) {
+			assert.True(t, woc.cronWf.Spec.Suspend)
+			assert.Equal(t, int64(2), woc.cronWf.Status.ConsecutiveFailures)
+			assert.Nil(t, woc.cronWf.Status.NextScheduledTime)
+			if assert.Len(t, woc.cronWf.Status.Conditions, 1) {
+				assert.Equal(t, v1alpha1.ConditionTypeAutoSuspended, woc.cronWf.Status.Conditions[0].Type)
+				assert.Equal(t, "Suspended after 2 consecutive failed workflows", woc.cronWf.Status.Conditions[0].Message)
+			}
+		}
+	})
+	t.Run("Resumed", func(t *testing.T) {
+		resumed, err := cs.ArgoprojV1alpha1().CronWorkflows("argo").Patch(ctx, "hello-world", types.MergePatchType, []byte(`{"spec":{"suspend":false}}`), v1.PatchOptions{})
+		assert.NoError(t, err)
+		woc = newWoc(resumed)
+		if assert.NoError(t, woc.reconcileActiveWfs(ctx, nil)) {
+			assert.False(t, woc.cronWf.Spec.Suspend)
+			assert.Zero(t, woc.cronWf.Status.ConsecutiveFailures)
+			assert.Empty(t, woc.cronWf.Status.Conditions)
+			assert.Equal(t, int64(2), woc.cronWf.Status.Failed)
+		}
+	})
+}
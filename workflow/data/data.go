@@ -1,7 +1,12 @@
 package data
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
+	"reflect"
 
 	"github.com/antonmedv/expr"
 
@@ -29,6 +34,18 @@ func processSource(source wfv1.DataSource, processor wfv1.DataSourceProcessor) (
 		if err != nil {
 			return nil, fmt.Errorf("unable to source artifact paths: %w", err)
 		}
+	case source.ArtifactFiles != nil:
+		data, err = processor.ProcessArtifactFiles(source.ArtifactFiles)
+		if err != nil {
+			return nil, fmt.Errorf("unable to source artifact files: %w", err)
+		}
+	case source.HTTP != nil:
+		data, err = processor.ProcessHTTP(source.HTTP)
+		if err != nil {
+			return nil, fmt.Errorf("unable to source HTTP: %w", err)
+		}
+	case source.Value != nil:
+		data = processValue(*source.Value)
 	default:
 		return nil, fmt.Errorf("no valid source is used for data template")
 	}
@@ -36,6 +53,15 @@ func processSource(source wfv1.DataSource, processor wfv1.DataSourceProcessor) (
 	return data, nil
 }
 
+// processValue returns the JSON value, or the value itself if it is not JSON
+func processValue(value string) interface{} {
+	data, err := Parse(wfv1.DataFormatJSON, value)
+	if err != nil {
+		return value
+	}
+	return data
+}
+
 func processTransformation(data interface{}, transformation *wfv1.Transformation) (interface{}, error) {
 	if transformation == nil {
 		return data, nil
@@ -46,6 +72,16 @@ func processTransformation(data interface{}, transformation *wfv1.Transformation
 		switch {
 		case step.Expression != "":
 			data, err = processExpression(step.Expression, data)
+		case step.Parse != "":
+			data, err = processParse(step.Parse, data)
+		case step.Filter != "":
+			data, err = processFilter(step.Filter, data)
+		case step.Map != "":
+			data, err = processMap(step.Map, data)
+		case step.GroupBy != "":
+			data, err = processGroupBy(step.GroupBy, data)
+		case step.Chunk > 0:
+			data, err = processChunk(int(step.Chunk), data)
 		}
 		if err != nil {
 			return nil, fmt.Errorf("error processing data step %d: %w", i, err)
@@ -58,3 +94,153 @@ func processTransformation(data interface{}, transformation *wfv1.Transformation
 func processExpression(expression string, data interface{}) (interface{}, error) {
 	return expr.Eval(expression, map[string]interface{}{"data": data})
 }
+
+func processItemExpression(expression string, item interface{}) (interface{}, error) {
+	return expr.Eval(expression, map[string]interface{}{"item": item})
+}
+
+// Parse parses the text in the format
+func Parse(format wfv1.DataFormat, text string) (interface{}, error) {
+	switch format {
+	case wfv1.DataFormatJSON:
+		var data interface{}
+		d := json.NewDecoder(bytes.NewBufferString(text))
+		if err := d.Decode(&data); err != nil {
+			return nil, err
+		}
+		if d.More() {
+			return nil, fmt.Errorf("unexpected data after JSON value")
+		}
+		return data, nil
+	case wfv1.DataFormatCSV:
+		r := csv.NewReader(bytes.NewBufferString(text))
+		header, err := r.Read()
+		if err == io.EOF {
+			return []interface{}{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		items := []interface{}{}
+		for {
+			record, err := r.Read()
+			if err == io.EOF {
+				return items, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			item := map[string]interface{}{}
+			for i, column := range header {
+				item[column] = record[i]
+			}
+			items = append(items, item)
+		}
+	default:
+		return nil, fmt.Errorf("unknown format %q, must be one of: json, csv", format)
+	}
+}
+
+func processParse(format wfv1.DataFormat, data interface{}) (interface{}, error) {
+	if text, ok := data.(string); ok {
+		return Parse(format, text)
+	}
+	items, err := toList(data)
+	if err != nil {
+		return nil, fmt.Errorf("can only parse strings or lists of strings: %w", err)
+	}
+	for i, item := range items {
+		text, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("can only parse strings or lists of strings, item %d is %T", i, item)
+		}
+		items[i], err = Parse(format, text)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse item %d: %w", i, err)
+		}
+	}
+	return items, nil
+}
+
+func processFilter(expression string, data interface{}) (interface{}, error) {
+	items, err := toList(data)
+	if err != nil {
+		return nil, err
+	}
+	filtered := []interface{}{}
+	for i, item := range items {
+		result, err := processItemExpression(expression, item)
+		if err != nil {
+			return nil, fmt.Errorf("unable to filter item %d: %w", i, err)
+		}
+		keep, ok := result.(bool)
+		if !ok {
+			return nil, fmt.Errorf("filter expression must be a boolean, but is %T for item %d", result, i)
+		}
+		if keep {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+func processMap(expression string, data interface{}) (interface{}, error) {
+	items, err := toList(data)
+	if err != nil {
+		return nil, err
+	}
+	for i, item := range items {
+		items[i], err = processItemExpression(expression, item)
+		if err != nil {
+			return nil, fmt.Errorf("unable to map item %d: %w", i, err)
+		}
+	}
+	return items, nil
+}
+
+func processGroupBy(expression string, data interface{}) (interface{}, error) {
+	items, err := toList(data)
+	if err != nil {
+		return nil, err
+	}
+	groups := map[string]interface{}{}
+	for i, item := range items {
+		key, err := processItemExpression(expression, item)
+		if err != nil {
+			return nil, fmt.Errorf("unable to group item %d: %w", i, err)
+		}
+		k := fmt.Sprint(key)
+		group, _ := groups[k].([]interface{})
+		groups[k] = append(group, item)
+	}
+	return groups, nil
+}
+
+func processChunk(size int, data interface{}) (interface{}, error) {
+	items, err := toList(data)
+	if err != nil {
+		return nil, err
+	}
+	chunks := []interface{}{}
+	for start := 0; start < len(items); start += size {
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		chunks = append(chunks, items[start:end])
+	}
+	return chunks, nil
+}
+
+// toList returns a copy of the list data as a []interface{}, whatever the type of its items
+func toList(data interface{}) ([]interface{}, error) {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("data must be a list, but is %T", data)
+	}
+	items := make([]interface{}, v.Len())
+	for i := range items {
+		items[i] = v.Index(i).Interface()
+	}
+	return items, nil
+}
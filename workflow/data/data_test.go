@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -17,6 +18,14 @@ func (t testDataSourceProcessor) ProcessArtifactPaths(*v1alpha1.ArtifactPaths) (
 	return []interface{}{"foo.py", "bar.pdf", "goo/foo.py", "moo/bar.pdf"}, nil
 }
 
+func (t testDataSourceProcessor) ProcessArtifactFiles(*v1alpha1.ArtifactFiles) (interface{}, error) {
+	return []interface{}{map[string]interface{}{"path": "foo.json", "content": `{"a":1}`}}, nil
+}
+
+func (t testDataSourceProcessor) ProcessHTTP(*v1alpha1.HTTPDataSource) (interface{}, error) {
+	return map[string]interface{}{"a": 1.0}, nil
+}
+
 type nullTestDataSourceProcessor struct{}
 
 var _ v1alpha1.DataSourceProcessor = &nullTestDataSourceProcessor{}
@@ -25,6 +34,14 @@ func (t nullTestDataSourceProcessor) ProcessArtifactPaths(*v1alpha1.ArtifactPath
 	return nil, fmt.Errorf("cannot get artifacts")
 }
 
+func (t nullTestDataSourceProcessor) ProcessArtifactFiles(*v1alpha1.ArtifactFiles) (interface{}, error) {
+	return nil, fmt.Errorf("cannot get artifacts")
+}
+
+func (t nullTestDataSourceProcessor) ProcessHTTP(*v1alpha1.HTTPDataSource) (interface{}, error) {
+	return nil, fmt.Errorf("cannot get URL")
+}
+
 func TestProcessSource(t *testing.T) {
 	artifactPathsSource := v1alpha1.DataSource{ArtifactPaths: &v1alpha1.ArtifactPaths{}}
 	data, err := processSource(artifactPathsSource, &testDataSourceProcessor{})
@@ -37,6 +54,101 @@ func TestProcessSource(t *testing.T) {
 
 	_, err = processSource(v1alpha1.DataSource{}, &nullTestDataSourceProcessor{})
 	assert.Error(t, err)
+
+	data, err = processSource(v1alpha1.DataSource{ArtifactFiles: &v1alpha1.ArtifactFiles{}}, &testDataSourceProcessor{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{map[string]interface{}{"path": "foo.json", "content": `{"a":1}`}}, data)
+	}
+	_, err = processSource(v1alpha1.DataSource{ArtifactFiles: &v1alpha1.ArtifactFiles{}}, &nullTestDataSourceProcessor{})
+	assert.Error(t, err)
+
+	data, err = processSource(v1alpha1.DataSource{HTTP: &v1alpha1.HTTPDataSource{}}, &testDataSourceProcessor{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]interface{}{"a": 1.0}, data)
+	}
+	_, err = processSource(v1alpha1.DataSource{HTTP: &v1alpha1.HTTPDataSource{}}, &nullTestDataSourceProcessor{})
+	assert.Error(t, err)
+
+	data, err = processSource(v1alpha1.DataSource{Value: pointer.StringPtr(`["a", "b"]`)}, &nullTestDataSourceProcessor{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{"a", "b"}, data)
+	}
+	data, err = processSource(v1alpha1.DataSource{Value: pointer.StringPtr(`not json`)}, &nullTestDataSourceProcessor{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "not json", data)
+	}
+	data, err = processSource(v1alpha1.DataSource{Value: pointer.StringPtr("")}, &nullTestDataSourceProcessor{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "", data, "an empty value is a value")
+	}
+}
+
+func TestParse(t *testing.T) {
+	data, err := Parse(v1alpha1.DataFormatJSON, `{"a": [1, "b"]}`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]interface{}{"a": []interface{}{1.0, "b"}}, data)
+	}
+	_, err = Parse(v1alpha1.DataFormatJSON, `{} {}`)
+	assert.Error(t, err)
+
+	data, err = Parse(v1alpha1.DataFormatCSV, "name,region\nfoo,eu\nbar,us\n")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"name": "foo", "region": "eu"},
+			map[string]interface{}{"name": "bar", "region": "us"},
+		}, data)
+	}
+	data, err = Parse(v1alpha1.DataFormatCSV, "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{}, data)
+	}
+	_, err = Parse(v1alpha1.DataFormatCSV, "name,region\nfoo\n")
+	assert.Error(t, err)
+
+	_, err = Parse("xml", "<a/>")
+	assert.EqualError(t, err, `unknown format "xml", must be one of: json, csv`)
+}
+
+func TestProcessStructuredTransformation(t *testing.T) {
+	csv := "name,region,size\nfoo,eu,1\nbar,us,2\nbaz,eu,3\n"
+	t.Run("Parse", func(t *testing.T) {
+		data, err := processTransformation([]interface{}{`{"a":1}`, `[2]`}, &v1alpha1.Transformation{{Parse: v1alpha1.DataFormatJSON}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []interface{}{map[string]interface{}{"a": 1.0}, []interface{}{2.0}}, data)
+		}
+		_, err = processTransformation([]interface{}{1.0}, &v1alpha1.Transformation{{Parse: v1alpha1.DataFormatJSON}})
+		assert.Error(t, err)
+	})
+	t.Run("FilterMap", func(t *testing.T) {
+		data, err := processTransformation(csv, &v1alpha1.Transformation{{Parse: v1alpha1.DataFormatCSV}, {Filter: `item.region == "eu"`}, {Map: `item.name`}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []interface{}{"foo", "baz"}, data)
+		}
+		_, err = processTransformation([]interface{}{"a"}, &v1alpha1.Transformation{{Filter: `item`}})
+		assert.EqualError(t, err, "error processing data step 0: filter expression must be a boolean, but is string for item 0")
+	})
+	t.Run("GroupBy", func(t *testing.T) {
+		data, err := processTransformation(csv, &v1alpha1.Transformation{{Parse: v1alpha1.DataFormatCSV}, {Map: `item.name`}, {GroupBy: `len(item)`}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]interface{}{"3": []interface{}{"foo", "bar", "baz"}}, data)
+		}
+		data, err = processTransformation(csv, &v1alpha1.Transformation{{Parse: v1alpha1.DataFormatCSV}, {GroupBy: `item.region`}, {Expression: `len(data.eu)`}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, 2, data)
+		}
+	})
+	t.Run("Chunk", func(t *testing.T) {
+		data, err := processTransformation([]string{"a", "b", "c"}, &v1alpha1.Transformation{{Chunk: 2}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []interface{}{[]interface{}{"a", "b"}, []interface{}{"c"}}, data)
+		}
+		data, err = processTransformation([]interface{}{}, &v1alpha1.Transformation{{Chunk: 2}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []interface{}{}, data)
+		}
+		_, err = processTransformation("a", &v1alpha1.Transformation{{Chunk: 2}})
+		assert.EqualError(t, err, "error processing data step 0: data must be a list, but is string")
+	})
 }
 
 func TestProcessTransformation(t *testing.T) {
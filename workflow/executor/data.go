@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"k8s.io/utils/pointer"
 
@@ -25,7 +28,14 @@ func (we *WorkflowExecutor) Data(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	we.Template.Outputs.Result = pointer.StringPtr(string(out))
+	if dataTemplate.OutputArtifact != "" {
+		err = we.saveDataOutputArtifact(ctx, dataTemplate.OutputArtifact, out)
+		if err != nil {
+			return fmt.Errorf("unable to save output artifact: %w", err)
+		}
+	} else {
+		we.Template.Outputs.Result = pointer.StringPtr(string(out))
+	}
 	err = we.AnnotateOutputs(ctx, nil)
 	if err != nil {
 		return err
@@ -33,3 +43,29 @@ func (we *WorkflowExecutor) Data(ctx context.Context) error {
 
 	return nil
 }
+
+// saveDataOutputArtifact saves the result of a data template to its output artifact, as a JSON file
+func (we *WorkflowExecutor) saveDataOutputArtifact(ctx context.Context, name string, out []byte) error {
+	for i, art := range we.Template.Outputs.Artifacts {
+		if art.Name != name {
+			continue
+		}
+		err := os.MkdirAll(tempOutArtDir, os.ModePerm)
+		if err != nil {
+			return err
+		}
+		fileName := art.Name + ".json"
+		localArtPath := filepath.Join(tempOutArtDir, fileName)
+		err = ioutil.WriteFile(localArtPath, out, 0o644)
+		if err != nil {
+			return err
+		}
+		err = we.saveArtifactFromFile(ctx, &art, fileName, localArtPath)
+		if err != nil {
+			return err
+		}
+		we.Template.Outputs.Artifacts[i] = art
+		return nil
+	}
+	return fmt.Errorf("output artifact %q not found", name)
+}
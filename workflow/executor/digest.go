@@ -0,0 +1,62 @@
+package executor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const digestAlgorithm = "sha256:"
+
+// digester computes the digest and size of everything written to it
+type digester struct {
+	hash hash.Hash
+	size int64
+}
+
+func newDigester() *digester {
+	return &digester{hash: sha256.New()}
+}
+
+func (d *digester) Write(p []byte) (int, error) {
+	d.size += int64(len(p))
+	return d.hash.Write(p)
+}
+
+func (d *digester) digest() string {
+	return digestAlgorithm + hex.EncodeToString(d.hash.Sum(nil))
+}
+
+// digestFile returns the digest and size of the file
+func digestFile(path string) (string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, errors.InternalWrapError(err)
+	}
+	defer f.Close()
+	d := newDigester()
+	if _, err := io.Copy(d, f); err != nil {
+		return "", 0, errors.InternalWrapError(err)
+	}
+	return d.digest(), d.size, nil
+}
+
+// verifyDigest returns an error if the artifact has a digest and it is not the digest of its loaded content
+func verifyDigest(art *wfv1.Artifact, digest string) error {
+	if art.Digest == "" {
+		return nil
+	}
+	if !strings.HasPrefix(art.Digest, digestAlgorithm) {
+		return errors.Errorf(errors.CodeBadRequest, "artifact %s has digest %q with an unsupported algorithm, must be sha256", art.Name, art.Digest)
+	}
+	if art.Digest != digest {
+		return errors.Errorf(errors.CodeBadRequest, "artifact %s failed integrity verification: expected digest %s, but got %s, it may be corrupt or have been overwritten", art.Name, art.Digest, digest)
+	}
+	return nil
+}
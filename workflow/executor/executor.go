@@ -12,6 +12,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"os/signal"
 	"path"
 	"path/filepath"
@@ -177,51 +178,60 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 			artPath = path.Join(common.ExecutorMainFilesystemDir, art.Path)
 		}
 
-		// The artifact is downloaded to a temporary location, after which we determine if
-		// the file is a tarball or not. If it is, it is first extracted then renamed to
-		// the desired location. If not, it is simply renamed to the location.
-		tempArtPath := artPath + ".tmp"
-		err = artDriver.Load(driverArt, tempArtPath)
+		streamed, err := loadArtifactStream(&art, driverArt, artDriver, artPath)
 		if err != nil {
-			if art.Optional && errors.IsCode(errors.CodeNotFound, err) {
-				log.Infof("Skipping optional input artifact that was not found: %s", art.Name)
-				continue
-			}
 			return err
 		}
-
-		isTar := false
-		isZip := false
-		if art.GetArchive().None != nil {
-			// explicitly not a tar
-			isTar = false
-			isZip = false
-		} else if art.GetArchive().Tar != nil {
-			// explicitly a tar
-			isTar = true
-		} else if art.GetArchive().Zip != nil {
-			// explicitly a zip
-			isZip = true
-		} else {
-			// auto-detect if tarball
-			// (don't try to autodetect zip files for backwards compatibility)
-			isTar, err = isTarball(tempArtPath)
+		if !streamed {
+			// The artifact is downloaded to a temporary location, after which we determine if
+			// the file is a tarball or not. If it is, it is first extracted then renamed to
+			// the desired location. If not, it is simply renamed to the location.
+			tempArtPath := artPath + ".tmp"
+			err = artDriver.Load(driverArt, tempArtPath)
 			if err != nil {
+				if art.Optional && errors.IsCode(errors.CodeNotFound, err) {
+					log.Infof("Skipping optional input artifact that was not found: %s", art.Name)
+					continue
+				}
+				return err
+			}
+			if err := verifyLoadedArtifact(&art, tempArtPath); err != nil {
 				return err
 			}
-		}
 
-		if isTar {
-			err = untar(tempArtPath, artPath)
-			_ = os.Remove(tempArtPath)
-		} else if isZip {
-			err = unzip(tempArtPath, artPath)
-			_ = os.Remove(tempArtPath)
-		} else {
-			err = os.Rename(tempArtPath, artPath)
-		}
-		if err != nil {
-			return err
+			isTar := false
+			isZip := false
+			if art.GetArchive().None != nil {
+				// explicitly not a tar
+				isTar = false
+				isZip = false
+			} else if art.GetArchive().Tar != nil {
+				// explicitly a tar
+				isTar = true
+			} else if art.GetArchive().Zip != nil {
+				// explicitly a zip
+				isZip = true
+			} else {
+				// auto-detect if tarball
+				// (don't try to autodetect zip files for backwards compatibility)
+				isTar, err = isTarball(tempArtPath)
+				if err != nil {
+					return err
+				}
+			}
+
+			if isTar {
+				err = untar(tempArtPath, artPath)
+				_ = os.Remove(tempArtPath)
+			} else if isZip {
+				err = unzip(tempArtPath, artPath)
+				_ = os.Remove(tempArtPath)
+			} else {
+				err = os.Rename(tempArtPath, artPath)
+			}
+			if err != nil {
+				return err
+			}
 		}
 
 		log.Infof("Successfully download file: %s", artPath)
@@ -243,11 +253,11 @@ func (we *WorkflowExecutor) StageFiles() error {
 	case wfv1.TemplateTypeScript:
 		log.Infof("Loading script source to %s", common.ExecutorScriptSourcePath)
 		filePath = common.ExecutorScriptSourcePath
-		body = []byte(we.Template.Script.Source)
+		body = []byte(common.ExpandSensitiveParameters(we.Template.Script.Source))
 	case wfv1.TemplateTypeResource:
 		log.Infof("Loading manifest to %s", common.ExecutorResourceManifestPath)
 		filePath = common.ExecutorResourceManifestPath
-		body = []byte(we.Template.Resource.Manifest)
+		body = []byte(common.ExpandSensitiveParameters(we.Template.Resource.Manifest))
 	default:
 		return nil
 	}
@@ -285,6 +295,10 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName stri
 	if art.Path == "" {
 		return errors.InternalErrorf("Artifact %s did not specify a path", art.Name)
 	}
+	streamed, err := we.streamArtifact(ctx, art)
+	if err != nil || streamed {
+		return err
+	}
 	fileName, localArtPath, err := we.stageArchiveFile(containerName, art)
 	if err != nil {
 		if art.Optional && errors.IsCode(errors.CodeNotFound, err) {
@@ -298,17 +312,8 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName stri
 
 // fileBase is probably path.Base(filePath), but can be something else
 func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.Artifact, fileName, localArtPath string) error {
-	if !art.HasKey() {
-		key, err := we.Template.ArchiveLocation.GetKey()
-		if err != nil {
-			return err
-		}
-		if err = art.SetType(we.Template.ArchiveLocation.Get()); err != nil {
-			return err
-		}
-		if err := art.SetKey(path.Join(key, fileName)); err != nil {
-			return err
-		}
+	if err := we.setArtifactKey(art, fileName); err != nil {
+		return err
 	}
 	driverArt, err := we.newDriverArt(art)
 	if err != nil {
@@ -318,15 +323,144 @@ func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.
 	if err != nil {
 		return err
 	}
+	// a directory is saved as one object per file, so has no digest
+	digest, size := "", int64(0)
+	isDir, err := argofile.IsDirectory(localArtPath)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	if !isDir {
+		digest, size, err = digestFile(localArtPath)
+		if err != nil {
+			return err
+		}
+	}
 	err = artDriver.Save(localArtPath, driverArt)
 	if err != nil {
 		return err
 	}
+	art.Digest, art.SizeBytes = digest, size
 	we.maybeDeleteLocalArtPath(localArtPath)
 	log.Infof("Successfully saved file: %s", localArtPath)
 	return nil
 }
 
+// setArtifactKey sets the key of an artifact without one to the file name within the archive location
+func (we *WorkflowExecutor) setArtifactKey(art *wfv1.Artifact, fileName string) error {
+	if art.HasKey() {
+		return nil
+	}
+	key, err := we.Template.ArchiveLocation.GetKey()
+	if err != nil {
+		return err
+	}
+	if err = art.SetType(we.Template.ArchiveLocation.Get()); err != nil {
+		return err
+	}
+	return art.SetKey(path.Join(key, fileName))
+}
+
+// streamArtifact uploads an artifact from a mirrored volume mount straight to the artifact repository, through the
+// archive encoder, rather than staging it as a local file. Artifacts are only streamed if their bucket is configured
+// for streaming and their driver supports it, so it returns false if the artifact must be staged instead.
+func (we *WorkflowExecutor) streamArtifact(ctx context.Context, art *wfv1.Artifact) (bool, error) {
+	strategy := archiveStrategy(art)
+	if strategy.Zip != nil || we.isBaseImagePath(art.Path) {
+		return false, nil
+	}
+	mountedArtPath := filepath.Join(common.ExecutorMainFilesystemDir, art.Path)
+	if !argofile.Exists(mountedArtPath) {
+		// staging reports the missing artifact
+		return false, nil
+	}
+	fileName := fmt.Sprintf("%s.tgz", art.Name)
+	if strategy.None != nil {
+		isDir, err := argofile.IsDirectory(mountedArtPath)
+		if err != nil {
+			return false, errors.InternalWrapError(err)
+		}
+		if isDir {
+			// an unarchived directory is uploaded as one object per file, so cannot be streamed
+			return false, nil
+		}
+		fileName = filepath.Base(art.Path)
+	}
+	streamedArt := art.DeepCopy()
+	if err := we.setArtifactKey(streamedArt, fileName); err != nil {
+		return false, err
+	}
+	driverArt, err := we.newDriverArt(streamedArt)
+	if err != nil {
+		return false, err
+	}
+	if driverArt.GetStreaming() == nil {
+		return false, nil
+	}
+	artDriver, err := we.InitDriver(ctx, driverArt)
+	if err != nil {
+		return false, err
+	}
+	streamer, ok := artDriver.(artifactcommon.ArtifactStreamer)
+	if !ok {
+		return false, nil
+	}
+	log.Infof("Streaming %s from mirrored volume mount %s", art.Path, mountedArtPath)
+	d, err := saveArtifactStream(streamer, driverArt, mountedArtPath, strategy)
+	if err != nil {
+		return false, err
+	}
+	streamedArt.Digest, streamedArt.SizeBytes = d.digest(), d.size
+	*art = *streamedArt
+	log.Infof("Successfully streamed %s from mirrored volume mount %s", art.Path, mountedArtPath)
+	return true, nil
+}
+
+// saveArtifactStream saves the file or directory at the path with the streamer, tar gzipping it on the fly unless the
+// strategy is none, and returns the digester of what was saved
+func saveArtifactStream(streamer artifactcommon.ArtifactStreamer, driverArt *wfv1.Artifact, path string, strategy *wfv1.ArchiveStrategy) (*digester, error) {
+	pr, pw := io.Pipe()
+	go func() {
+		if strategy.None != nil {
+			f, err := os.Open(path)
+			if err != nil {
+				_ = pw.CloseWithError(err)
+				return
+			}
+			defer f.Close()
+			_, err = io.Copy(pw, f)
+			_ = pw.CloseWithError(err)
+			return
+		}
+		_ = pw.CloseWithError(archive.TarGzToWriter(path, compressionLevel(strategy), pw))
+	}()
+	d := newDigester()
+	err := streamer.SaveStream(io.TeeReader(pr, d), driverArt)
+	// unblocks the writer if the upload failed before reading everything
+	_ = pr.Close()
+	return d, err
+}
+
+// archiveStrategy returns the archive strategy of the output artifact, which defaults to tar
+func archiveStrategy(art *wfv1.Artifact) *wfv1.ArchiveStrategy {
+	if art.Archive == nil {
+		return &wfv1.ArchiveStrategy{
+			Tar: &wfv1.TarStrategy{},
+		}
+	}
+	return art.Archive
+}
+
+// compressionLevel returns the gzip compression level of the archive strategy
+func compressionLevel(strategy *wfv1.ArchiveStrategy) int {
+	if strategy.Tar == nil {
+		return gzip.NoCompression
+	}
+	if l := strategy.Tar.CompressionLevel; l != nil {
+		return int(*l)
+	}
+	return gzip.DefaultCompression
+}
+
 func (we *WorkflowExecutor) maybeDeleteLocalArtPath(localArtPath string) {
 	if os.Getenv("REMOVE_LOCAL_ART_PATH") == "true" {
 		log.WithField("localArtPath", localArtPath).Info("deleting local artifact")
@@ -348,21 +482,8 @@ func (we *WorkflowExecutor) maybeDeleteLocalArtPath(localArtPath string) {
 // to the SaveArtifacts call and may be a directory or file.
 func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Artifact) (string, string, error) {
 	log.Infof("Staging artifact: %s", art.Name)
-	strategy := art.Archive
-	if strategy == nil {
-		// If no strategy is specified, default to the tar strategy
-		strategy = &wfv1.ArchiveStrategy{
-			Tar: &wfv1.TarStrategy{},
-		}
-	}
-	compressionLevel := gzip.NoCompression
-	if strategy.Tar != nil {
-		if l := strategy.Tar.CompressionLevel; l != nil {
-			compressionLevel = int(*l)
-		} else {
-			compressionLevel = gzip.DefaultCompression
-		}
-	}
+	strategy := archiveStrategy(art)
+	compressionLevel := compressionLevel(strategy)
 
 	if !we.isBaseImagePath(art.Path) {
 		// If we get here, we are uploading an artifact from a mirrored volume mount which the wait
@@ -539,7 +660,7 @@ func (we *WorkflowExecutor) SaveLogs(ctx context.Context) (*wfv1.Artifact, error
 	if err != nil {
 		return nil, err
 	}
-	art := &wfv1.Artifact{Name: "main-logs"}
+	art := &wfv1.Artifact{Name: common.MainLogsArtifactName}
 	err = we.saveArtifactFromFile(ctx, art, fileName, mainLog)
 	if err != nil {
 		return nil, err
@@ -747,14 +868,94 @@ func isTarball(filePath string) (bool, error) {
 		return false, err
 	}
 	defer f.Close()
-	gzr, err := gzip.NewReader(f)
+	return isTarballStream(f), nil
+}
+
+// isTarballStream returns whether or not the stream starts with a tarball
+func isTarballStream(r io.Reader) bool {
+	gzr, err := gzip.NewReader(r)
 	if err != nil {
-		return false, nil
+		return false
 	}
 	defer gzr.Close()
 	tarr := tar.NewReader(gzr)
 	_, err = tarr.Next()
-	return err == nil, nil
+	return err == nil
+}
+
+// tarballDetectionSize is how much of a stream is buffered to detect if it is a tarball, which is plenty to decompress
+// the header of its first file
+const tarballDetectionSize = 64 * 1024
+
+// loadArtifactStream downloads an artifact whose bucket is configured for streaming to the path, unarchiving it as it
+// is downloaded, rather than staging it as a local file. It returns false if the artifact must be staged instead, e.g.
+// because its driver does not support streaming, or its key is a directory of objects rather than an object.
+func loadArtifactStream(art *wfv1.Artifact, driverArt *wfv1.Artifact, artDriver artifactcommon.ArtifactDriver, artPath string) (bool, error) {
+	streamer, ok := artDriver.(artifactcommon.ArtifactStreamer)
+	if !ok || driverArt.GetStreaming() == nil || art.GetArchive().Zip != nil {
+		return false, nil
+	}
+	rc, err := streamer.OpenStream(driverArt)
+	if errors.IsCode(errors.CodeNotFound, err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer rc.Close()
+	d := newDigester()
+	r := bufio.NewReaderSize(io.TeeReader(rc, d), tarballDetectionSize)
+	isTar := false
+	if art.GetArchive().Tar != nil {
+		isTar = true
+	} else if art.GetArchive().None == nil {
+		// auto-detect if tarball, a short peek just means the artifact is smaller than the buffer
+		head, _ := r.Peek(tarballDetectionSize)
+		isTar = isTarballStream(bytes.NewReader(head))
+	}
+	log.Infof("Streaming artifact %s to %s", art.Name, artPath)
+	if isTar {
+		err = untarStream(r, artPath)
+	} else {
+		err = copyToFile(r, artPath)
+	}
+	if err != nil {
+		return false, err
+	}
+	// the digest is of the whole stream, including anything after the end of the tarball
+	if _, err := io.Copy(ioutil.Discard, r); err != nil {
+		return false, err
+	}
+	return true, verifyDigest(art, d.digest())
+}
+
+func copyToFile(r io.Reader, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	defer f.Close()
+	_, err = io.Copy(f, r)
+	return err
+}
+
+// verifyLoadedArtifact verifies the digest of the artifact loaded to the path
+func verifyLoadedArtifact(art *wfv1.Artifact, path string) error {
+	if art.Digest == "" {
+		return nil
+	}
+	isDir, err := argofile.IsDirectory(path)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	if isDir {
+		return errors.Errorf(errors.CodeBadRequest, "artifact %s has digest %s, but was loaded as a directory which cannot be verified", art.Name, art.Digest)
+	}
+	digest, _, err := digestFile(path)
+	if err != nil {
+		return err
+	}
+	return verifyDigest(art, digest)
 }
 
 // untar extracts a tarball to a temporary directory,
@@ -768,6 +969,28 @@ func untar(tarPath string, destPath string) error {
 	return unpack(tarPath, destPath, decompressor)
 }
 
+// untarStream is like untar, but the gzipped tarball is read from the stream, so that it is extracted in the same way
+// whether or not the artifact is streamed
+func untarStream(r io.Reader, destPath string) error {
+	decompressor := func(_ string, dest string) error {
+		cmd := exec.Command("tar", "-xzf", "-", "-C", dest)
+		cmd.Stdin = r
+		cmdStr := strings.Join(cmd.Args, " ")
+		log.Info(cmdStr)
+		if _, err := cmd.Output(); err != nil {
+			if exErr, ok := err.(*exec.ExitError); ok {
+				errOutput := string(exErr.Stderr)
+				log.Errorf("`%s` failed: %s", cmdStr, errOutput)
+				return errors.InternalError(strings.TrimSpace(errOutput))
+			}
+			return errors.InternalWrapError(err)
+		}
+		return nil
+	}
+
+	return unpack("", destPath, decompressor)
+}
+
 // unzip extracts a zip folder to a temporary directory,
 // renaming it to the desired location
 func unzip(zipPath string, destPath string) error {
@@ -2,8 +2,23 @@ package executor
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/data"
+)
+
+var (
+	// the HTTP data source fails, rather than hangs the pod, if the server is slow to respond
+	httpDataSourceTimeout = 60 * time.Second
+	// the HTTP data source fails, rather than runs out of memory, if the response is too large
+	httpDataSourceMaxSize int64 = 64 * 1024 * 1024
 )
 
 type executorDataSourceProcessor struct {
@@ -36,3 +51,103 @@ func (ep *executorDataSourceProcessor) ProcessArtifactPaths(artifacts *wfv1.Arti
 
 	return files, nil
 }
+
+func (ep *executorDataSourceProcessor) ProcessArtifactFiles(artifactFiles *wfv1.ArtifactFiles) (interface{}, error) {
+	art := &artifactFiles.Artifact
+	driverArt, err := ep.we.newDriverArt(art)
+	if err != nil {
+		return nil, err
+	}
+	artDriver, err := ep.we.InitDriver(ep.ctx, driverArt)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := ioutil.TempDir("", "data")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	// like input artifacts, the artifact is loaded to a temporary location, after which it is extracted if it is a tarball
+	tempArtPath := filepath.Join(dir, "artifact.tmp")
+	artPath := filepath.Join(dir, "artifact")
+	err = artDriver.Load(driverArt, tempArtPath)
+	if err != nil {
+		return nil, err
+	}
+	isTar, err := isTarball(tempArtPath)
+	if err != nil {
+		return nil, err
+	}
+	if isTar {
+		err = untar(tempArtPath, artPath)
+	} else {
+		err = os.Rename(tempArtPath, artPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	files := []interface{}{}
+	err = filepath.Walk(artPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var content interface{} = string(b)
+		if artifactFiles.Format != "" {
+			content, err = data.Parse(artifactFiles.Format, string(b))
+			if err != nil {
+				return fmt.Errorf("unable to parse %s: %w", path, err)
+			}
+		}
+		name, err := filepath.Rel(artPath, path)
+		if err != nil {
+			return err
+		}
+		if name == "." {
+			// the artifact is a single file
+			name = art.Name
+			if key, err := art.GetKey(); err == nil && key != "" {
+				name = filepath.Base(key)
+			}
+		}
+		files = append(files, map[string]interface{}{"path": name, "content": content})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func (ep *executorDataSourceProcessor) ProcessHTTP(source *wfv1.HTTPDataSource) (interface{}, error) {
+	req, err := http.NewRequestWithContext(ep.ctx, http.MethodGet, source.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range source.Headers {
+		req.Header.Add(h.Name, h.Value)
+	}
+	client := &http.Client{Timeout: httpDataSourceTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("GET %s failed: %s", source.URL, resp.Status)
+	}
+	// read one more byte than the limit, so we can tell if the response was truncated
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, httpDataSourceMaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > httpDataSourceMaxSize {
+		return nil, fmt.Errorf("GET %s failed: response is larger than %d bytes", source.URL, httpDataSourceMaxSize)
+	}
+	return data.Parse(wfv1.DataFormatJSON, string(body))
+}
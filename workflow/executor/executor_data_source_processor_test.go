@@ -0,0 +1,66 @@
+package executor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestExecutorDataSourceProcessor(t *testing.T) {
+	ep := newExecutorDataSourceProcessor(context.Background(), &WorkflowExecutor{})
+	t.Run("ArtifactFiles", func(t *testing.T) {
+		art := wfv1.Artifact{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: `{"a": 1}`}}}
+		files, err := ep.ProcessArtifactFiles(&wfv1.ArtifactFiles{Artifact: art})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []interface{}{map[string]interface{}{"path": "my-art", "content": `{"a": 1}`}}, files)
+		}
+		files, err = ep.ProcessArtifactFiles(&wfv1.ArtifactFiles{Artifact: art, Format: wfv1.DataFormatJSON})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []interface{}{map[string]interface{}{"path": "my-art", "content": map[string]interface{}{"a": 1.0}}}, files)
+		}
+	})
+	t.Run("HTTP", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer my-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`[{"name": "foo"}]`))
+		}))
+		defer server.Close()
+		data, err := ep.ProcessHTTP(&wfv1.HTTPDataSource{URL: server.URL, Headers: []wfv1.Header{{Name: "Authorization", Value: "Bearer my-token"}}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []interface{}{map[string]interface{}{"name": "foo"}}, data)
+		}
+		_, err = ep.ProcessHTTP(&wfv1.HTTPDataSource{URL: server.URL})
+		assert.EqualError(t, err, "GET "+server.URL+" failed: 401 Unauthorized")
+	})
+	t.Run("HTTPTooLarge", func(t *testing.T) {
+		defer func(v int64) { httpDataSourceMaxSize = v }(httpDataSourceMaxSize)
+		httpDataSourceMaxSize = 8
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[{"name": "foo"}]`))
+		}))
+		defer server.Close()
+		_, err := ep.ProcessHTTP(&wfv1.HTTPDataSource{URL: server.URL})
+		assert.EqualError(t, err, "GET "+server.URL+" failed: response is larger than 8 bytes")
+	})
+	t.Run("HTTPTimeout", func(t *testing.T) {
+		defer func(v time.Duration) { httpDataSourceTimeout = v }(httpDataSourceTimeout)
+		httpDataSourceTimeout = 10 * time.Millisecond
+		done := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-done
+		}))
+		defer server.Close()
+		defer close(done)
+		_, err := ep.ProcessHTTP(&wfv1.HTTPDataSource{URL: server.URL})
+		assert.Error(t, err)
+	})
+}
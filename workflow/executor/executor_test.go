@@ -1,16 +1,22 @@
 package executor
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
 )
@@ -202,6 +208,158 @@ func TestIsTarball(t *testing.T) {
 	}
 }
 
+// fakeStreamer is an artifact driver that streams artifacts to and from memory
+type fakeStreamer struct {
+	objects map[string][]byte
+}
+
+func (f *fakeStreamer) Load(*wfv1.Artifact, string) error            { return fmt.Errorf("not implemented") }
+func (f *fakeStreamer) Save(string, *wfv1.Artifact) error            { return fmt.Errorf("not implemented") }
+func (f *fakeStreamer) ListObjects(*wfv1.Artifact) ([]string, error) { return nil, nil }
+
+func (f *fakeStreamer) OpenStream(art *wfv1.Artifact) (io.ReadCloser, error) {
+	data, ok := f.objects[art.S3.Key]
+	if !ok {
+		return nil, errors.Errorf(errors.CodeNotFound, "%s not found", art.S3.Key)
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (f *fakeStreamer) SaveStream(reader io.Reader, art *wfv1.Artifact) error {
+	data, err := ioutil.ReadAll(reader)
+	f.objects[art.S3.Key] = data
+	return err
+}
+
+func TestArtifactStreaming(t *testing.T) {
+	dir, err := ioutil.TempDir("", "artifact-streaming")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	src := filepath.Join(dir, "src")
+	assert.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(src, "a.txt"), []byte("a"), 0o644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(src, "sub", "b.txt"), []byte("b"), 0o644))
+
+	driver := &fakeStreamer{objects: map[string][]byte{}}
+	newArt := func(key string, archive *wfv1.ArchiveStrategy) *wfv1.Artifact {
+		return &wfv1.Artifact{
+			Name:    "art",
+			Archive: archive,
+			ArtifactLocation: wfv1.ArtifactLocation{
+				S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Streaming: &wfv1.ArtifactStreaming{}}, Key: key},
+			},
+		}
+	}
+
+	t.Run("Tar", func(t *testing.T) {
+		art := newArt("dir.tgz", nil)
+		d, err := saveArtifactStream(driver, art, src, archiveStrategy(art))
+		assert.NoError(t, err)
+		assert.True(t, isTarballStream(bytes.NewReader(driver.objects["dir.tgz"])))
+		assert.Equal(t, int64(len(driver.objects["dir.tgz"])), d.size)
+		art.Digest = d.digest()
+		dest := filepath.Join(dir, "tar")
+		streamed, err := loadArtifactStream(art, art, driver, dest)
+		assert.NoError(t, err)
+		assert.True(t, streamed)
+		b, err := ioutil.ReadFile(filepath.Join(dest, "sub", "b.txt"))
+		assert.NoError(t, err)
+		assert.Equal(t, "b", string(b))
+	})
+	t.Run("HardLink", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		gzw := gzip.NewWriter(buf)
+		tw := tar.NewWriter(gzw)
+		assert.NoError(t, tw.WriteHeader(&tar.Header{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0o755}))
+		assert.NoError(t, tw.WriteHeader(&tar.Header{Name: "dir/a.txt", Typeflag: tar.TypeReg, Mode: 0o644, Size: 1}))
+		_, err := tw.Write([]byte("a"))
+		assert.NoError(t, err)
+		assert.NoError(t, tw.WriteHeader(&tar.Header{Name: "dir/b.txt", Typeflag: tar.TypeLink, Linkname: "dir/a.txt"}))
+		assert.NoError(t, tw.Close())
+		assert.NoError(t, gzw.Close())
+		driver.objects["link.tgz"] = buf.Bytes()
+		art := newArt("link.tgz", nil)
+		dest := filepath.Join(dir, "link")
+		streamed, err := loadArtifactStream(art, art, driver, dest)
+		assert.NoError(t, err)
+		assert.True(t, streamed)
+		b, err := ioutil.ReadFile(filepath.Join(dest, "b.txt"))
+		assert.NoError(t, err)
+		assert.Equal(t, "a", string(b))
+	})
+	t.Run("None", func(t *testing.T) {
+		art := newArt("a.txt", &wfv1.ArchiveStrategy{None: &wfv1.NoneStrategy{}})
+		d, err := saveArtifactStream(driver, art, filepath.Join(src, "a.txt"), archiveStrategy(art))
+		assert.NoError(t, err)
+		assert.Equal(t, "a", string(driver.objects["a.txt"]))
+		assert.Equal(t, "sha256:ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb", d.digest())
+		dest := filepath.Join(dir, "none.txt")
+		streamed, err := loadArtifactStream(newArt("a.txt", nil), art, driver, dest)
+		assert.NoError(t, err)
+		assert.True(t, streamed)
+		b, err := ioutil.ReadFile(dest)
+		assert.NoError(t, err)
+		assert.Equal(t, "a", string(b))
+	})
+	t.Run("DigestMismatch", func(t *testing.T) {
+		art := newArt("a.txt", nil)
+		art.Digest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+		_, err := loadArtifactStream(art, art, driver, filepath.Join(dir, "mismatch.txt"))
+		assert.EqualError(t, err, "artifact art failed integrity verification: expected digest sha256:0000000000000000000000000000000000000000000000000000000000000000, but got sha256:ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb, it may be corrupt or have been overwritten")
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		art := newArt("missing", nil)
+		streamed, err := loadArtifactStream(art, art, driver, filepath.Join(dir, "missing"))
+		assert.NoError(t, err)
+		assert.False(t, streamed)
+	})
+	t.Run("NotConfigured", func(t *testing.T) {
+		art := newArt("dir.tgz", nil)
+		art.S3.Streaming = nil
+		streamed, err := loadArtifactStream(art, art, driver, filepath.Join(dir, "not-configured"))
+		assert.NoError(t, err)
+		assert.False(t, streamed)
+	})
+	t.Run("SaveError", func(t *testing.T) {
+		art := newArt("missing.tgz", nil)
+		_, err := saveArtifactStream(driver, art, filepath.Join(dir, "missing"), archiveStrategy(art))
+		assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+	})
+}
+
+func TestVerifyLoadedArtifact(t *testing.T) {
+	const digest = "sha256:ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb"
+	dir, err := ioutil.TempDir("", "verify-loaded-artifact")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "a.txt")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("a"), 0o644))
+
+	actual, size, err := digestFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, digest, actual)
+	assert.Equal(t, int64(1), size)
+
+	t.Run("NoDigest", func(t *testing.T) {
+		assert.NoError(t, verifyLoadedArtifact(&wfv1.Artifact{Name: "art"}, path))
+	})
+	t.Run("Match", func(t *testing.T) {
+		assert.NoError(t, verifyLoadedArtifact(&wfv1.Artifact{Name: "art", Digest: digest}, path))
+	})
+	t.Run("Mismatch", func(t *testing.T) {
+		err := verifyLoadedArtifact(&wfv1.Artifact{Name: "art", Digest: "sha256:00"}, path)
+		assert.EqualError(t, err, "artifact art failed integrity verification: expected digest sha256:00, but got "+digest+", it may be corrupt or have been overwritten")
+	})
+	t.Run("UnsupportedAlgorithm", func(t *testing.T) {
+		err := verifyLoadedArtifact(&wfv1.Artifact{Name: "art", Digest: "md5:00"}, path)
+		assert.EqualError(t, err, `artifact art has digest "md5:00" with an unsupported algorithm, must be sha256`)
+	})
+	t.Run("Directory", func(t *testing.T) {
+		err := verifyLoadedArtifact(&wfv1.Artifact{Name: "art", Digest: digest}, dir)
+		assert.Error(t, err)
+	})
+}
+
 func TestUnzip(t *testing.T) {
 	zipPath := "testdata/file.zip"
 	destPath := "testdata/unzippedFile"
@@ -77,7 +77,7 @@ func (h hydrator) Hydrate(wf *wfv1.Workflow) error {
 	if wf.Status.IsOffloadNodeStatus() {
 		var offloadedNodes wfv1.Nodes
 		err := waitutil.Backoff(readRetry, func() (bool, error) {
-			offloadedNodes, err = h.offloadNodeStatusRepo.Get(string(wf.UID), wf.GetOffloadNodeStatusVersion())
+			offloadedNodes, err = h.offloadNodeStatusRepo.Get(string(wf.UID), wf.Namespace, wf.GetOffloadNodeStatusVersion())
 			return !errorsutil.IsTransientErr(err), err
 		})
 		if err != nil {
@@ -86,10 +86,10 @@ func TestHydrator(t *testing.T) {
 	t.Run("Hydrate", func(t *testing.T) {
 		t.Run("Offloaded", func(t *testing.T) {
 			offloadNodeStatusRepo := &sqldbmocks.OffloadNodeStatusRepo{}
-			offloadNodeStatusRepo.On("Get", "my-uid", "my-offload-version").Return(wfv1.Nodes{"foo": wfv1.NodeStatus{}}, nil)
+			offloadNodeStatusRepo.On("Get", "my-uid", "my-ns", "my-offload-version").Return(wfv1.Nodes{"foo": wfv1.NodeStatus{}}, nil)
 			hydrator := New(offloadNodeStatusRepo)
 			wf := &wfv1.Workflow{
-				ObjectMeta: metav1.ObjectMeta{UID: "my-uid"},
+				ObjectMeta: metav1.ObjectMeta{UID: "my-uid", Namespace: "my-ns"},
 				Status:     wfv1.WorkflowStatus{OffloadNodeStatusVersion: "my-offload-version"},
 			}
 			err := hydrator.Hydrate(wf)
@@ -101,10 +101,10 @@ func TestHydrator(t *testing.T) {
 		})
 		t.Run("OffloadingDisabled", func(t *testing.T) {
 			offloadNodeStatusRepo := &sqldbmocks.OffloadNodeStatusRepo{}
-			offloadNodeStatusRepo.On("Get", "my-uid", "my-offload-version").Return(nil, sqldb.OffloadNotSupportedError)
+			offloadNodeStatusRepo.On("Get", "my-uid", "my-ns", "my-offload-version").Return(nil, sqldb.OffloadNotSupportedError)
 			hydrator := New(offloadNodeStatusRepo)
 			wf := &wfv1.Workflow{
-				ObjectMeta: metav1.ObjectMeta{UID: "my-uid"},
+				ObjectMeta: metav1.ObjectMeta{UID: "my-uid", Namespace: "my-ns"},
 				Status:     wfv1.WorkflowStatus{OffloadNodeStatusVersion: "my-offload-version"},
 			}
 			err := hydrator.Hydrate(wf)
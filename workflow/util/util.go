@@ -271,7 +271,10 @@ func ApplySubmitOpts(wf *wfv1.Workflow, opts *wfv1.SubmitOpts) error {
 			if len(parts) != 2 {
 				return fmt.Errorf("expected parameter of the form: NAME=VALUE. Received: %s", paramStr)
 			}
-			param := wfv1.Parameter{Name: parts[0], Value: wfv1.AnyStringPtr(parts[1])}
+			param, err := newSuppliedParameter(wf, parts[0], parts[1])
+			if err != nil {
+				return err
+			}
 			newParams = append(newParams, param)
 			passedParams[param.Name] = true
 		}
@@ -305,11 +308,14 @@ func ApplySubmitOpts(wf *wfv1.Workflow, opts *wfv1.SubmitOpts) error {
 					// the string is already clean.
 					value = string(v)
 				}
-				param := wfv1.Parameter{Name: k, Value: wfv1.AnyStringPtr(value)}
-				if _, ok := passedParams[param.Name]; ok {
+				if _, ok := passedParams[k]; ok {
 					// this parameter was overridden via command line
 					continue
 				}
+				param, err := newSuppliedParameter(wf, k, value)
+				if err != nil {
+					return err
+				}
 				newParams = append(newParams, param)
 				passedParams[param.Name] = true
 			}
@@ -336,6 +342,19 @@ func ApplySubmitOpts(wf *wfv1.Workflow, opts *wfv1.SubmitOpts) error {
 	return nil
 }
 
+// newSuppliedParameter returns the parameter with the supplied value, keeping the schema the workflow declares for it,
+// so that the value is validated now, rather than when the workflow runs
+func newSuppliedParameter(wf *wfv1.Workflow, name, value string) (wfv1.Parameter, error) {
+	param := wfv1.Parameter{Name: name, Value: wfv1.AnyStringPtr(value)}
+	if declared := wf.Spec.Arguments.GetParameterByName(name); declared != nil && declared.Schema != nil {
+		param.Schema = declared.Schema.DeepCopy()
+		if err := param.Schema.Validate(value); err != nil {
+			return param, fmt.Errorf("parameter %q: %w", name, err)
+		}
+	}
+	return param, nil
+}
+
 // SuspendWorkflow suspends a workflow by setting spec.suspend to true. Retries conflict errors
 func SuspendWorkflow(ctx context.Context, wfIf v1alpha1.WorkflowInterface, workflowName string) error {
 	err := waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
@@ -592,7 +611,7 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 	}
 	for key, val := range wf.ObjectMeta.Labels {
 		switch key {
-		case common.LabelKeyCreator, common.LabelKeyPhase, common.LabelKeyCompleted, common.LabelKeyWorkflowArchivingStatus:
+		case common.LabelKeyCreator, common.LabelKeyPhase, common.LabelKeyCompleted, common.LabelKeyWorkflowArchivingStatus, common.LabelKeyWorkflowImported:
 			// ignore
 		default:
 			newWF.ObjectMeta.Labels[key] = val
@@ -699,23 +718,45 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 	if err != nil {
 		return nil, err
 	}
-	switch wf.Status.Phase {
-	case wfv1.WorkflowFailed, wfv1.WorkflowError:
-	default:
-		return nil, errors.Errorf(errors.CodeBadRequest, "workflow must be Failed/Error to retry")
-	}
 	err = hydrator.Hydrate(wf)
 	if err != nil {
 		return nil, err
 	}
+	newWF, podsToDelete, err := FormulateRetryWorkflow(wf, restartSuccessful, nodeFieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	err = deletePods(ctx, kubeClient, wf.Namespace, podsToDelete)
+	if err != nil {
+		return nil, err
+	}
+	err = hydrator.Dehydrate(newWF)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compress or offload workflow nodes: %s", err)
+	}
+	return wfClient.Update(ctx, newWF, metav1.UpdateOptions{})
+}
+
+// FormulateRetryWorkflow formulates a retried workflow from a previous (hydrated) workflow, deleting all failed steps
+// as well as the onExit node (and children). It returns the names of the pods that must be deleted before the
+// workflow is resumed.
+func FormulateRetryWorkflow(wf *wfv1.Workflow, restartSuccessful bool, nodeFieldSelector string) (*wfv1.Workflow, []string, error) {
+	switch wf.Status.Phase {
+	case wfv1.WorkflowFailed, wfv1.WorkflowError:
+	default:
+		return nil, nil, errors.Errorf(errors.CodeBadRequest, "workflow must be Failed/Error to retry")
+	}
 
 	newWF := wf.DeepCopy()
-	podIf := kubeClient.CoreV1().Pods(wf.ObjectMeta.Namespace)
 
 	// Delete/reset fields which indicate workflow completed
 	delete(newWF.Labels, common.LabelKeyCompleted)
 	delete(newWF.Labels, common.LabelKeyWorkflowArchivingStatus)
+	delete(newWF.Labels, common.LabelKeyWorkflowImported)
 	newWF.Status.Conditions.UpsertCondition(wfv1.Condition{Status: metav1.ConditionFalse, Type: wfv1.ConditionTypeCompleted})
+	if newWF.ObjectMeta.Labels == nil {
+		newWF.ObjectMeta.Labels = make(map[string]string)
+	}
 	newWF.ObjectMeta.Labels[common.LabelKeyPhase] = string(wfv1.NodeRunning)
 	newWF.Status.Phase = wfv1.WorkflowRunning
 	newWF.Status.Nodes = make(wfv1.Nodes)
@@ -731,11 +772,12 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 	// Get all children of nodes that match filter
 	nodeIDsToReset, err := getNodeIDsToReset(restartSuccessful, nodeFieldSelector, wf.Status.Nodes)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Iterate the previous nodes. If it was successful Pod carry it forward
 	deletedNodes := make(map[string]bool)
+	var podsToDelete []string
 	for _, node := range wf.Status.Nodes {
 		doForceResetNode := false
 		if _, present := nodeIDsToReset[node.ID]; present {
@@ -762,14 +804,10 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 			// do not add this status to the node. pretend as if this node never existed.
 		default:
 			// Do not allow retry of workflows with pods in Running/Pending phase
-			return nil, errors.InternalErrorf("Workflow cannot be retried with node %s in %s phase", node.Name, node.Phase)
+			return nil, nil, errors.InternalErrorf("Workflow cannot be retried with node %s in %s phase", node.Name, node.Phase)
 		}
 		if node.Type == wfv1.NodeTypePod {
-			log.Infof("Deleting pod: %s", node.ID)
-			err := podIf.Delete(ctx, node.ID, metav1.DeleteOptions{})
-			if err != nil && !apierr.IsNotFound(err) {
-				return nil, errors.InternalWrapError(err)
-			}
+			podsToDelete = append(podsToDelete, node.ID)
 		} else if node.Name == wf.ObjectMeta.Name {
 			newNode := node.DeepCopy()
 			newNode.Phase = wfv1.NodeRunning
@@ -802,17 +840,24 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 		}
 	}
 
-	err = hydrator.Dehydrate(newWF)
-	if err != nil {
-		return nil, fmt.Errorf("unable to compress or offload workflow nodes: %s", err)
-	}
-
 	newWF.Status.StoredTemplates = make(map[string]wfv1.Template)
 	for id, tmpl := range wf.Status.StoredTemplates {
 		newWF.Status.StoredTemplates[id] = tmpl
 	}
 
-	return wfClient.Update(ctx, newWF, metav1.UpdateOptions{})
+	return newWF, podsToDelete, nil
+}
+
+func deletePods(ctx context.Context, kubeClient kubernetes.Interface, namespace string, podNames []string) error {
+	podIf := kubeClient.CoreV1().Pods(namespace)
+	for _, podName := range podNames {
+		log.Infof("Deleting pod: %s", podName)
+		err := podIf.Delete(ctx, podName, metav1.DeleteOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return errors.InternalWrapError(err)
+		}
+	}
+	return nil
 }
 
 func getNodeIDsToReset(restartSuccessful bool, nodeFieldSelector string, nodes wfv1.Nodes) (map[string]bool, error) {
@@ -525,6 +525,24 @@ func TestApplySubmitOpts(t *testing.T) {
 			assert.Equal(t, "81861780812", parameters[0].Value.String())
 		}
 	})
+	t.Run("TypedParameters", func(t *testing.T) {
+		wf := &wfv1.Workflow{
+			Spec: wfv1.WorkflowSpec{
+				Arguments: wfv1.Arguments{
+					Parameters: []wfv1.Parameter{{Name: "a", Value: wfv1.AnyStringPtr("0"), Schema: &wfv1.ParameterSchema{Type: wfv1.ParameterTypeInteger}}},
+				},
+			},
+		}
+		err := ApplySubmitOpts(wf, &wfv1.SubmitOpts{Parameters: []string{"a=one"}})
+		assert.EqualError(t, err, `parameter "a": value "one" must be JSON of type integer`)
+		err = ApplySubmitOpts(wf, &wfv1.SubmitOpts{Parameters: []string{"a=1"}})
+		assert.NoError(t, err)
+		parameters := wf.Spec.Arguments.Parameters
+		if assert.Len(t, parameters, 1) {
+			assert.Equal(t, "1", parameters[0].Value.String())
+			assert.NotNil(t, parameters[0].Schema)
+		}
+	})
 	t.Run("ParameterFile", func(t *testing.T) {
 		wf := &wfv1.Workflow{}
 		file, err := ioutil.TempFile("", "")
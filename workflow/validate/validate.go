@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/antonmedv/expr"
 	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -146,6 +147,12 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	if wf.Spec.WorkflowTemplateRef != nil {
 		wfArgs.Parameters = util.MergeParameters(wfArgs.Parameters, wfSpecHolder.GetWorkflowSpec().Arguments.Parameters)
 		wfArgs.Artifacts = util.MergeArtifacts(wfArgs.Artifacts, wfSpecHolder.GetWorkflowSpec().Arguments.Artifacts)
+		// the values may be supplied by the workflow, but the schemas are declared by the template
+		for i, param := range wfArgs.Parameters {
+			if declared := wfSpecHolder.GetWorkflowSpec().Arguments.GetParameterByName(param.Name); param.Schema == nil && declared != nil {
+				wfArgs.Parameters[i].Schema = declared.Schema
+			}
+		}
 	}
 	if err != nil {
 		return nil, errors.Errorf(errors.CodeBadRequest, "spec.templates%s", err.Error())
@@ -154,6 +161,9 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 		// if we are just linting we don't care if spec.arguments.parameters.XXX doesn't have an
 		// explicit value. workflows without a default value is a desired use case
 		err = validateArgumentsFieldNames("spec.arguments.", wfArgs)
+		if err == nil {
+			err = validateParameterValues("spec.arguments.", wfArgs.Parameters)
+		}
 	} else {
 		err = validateArguments("spec.arguments.", wfArgs)
 	}
@@ -280,8 +290,32 @@ func ValidateCronWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamesp
 		return fmt.Errorf("cron workflow name %q must not be more than 52 characters long (currently %d)", cronWf.Name, len(cronWf.Name))
 	}
 
-	if _, err := cron.ParseStandard(cronWf.Spec.Schedule); err != nil {
-		return errors.Errorf(errors.CodeBadRequest, "cron schedule is malformed: %s", err)
+	schedules := cronWf.Spec.GetSchedules()
+	if len(schedules) == 0 {
+		return errors.Errorf(errors.CodeBadRequest, "cron workflow must have a schedule")
+	}
+	for _, schedule := range schedules {
+		if _, err := cron.ParseStandard(schedule); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "cron schedule is malformed: %s", err)
+		}
+	}
+
+	if cronWf.Spec.When != "" {
+		if _, err := expr.Compile(cronWf.Spec.When); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "when expression is malformed: %s", err)
+		}
+	}
+
+	if cronWf.Spec.Exclusions != nil {
+		for _, date := range cronWf.Spec.Exclusions.Dates {
+			if _, err := time.Parse("2006-01-02", date); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "exclusion date %q must be in YYYY-MM-DD format", date)
+			}
+		}
+	}
+
+	if cronWf.Spec.StopStrategy != nil && cronWf.Spec.StopStrategy.ConsecutiveFailures < 1 {
+		return errors.Errorf(errors.CodeBadRequest, "stopStrategy.consecutiveFailures must be at least 1")
 	}
 
 	switch cronWf.Spec.ConcurrencyPolicy {
@@ -340,6 +374,10 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 	if err != nil {
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s %s", tmpl.Name, err)
 	}
+	err = validateParameterValues(fmt.Sprintf("templates.%s.inputs.parameters.", tmpl.Name), newTmpl.Inputs.Parameters)
+	if err != nil {
+		return err
+	}
 
 	if newTmpl.Timeout != "" {
 		if !newTmpl.IsLeaf() {
@@ -528,10 +566,29 @@ func validateArtifactLocation(errPrefix string, art wfv1.ArtifactLocation) error
 			return err
 		}
 	}
+	err := validateArtifactStreaming(errPrefix, art)
+	if err != nil {
+		return err
+	}
 	// TODO: validate other artifact locations
 	return nil
 }
 
+// validateArtifactStreaming validates that the streaming of multipart uploads is configured as S3 and OSS require
+func validateArtifactStreaming(errPrefix string, art wfv1.ArtifactLocation) error {
+	if art.S3 != nil && art.S3.Streaming != nil {
+		if err := art.S3.Streaming.ValidateMultipart(); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s.s3.%v", errPrefix, err)
+		}
+	}
+	if art.OSS != nil && art.OSS.Streaming != nil {
+		if err := art.OSS.Streaming.ValidateMultipart(); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s.oss.%v", errPrefix, err)
+		}
+	}
+	return nil
+}
+
 // resolveAllVariables is a helper to ensure all {{variables}} are resolveable from current scope
 func resolveAllVariables(scope map[string]interface{}, tmplStr string) error {
 	_, allowAllItemRefs := scope[anyItemMagicValue] // 'item.*' is a magic placeholder value set by addItemsToScope
@@ -632,6 +689,12 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.script.image may not be empty", tmpl.Name)
 		}
 	}
+	if tmpl.Data != nil {
+		err := validateData(tmpl)
+		if err != nil {
+			return err
+		}
+	}
 	if tmpl.ActiveDeadlineSeconds != nil {
 		if !intstr.IsValidIntOrArgoVariable(tmpl.ActiveDeadlineSeconds) && !placeholderGenerator.IsPlaceholder(tmpl.ActiveDeadlineSeconds.StrVal) {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.activeDeadlineSeconds must be a positive integer > 0 or an argo variable", tmpl.Name)
@@ -661,6 +724,58 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 	return nil
 }
 
+func validateData(tmpl *wfv1.Template) error {
+	source := tmpl.Data.Source
+	numSources := 0
+	for _, set := range []bool{source.ArtifactPaths != nil, source.ArtifactFiles != nil, source.HTTP != nil, source.Value != nil} {
+		if set {
+			numSources++
+		}
+	}
+	if numSources != 1 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.source must have exactly one of: artifactPaths, artifactFiles, http, value", tmpl.Name)
+	}
+	if source.ArtifactFiles != nil {
+		if err := validateDataFormat(source.ArtifactFiles.Format); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.source.artifactFiles.format %s", tmpl.Name, err)
+		}
+	}
+	if source.HTTP != nil && source.HTTP.URL == "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.source.http.url may not be empty", tmpl.Name)
+	}
+	for i, step := range tmpl.Data.Transformation {
+		numTransformations := 0
+		for _, set := range []bool{step.Expression != "", step.Parse != "", step.Filter != "", step.Map != "", step.GroupBy != "", step.Chunk != 0} {
+			if set {
+				numTransformations++
+			}
+		}
+		if numTransformations != 1 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.transformation[%d] must have exactly one of: expression, parse, filter, map, groupBy, chunk", tmpl.Name, i)
+		}
+		if step.Parse != "" {
+			if err := validateDataFormat(step.Parse); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.transformation[%d].parse %s", tmpl.Name, i, err)
+			}
+		}
+		if step.Chunk < 0 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.transformation[%d].chunk must be greater than zero", tmpl.Name, i)
+		}
+	}
+	if tmpl.Data.OutputArtifact != "" && tmpl.Outputs.GetArtifactByName(tmpl.Data.OutputArtifact) == nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.outputArtifact %q is not an output artifact of the template", tmpl.Name, tmpl.Data.OutputArtifact)
+	}
+	return nil
+}
+
+func validateDataFormat(format wfv1.DataFormat) error {
+	switch format {
+	case "", wfv1.DataFormatJSON, wfv1.DataFormatCSV:
+		return nil
+	}
+	return fmt.Errorf("%q is not one of: json, csv", format)
+}
+
 func validateArguments(prefix string, arguments wfv1.Arguments) error {
 	err := validateArgumentsFieldNames(prefix, arguments)
 	if err != nil {
@@ -705,6 +820,10 @@ func validateArgumentsValues(prefix string, arguments wfv1.Arguments) error {
 			}
 		}
 	}
+	err := validateParameterValues(prefix, arguments.Parameters)
+	if err != nil {
+		return err
+	}
 	for _, art := range arguments.Artifacts {
 		if art.From == "" && !art.HasLocationOrKey() {
 			return errors.Errorf(errors.CodeBadRequest, "%s%s.from, artifact location, or key is required", prefix, art.Name)
@@ -716,6 +835,29 @@ func validateArgumentsValues(prefix string, arguments wfv1.Arguments) error {
 	return nil
 }
 
+// validateParameterValues ensures that the schemas of the parameters are valid, and that the values known before the
+// workflow runs match them
+func validateParameterValues(prefix string, params []wfv1.Parameter) error {
+	for _, param := range params {
+		if param.Schema == nil {
+			continue
+		}
+		err := param.Schema.ValidateSchema()
+		if err == nil && param.Value != nil && isLiteral(param.Value.String()) {
+			err = param.Schema.Validate(param.Value.String())
+		}
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s%s.%s", prefix, param.Name, err.Error())
+		}
+	}
+	return nil
+}
+
+// isLiteral returns whether the value does not depend on variables, which are replaced by placeholders when validating
+func isLiteral(value string) bool {
+	return !strings.Contains(value, "{{") && !placeholderGenerator.ContainsPlaceholder(value)
+}
+
 func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tmplCtx *templateresolution.Context, tmpl *wfv1.Template) error {
 	err := validateNonLeaf(tmpl)
 	if err != nil {
@@ -894,7 +1036,8 @@ func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 	for _, art := range tmpl.Outputs.Artifacts {
 		artRef := fmt.Sprintf("outputs.artifacts.%s", art.Name)
 		if tmpl.IsLeaf() {
-			if art.Path == "" {
+			// data templates save their result to their output artifact, rather than a path
+			if art.Path == "" && tmpl.Data == nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.path not specified", tmpl.Name, artRef)
 			}
 		} else {
@@ -908,6 +1051,10 @@ func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.globalName: %s", tmpl.Name, artRef, errs[0])
 			}
 		}
+		err = validateArtifactStreaming(fmt.Sprintf("templates.%s.%s", tmpl.Name, artRef), art.ArtifactLocation)
+		if err != nil {
+			return err
+		}
 	}
 	for _, param := range tmpl.Outputs.Parameters {
 		paramRef := fmt.Sprintf("templates.%s.outputs.parameters.%s", tmpl.Name, param.Name)
@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 
@@ -1294,6 +1296,14 @@ func TestPodNameVariable(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestArtifactStreamingPartSize(t *testing.T) {
+	wf := unmarshalWf(podNameVariable)
+	partSize := resource.MustParse("1Mi")
+	wf.Spec.Templates[0].Outputs.Artifacts[0].S3.Streaming = &wfv1.ArtifactStreaming{PartSize: &partSize}
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.pod-name-variable.outputs.artifacts.my-out.s3.streaming.partSize 1Mi is less than the minimum of 5Mi")
+}
+
 func TestGlobalParamWithVariable(t *testing.T) {
 	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, test.LoadE2EWorkflow("functional/global-outputs-variable.yaml"), ValidateOpts{})
 
@@ -2669,6 +2679,93 @@ func TestWorkflowTemplateWithArgumentValueNotFromEnumList(t *testing.T) {
 	assert.EqualError(t, err, "spec.arguments.message.value should be present in spec.arguments.message.enum list")
 }
 
+var workflowWithTypedParameters = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: typed-parameters-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+      - name: count
+        value: "3"
+        schema:
+          type: integer
+          minimum: 1
+          maximum: 10
+  templates:
+    - name: main
+      steps:
+        - - name: sum
+            template: sum
+            arguments:
+              parameters:
+                - name: numbers
+                  value: "[1, 2, {{workflow.parameters.count}}]"
+                - name: mode
+                  value: fast
+    - name: sum
+      inputs:
+        parameters:
+          - name: numbers
+            schema:
+              type: array
+              items:
+                type: integer
+          - name: mode
+            schema:
+              pattern: ^(fast|slow)$
+      container:
+        image: argoproj/argosay:v2
+        args: [echo, "{{inputs.parameters.numbers}}", "{{inputs.parameters.mode}}"]
+`
+
+func TestTypedParameters(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		_, err := validate(workflowWithTypedParameters)
+		assert.NoError(t, err)
+	})
+	t.Run("InvalidArgument", func(t *testing.T) {
+		wf := unmarshalWf(workflowWithTypedParameters)
+		wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("11")
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "spec.arguments.count.value 11 must be at most 10")
+	})
+	t.Run("InvalidInput", func(t *testing.T) {
+		wf := unmarshalWf(workflowWithTypedParameters)
+		wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("3")
+		wf.Spec.Templates[0].Steps[0].Steps[0].Arguments.Parameters[1].Value = wfv1.AnyStringPtr("medium")
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, `templates.main.steps[0].sum templates.sum.inputs.parameters.mode.value "medium" must match "^(fast|slow)$"`)
+	})
+	t.Run("InvalidInputFromVariable", func(t *testing.T) {
+		wf := unmarshalWf(workflowWithTypedParameters)
+		wf.Spec.Arguments.Parameters[0].Schema = nil
+		wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("three")
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, `templates.main.steps[0].sum templates.sum.inputs.parameters.numbers.value "[1, 2, three]" must be JSON of type array`)
+	})
+	t.Run("InvalidInputLikePlaceholder", func(t *testing.T) {
+		wf := unmarshalWf(workflowWithTypedParameters)
+		wf.Spec.Templates[0].Steps[0].Steps[0].Arguments.Parameters[1].Value = wfv1.AnyStringPtr("my-placeholder-x")
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, `templates.main.steps[0].sum templates.sum.inputs.parameters.mode.value "my-placeholder-x" must match "^(fast|slow)$"`)
+	})
+	t.Run("InputFromPlaceholder", func(t *testing.T) {
+		wf := unmarshalWf(workflowWithTypedParameters)
+		wf.Spec.Templates[0].Steps[0].Steps[0].Arguments.Parameters[0].Value = wfv1.AnyStringPtr("[1, {{workflow.name}}]")
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err)
+	})
+	t.Run("InvalidSchema", func(t *testing.T) {
+		wf := unmarshalWf(workflowWithTypedParameters)
+		wf.Spec.Arguments.Parameters[0].Schema.Type = "int"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, `spec.arguments.count.schema.type "int" is not one of: string, integer, number, boolean, array, object`)
+	})
+}
+
 var validActiveDeadlineSecondsArgoVariable = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -2730,3 +2827,116 @@ func TestMaxLengthName(t *testing.T) {
 	err = ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf)
 	assert.EqualError(t, err, "cron workflow name \"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\" must not be more than 52 characters long (currently 60)")
 }
+
+func TestValidateCronWorkflowSchedules(t *testing.T) {
+	newCronWf := func(spec wfv1.CronWorkflowSpec) *wfv1.CronWorkflow {
+		spec.WorkflowSpec = wfv1.WorkflowSpec{Entrypoint: "main", Templates: []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "argoproj/argosay:v2"}}}}
+		return &wfv1.CronWorkflow{ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"}, Spec: spec}
+	}
+	t.Run("NoSchedule", func(t *testing.T) {
+		err := ValidateCronWorkflow(wftmplGetter, cwftmplGetter, newCronWf(wfv1.CronWorkflowSpec{}))
+		assert.EqualError(t, err, "cron workflow must have a schedule")
+	})
+	t.Run("Schedules", func(t *testing.T) {
+		err := ValidateCronWorkflow(wftmplGetter, cwftmplGetter, newCronWf(wfv1.CronWorkflowSpec{Schedules: []string{"0 18 * * 1-5", "0 20 28-31 * *"}}))
+		assert.NoError(t, err)
+	})
+	t.Run("MalformedSchedules", func(t *testing.T) {
+		err := ValidateCronWorkflow(wftmplGetter, cwftmplGetter, newCronWf(wfv1.CronWorkflowSpec{Schedule: "* * * * *", Schedules: []string{"foo"}}))
+		assert.Error(t, err)
+	})
+	t.Run("MalformedWhen", func(t *testing.T) {
+		err := ValidateCronWorkflow(wftmplGetter, cwftmplGetter, newCronWf(wfv1.CronWorkflowSpec{Schedule: "* * * * *", When: "!("}))
+		assert.Error(t, err)
+	})
+	t.Run("MalformedExclusion", func(t *testing.T) {
+		err := ValidateCronWorkflow(wftmplGetter, cwftmplGetter, newCronWf(wfv1.CronWorkflowSpec{Schedule: "* * * * *", Exclusions: &wfv1.CronExclusions{Dates: []string{"25/12/2021"}}}))
+		assert.EqualError(t, err, `exclusion date "25/12/2021" must be in YYYY-MM-DD format`)
+	})
+	t.Run("StopStrategy", func(t *testing.T) {
+		err := ValidateCronWorkflow(wftmplGetter, cwftmplGetter, newCronWf(wfv1.CronWorkflowSpec{Schedule: "* * * * *", StopStrategy: &wfv1.StopStrategy{}}))
+		assert.EqualError(t, err, "stopStrategy.consecutiveFailures must be at least 1")
+	})
+}
+
+func TestDataTemplate(t *testing.T) {
+	newWf := func(data string) string {
+		return `
+metadata:
+  name: my-wf
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    outputs:
+      artifacts:
+      - name: batches
+    data:
+` + data
+	}
+	t.Run("Valid", func(t *testing.T) {
+		_, err := validate(newWf(`
+      source:
+        value: '[1, 2, 3]'
+      transformation:
+      - filter: item > 1
+      - chunk: 2
+      outputArtifact: batches
+`))
+		assert.NoError(t, err)
+	})
+	t.Run("NoSource", func(t *testing.T) {
+		_, err := validate(newWf(`
+      source: {}
+      transformation: []
+`))
+		assert.EqualError(t, err, "templates.main.data.source must have exactly one of: artifactPaths, artifactFiles, http, value")
+	})
+	t.Run("EmptyValue", func(t *testing.T) {
+		_, err := validate(newWf(`
+      source:
+        value: ''
+      transformation: []
+      outputArtifact: batches
+`))
+		assert.NoError(t, err)
+	})
+	t.Run("MultipleSources", func(t *testing.T) {
+		_, err := validate(newWf(`
+      source:
+        value: '[]'
+        http:
+          url: http://my-url
+      transformation: []
+`))
+		assert.EqualError(t, err, "templates.main.data.source must have exactly one of: artifactPaths, artifactFiles, http, value")
+	})
+	t.Run("MultipleTransformations", func(t *testing.T) {
+		_, err := validate(newWf(`
+      source:
+        value: '[]'
+      transformation:
+      - filter: item > 1
+        map: item
+`))
+		assert.EqualError(t, err, "templates.main.data.transformation[0] must have exactly one of: expression, parse, filter, map, groupBy, chunk")
+	})
+	t.Run("UnknownFormat", func(t *testing.T) {
+		_, err := validate(newWf(`
+      source:
+        value: '[]'
+      transformation:
+      - parse: xml
+`))
+		assert.EqualError(t, err, `templates.main.data.transformation[0].parse "xml" is not one of: json, csv`)
+	})
+	t.Run("UnknownOutputArtifact", func(t *testing.T) {
+		_, err := validate(newWf(`
+      source:
+        value: '[]'
+      transformation: []
+      outputArtifact: foo
+`))
+		assert.EqualError(t, err, `templates.main.data.outputArtifact "foo" is not an output artifact of the template`)
+	})
+}